@@ -1778,13 +1778,65 @@ func (x *fastReflection_ModuleCredential) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedFeeDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_max_memo_characters       protoreflect.FieldDescriptor
-	fd_Params_tx_sig_limit              protoreflect.FieldDescriptor
-	fd_Params_tx_size_cost_per_byte     protoreflect.FieldDescriptor
-	fd_Params_sig_verify_cost_ed25519   protoreflect.FieldDescriptor
-	fd_Params_sig_verify_cost_secp256k1 protoreflect.FieldDescriptor
+	md_Params                                        protoreflect.MessageDescriptor
+	fd_Params_max_memo_characters                    protoreflect.FieldDescriptor
+	fd_Params_tx_sig_limit                           protoreflect.FieldDescriptor
+	fd_Params_tx_size_cost_per_byte                  protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_ed25519                protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_secp256k1              protoreflect.FieldDescriptor
+	fd_Params_max_pub_key_rotations                  protoreflect.FieldDescriptor
+	fd_Params_pub_key_rotation_gas_cost              protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_multisig_per_signature protoreflect.FieldDescriptor
+	fd_Params_simulation_signature_size              protoreflect.FieldDescriptor
+	fd_Params_allowed_fee_denoms                     protoreflect.FieldDescriptor
+	fd_Params_max_refund_rate                        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1795,6 +1847,12 @@ func init() {
 	fd_Params_tx_size_cost_per_byte = md_Params.Fields().ByName("tx_size_cost_per_byte")
 	fd_Params_sig_verify_cost_ed25519 = md_Params.Fields().ByName("sig_verify_cost_ed25519")
 	fd_Params_sig_verify_cost_secp256k1 = md_Params.Fields().ByName("sig_verify_cost_secp256k1")
+	fd_Params_max_pub_key_rotations = md_Params.Fields().ByName("max_pub_key_rotations")
+	fd_Params_pub_key_rotation_gas_cost = md_Params.Fields().ByName("pub_key_rotation_gas_cost")
+	fd_Params_sig_verify_cost_multisig_per_signature = md_Params.Fields().ByName("sig_verify_cost_multisig_per_signature")
+	fd_Params_simulation_signature_size = md_Params.Fields().ByName("simulation_signature_size")
+	fd_Params_allowed_fee_denoms = md_Params.Fields().ByName("allowed_fee_denoms")
+	fd_Params_max_refund_rate = md_Params.Fields().ByName("max_refund_rate")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -1892,6 +1950,42 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxPubKeyRotations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPubKeyRotations)
+		if !f(fd_Params_max_pub_key_rotations, value) {
+			return
+		}
+	}
+	if x.PubKeyRotationGasCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PubKeyRotationGasCost)
+		if !f(fd_Params_pub_key_rotation_gas_cost, value) {
+			return
+		}
+	}
+	if x.SigVerifyCostMultisigPerSignature != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigVerifyCostMultisigPerSignature)
+		if !f(fd_Params_sig_verify_cost_multisig_per_signature, value) {
+			return
+		}
+	}
+	if x.SimulationSignatureSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SimulationSignatureSize)
+		if !f(fd_Params_simulation_signature_size, value) {
+			return
+		}
+	}
+	if len(x.AllowedFeeDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.AllowedFeeDenoms})
+		if !f(fd_Params_allowed_fee_denoms, value) {
+			return
+		}
+	}
+	if x.MaxRefundRate != "" {
+		value := protoreflect.ValueOfString(x.MaxRefundRate)
+		if !f(fd_Params_max_refund_rate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1917,6 +2011,18 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SigVerifyCostEd25519 != uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return x.SigVerifyCostSecp256K1 != uint64(0)
+	case "cosmos.auth.v1beta1.Params.max_pub_key_rotations":
+		return x.MaxPubKeyRotations != uint64(0)
+	case "cosmos.auth.v1beta1.Params.pub_key_rotation_gas_cost":
+		return x.PubKeyRotationGasCost != uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_multisig_per_signature":
+		return x.SigVerifyCostMultisigPerSignature != uint64(0)
+	case "cosmos.auth.v1beta1.Params.simulation_signature_size":
+		return x.SimulationSignatureSize != uint64(0)
+	case "cosmos.auth.v1beta1.Params.allowed_fee_denoms":
+		return len(x.AllowedFeeDenoms) != 0
+	case "cosmos.auth.v1beta1.Params.max_refund_rate":
+		return x.MaxRefundRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1943,6 +2049,18 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SigVerifyCostEd25519 = uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = uint64(0)
+	case "cosmos.auth.v1beta1.Params.max_pub_key_rotations":
+		x.MaxPubKeyRotations = uint64(0)
+	case "cosmos.auth.v1beta1.Params.pub_key_rotation_gas_cost":
+		x.PubKeyRotationGasCost = uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_multisig_per_signature":
+		x.SigVerifyCostMultisigPerSignature = uint64(0)
+	case "cosmos.auth.v1beta1.Params.simulation_signature_size":
+		x.SimulationSignatureSize = uint64(0)
+	case "cosmos.auth.v1beta1.Params.allowed_fee_denoms":
+		x.AllowedFeeDenoms = nil
+	case "cosmos.auth.v1beta1.Params.max_refund_rate":
+		x.MaxRefundRate = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1974,6 +2092,27 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		value := x.SigVerifyCostSecp256K1
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.max_pub_key_rotations":
+		value := x.MaxPubKeyRotations
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.pub_key_rotation_gas_cost":
+		value := x.PubKeyRotationGasCost
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_multisig_per_signature":
+		value := x.SigVerifyCostMultisigPerSignature
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.simulation_signature_size":
+		value := x.SimulationSignatureSize
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.allowed_fee_denoms":
+		if len(x.AllowedFeeDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.AllowedFeeDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.Params.max_refund_rate":
+		value := x.MaxRefundRate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2004,6 +2143,20 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SigVerifyCostEd25519 = value.Uint()
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = value.Uint()
+	case "cosmos.auth.v1beta1.Params.max_pub_key_rotations":
+		x.MaxPubKeyRotations = value.Uint()
+	case "cosmos.auth.v1beta1.Params.pub_key_rotation_gas_cost":
+		x.PubKeyRotationGasCost = value.Uint()
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_multisig_per_signature":
+		x.SigVerifyCostMultisigPerSignature = value.Uint()
+	case "cosmos.auth.v1beta1.Params.simulation_signature_size":
+		x.SimulationSignatureSize = value.Uint()
+	case "cosmos.auth.v1beta1.Params.allowed_fee_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.AllowedFeeDenoms = *clv.list
+	case "cosmos.auth.v1beta1.Params.max_refund_rate":
+		x.MaxRefundRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2024,6 +2177,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.allowed_fee_denoms":
+		if x.AllowedFeeDenoms == nil {
+			x.AllowedFeeDenoms = []string{}
+		}
+		value := &_Params_10_list{list: &x.AllowedFeeDenoms}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.auth.v1beta1.Params.max_memo_characters":
 		panic(fmt.Errorf("field max_memo_characters of message cosmos.auth.v1beta1.Params is not mutable"))
 	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
@@ -2034,6 +2193,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field sig_verify_cost_ed25519 of message cosmos.auth.v1beta1.Params is not mutable"))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		panic(fmt.Errorf("field sig_verify_cost_secp256k1 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.max_pub_key_rotations":
+		panic(fmt.Errorf("field max_pub_key_rotations of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.pub_key_rotation_gas_cost":
+		panic(fmt.Errorf("field pub_key_rotation_gas_cost of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_multisig_per_signature":
+		panic(fmt.Errorf("field sig_verify_cost_multisig_per_signature of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.simulation_signature_size":
+		panic(fmt.Errorf("field simulation_signature_size of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.max_refund_rate":
+		panic(fmt.Errorf("field max_refund_rate of message cosmos.auth.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2057,6 +2226,19 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.max_pub_key_rotations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.pub_key_rotation_gas_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_multisig_per_signature":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.simulation_signature_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.allowed_fee_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "cosmos.auth.v1beta1.Params.max_refund_rate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2141,6 +2323,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.SigVerifyCostSecp256K1 != 0 {
 			n += 1 + runtime.Sov(uint64(x.SigVerifyCostSecp256K1))
 		}
+		if x.MaxPubKeyRotations != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPubKeyRotations))
+		}
+		if x.PubKeyRotationGasCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.PubKeyRotationGasCost))
+		}
+		if x.SigVerifyCostMultisigPerSignature != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigVerifyCostMultisigPerSignature))
+		}
+		if x.SimulationSignatureSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.SimulationSignatureSize))
+		}
+		if len(x.AllowedFeeDenoms) > 0 {
+			for _, s := range x.AllowedFeeDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxRefundRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2170,6 +2374,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxRefundRate) > 0 {
+			i -= len(x.MaxRefundRate)
+			copy(dAtA[i:], x.MaxRefundRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRefundRate)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.AllowedFeeDenoms) > 0 {
+			for iNdEx := len(x.AllowedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedFeeDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedFeeDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedFeeDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.SimulationSignatureSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SimulationSignatureSize))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.SigVerifyCostMultisigPerSignature != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostMultisigPerSignature))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PubKeyRotationGasCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PubKeyRotationGasCost))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxPubKeyRotations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPubKeyRotations))
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.SigVerifyCostSecp256K1 != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostSecp256K1))
 			i--
@@ -2339,6 +2579,146 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPubKeyRotations", wireType)
+				}
+				x.MaxPubKeyRotations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPubKeyRotations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationGasCost", wireType)
+				}
+				x.PubKeyRotationGasCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PubKeyRotationGasCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostMultisigPerSignature", wireType)
+				}
+				x.SigVerifyCostMultisigPerSignature = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigVerifyCostMultisigPerSignature |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SimulationSignatureSize", wireType)
+				}
+				x.SimulationSignatureSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SimulationSignatureSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedFeeDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedFeeDenoms = append(x.AllowedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRefundRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxRefundRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2561,6 +2941,25 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostEd25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256K1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// max_pub_key_rotations is the maximum number of times the public key of an
+	// account can be rotated with MsgRotatePubKey. Zero disables the rotations.
+	MaxPubKeyRotations uint64 `protobuf:"varint,6,opt,name=max_pub_key_rotations,json=maxPubKeyRotations,proto3" json:"max_pub_key_rotations,omitempty"`
+	// pub_key_rotation_gas_cost is the gas consumed by MsgRotatePubKey, on top of
+	// the gas of the transaction, to discourage abuse.
+	PubKeyRotationGasCost uint64 `protobuf:"varint,7,opt,name=pub_key_rotation_gas_cost,json=pubKeyRotationGasCost,proto3" json:"pub_key_rotation_gas_cost,omitempty"`
+	// sig_verify_cost_multisig_per_signature is the gas consumed for each signature
+	// of a multisig, on top of the verification cost of the signing key.
+	SigVerifyCostMultisigPerSignature uint64 `protobuf:"varint,8,opt,name=sig_verify_cost_multisig_per_signature,json=sigVerifyCostMultisigPerSignature,proto3" json:"sig_verify_cost_multisig_per_signature,omitempty"`
+	// simulation_signature_size is the estimated size in bytes of each signature
+	// missing from a simulated transaction, whose tx size gas is consumed as if the
+	// transaction was signed.
+	SimulationSignatureSize uint64 `protobuf:"varint,9,opt,name=simulation_signature_size,json=simulationSignatureSize,proto3" json:"simulation_signature_size,omitempty"`
+	// allowed_fee_denoms are the denoms transaction fees can be paid in. Empty
+	// allows all denoms.
+	AllowedFeeDenoms []string `protobuf:"bytes,10,rep,name=allowed_fee_denoms,json=allowedFeeDenoms,proto3" json:"allowed_fee_denoms,omitempty"`
+	// max_refund_rate is the maximum share of the fee refunded to the fee payer
+	// for the gas a successful transaction did not use. Zero disables the refunds.
+	MaxRefundRate string `protobuf:"bytes,11,opt,name=max_refund_rate,json=maxRefundRate,proto3" json:"max_refund_rate,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2618,6 +3017,48 @@ func (x *Params) GetSigVerifyCostSecp256K1() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxPubKeyRotations() uint64 {
+	if x != nil {
+		return x.MaxPubKeyRotations
+	}
+	return 0
+}
+
+func (x *Params) GetPubKeyRotationGasCost() uint64 {
+	if x != nil {
+		return x.PubKeyRotationGasCost
+	}
+	return 0
+}
+
+func (x *Params) GetSigVerifyCostMultisigPerSignature() uint64 {
+	if x != nil {
+		return x.SigVerifyCostMultisigPerSignature
+	}
+	return 0
+}
+
+func (x *Params) GetSimulationSignatureSize() uint64 {
+	if x != nil {
+		return x.SimulationSignatureSize
+	}
+	return 0
+}
+
+func (x *Params) GetAllowedFeeDenoms() []string {
+	if x != nil {
+		return x.AllowedFeeDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetMaxRefundRate() string {
+	if x != nil {
+		return x.MaxRefundRate
+	}
+	return ""
+}
+
 var File_cosmos_auth_v1beta1_auth_proto protoreflect.FileDescriptor
 
 var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
@@ -2671,8 +3112,8 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x26, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xd7,
-	0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xe1,
+	0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78,
 	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x43,
 	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f,
@@ -2691,22 +3132,47 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
 	0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x16, 0x73,
 	0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70,
-	0x32, 0x35, 0x36, 0x6b, 0x31, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41,
-	0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x32, 0x35, 0x36, 0x6b, 0x31, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x19, 0x70, 0x75, 0x62, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x51, 0x0a, 0x26, 0x73, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x21, 0x73, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73,
+	0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12,
+	0x5e, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x65, 0x3a,
+	0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
+	0x43, 0x41, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
+	0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_RotatePubKey_FullMethodName = "/cosmos.auth.v1beta1.Msg/RotatePubKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, signed with its current public key.
+	RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +54,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error) {
+	out := new(MsgRotatePubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotatePubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +72,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, signed with its current public key.
+	RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +85,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePubKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +119,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotatePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotatePubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePubKey(ctx, req.(*MsgRotatePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +148,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RotatePubKey",
+			Handler:    _Msg_RotatePubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Service_Config_FullMethodName = "/cosmos.base.node.v1beta1.Service/Config"
 	Service_Status_FullMethodName = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_Health_FullMethodName = "/cosmos.base.node.v1beta1.Service/Health"
 )
 
 // ServiceClient is the client API for Service service.
@@ -31,6 +32,10 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Health queries for the readiness of the node to serve application
+	// requests: its sync status, latest application state, chain-id, versions
+	// and minimum gas prices.
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
 type serviceClient struct {
@@ -59,6 +64,15 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, Service_Health_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -67,6 +81,10 @@ type ServiceServer interface {
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// Health queries for the readiness of the node to serve application
+	// requests: its sync status, latest application state, chain-id, versions
+	// and minimum gas prices.
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -80,6 +98,9 @@ func (UnimplementedServiceServer) Config(context.Context, *ConfigRequest) (*Conf
 func (UnimplementedServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +150,24 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +183,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _Service_Health_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
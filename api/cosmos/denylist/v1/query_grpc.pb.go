@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/denylist/v1/query.proto
+
+package denylistv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_IsBlocked_FullMethodName        = "/cosmos.denylist.v1.Query/IsBlocked"
+	Query_BlockedAddresses_FullMethodName = "/cosmos.denylist.v1.Query/BlockedAddresses"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// IsBlocked returns whether an address is on the deny-list.
+	IsBlocked(ctx context.Context, in *QueryIsBlockedRequest, opts ...grpc.CallOption) (*QueryIsBlockedResponse, error)
+	// BlockedAddresses returns the addresses on the deny-list.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) IsBlocked(ctx context.Context, in *QueryIsBlockedRequest, opts ...grpc.CallOption) (*QueryIsBlockedResponse, error) {
+	out := new(QueryIsBlockedResponse)
+	err := c.cc.Invoke(ctx, Query_IsBlocked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_BlockedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// IsBlocked returns whether an address is on the deny-list.
+	IsBlocked(context.Context, *QueryIsBlockedRequest) (*QueryIsBlockedResponse, error)
+	// BlockedAddresses returns the addresses on the deny-list.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) IsBlocked(context.Context, *QueryIsBlockedRequest) (*QueryIsBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
+func (UnimplementedQueryServer) BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IsBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsBlocked(ctx, req.(*QueryIsBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.denylist.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsBlocked",
+			Handler:    _Query_IsBlocked_Handler,
+		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/denylist/v1/query.proto",
+}
@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/denylist/v1/tx.proto
+
+package denylistv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_BlockAddresses_FullMethodName   = "/cosmos.denylist.v1.Msg/BlockAddresses"
+	Msg_UnblockAddresses_FullMethodName = "/cosmos.denylist.v1.Msg/UnblockAddresses"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// BlockAddresses adds addresses to the deny-list. The authority defaults to
+	// the x/gov module account.
+	BlockAddresses(ctx context.Context, in *MsgBlockAddresses, opts ...grpc.CallOption) (*MsgBlockAddressesResponse, error)
+	// UnblockAddresses removes addresses from the deny-list. The authority
+	// defaults to the x/gov module account.
+	UnblockAddresses(ctx context.Context, in *MsgUnblockAddresses, opts ...grpc.CallOption) (*MsgUnblockAddressesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) BlockAddresses(ctx context.Context, in *MsgBlockAddresses, opts ...grpc.CallOption) (*MsgBlockAddressesResponse, error) {
+	out := new(MsgBlockAddressesResponse)
+	err := c.cc.Invoke(ctx, Msg_BlockAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblockAddresses(ctx context.Context, in *MsgUnblockAddresses, opts ...grpc.CallOption) (*MsgUnblockAddressesResponse, error) {
+	out := new(MsgUnblockAddressesResponse)
+	err := c.cc.Invoke(ctx, Msg_UnblockAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// BlockAddresses adds addresses to the deny-list. The authority defaults to
+	// the x/gov module account.
+	BlockAddresses(context.Context, *MsgBlockAddresses) (*MsgBlockAddressesResponse, error)
+	// UnblockAddresses removes addresses from the deny-list. The authority
+	// defaults to the x/gov module account.
+	UnblockAddresses(context.Context, *MsgUnblockAddresses) (*MsgUnblockAddressesResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) BlockAddresses(context.Context, *MsgBlockAddresses) (*MsgBlockAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAddresses not implemented")
+}
+func (UnimplementedMsgServer) UnblockAddresses(context.Context, *MsgUnblockAddresses) (*MsgUnblockAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAddresses not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_BlockAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BlockAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockAddresses(ctx, req.(*MsgBlockAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnblockAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockAddresses(ctx, req.(*MsgUnblockAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.denylist.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockAddresses",
+			Handler:    _Msg_BlockAddresses_Handler,
+		},
+		{
+			MethodName: "UnblockAddresses",
+			Handler:    _Msg_UnblockAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/denylist/v1/tx.proto",
+}
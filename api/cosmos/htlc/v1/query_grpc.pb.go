@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/htlc/v1/query.proto
+
+package htlcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName           = "/cosmos.htlc.v1.Query/Params"
+	Query_Swap_FullMethodName             = "/cosmos.htlc.v1.Query/Swap"
+	Query_SwapsBySender_FullMethodName    = "/cosmos.htlc.v1.Query/SwapsBySender"
+	Query_SwapsByRecipient_FullMethodName = "/cosmos.htlc.v1.Query/SwapsByRecipient"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the htlc module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Swap returns a swap.
+	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	// SwapsBySender returns the open and expired swaps of a sender.
+	SwapsBySender(ctx context.Context, in *QuerySwapsBySenderRequest, opts ...grpc.CallOption) (*QuerySwapsBySenderResponse, error)
+	// SwapsByRecipient returns the open and expired swaps of a recipient.
+	SwapsByRecipient(ctx context.Context, in *QuerySwapsByRecipientRequest, opts ...grpc.CallOption) (*QuerySwapsByRecipientResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
+	out := new(QuerySwapResponse)
+	err := c.cc.Invoke(ctx, Query_Swap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapsBySender(ctx context.Context, in *QuerySwapsBySenderRequest, opts ...grpc.CallOption) (*QuerySwapsBySenderResponse, error) {
+	out := new(QuerySwapsBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_SwapsBySender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapsByRecipient(ctx context.Context, in *QuerySwapsByRecipientRequest, opts ...grpc.CallOption) (*QuerySwapsByRecipientResponse, error) {
+	out := new(QuerySwapsByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_SwapsByRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params returns the htlc module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Swap returns a swap.
+	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	// SwapsBySender returns the open and expired swaps of a sender.
+	SwapsBySender(context.Context, *QuerySwapsBySenderRequest) (*QuerySwapsBySenderResponse, error)
+	// SwapsByRecipient returns the open and expired swaps of a recipient.
+	SwapsByRecipient(context.Context, *QuerySwapsByRecipientRequest) (*QuerySwapsByRecipientResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (UnimplementedQueryServer) SwapsBySender(context.Context, *QuerySwapsBySenderRequest) (*QuerySwapsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapsBySender not implemented")
+}
+func (UnimplementedQueryServer) SwapsByRecipient(context.Context, *QuerySwapsByRecipientRequest) (*QuerySwapsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapsByRecipient not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Swap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SwapsBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapsBySender(ctx, req.(*QuerySwapsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SwapsByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapsByRecipient(ctx, req.(*QuerySwapsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.htlc.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Query_Swap_Handler,
+		},
+		{
+			MethodName: "SwapsBySender",
+			Handler:    _Query_SwapsBySender_Handler,
+		},
+		{
+			MethodName: "SwapsByRecipient",
+			Handler:    _Query_SwapsByRecipient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/htlc/v1/query.proto",
+}
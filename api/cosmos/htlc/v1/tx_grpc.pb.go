@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/htlc/v1/tx.proto
+
+package htlcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_CreateSwap_FullMethodName   = "/cosmos.htlc.v1.Msg/CreateSwap"
+	Msg_ClaimSwap_FullMethodName    = "/cosmos.htlc.v1.Msg/ClaimSwap"
+	Msg_RefundSwap_FullMethodName   = "/cosmos.htlc.v1.Msg/RefundSwap"
+	Msg_UpdateParams_FullMethodName = "/cosmos.htlc.v1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateSwap locks an amount of the sender, paid to the recipient against
+	// the secret of the hash lock until the swap expires.
+	CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error)
+	// ClaimSwap pays the amount of an open swap to its recipient, revealing its
+	// secret.
+	ClaimSwap(ctx context.Context, in *MsgClaimSwap, opts ...grpc.CallOption) (*MsgClaimSwapResponse, error)
+	// RefundSwap refunds the amount of an expired swap to its sender.
+	RefundSwap(ctx context.Context, in *MsgRefundSwap, opts ...grpc.CallOption) (*MsgRefundSwapResponse, error)
+	// UpdateParams defines a governance operation for updating the x/htlc
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error) {
+	out := new(MsgCreateSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimSwap(ctx context.Context, in *MsgClaimSwap, opts ...grpc.CallOption) (*MsgClaimSwapResponse, error) {
+	out := new(MsgClaimSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundSwap(ctx context.Context, in *MsgRefundSwap, opts ...grpc.CallOption) (*MsgRefundSwapResponse, error) {
+	out := new(MsgRefundSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// CreateSwap locks an amount of the sender, paid to the recipient against
+	// the secret of the hash lock until the swap expires.
+	CreateSwap(context.Context, *MsgCreateSwap) (*MsgCreateSwapResponse, error)
+	// ClaimSwap pays the amount of an open swap to its recipient, revealing its
+	// secret.
+	ClaimSwap(context.Context, *MsgClaimSwap) (*MsgClaimSwapResponse, error)
+	// RefundSwap refunds the amount of an expired swap to its sender.
+	RefundSwap(context.Context, *MsgRefundSwap) (*MsgRefundSwapResponse, error)
+	// UpdateParams defines a governance operation for updating the x/htlc
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) CreateSwap(context.Context, *MsgCreateSwap) (*MsgCreateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwap not implemented")
+}
+func (UnimplementedMsgServer) ClaimSwap(context.Context, *MsgClaimSwap) (*MsgClaimSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimSwap not implemented")
+}
+func (UnimplementedMsgServer) RefundSwap(context.Context, *MsgRefundSwap) (*MsgRefundSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundSwap not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_CreateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSwap(ctx, req.(*MsgCreateSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimSwap(ctx, req.(*MsgClaimSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundSwap(ctx, req.(*MsgRefundSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.htlc.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSwap",
+			Handler:    _Msg_CreateSwap_Handler,
+		},
+		{
+			MethodName: "ClaimSwap",
+			Handler:    _Msg_ClaimSwap_Handler,
+		},
+		{
+			MethodName: "RefundSwap",
+			Handler:    _Msg_RefundSwap_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/htlc/v1/tx.proto",
+}
@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/lockup/v1/query.proto
+
+package lockupv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName           = "/cosmos.lockup.v1.Query/Params"
+	Query_Lock_FullMethodName             = "/cosmos.lockup.v1.Query/Lock"
+	Query_AccountLocks_FullMethodName     = "/cosmos.lockup.v1.Query/AccountLocks"
+	Query_LockedByDuration_FullMethodName = "/cosmos.lockup.v1.Query/LockedByDuration"
+	Query_Gauge_FullMethodName            = "/cosmos.lockup.v1.Query/Gauge"
+	Query_Gauges_FullMethodName           = "/cosmos.lockup.v1.Query/Gauges"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the lockup module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Lock returns a lock.
+	Lock(ctx context.Context, in *QueryLockRequest, opts ...grpc.CallOption) (*QueryLockResponse, error)
+	// AccountLocks returns the locks of an owner.
+	AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error)
+	// LockedByDuration returns the coins locked for at least a duration, and not
+	// unlocking, by an owner or by all owners.
+	LockedByDuration(ctx context.Context, in *QueryLockedByDurationRequest, opts ...grpc.CallOption) (*QueryLockedByDurationResponse, error)
+	// Gauge returns a gauge.
+	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
+	// Gauges returns the gauges which are not fully distributed.
+	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lock(ctx context.Context, in *QueryLockRequest, opts ...grpc.CallOption) (*QueryLockResponse, error) {
+	out := new(QueryLockResponse)
+	err := c.cc.Invoke(ctx, Query_Lock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error) {
+	out := new(QueryAccountLocksResponse)
+	err := c.cc.Invoke(ctx, Query_AccountLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedByDuration(ctx context.Context, in *QueryLockedByDurationRequest, opts ...grpc.CallOption) (*QueryLockedByDurationResponse, error) {
+	out := new(QueryLockedByDurationResponse)
+	err := c.cc.Invoke(ctx, Query_LockedByDuration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error) {
+	out := new(QueryGaugeResponse)
+	err := c.cc.Invoke(ctx, Query_Gauge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error) {
+	out := new(QueryGaugesResponse)
+	err := c.cc.Invoke(ctx, Query_Gauges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params returns the lockup module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Lock returns a lock.
+	Lock(context.Context, *QueryLockRequest) (*QueryLockResponse, error)
+	// AccountLocks returns the locks of an owner.
+	AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error)
+	// LockedByDuration returns the coins locked for at least a duration, and not
+	// unlocking, by an owner or by all owners.
+	LockedByDuration(context.Context, *QueryLockedByDurationRequest) (*QueryLockedByDurationResponse, error)
+	// Gauge returns a gauge.
+	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
+	// Gauges returns the gauges which are not fully distributed.
+	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Lock(context.Context, *QueryLockRequest) (*QueryLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedQueryServer) AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLocks not implemented")
+}
+func (UnimplementedQueryServer) LockedByDuration(context.Context, *QueryLockedByDurationRequest) (*QueryLockedByDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedByDuration not implemented")
+}
+func (UnimplementedQueryServer) Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauge not implemented")
+}
+func (UnimplementedQueryServer) Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Lock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lock(ctx, req.(*QueryLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLocks(ctx, req.(*QueryAccountLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedByDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedByDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedByDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockedByDuration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedByDuration(ctx, req.(*QueryLockedByDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Gauge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauge(ctx, req.(*QueryGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Gauges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauges(ctx, req.(*QueryGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.lockup.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Query_Lock_Handler,
+		},
+		{
+			MethodName: "AccountLocks",
+			Handler:    _Query_AccountLocks_Handler,
+		},
+		{
+			MethodName: "LockedByDuration",
+			Handler:    _Query_LockedByDuration_Handler,
+		},
+		{
+			MethodName: "Gauge",
+			Handler:    _Query_Gauge_Handler,
+		},
+		{
+			MethodName: "Gauges",
+			Handler:    _Query_Gauges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/lockup/v1/query.proto",
+}
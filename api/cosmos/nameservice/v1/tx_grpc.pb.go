@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/nameservice/v1/tx.proto
+
+package nameservicev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_RegisterName_FullMethodName   = "/cosmos.nameservice.v1.Msg/RegisterName"
+	Msg_RenewName_FullMethodName      = "/cosmos.nameservice.v1.Msg/RenewName"
+	Msg_TransferName_FullMethodName   = "/cosmos.nameservice.v1.Msg/TransferName"
+	Msg_SetNameRecords_FullMethodName = "/cosmos.nameservice.v1.Msg/SetNameRecords"
+	Msg_UpdateParams_FullMethodName   = "/cosmos.nameservice.v1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterName registers an available name for a number of periods.
+	RegisterName(ctx context.Context, in *MsgRegisterName, opts ...grpc.CallOption) (*MsgRegisterNameResponse, error)
+	// RenewName extends the registration of a name by a number of periods.
+	RenewName(ctx context.Context, in *MsgRenewName, opts ...grpc.CallOption) (*MsgRenewNameResponse, error)
+	// TransferName transfers a name to a new owner.
+	TransferName(ctx context.Context, in *MsgTransferName, opts ...grpc.CallOption) (*MsgTransferNameResponse, error)
+	// SetNameRecords sets the address a name resolves to and its records.
+	SetNameRecords(ctx context.Context, in *MsgSetNameRecords, opts ...grpc.CallOption) (*MsgSetNameRecordsResponse, error)
+	// UpdateParams defines a governance operation for updating the x/nameservice
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterName(ctx context.Context, in *MsgRegisterName, opts ...grpc.CallOption) (*MsgRegisterNameResponse, error) {
+	out := new(MsgRegisterNameResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewName(ctx context.Context, in *MsgRenewName, opts ...grpc.CallOption) (*MsgRenewNameResponse, error) {
+	out := new(MsgRenewNameResponse)
+	err := c.cc.Invoke(ctx, Msg_RenewName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferName(ctx context.Context, in *MsgTransferName, opts ...grpc.CallOption) (*MsgTransferNameResponse, error) {
+	out := new(MsgTransferNameResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNameRecords(ctx context.Context, in *MsgSetNameRecords, opts ...grpc.CallOption) (*MsgSetNameRecordsResponse, error) {
+	out := new(MsgSetNameRecordsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetNameRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// RegisterName registers an available name for a number of periods.
+	RegisterName(context.Context, *MsgRegisterName) (*MsgRegisterNameResponse, error)
+	// RenewName extends the registration of a name by a number of periods.
+	RenewName(context.Context, *MsgRenewName) (*MsgRenewNameResponse, error)
+	// TransferName transfers a name to a new owner.
+	TransferName(context.Context, *MsgTransferName) (*MsgTransferNameResponse, error)
+	// SetNameRecords sets the address a name resolves to and its records.
+	SetNameRecords(context.Context, *MsgSetNameRecords) (*MsgSetNameRecordsResponse, error)
+	// UpdateParams defines a governance operation for updating the x/nameservice
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) RegisterName(context.Context, *MsgRegisterName) (*MsgRegisterNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterName not implemented")
+}
+func (UnimplementedMsgServer) RenewName(context.Context, *MsgRenewName) (*MsgRenewNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewName not implemented")
+}
+func (UnimplementedMsgServer) TransferName(context.Context, *MsgTransferName) (*MsgTransferNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferName not implemented")
+}
+func (UnimplementedMsgServer) SetNameRecords(context.Context, *MsgSetNameRecords) (*MsgSetNameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNameRecords not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_RegisterName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterName(ctx, req.(*MsgRegisterName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RenewName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewName(ctx, req.(*MsgRenewName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferName(ctx, req.(*MsgTransferName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNameRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNameRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetNameRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNameRecords(ctx, req.(*MsgSetNameRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.nameservice.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterName",
+			Handler:    _Msg_RegisterName_Handler,
+		},
+		{
+			MethodName: "RenewName",
+			Handler:    _Msg_RenewName_Handler,
+		},
+		{
+			MethodName: "TransferName",
+			Handler:    _Msg_TransferName_Handler,
+		},
+		{
+			MethodName: "SetNameRecords",
+			Handler:    _Msg_SetNameRecords_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/nameservice/v1/tx.proto",
+}
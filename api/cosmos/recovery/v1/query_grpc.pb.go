@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/recovery/v1/query.proto
+
+package recoveryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName          = "/cosmos.recovery.v1.Query/Params"
+	Query_RecoveryConfig_FullMethodName  = "/cosmos.recovery.v1.Query/RecoveryConfig"
+	Query_RecoveryRequest_FullMethodName = "/cosmos.recovery.v1.Query/RecoveryRequest"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the recovery module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RecoveryConfig returns the recovery configuration of an account.
+	RecoveryConfig(ctx context.Context, in *QueryRecoveryConfigRequest, opts ...grpc.CallOption) (*QueryRecoveryConfigResponse, error)
+	// RecoveryRequest returns the pending recovery of an account.
+	RecoveryRequest(ctx context.Context, in *QueryRecoveryRequestRequest, opts ...grpc.CallOption) (*QueryRecoveryRequestResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecoveryConfig(ctx context.Context, in *QueryRecoveryConfigRequest, opts ...grpc.CallOption) (*QueryRecoveryConfigResponse, error) {
+	out := new(QueryRecoveryConfigResponse)
+	err := c.cc.Invoke(ctx, Query_RecoveryConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecoveryRequest(ctx context.Context, in *QueryRecoveryRequestRequest, opts ...grpc.CallOption) (*QueryRecoveryRequestResponse, error) {
+	out := new(QueryRecoveryRequestResponse)
+	err := c.cc.Invoke(ctx, Query_RecoveryRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params returns the recovery module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RecoveryConfig returns the recovery configuration of an account.
+	RecoveryConfig(context.Context, *QueryRecoveryConfigRequest) (*QueryRecoveryConfigResponse, error)
+	// RecoveryRequest returns the pending recovery of an account.
+	RecoveryRequest(context.Context, *QueryRecoveryRequestRequest) (*QueryRecoveryRequestResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) RecoveryConfig(context.Context, *QueryRecoveryConfigRequest) (*QueryRecoveryConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryConfig not implemented")
+}
+func (UnimplementedQueryServer) RecoveryRequest(context.Context, *QueryRecoveryRequestRequest) (*QueryRecoveryRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryRequest not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecoveryConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecoveryConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryConfig(ctx, req.(*QueryRecoveryConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecoveryRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecoveryRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryRequest(ctx, req.(*QueryRecoveryRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.recovery.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RecoveryConfig",
+			Handler:    _Query_RecoveryConfig_Handler,
+		},
+		{
+			MethodName: "RecoveryRequest",
+			Handler:    _Query_RecoveryRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/recovery/v1/query.proto",
+}
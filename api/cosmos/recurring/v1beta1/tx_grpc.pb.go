@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/recurring/v1beta1/tx.proto
+
+package recurringv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_CreateRecurringPayment_FullMethodName = "/cosmos.recurring.v1beta1.Msg/CreateRecurringPayment"
+	Msg_CancelRecurringPayment_FullMethodName = "/cosmos.recurring.v1beta1.Msg/CancelRecurringPayment"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.recurring.v1beta1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateRecurringPayment defines a method that enables authorizing a payment
+	// of a fixed amount to a recipient every given number of blocks.
+	CreateRecurringPayment(ctx context.Context, in *MsgCreateRecurringPayment, opts ...grpc.CallOption) (*MsgCreateRecurringPaymentResponse, error)
+	// CancelRecurringPayment defines a method that enables the payer of a
+	// recurring payment to cancel it.
+	CancelRecurringPayment(ctx context.Context, in *MsgCancelRecurringPayment, opts ...grpc.CallOption) (*MsgCancelRecurringPaymentResponse, error)
+	// UpdateParams defines a governance operation for updating the recurring
+	// module parameters. The authority is the one of the x/auth module.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateRecurringPayment(ctx context.Context, in *MsgCreateRecurringPayment, opts ...grpc.CallOption) (*MsgCreateRecurringPaymentResponse, error) {
+	out := new(MsgCreateRecurringPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateRecurringPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecurringPayment(ctx context.Context, in *MsgCancelRecurringPayment, opts ...grpc.CallOption) (*MsgCancelRecurringPaymentResponse, error) {
+	out := new(MsgCancelRecurringPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRecurringPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// CreateRecurringPayment defines a method that enables authorizing a payment
+	// of a fixed amount to a recipient every given number of blocks.
+	CreateRecurringPayment(context.Context, *MsgCreateRecurringPayment) (*MsgCreateRecurringPaymentResponse, error)
+	// CancelRecurringPayment defines a method that enables the payer of a
+	// recurring payment to cancel it.
+	CancelRecurringPayment(context.Context, *MsgCancelRecurringPayment) (*MsgCancelRecurringPaymentResponse, error)
+	// UpdateParams defines a governance operation for updating the recurring
+	// module parameters. The authority is the one of the x/auth module.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) CreateRecurringPayment(context.Context, *MsgCreateRecurringPayment) (*MsgCreateRecurringPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringPayment not implemented")
+}
+func (UnimplementedMsgServer) CancelRecurringPayment(context.Context, *MsgCancelRecurringPayment) (*MsgCancelRecurringPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringPayment not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_CreateRecurringPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRecurringPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRecurringPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateRecurringPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRecurringPayment(ctx, req.(*MsgCreateRecurringPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecurringPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRecurringPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringPayment(ctx, req.(*MsgCancelRecurringPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.recurring.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecurringPayment",
+			Handler:    _Msg_CreateRecurringPayment_Handler,
+		},
+		{
+			MethodName: "CancelRecurringPayment",
+			Handler:    _Msg_CancelRecurringPayment_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/recurring/v1beta1/tx.proto",
+}
@@ -28,6 +28,7 @@ const (
 	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
 	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
 	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_TxSignBytes_FullMethodName     = "/cosmos.tx.v1beta1.Service/TxSignBytes"
 )
 
 // ServiceClient is the client API for Service service.
@@ -62,6 +63,11 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// TxSignBytes returns the canonical SIGN_MODE_LEGACY_AMINO_JSON sign bytes of a
+	// transaction, the bytes hardware wallets sign, along with their SHA256 hash.
+	//
+	// Since: cosmos-sdk 0.51
+	TxSignBytes(ctx context.Context, in *TxSignBytesRequest, opts ...grpc.CallOption) (*TxSignBytesResponse, error)
 }
 
 type serviceClient struct {
@@ -153,6 +159,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) TxSignBytes(ctx context.Context, in *TxSignBytesRequest, opts ...grpc.CallOption) (*TxSignBytesResponse, error) {
+	out := new(TxSignBytesResponse)
+	err := c.cc.Invoke(ctx, Service_TxSignBytes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -185,6 +200,11 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// TxSignBytes returns the canonical SIGN_MODE_LEGACY_AMINO_JSON sign bytes of a
+	// transaction, the bytes hardware wallets sign, along with their SHA256 hash.
+	//
+	// Since: cosmos-sdk 0.51
+	TxSignBytes(context.Context, *TxSignBytesRequest) (*TxSignBytesResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -219,6 +239,9 @@ func (UnimplementedServiceServer) TxEncodeAmino(context.Context, *TxEncodeAminoR
 func (UnimplementedServiceServer) TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (UnimplementedServiceServer) TxSignBytes(context.Context, *TxSignBytesRequest) (*TxSignBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxSignBytes not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -394,6 +417,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_TxSignBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxSignBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TxSignBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_TxSignBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TxSignBytes(ctx, req.(*TxSignBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -437,6 +478,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "TxSignBytes",
+			Handler:    _Service_TxSignBytes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -681,6 +681,53 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_GasTracer(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	var traces []baseapp.TxGasTrace
+	suite.baseApp.SetGasTracer(func(trace baseapp.TxGasTrace) {
+		traces = append(traces, trace)
+	})
+
+	txs := [][]byte{}
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, 2*i, 2*i+1))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, traces, len(txs))
+
+	for i, trace := range traces {
+		require.Equal(t, int64(1), trace.Height)
+		require.Equal(t, i, trace.Index)
+		require.Equal(t, uint64(res.TxResults[i].GasUsed), trace.GasUsed)
+		require.Len(t, trace.Msgs, 2)
+
+		// the gas of the transaction is the gas consumed before and by its messages
+		msgsGas := uint64(0)
+		for _, msg := range trace.Msgs {
+			require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), msg.TypeURL)
+			require.NotZero(t, msg.GasUsed)
+			require.NotZero(t, msg.Descriptors[storetypes.GasWriteCostFlatDesc])
+			msgsGas += msg.GasUsed
+		}
+		require.Equal(t, trace.GasUsed, trace.AnteGas+msgsGas)
+	}
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
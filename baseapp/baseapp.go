@@ -177,6 +177,11 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// gasTracer receives the gas trace of every transaction executed in
+	// FinalizeBlock, txGasTrace being the trace of the transaction executed.
+	gasTracer  GasTracer
+	txGasTrace *TxGasTrace
+
 	chainID string
 
 	cdc codec.Codec
@@ -993,6 +998,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 	events := sdk.EmptyEvents()
 	msgResponses := make([]*codectypes.Any, 0, len(msgs))
 
+	traceGas := mode == execModeFinalize && app.txGasTrace != nil
+	if traceGas {
+		app.txGasTrace.AnteGas = ctx.GasMeter().GasConsumed()
+	}
+
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
 		if mode != execModeFinalize && mode != execModeSimulate {
@@ -1004,8 +1014,13 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
 		}
 
+		msgCtx := ctx
+		if traceGas {
+			msgCtx = app.traceMsgGas(ctx, msg)
+		}
+
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgResult, err := handler(msgCtx, msg)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -0,0 +1,109 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxGasTrace is the gas consumed by a transaction executed in FinalizeBlock,
+// broken down by message and by gas descriptor, i.e. by store operation.
+type TxGasTrace struct {
+	Height    int64  `json:"height"`
+	Index     int    `json:"index"`
+	Hash      []byte `json:"hash"`
+	Code      uint32 `json:"code"`
+	GasWanted uint64 `json:"gas_wanted"`
+	GasUsed   uint64 `json:"gas_used"`
+	// AnteGas is the gas consumed before the messages of the transaction were
+	// executed, mostly by the AnteHandler.
+	AnteGas uint64         `json:"ante_gas"`
+	Msgs    []*MsgGasTrace `json:"msgs"`
+}
+
+// MsgGasTrace is the gas consumed by the execution of a message.
+type MsgGasTrace struct {
+	TypeURL string `json:"type_url"`
+	GasUsed uint64 `json:"gas_used"`
+	// Descriptors is the gas consumed per gas descriptor, e.g.
+	// storetypes.GasReadPerByteDesc. Refunds are recorded under the descriptor
+	// of the refund prefixed with "refund: ".
+	Descriptors map[string]uint64 `json:"descriptors"`
+}
+
+// GasTracer is called with the gas trace of every transaction executed in
+// FinalizeBlock.
+type GasTracer func(trace TxGasTrace)
+
+// SetGasTracer sets a tracer receiving the gas consumed by every transaction
+// executed in FinalizeBlock. As it only observes the execution, it can be set
+// after the BaseApp is sealed, e.g. by tools replaying blocks.
+func (app *BaseApp) SetGasTracer(tracer GasTracer) {
+	app.gasTracer = tracer
+}
+
+// startGasTrace starts the gas trace of the transaction at the given index of
+// the block being finalized, if a gas tracer is set.
+func (app *BaseApp) startGasTrace(height int64, index int, txBytes []byte) {
+	if app.gasTracer == nil {
+		return
+	}
+
+	hash := sha256.Sum256(txBytes)
+	app.txGasTrace = &TxGasTrace{Height: height, Index: index, Hash: hash[:]}
+}
+
+// endGasTrace completes the gas trace of the current transaction with its
+// result and passes it to the gas tracer.
+func (app *BaseApp) endGasTrace(result *abci.ExecTxResult) {
+	if app.txGasTrace == nil {
+		return
+	}
+
+	trace := app.txGasTrace
+	app.txGasTrace = nil
+
+	trace.Code = result.Code
+	trace.GasWanted = uint64(result.GasWanted)
+	trace.GasUsed = uint64(result.GasUsed)
+	app.gasTracer(*trace)
+}
+
+// traceMsgGas returns a context whose gas meter records the gas consumed by
+// the given message in the gas trace of the current transaction.
+func (app *BaseApp) traceMsgGas(ctx sdk.Context, msg sdk.Msg) sdk.Context {
+	trace := &MsgGasTrace{
+		TypeURL:     sdk.MsgTypeURL(msg),
+		Descriptors: make(map[string]uint64),
+	}
+	app.txGasTrace.Msgs = append(app.txGasTrace.Msgs, trace)
+
+	return ctx.WithGasMeter(&tracingGasMeter{GasMeter: ctx.GasMeter(), trace: trace})
+}
+
+// tracingGasMeter records the gas consumed and refunded through the wrapped
+// gas meter, without altering its behavior.
+type tracingGasMeter struct {
+	storetypes.GasMeter
+	trace *MsgGasTrace
+}
+
+func (g *tracingGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	g.trace.GasUsed += amount
+	g.trace.Descriptors[descriptor] += amount
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (g *tracingGasMeter) RefundGas(amount storetypes.Gas, descriptor string) {
+	g.trace.Descriptors["refund: "+descriptor] += amount
+	if amount > g.trace.GasUsed {
+		g.trace.GasUsed = 0
+	} else {
+		g.trace.GasUsed -= amount
+	}
+	g.GasMeter.RefundGas(amount, descriptor)
+}
@@ -66,3 +66,19 @@ func (app *BaseApp) GetContextForFinalizeBlock(txBytes []byte) sdk.Context {
 func (app *BaseApp) GetContextForCheckTx(txBytes []byte) sdk.Context {
 	return app.getContextForTx(execModeCheck, txBytes)
 }
+
+// SimBlockGasConsumed returns the gas consumed so far by the transactions of
+// the block being finalized. It is used by the simulator to measure the gas
+// used by each operation.
+func (app *BaseApp) SimBlockGasConsumed() uint64 {
+	if app.finalizeBlockState == nil {
+		return 0
+	}
+
+	gasMeter := app.finalizeBlockState.Context().BlockGasMeter()
+	if gasMeter == nil {
+		return 0
+	}
+
+	return gasMeter.GasConsumed()
+}
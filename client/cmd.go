@@ -97,9 +97,22 @@ func ValidateCmd(cmd *cobra.Command, args []string) error {
 func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, error) {
 	if clientCtx.OutputFormat == "" || flagSet.Changed(flags.FlagOutput) {
 		output, _ := flagSet.GetString(flags.FlagOutput)
+		switch output {
+		case "", flags.OutputFormatJSON, flags.OutputFormatText:
+		default:
+			return clientCtx, fmt.Errorf("invalid output format %q, expected %s or %s", output, flags.OutputFormatText, flags.OutputFormatJSON)
+		}
 		clientCtx = clientCtx.WithOutputFormat(output)
 	}
 
+	if clientCtx.OutputIndent == 0 || flagSet.Changed(flags.FlagOutputIndent) {
+		indent, _ := flagSet.GetInt(flags.FlagOutputIndent)
+		if indent < 0 {
+			return clientCtx, fmt.Errorf("invalid output indent %d, must not be negative", indent)
+		}
+		clientCtx = clientCtx.WithOutputIndent(indent)
+	}
+
 	if clientCtx.HomeDir == "" || flagSet.Changed(flags.FlagHome) {
 		homeDir, _ := flagSet.GetString(flags.FlagHome)
 		clientCtx = clientCtx.WithHomeDir(homeDir)
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -119,3 +120,24 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestReadPersistentCommandFlagsOutput(t *testing.T) {
+	newFlagSet := func(args ...string) *pflag.FlagSet {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.String(flags.FlagOutput, flags.OutputFormatText, "")
+		fs.Int(flags.FlagOutputIndent, 0, "")
+		require.NoError(t, fs.Parse(args))
+		return fs
+	}
+
+	clientCtx, err := client.ReadPersistentCommandFlags(client.Context{}, newFlagSet("--output=json", "--output-indent=4"))
+	require.NoError(t, err)
+	require.Equal(t, flags.OutputFormatJSON, clientCtx.OutputFormat)
+	require.Equal(t, 4, clientCtx.OutputIndent)
+
+	_, err = client.ReadPersistentCommandFlags(client.Context{}, newFlagSet("--output=yaml"))
+	require.ErrorContains(t, err, `invalid output format "yaml"`)
+
+	_, err = client.ReadPersistentCommandFlags(client.Context{}, newFlagSet("--output-indent=-1"))
+	require.ErrorContains(t, err, "invalid output indent -1")
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -41,6 +42,7 @@ type Context struct {
 	KeyringDefaultKeyName string
 	Output                io.Writer
 	OutputFormat          string
+	OutputIndent          int
 	Height                int64
 	HomeDir               string
 	// From is a name or an address of a keyring account used to set FromName and FromAddress fields.
@@ -144,6 +146,13 @@ func (ctx Context) WithOutputFormat(format string) Context {
 	return ctx
 }
 
+// WithOutputIndent returns a copy of the context with an updated OutputIndent
+// field, the number of spaces used to indent JSON output.
+func (ctx Context) WithOutputIndent(indent int) Context {
+	ctx.OutputIndent = indent
+	return ctx
+}
+
 // WithNodeURI returns a copy of the context with an updated node URI.
 func (ctx Context) WithNodeURI(nodeURI string) Context {
 	ctx.NodeURI = nodeURI
@@ -388,13 +397,32 @@ func (ctx Context) PrintRaw(toPrint json.RawMessage) error {
 	return ctx.printOutput(toPrint)
 }
 
+// PrintError outputs err to ctx.Output based on ctx.OutputFormat, using the
+// ErrorResponse schema. ABCI error codes and codespaces are preserved for
+// errors registered through cosmossdk.io/errors.
+func (ctx Context) PrintError(err error) error {
+	out, merr := json.Marshal(NewErrorResponse(err))
+	if merr != nil {
+		return merr
+	}
+
+	return ctx.printOutput(out)
+}
+
 func (ctx Context) printOutput(out []byte) error {
 	var err error
-	if ctx.OutputFormat == "text" {
+	switch {
+	case ctx.OutputFormat == "text":
 		out, err = yaml.JSONToYAML(out)
 		if err != nil {
 			return err
 		}
+	case ctx.OutputIndent > 0:
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, out, "", strings.Repeat(" ", ctx.OutputIndent)); err != nil {
+			return err
+		}
+		out = buf.Bytes()
 	}
 
 	writer := ctx.Output
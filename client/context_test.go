@@ -3,12 +3,15 @@ package client_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -98,6 +102,50 @@ x: "10"
 `, buf.String())
 }
 
+func TestContext_PrintRawIndent(t *testing.T) {
+	hasAnimal := json.RawMessage(`{"animal":{"@type":"/testpb.Dog","name":"Spot"},"x":"10"}`)
+
+	buf := &bytes.Buffer{}
+	ctx := client.Context{}.WithOutput(buf).WithOutputFormat(flags.OutputFormatJSON).WithOutputIndent(2)
+	require.NoError(t, ctx.PrintRaw(hasAnimal))
+	require.Equal(t,
+		`{
+  "animal": {
+    "@type": "/testpb.Dog",
+    "name": "Spot"
+  },
+  "x": "10"
+}
+`, buf.String())
+
+	// indentation is ignored for text output
+	buf = &bytes.Buffer{}
+	ctx = ctx.WithOutput(buf).WithOutputFormat(flags.OutputFormatText)
+	require.NoError(t, ctx.PrintRaw(hasAnimal))
+	require.Equal(t,
+		`animal:
+  '@type': /testpb.Dog
+  name: Spot
+x: "10"
+`, buf.String())
+}
+
+func TestContext_PrintError(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ctx := client.Context{}.WithOutput(buf).WithOutputFormat(flags.OutputFormatJSON)
+
+	err := errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "10stake is smaller than 20stake")
+	require.NoError(t, ctx.PrintError(err))
+	require.Equal(t,
+		`{"codespace":"sdk","code":5,"message":"10stake is smaller than 20stake: insufficient funds"}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, ctx.PrintError(errors.New("boom")))
+	require.Equal(t, `{"codespace":"undefined","code":1,"message":"boom"}
+`, buf.String())
+}
+
 func TestGetFromFields(t *testing.T) {
 	cfg := testutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
 	path := hd.CreateHDPath(118, 0, 0).String()
@@ -1,6 +1,7 @@
 package debug
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -41,6 +42,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(PrefixesCmd())
+	cmd.AddCommand(TxCmd())
 
 	return cmd
 }
@@ -120,8 +122,15 @@ func PubkeyCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			acc, err := clientCtx.AddressCodec.BytesToString(pk.Address())
+			if err != nil {
+				return err
+			}
+
 			cmd.Println("Address:", pk.Address())
+			cmd.Println("Bech32 Acc:", acc)
 			cmd.Println("PubKey Hex:", hex.EncodeToString(pk.Bytes()))
+			cmd.Println("PubKey Base64:", base64.StdEncoding.EncodeToString(pk.Bytes()))
 			return nil
 		},
 	}
@@ -248,14 +257,14 @@ func PubkeyRawCmd() *cobra.Command {
 func AddrCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:     "addr [address]",
-		Short:   "Convert an address between hex and bech32",
+		Short:   "Convert an address between hex, base64 and bech32",
 		Example: fmt.Sprintf("%s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			addrString := args[0]
-			// try hex, then bech32
+			// try hex, then bech32, then base64, as bech32 strings may be valid base64
 			var (
 				addr []byte
 				err  error
@@ -268,18 +277,29 @@ func AddrCmd() *cobra.Command {
 					var err3 error
 					addr, err3 = clientCtx.ValidatorAddressCodec.StringToBytes(addrString)
 					if err3 != nil {
-						return fmt.Errorf("expected hex or bech32. Got errors: hex: %w, bech32 acc: %w, bech32 val: %w", err, err2, err3)
+						var err4 error
+						addr, err4 = clientCtx.ConsensusAddressCodec.StringToBytes(addrString)
+						if err4 != nil {
+							var err5 error
+							addr, err5 = base64.StdEncoding.DecodeString(addrString)
+							if err5 != nil {
+								return fmt.Errorf("expected hex, bech32 or base64. Got errors: hex: %w, bech32 acc: %w, bech32 val: %w, bech32 con: %w, base64: %w", err, err2, err3, err4, err5)
+							}
+						}
 					}
 				}
 			}
 
 			acc, _ := clientCtx.AddressCodec.BytesToString(addr)
 			val, _ := clientCtx.ValidatorAddressCodec.BytesToString(addr)
+			cons, _ := clientCtx.ConsensusAddressCodec.BytesToString(addr)
 
 			cmd.Println("Address:", addr)
 			cmd.Printf("Address (hex): %X\n", addr)
+			cmd.Printf("Address (base64): %s\n", base64.StdEncoding.EncodeToString(addr))
 			cmd.Printf("Bech32 Acc: %s\n", acc)
 			cmd.Printf("Bech32 Val: %s\n", val)
+			cmd.Printf("Bech32 Con: %s\n", cons)
 			return nil
 		},
 	}
@@ -338,3 +358,53 @@ func PrefixesCmd() *cobra.Command {
 		},
 	}
 }
+
+// TxCmd creates and returns a new cmd converting a transaction hash between hex
+// and base64, or decoding raw transaction bytes.
+func TxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx [tx-hash|tx-bytes]",
+		Short: "Convert a transaction hash between hex and base64, or decode a hex or base64 encoded transaction",
+		Long: `Convert a transaction hash between hex and base64, or decode a hex or base64 encoded transaction.
+A 32 bytes input is a transaction hash, which is printed in hex and base64. Any other
+input is decoded as a transaction, e.g. as found in the JSON of a block, and printed
+in JSON along with its hash.`,
+		Example: fmt.Sprintf(`%s debug tx B1F3E12EAD240EE0A1E6CF24F90757126BAD274A5CDE14BF6635EAE7A7C05C80
+%s debug tx sfPhLq0kDuCh5s8k+QdXEmutJ0pc3hS/ZjXq56fAXIA=`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := hex.DecodeString(args[0])
+			if err != nil {
+				var err2 error
+				bz, err2 = base64.StdEncoding.DecodeString(args[0])
+				if err2 != nil {
+					return fmt.Errorf("expected hex or base64. Got errors: hex: %w, base64: %w", err, err2)
+				}
+			}
+
+			if len(bz) == sha256.Size {
+				cmd.Printf("Hash (hex): %X\n", bz)
+				cmd.Printf("Hash (base64): %s\n", base64.StdEncoding.EncodeToString(bz))
+				return nil
+			}
+
+			tx, err := clientCtx.TxConfig.TxDecoder()(bz)
+			if err != nil {
+				return fmt.Errorf("failed to decode transaction: %w", err)
+			}
+
+			txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			hash := sha256.Sum256(bz)
+			cmd.Printf("Hash (hex): %X\n", hash)
+			cmd.Printf("Hash (base64): %s\n", base64.StdEncoding.EncodeToString(hash[:]))
+			cmd.Printf("Tx: %s\n", txJSON)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ErrorResponse defines the schema used to report errors in machine-readable
+// (JSON) command output.
+type ErrorResponse struct {
+	Codespace string `json:"codespace,omitempty"`
+	Code      uint32 `json:"code"`
+	Message   string `json:"message"`
+}
+
+// NewErrorResponse returns the ErrorResponse describing err. Errors that were
+// not registered with a codespace are reported with the undefined codespace.
+func NewErrorResponse(err error) ErrorResponse {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	return ErrorResponse{
+		Codespace: codespace,
+		Code:      code,
+		Message:   err.Error(),
+	}
+}
@@ -86,6 +86,8 @@ const (
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
+	// FlagOutputIndent is the flag to set the number of spaces used to indent JSON output.
+	FlagOutputIndent = "output-indent"
 	// Logging flags
 	FlagLogLevel   = "log_level"
 	FlagLogFormat  = "log_format"
@@ -109,6 +111,7 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagGRPCInsecure, false, "allow gRPC over insecure channels, if not the server must use TLS")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
 	cmd.Flags().StringP(FlagOutput, "o", "text", "Output format (text|json)")
+	cmd.Flags().Int(FlagOutputIndent, 0, "Number of spaces used to indent JSON output; 0 prints compact JSON")
 
 	// some base commands does not require chainID e.g `simd testnet` while subcommands do
 	// hence the flag should not be required for those commands
@@ -119,6 +122,7 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f := cmd.Flags()
 	f.StringP(FlagOutput, "o", OutputFormatJSON, "Output format (text|json)")
+	f.Int(FlagOutputIndent, 0, "Number of spaces used to indent JSON output; 0 prints compact JSON")
 	if cmd.Flag(FlagFrom) == nil { // avoid flag redefinition when it's already been added by AutoCLI
 		f.String(FlagFrom, "", "Name or address of private key with which to sign")
 	}
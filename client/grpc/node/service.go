@@ -5,10 +5,13 @@ import (
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
@@ -32,6 +35,7 @@ type queryServer struct {
 func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
 	return queryServer{
 		clientCtx: clientCtx,
+		cfg:       cfg,
 	}
 }
 
@@ -62,3 +66,35 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+// Health returns the readiness of the node to serve application requests. The
+// node is ready once it has committed a height and is no longer catching up.
+func (s queryServer) Health(ctx context.Context, _ *HealthRequest) (*HealthResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var catchingUp bool
+	if s.clientCtx.Client != nil {
+		nodeStatus, err := s.clientCtx.Client.Status(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to query the node status: %v", err)
+		}
+		catchingUp = nodeStatus.SyncInfo.CatchingUp
+	}
+
+	blockTime := sdkCtx.HeaderInfo().Time
+	height := sdkCtx.BlockHeight()
+	consensusParams := sdkCtx.ConsensusParams()
+
+	return &HealthResponse{
+		Ready:           !catchingUp && height > 0,
+		CatchingUp:      catchingUp,
+		ChainId:         sdkCtx.ChainID(),
+		Height:          uint64(height),
+		AppHash:         sdkCtx.BlockHeader().AppHash,
+		Timestamp:       &blockTime,
+		Version:         version.Version,
+		Commit:          version.Commit,
+		AppVersion:      consensusParams.GetVersion().GetApp(),
+		MinimumGasPrice: sdkCtx.MinGasPrices().String(),
+	}, nil
+}
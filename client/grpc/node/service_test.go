@@ -2,9 +2,13 @@ package node
 
 import (
 	"testing"
+	"time"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,3 +25,28 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+func TestServiceServer_Health(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.Context{}.
+		WithBlockHeader(cmtproto.Header{ChainID: "test-chain", Height: 10, AppHash: []byte("app-hash")}).
+		WithHeaderInfo(header.Info{Height: 10, Time: blockTime}).
+		WithConsensusParams(cmtproto.ConsensusParams{Version: &cmtproto.VersionParams{App: 2}}).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)))
+
+	resp, err := svr.Health(ctx, &HealthRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Ready)
+	require.False(t, resp.CatchingUp)
+	require.Equal(t, "test-chain", resp.ChainId)
+	require.Equal(t, uint64(10), resp.Height)
+	require.Equal(t, []byte("app-hash"), resp.AppHash)
+	require.Equal(t, blockTime, *resp.Timestamp)
+	require.Equal(t, uint64(2), resp.AppVersion)
+	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
+
+	resp, err = svr.Health(sdk.Context{}, &HealthRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.Ready)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
@@ -27,7 +28,7 @@ func DumpArchiveCmd() *cobra.Command {
 				return err
 			}
 
-			output, err := cmd.Flags().GetString("output")
+			output, err := cmd.Flags().GetString(flags.FlagOutputDocument)
 			if err != nil {
 				return err
 			}
@@ -102,7 +103,7 @@ func DumpArchiveCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringP("output", "o", "", "output file")
+	cmd.Flags().StringP(flags.FlagOutputDocument, "o", "", "Archive file path (defaults to <height>-<format>.tar.gz)")
 
 	return cmd
 }
@@ -0,0 +1,119 @@
+package tx
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgSummaryFormatter renders a single message as a short, human-readable
+// description shown to the user before a transaction is signed.
+type MsgSummaryFormatter func(msg sdk.Msg) (string, error)
+
+var (
+	msgSummaryFormattersMu sync.RWMutex
+	msgSummaryFormatters   = map[string]MsgSummaryFormatter{}
+)
+
+// RegisterMsgSummaryFormatter registers a formatter used to render messages of
+// the same type as msg in the transaction confirmation summary. Registering a
+// second formatter for the same message type overrides the first one.
+func RegisterMsgSummaryFormatter(msg sdk.Msg, formatter MsgSummaryFormatter) {
+	msgSummaryFormattersMu.Lock()
+	defer msgSummaryFormattersMu.Unlock()
+
+	msgSummaryFormatters[sdk.MsgTypeURL(msg)] = formatter
+}
+
+func getMsgSummaryFormatter(typeURL string) (MsgSummaryFormatter, bool) {
+	msgSummaryFormattersMu.RLock()
+	defer msgSummaryFormattersMu.RUnlock()
+
+	formatter, ok := msgSummaryFormatters[typeURL]
+	return formatter, ok
+}
+
+// WriteTxSummary writes a human-readable summary of the given transaction
+// (messages, fees, gas, memo and signer) to w. Messages without a registered
+// formatter are rendered as JSON using the client codec.
+func WriteTxSummary(clientCtx client.Context, w io.Writer, tx authsigning.Tx) error {
+	var sb strings.Builder
+
+	msgs := tx.GetMsgs()
+	sb.WriteString("Transaction summary:\n")
+	if clientCtx.ChainID != "" {
+		fmt.Fprintf(&sb, "  chain-id: %s\n", clientCtx.ChainID)
+	}
+	fmt.Fprintf(&sb, "  signer:   %s\n", formatSigner(clientCtx))
+	fmt.Fprintf(&sb, "  messages: %d\n", len(msgs))
+	for i, msg := range msgs {
+		line, err := formatMsgSummary(clientCtx, msg)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&sb, "    [%d] %s\n", i, line)
+	}
+
+	fee := sdk.Coins(tx.GetFee())
+	if fee.Empty() {
+		sb.WriteString("  fee:      none\n")
+	} else {
+		fmt.Fprintf(&sb, "  fee:      %s\n", fee)
+	}
+	fmt.Fprintf(&sb, "  gas:      %d\n", tx.GetGas())
+	if memo := tx.GetMemo(); memo != "" {
+		fmt.Fprintf(&sb, "  memo:     %q\n", memo)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func formatSigner(clientCtx client.Context) string {
+	addr := clientCtx.GetFromAddress()
+	if addr.Empty() {
+		return clientCtx.FromName
+	}
+
+	addrStr := addr.String()
+	if clientCtx.AddressCodec != nil {
+		if s, err := clientCtx.AddressCodec.BytesToString(addr); err == nil {
+			addrStr = s
+		}
+	}
+
+	if name := clientCtx.FromName; name != "" && name != addrStr {
+		return fmt.Sprintf("%s (%s)", addrStr, name)
+	}
+
+	return addrStr
+}
+
+func formatMsgSummary(clientCtx client.Context, msg sdk.Msg) (string, error) {
+	typeURL := sdk.MsgTypeURL(msg)
+	if formatter, ok := getMsgSummaryFormatter(typeURL); ok {
+		line, err := formatter(msg)
+		if err != nil {
+			return "", fmt.Errorf("failed to format %s: %w", typeURL, err)
+		}
+
+		return fmt.Sprintf("%s: %s", typeURL, line), nil
+	}
+
+	if clientCtx.Codec == nil {
+		return typeURL, nil
+	}
+
+	bz, err := clientCtx.Codec.MarshalJSON(msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to format %s: %w", typeURL, err)
+	}
+
+	return fmt.Sprintf("%s: %s", typeURL, bz), nil
+}
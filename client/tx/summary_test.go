@@ -0,0 +1,62 @@
+package tx
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	countertypes "github.com/cosmos/cosmos-sdk/x/counter/types"
+)
+
+func TestWriteTxSummary(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	from := sdk.AccAddress("from")
+	msg := &countertypes.MsgIncreaseCounter{Signer: from.String(), Count: 3}
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	builder.SetGasLimit(200000)
+	builder.SetMemo("hello")
+
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithChainID("test-chain").
+		WithFromAddress(from).
+		WithFromName("alice")
+
+	// without a registered formatter the message is rendered as JSON
+	var buf bytes.Buffer
+	require.NoError(t, WriteTxSummary(clientCtx, &buf, builder.GetTx()))
+	out := buf.String()
+	require.Contains(t, out, "chain-id: test-chain")
+	require.Contains(t, out, fmt.Sprintf("signer:   %s (alice)", from))
+	require.Contains(t, out, "messages: 1")
+	require.Contains(t, out, `[0] /cosmos.counter.v1.MsgIncreaseCounter: {"signer":"`)
+	require.Contains(t, out, "fee:      10stake")
+	require.Contains(t, out, "gas:      200000")
+	require.Contains(t, out, `memo:     "hello"`)
+
+	RegisterMsgSummaryFormatter(&countertypes.MsgIncreaseCounter{}, func(msg sdk.Msg) (string, error) {
+		return fmt.Sprintf("increase counter by %d", msg.(*countertypes.MsgIncreaseCounter).Count), nil
+	})
+	t.Cleanup(func() {
+		msgSummaryFormattersMu.Lock()
+		delete(msgSummaryFormatters, sdk.MsgTypeURL(msg))
+		msgSummaryFormattersMu.Unlock()
+	})
+
+	buf.Reset()
+	require.NoError(t, WriteTxSummary(clientCtx, &buf, builder.GetTx()))
+	require.Contains(t, buf.String(), "[0] /cosmos.counter.v1.MsgIncreaseCounter: increase counter by 3")
+
+	// a transaction without fees is reported explicitly
+	builder.SetFeeAmount(nil)
+	buf.Reset()
+	require.NoError(t, WriteTxSummary(clientCtx, &buf, builder.GetTx()))
+	require.Contains(t, buf.String(), "fee:      none")
+}
@@ -3,7 +3,6 @@ package tx
 import (
 	"bufio"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -100,18 +99,8 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	if !clientCtx.SkipConfirm {
-		encoder := txf.txConfig.TxJSONEncoder()
-		if encoder == nil {
-			return errors.New("failed to encode transaction: tx json encoder is nil")
-		}
-
-		txBytes, err := encoder(tx.GetTx())
-		if err != nil {
-			return fmt.Errorf("failed to encode transaction: %w", err)
-		}
-
-		if err := clientCtx.PrintRaw(json.RawMessage(txBytes)); err != nil {
-			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n%s\n", err, txBytes)
+		if err := WriteTxSummary(clientCtx, os.Stderr, tx.GetTx()); err != nil {
+			return err
 		}
 
 		buf := bufio.NewReader(os.Stdin)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
@@ -128,11 +129,15 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 			return err
 		}
 
+		opts := encoderOptions
+		if indent, _ := cmd.Flags().GetInt(flags.FlagOutputIndent); indent > 0 {
+			opts.Indent = strings.Repeat(" ", indent)
+		}
 		if noIndent, _ := cmd.Flags().GetBool(flags.FlagNoIndent); noIndent {
-			encoderOptions.Indent = ""
+			opts.Indent = ""
 		}
 
-		enc := encoder(aminojson.NewEncoder(encoderOptions))
+		enc := encoder(aminojson.NewEncoder(opts))
 		bz, err := enc.Marshal(output.Interface())
 		if err != nil {
 			return fmt.Errorf("cannot marshal response %v: %w", output.Interface(), err)
@@ -11,6 +11,9 @@ const (
 	// FlagNoIndent is the flag to not indent the output.
 	FlagNoIndent = "no-indent"
 
+	// FlagOutputIndent is the flag to set the number of spaces used to indent the output.
+	FlagOutputIndent = "output-indent"
+
 	// FlagNoPrompt is the flag to not use a prompt for commands.
 	FlagNoPrompt = "no-prompt"
 
@@ -0,0 +1,48 @@
+#!/usr/bin/env bash
+
+# Runs the benchmarks of the hot paths of the SDK (bank sends, delegations,
+# reward withdrawals, accounts, coins and IAVL iteration) and writes their
+# results to a file in the Go benchmark format. When a baseline file produced
+# by a previous run is given, the results are compared against it with
+# benchstat.
+#
+# Usage: scripts/benchmark.sh <output-file> [baseline-file]
+#
+# BENCH_COUNT (default 6) sets how many times each benchmark is run, and
+# BENCH_TIME (default 1s) the -benchtime of each run.
+
+set -euo pipefail
+
+OUTPUT=${1:?usage: $0 <output-file> [baseline-file]}
+BASELINE=${2:-}
+COUNT=${BENCH_COUNT:-6}
+BENCHTIME=${BENCH_TIME:-1s}
+
+ROOT=$(cd "$(dirname "$0")/.." && pwd)
+OUTPUT=$(cd "$(dirname "$OUTPUT")" && pwd)/$(basename "$OUTPUT")
+
+# module directory, package and benchmark pattern of every hot path
+SUITES=(
+  ".                ./types                            ^Benchmark(Coins|SumOfCoinAdds)"
+  "x/auth           ./keeper                           ^BenchmarkAccountMapper"
+  "tests            ./integration/bank                 ^BenchmarkOneBank"
+  "tests            ./integration/staking/keeper       ^Benchmark(Delegate|GetValidator)"
+  "tests            ./integration/distribution/keeper  ^BenchmarkWithdrawDelegationRewards$"
+  "tests            ./integration/store/iavl           ^BenchmarkIAVL"
+)
+
+: > "$OUTPUT"
+for suite in "${SUITES[@]}"; do
+  read -r dir pkg pattern <<< "$suite"
+  echo "Running $pattern in $dir/$pkg"
+  (cd "$ROOT/$dir" && go test -mod=readonly -run='^$' -bench="$pattern" -benchmem -count="$COUNT" -benchtime="$BENCHTIME" "$pkg") | grep -E '^(goos|goarch|pkg|cpu|Benchmark)' >> "$OUTPUT"
+done
+echo "Results written to $OUTPUT"
+
+if [ -n "$BASELINE" ]; then
+  if command -v benchstat &> /dev/null; then
+    benchstat "$BASELINE" "$OUTPUT"
+  else
+    go run golang.org/x/perf/cmd/benchstat@latest "$BASELINE" "$OUTPUT"
+  fi
+fi
@@ -12,6 +12,20 @@ $mockgen_cmd -source=orm/model/ormtable/hooks.go -package ormmocks -destination
 $mockgen_cmd -source=x/nft/expected_keepers.go -package testutil -destination x/nft/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/feegrant/expected_keepers.go -package testutil -destination x/feegrant/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/mint/types/expected_keepers.go -package testutil -destination x/mint/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/faucet/types/expected_keepers.go -package testutil -destination x/faucet/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/tokenfactory/types/expected_keepers.go -package testutil -destination x/tokenfactory/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/recovery/types/expected_keepers.go -package testutil -destination x/recovery/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/nameservice/types/expected_keepers.go -package testutil -destination x/nameservice/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/airdrop/types/expected_keepers.go -package testutil -destination x/airdrop/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/paychan/types/expected_keepers.go -package testutil -destination x/paychan/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/lockup/types/expected_keepers.go -package testutil -destination x/lockup/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/notary/types/expected_keepers.go -package testutil -destination x/notary/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/htlc/types/expected_keepers.go -package testutil -destination x/htlc/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/feemarket/types/expected_keepers.go -package testutil -destination x/feemarket/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/amm/types/expected_keepers.go -package testutil -destination x/amm/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/did/types/expected_keepers.go -package testutil -destination x/did/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/denylist/types/expected_keepers.go -package testutil -destination x/denylist/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/oracle/types/expected_keepers.go -package testutil -destination x/oracle/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/crisis/types/expected_keepers.go -package testutil -destination x/crisis/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/tx/config/expected_keepers.go -package testutil -destination x/auth/tx/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/types/expected_keepers.go -package testutil -destination x/auth/testutil/expected_keepers_mocks.go
@@ -31,7 +31,16 @@ func Execute(rootCmd *cobra.Command, envPrefix, defaultHome string) error {
 	rootCmd.PersistentFlags().Bool(flags.FlagLogNoColor, false, "Disable colored logs")
 
 	executor := cmtcli.PrepareBaseCmd(rootCmd, envPrefix, defaultHome)
-	return executor.ExecuteContext(ctx)
+	cmd, err := executor.ExecuteContextC(ctx)
+	if err != nil && cmd != nil {
+		// with JSON output, errors are reported on the command output as well so that
+		// automation consuming it does not need to parse human-readable messages.
+		if clientCtx := client.GetClientContextFromCmd(cmd); clientCtx.OutputFormat == flags.OutputFormatJSON {
+			_ = clientCtx.WithOutput(cmd.OutOrStdout()).PrintError(err)
+		}
+	}
+
+	return err
 }
 
 // CreateExecuteContext returns a base Context with server and client context
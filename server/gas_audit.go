@@ -0,0 +1,330 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagGasReport = "report"
+
+// GasReport holds the gas traces of the transactions of a range of replayed
+// blocks.
+type GasReport struct {
+	FromHeight int64                `json:"from_height"`
+	ToHeight   int64                `json:"to_height"`
+	Txs        []baseapp.TxGasTrace `json:"txs"`
+}
+
+// GasDivergence is a difference between the gas consumed by a transaction in
+// two gas reports.
+type GasDivergence struct {
+	Height int64 `json:"height"`
+	Index  int   `json:"index"`
+	// Msg is the index of the diverging message, or -1 when the divergence is
+	// not specific to a message.
+	Msg   int    `json:"msg"`
+	Field string `json:"field"`
+	A     uint64 `json:"a"`
+	B     uint64 `json:"b"`
+}
+
+func (d GasDivergence) String() string {
+	if d.Msg < 0 {
+		return fmt.Sprintf("height %d, tx %d: %s %d != %d", d.Height, d.Index, d.Field, d.A, d.B)
+	}
+
+	return fmt.Sprintf("height %d, tx %d, msg %d: %s %d != %d", d.Height, d.Index, d.Msg, d.Field, d.A, d.B)
+}
+
+// NewGasAuditCmd creates a command to record and compare the gas consumed by
+// the transactions of a range of blocks.
+func NewGasAuditCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-audit",
+		Short: "Record and compare the gas consumed by the transactions of a range of blocks",
+		Long: `Record and compare the gas consumed by the transactions of a range of blocks.
+
+The blocks are replayed by two binaries, or by one binary with two configurations,
+each recording a gas report with the replay subcommand. The diff subcommand then
+flags every transaction whose gas consumption differs between the two reports, down
+to the gas consumed by each message per store operation.`,
+	}
+
+	cmd.AddCommand(
+		newReplayGasCmd(appCreator),
+		newDiffGasCmd(),
+	)
+
+	return cmd
+}
+
+func newReplayGasCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [from-height] [to-height]",
+		Short: "Replay a range of blocks from the block store and record the gas consumed by their transactions",
+		Long: `Replay a range of blocks from the block store and record the gas consumed by their transactions.
+
+The application state is rolled back to the height preceding the first replayed block,
+which must not have been pruned. As the replayed blocks are then committed to the
+application state, the command must be run on a copy of the node home directory.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+			to, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+			if from <= 0 || to < from {
+				return fmt.Errorf("invalid height range %d to %d", from, to)
+			}
+
+			reportFile, err := cmd.Flags().GetString(flagGasReport)
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := OpenDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			defer app.Close()
+
+			tracer, ok := any(app).(interface{ SetGasTracer(baseapp.GasTracer) })
+			if !ok {
+				return fmt.Errorf("the application does not support gas tracing")
+			}
+
+			cms := app.CommitMultiStore()
+			if version := cms.LastCommitID().Version; version < from-1 {
+				return fmt.Errorf("the application state is at height %d, replaying from height %d requires the state at height %d", version, from, from-1)
+			} else if version > from-1 {
+				if err := cms.RollbackToVersion(from - 1); err != nil {
+					return fmt.Errorf("failed to roll the application state back to height %d: %w", from-1, err)
+				}
+			}
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: ctx.Config})
+			if err != nil {
+				return err
+			}
+			blockStore := store.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: ctx.Config})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+			defer stateStore.Close()
+
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+
+			report := GasReport{FromHeight: from, ToHeight: to}
+			tracer.SetGasTracer(func(trace baseapp.TxGasTrace) {
+				report.Txs = append(report.Txs, trace)
+			})
+
+			for height := from; height <= to; height++ {
+				req, err := finalizeBlockRequest(blockStore, stateStore, height, state.InitialHeight)
+				if err != nil {
+					return err
+				}
+
+				if _, err := app.FinalizeBlock(req); err != nil {
+					return fmt.Errorf("failed to finalize block %d: %w", height, err)
+				}
+				if _, err := app.Commit(); err != nil {
+					return fmt.Errorf("failed to commit block %d: %w", height, err)
+				}
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Clean(reportFile), bz, 0o600); err != nil {
+				return err
+			}
+
+			cmd.Printf("recorded the gas of %d transactions from height %d to %d in %s\n", len(report.Txs), from, to, reportFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGasReport, "gas-report.json", "File the gas report is written to")
+	return cmd
+}
+
+// finalizeBlockRequest returns the request finalizing the block stored at the
+// given height, as built by CometBFT when the block was committed.
+func finalizeBlockRequest(blockStore *store.BlockStore, stateStore sm.Store, height, initialHeight int64) (*abci.RequestFinalizeBlock, error) {
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block %d is not in the block store", height)
+	}
+
+	commitInfo := abci.CommitInfo{}
+	if height != initialHeight {
+		lastValSet, err := stateStore.LoadValidators(height - 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the validators of height %d: %w", height-1, err)
+		}
+		commitInfo = sm.BuildLastCommitInfo(block, lastValSet, initialHeight)
+	}
+
+	return &abci.RequestFinalizeBlock{
+		Txs:                block.Txs.ToSliceOfBytes(),
+		DecidedLastCommit:  commitInfo,
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Hash:               block.Hash(),
+		Height:             block.Height,
+		Time:               block.Time,
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+	}, nil
+}
+
+func newDiffGasCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [report-a] [report-b]",
+		Short: "Compare two gas reports and print the transactions whose gas consumption differs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			a, err := readGasReport(args[0])
+			if err != nil {
+				return err
+			}
+			b, err := readGasReport(args[1])
+			if err != nil {
+				return err
+			}
+
+			divergences := DiffGasReports(a, b)
+			if len(divergences) == 0 {
+				cmd.Printf("the gas consumption of the %d transactions is identical\n", len(a.Txs))
+				return nil
+			}
+
+			for _, d := range divergences {
+				cmd.Println(d)
+			}
+
+			return fmt.Errorf("found %d gas divergences", len(divergences))
+		},
+	}
+}
+
+func readGasReport(path string) (GasReport, error) {
+	var report GasReport
+
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return report, err
+	}
+
+	if err := json.Unmarshal(bz, &report); err != nil {
+		return report, fmt.Errorf("invalid gas report %s: %w", path, err)
+	}
+
+	return report, nil
+}
+
+// DiffGasReports returns, sorted by height and transaction index, every
+// difference between the gas consumed by the transactions of two reports.
+func DiffGasReports(a, b GasReport) []GasDivergence {
+	type txKey struct {
+		height int64
+		index  int
+	}
+
+	txsB := make(map[txKey]baseapp.TxGasTrace, len(b.Txs))
+	for _, tx := range b.Txs {
+		txsB[txKey{tx.Height, tx.Index}] = tx
+	}
+
+	var divergences []GasDivergence
+	for _, txA := range a.Txs {
+		key := txKey{txA.Height, txA.Index}
+		txB, ok := txsB[key]
+		if !ok {
+			divergences = append(divergences, GasDivergence{Height: txA.Height, Index: txA.Index, Msg: -1, Field: "gas_used (missing in b)", A: txA.GasUsed})
+			continue
+		}
+		delete(txsB, key)
+
+		divergences = append(divergences, diffTxGas(txA, txB)...)
+	}
+
+	for _, txB := range txsB {
+		divergences = append(divergences, GasDivergence{Height: txB.Height, Index: txB.Index, Msg: -1, Field: "gas_used (missing in a)", B: txB.GasUsed})
+	}
+
+	sort.SliceStable(divergences, func(i, j int) bool {
+		if divergences[i].Height != divergences[j].Height {
+			return divergences[i].Height < divergences[j].Height
+		}
+		return divergences[i].Index < divergences[j].Index
+	})
+
+	return divergences
+}
+
+func diffTxGas(a, b baseapp.TxGasTrace) []GasDivergence {
+	var divergences []GasDivergence
+	diff := func(msg int, field string, valueA, valueB uint64) {
+		if valueA != valueB {
+			divergences = append(divergences, GasDivergence{Height: a.Height, Index: a.Index, Msg: msg, Field: field, A: valueA, B: valueB})
+		}
+	}
+
+	diff(-1, "code", uint64(a.Code), uint64(b.Code))
+	diff(-1, "gas_used", a.GasUsed, b.GasUsed)
+	diff(-1, "ante_gas", a.AnteGas, b.AnteGas)
+	diff(-1, "msgs", uint64(len(a.Msgs)), uint64(len(b.Msgs)))
+
+	for i := 0; i < len(a.Msgs) && i < len(b.Msgs); i++ {
+		msgA, msgB := a.Msgs[i], b.Msgs[i]
+		diff(i, fmt.Sprintf("gas_used (%s)", msgA.TypeURL), msgA.GasUsed, msgB.GasUsed)
+
+		descriptors := make(map[string]struct{}, len(msgA.Descriptors))
+		for descriptor := range msgA.Descriptors {
+			descriptors[descriptor] = struct{}{}
+		}
+		for descriptor := range msgB.Descriptors {
+			descriptors[descriptor] = struct{}{}
+		}
+
+		sorted := make([]string, 0, len(descriptors))
+		for descriptor := range descriptors {
+			sorted = append(sorted, descriptor)
+		}
+		sort.Strings(sorted)
+
+		for _, descriptor := range sorted {
+			diff(i, descriptor, msgA.Descriptors[descriptor], msgB.Descriptors[descriptor])
+		}
+	}
+
+	return divergences
+}
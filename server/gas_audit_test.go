@@ -0,0 +1,37 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestDiffGasReports(t *testing.T) {
+	newTx := func(height int64, index int, readPerByte uint64) baseapp.TxGasTrace {
+		return baseapp.TxGasTrace{
+			Height:  height,
+			Index:   index,
+			GasUsed: 1000 + readPerByte,
+			AnteGas: 1000,
+			Msgs: []*baseapp.MsgGasTrace{{
+				TypeURL:     "/cosmos.bank.v1beta1.MsgSend",
+				GasUsed:     readPerByte,
+				Descriptors: map[string]uint64{"ReadPerByte": readPerByte},
+			}},
+		}
+	}
+
+	a := server.GasReport{FromHeight: 1, ToHeight: 2, Txs: []baseapp.TxGasTrace{newTx(1, 0, 30), newTx(2, 0, 30), newTx(2, 1, 30)}}
+	require.Empty(t, server.DiffGasReports(a, a))
+
+	b := server.GasReport{FromHeight: 1, ToHeight: 2, Txs: []baseapp.TxGasTrace{newTx(1, 0, 30), newTx(2, 0, 33)}}
+	require.Equal(t, []server.GasDivergence{
+		{Height: 2, Index: 0, Msg: -1, Field: "gas_used", A: 1030, B: 1033},
+		{Height: 2, Index: 0, Msg: 0, Field: "gas_used (/cosmos.bank.v1beta1.MsgSend)", A: 30, B: 33},
+		{Height: 2, Index: 0, Msg: 0, Field: "ReadPerByte", A: 30, B: 33},
+		{Height: 2, Index: 1, Msg: -1, Field: "gas_used (missing in b)", A: 1030},
+	}, server.DiffGasReports(a, b))
+}
@@ -9,22 +9,36 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
-// NewRollbackCmd creates a command to rollback CometBFT and multistore state by one height.
+// NewRollbackCmd creates a command to rollback CometBFT and multistore state by one or more heights.
 func NewRollbackCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		numBlocks   uint64
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK and CometBFT state by one or more heights",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
 progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting CometBFT the transactions in block n will be re-executed against the
-application.
+The application also rolls back to height n - 1, deleting the multistore versions
+above it. No blocks are removed, so upon restarting CometBFT the transactions in
+block n will be re-executed against the application.
+
+Rolling back more than one height, e.g. to undo the blocks committed by a bad local
+upgrade, also removes the rolled back blocks and thus requires the --hard flag.
 `,
+		Example: "rollback --hard --num-blocks 3",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if numBlocks == 0 {
+				return fmt.Errorf("the number of heights to roll back must be positive")
+			}
+			if numBlocks > 1 && !removeBlock {
+				return fmt.Errorf("rolling back more than one height requires the --hard flag")
+			}
+
 			ctx := GetServerContextFromCmd(cmd)
 
 			db, err := OpenDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
@@ -32,10 +46,16 @@ application.
 				return err
 			}
 			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
-			// rollback CometBFT state
-			height, hash, err := cmtcmd.RollbackState(ctx.Config, removeBlock)
-			if err != nil {
-				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+			// rollback CometBFT state, one height at a time
+			var (
+				height int64
+				hash   []byte
+			)
+			for i := uint64(0); i < numBlocks; i++ {
+				height, hash, err = cmtcmd.RollbackState(ctx.Config, removeBlock)
+				if err != nil {
+					return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+				}
 			}
 			// rollback the multistore
 
@@ -49,5 +69,6 @@ application.
 	}
 
 	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().Uint64Var(&numBlocks, "num-blocks", 1, "number of heights to roll back, more than one requires --hard")
 	return cmd
 }
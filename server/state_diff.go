@@ -0,0 +1,217 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+const flagStores = "stores"
+
+// KVChangeType is the type of change made to a key between two versions of a
+// store.
+type KVChangeType string
+
+const (
+	KVAdded   KVChangeType = "added"
+	KVRemoved KVChangeType = "removed"
+	KVChanged KVChangeType = "changed"
+)
+
+// KVChange is a key added, removed or changed between two versions of a store.
+// A holds the pair at the first version and B the pair at the second one, the
+// value of a missing pair being nil.
+type KVChange struct {
+	Type KVChangeType
+	A, B kv.Pair
+}
+
+// NewStateDiffCmd creates a command to print the keys changed in the
+// application state between two heights.
+func NewStateDiffCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff-state [height-a] [height-b]",
+		Short: "Print the keys added, removed and changed in every store between two heights",
+		Long: `Print the keys added, removed and changed in every store between two heights.
+Both heights must not have been pruned. The values of the stores whose module
+registers a store decoder are decoded, the others are printed in hexadecimal.
+
+The node must be stopped, as the command reads the application database directly.`,
+		Example: "diff-state 100 101 --stores bank,staking",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			heightA, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			heightB, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[1], err)
+			}
+
+			storeNames, err := cmd.Flags().GetStringSlice(flagStores)
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := OpenDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(log.NewNopLogger(), db, nil, ctx.Viper)
+			rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the diffing of rootmulti.Store type")
+			}
+
+			msA, err := rootMultiStore.CacheMultiStoreWithVersion(heightA)
+			if err != nil {
+				return fmt.Errorf("failed to load height %d: %w", heightA, err)
+			}
+			msB, err := rootMultiStore.CacheMultiStoreWithVersion(heightB)
+			if err != nil {
+				return fmt.Errorf("failed to load height %d: %w", heightB, err)
+			}
+
+			var decoders simtypes.StoreDecoderRegistry
+			if simApp, ok := any(app).(interface {
+				SimulationManager() *module.SimulationManager
+			}); ok && simApp.SimulationManager() != nil {
+				decoders = simApp.SimulationManager().StoreDecoders
+			}
+
+			storeKeys := rootMultiStore.StoreKeysByName()
+			if len(storeNames) == 0 {
+				for name := range storeKeys {
+					storeNames = append(storeNames, name)
+				}
+			}
+			sort.Strings(storeNames)
+
+			var changes int
+			for _, name := range storeNames {
+				storeKey, ok := storeKeys[name]
+				if !ok {
+					return fmt.Errorf("the application has no %s store", name)
+				}
+
+				var header bool
+				err := DiffKVStores(msA.GetKVStore(storeKey), msB.GetKVStore(storeKey), func(change KVChange) error {
+					if !header {
+						cmd.Printf("store %s:\n", name)
+						header = true
+					}
+					changes++
+
+					return writeKVChange(cmd.OutOrStdout(), decoders[name], change)
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("%d keys differ between height %d and %d\n", changes, heightA, heightB)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagStores, nil, "Names of the stores to diff (defaults to all stores)")
+	return cmd
+}
+
+// DiffKVStores walks two stores in key order and calls fn with every key
+// added, removed or changed from store a to store b.
+func DiffKVStores(a, b storetypes.KVStore, fn func(KVChange) error) error {
+	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
+
+	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var change KVChange
+
+		switch {
+		case !iterB.Valid() || (iterA.Valid() && bytes.Compare(iterA.Key(), iterB.Key()) < 0):
+			change = KVChange{Type: KVRemoved, A: kv.Pair{Key: iterA.Key(), Value: iterA.Value()}, B: kv.Pair{Key: iterA.Key()}}
+			iterA.Next()
+
+		case !iterA.Valid() || bytes.Compare(iterA.Key(), iterB.Key()) > 0:
+			change = KVChange{Type: KVAdded, A: kv.Pair{Key: iterB.Key()}, B: kv.Pair{Key: iterB.Key(), Value: iterB.Value()}}
+			iterB.Next()
+
+		default:
+			valueA, valueB := iterA.Value(), iterB.Value()
+			key := iterA.Key()
+			iterA.Next()
+			iterB.Next()
+
+			if bytes.Equal(valueA, valueB) {
+				continue
+			}
+			change = KVChange{Type: KVChanged, A: kv.Pair{Key: key, Value: valueA}, B: kv.Pair{Key: key, Value: valueB}}
+		}
+
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeKVChange writes a change, decoding its values with the given store
+// decoder if any. Values which cannot be decoded are written in hexadecimal.
+func writeKVChange(w io.Writer, decoder func(kvA, kvB kv.Pair) string, change KVChange) error {
+	var sb strings.Builder
+	switch change.Type {
+	case KVAdded:
+		fmt.Fprintf(&sb, "  + %X => %X\n", change.B.Key, change.B.Value)
+	case KVRemoved:
+		fmt.Fprintf(&sb, "  - %X => %X\n", change.A.Key, change.A.Value)
+	case KVChanged:
+		fmt.Fprintf(&sb, "  ~ %X: %X => %X\n", change.A.Key, change.A.Value, change.B.Value)
+	}
+
+	if decoded, ok := decodeKVChange(decoder, change); ok {
+		for _, line := range strings.Split(strings.TrimRight(decoded, "\n"), "\n") {
+			fmt.Fprintf(&sb, "      %s\n", line)
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// decodeKVChange decodes the values of a change with a store decoder. Store
+// decoders panic on keys they do not know, which are then left undecoded.
+func decodeKVChange(decoder func(kvA, kvB kv.Pair) string, change KVChange) (decoded string, ok bool) {
+	if decoder == nil {
+		return "", false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			decoded, ok = "", false
+		}
+	}()
+
+	return decoder(change.A, change.B), true
+}
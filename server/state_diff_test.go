@@ -0,0 +1,46 @@
+package server_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDiffKVStores(t *testing.T) {
+	a := dbadapter.Store{DB: dbm.NewMemDB()}
+	a.Set([]byte("a"), []byte("1"))
+	a.Set([]byte("b"), []byte("2"))
+	a.Set([]byte("c"), []byte("3"))
+	a.Set([]byte("e"), []byte("5"))
+
+	b := dbadapter.Store{DB: dbm.NewMemDB()}
+	b.Set([]byte("b"), []byte("2"))
+	b.Set([]byte("c"), []byte("4"))
+	b.Set([]byte("d"), []byte("4"))
+	b.Set([]byte("e"), []byte("5"))
+	b.Set([]byte("f"), []byte("6"))
+
+	var changes []server.KVChange
+	require.NoError(t, server.DiffKVStores(a, b, func(change server.KVChange) error {
+		changes = append(changes, change)
+		return nil
+	}))
+
+	require.Equal(t, []server.KVChange{
+		{Type: server.KVRemoved, A: kv.Pair{Key: []byte("a"), Value: []byte("1")}, B: kv.Pair{Key: []byte("a")}},
+		{Type: server.KVChanged, A: kv.Pair{Key: []byte("c"), Value: []byte("3")}, B: kv.Pair{Key: []byte("c"), Value: []byte("4")}},
+		{Type: server.KVAdded, A: kv.Pair{Key: []byte("d")}, B: kv.Pair{Key: []byte("d"), Value: []byte("4")}},
+		{Type: server.KVAdded, A: kv.Pair{Key: []byte("f")}, B: kv.Pair{Key: []byte("f"), Value: []byte("6")}},
+	}, changes)
+
+	require.NoError(t, server.DiffKVStores(a, a, func(server.KVChange) error {
+		t.Fatal("identical stores must not differ")
+		return nil
+	}))
+}
@@ -351,6 +351,8 @@ func AddCommands[T types.Application](rootCmd *cobra.Command, appCreator types.A
 		cometCmd,
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator),
+		NewGasAuditCmd(appCreator),
+		NewStateDiffCmd(appCreator),
 	)
 }
 
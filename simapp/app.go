@@ -31,11 +31,15 @@ import (
 	authcodec "cosmossdk.io/x/auth/codec"
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	"cosmossdk.io/x/auth/posthandler"
+	"cosmossdk.io/x/auth/recurring"
+	recurringkeeper "cosmossdk.io/x/auth/recurring/keeper"
+	recurringtypes "cosmossdk.io/x/auth/recurring/types"
 	authsims "cosmossdk.io/x/auth/simulation"
 	authtx "cosmossdk.io/x/auth/tx"
 	txmodule "cosmossdk.io/x/auth/tx/config"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/auth/vesting"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
 	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	"cosmossdk.io/x/authz"
 	authzkeeper "cosmossdk.io/x/authz/keeper"
@@ -59,6 +63,7 @@ import (
 	govkeeper "cosmossdk.io/x/gov/keeper"
 	govtypes "cosmossdk.io/x/gov/types"
 	govv1beta1 "cosmossdk.io/x/gov/types/v1beta1"
+	"cosmossdk.io/x/gov/vestingproposal"
 	"cosmossdk.io/x/group"
 	groupkeeper "cosmossdk.io/x/group/keeper"
 	groupmodule "cosmossdk.io/x/group/module"
@@ -161,6 +166,8 @@ type SimApp struct {
 	AuthzKeeper           authzkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
+	VestingKeeper         vestingkeeper.Keeper
+	RecurringKeeper       recurringkeeper.Keeper
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
@@ -258,7 +265,7 @@ func NewSimApp(
 		govtypes.StoreKey, consensusparamtypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, circuittypes.StoreKey,
 		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, pooltypes.StoreKey,
-		accounts.StoreKey,
+		accounts.StoreKey, vestingtypes.StoreKey, recurringtypes.StoreKey,
 	)
 
 	// register streaming services
@@ -343,6 +350,10 @@ func NewSimApp(
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[feegrant.StoreKey]), logger), appCodec, app.AuthKeeper)
 
+	app.VestingKeeper = vestingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[vestingtypes.StoreKey]), logger), app.AuthKeeper)
+
+	app.RecurringKeeper = recurringkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[recurringtypes.StoreKey]), logger), appCodec, app.AuthKeeper, app.BankKeeper)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper.SetHooks(
@@ -378,6 +389,7 @@ func NewSimApp(
 	// by granting the governance module the right to execute the message.
 	// See: https://docs.cosmos.network/main/modules/gov#proposal-messages
 	govRouter := govv1beta1.NewRouter()
+	govRouter.AddRoute(vestingproposal.RouterKey, vestingproposal.NewClawbackProposalHandler(app.AuthKeeper, app.PoolKeeper, app.VestingKeeper))
 	govConfig := govkeeper.DefaultConfig()
 	/*
 		Example of setting gov params:
@@ -411,7 +423,8 @@ func NewSimApp(
 		genutil.NewAppModule(app.AuthKeeper, app.StakingKeeper, app, txConfig, genutiltypes.DefaultMessageValidator),
 		accounts.NewAppModule(app.AccountsKeeper),
 		auth.NewAppModule(appCodec, app.AuthKeeper, authsims.RandomGenesisAccounts),
-		vesting.NewAppModule(app.AuthKeeper, app.BankKeeper),
+		vesting.NewAppModule(app.VestingKeeper, app.AuthKeeper, app.BankKeeper, app.PoolKeeper, app.StakingKeeper),
+		recurring.NewAppModule(app.RecurringKeeper, app.AuthKeeper, app.BankKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AuthKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AuthKeeper, app.BankKeeper, app.PoolKeeper),
@@ -430,6 +443,8 @@ func NewSimApp(
 	)
 	app.ModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.ModuleManager.RegisterInterfaces(interfaceRegistry)
+	vestingproposal.RegisterLegacyAminoCodec(legacyAmino)
+	vestingproposal.RegisterInterfaces(interfaceRegistry)
 
 	// NOTE: upgrade module is required to be prioritized
 	app.ModuleManager.SetOrderPreBlockers(
@@ -440,6 +455,7 @@ func NewSimApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.ModuleManager.SetOrderBeginBlockers(
+		circuittypes.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -447,6 +463,8 @@ func NewSimApp(
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
+		vestingtypes.ModuleName,
+		recurringtypes.ModuleName,
 	)
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName,
@@ -465,7 +483,7 @@ func NewSimApp(
 		distrtypes.ModuleName, stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
 		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName, upgradetypes.ModuleName,
-		vestingtypes.ModuleName, consensusparamtypes.ModuleName, circuittypes.ModuleName, pooltypes.ModuleName,
+		vestingtypes.ModuleName, recurringtypes.ModuleName, consensusparamtypes.ModuleName, circuittypes.ModuleName, pooltypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -596,7 +614,10 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 func (app *SimApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+		posthandler.HandlerOptions{
+			AccountKeeper: app.AuthKeeper,
+			BankKeeper:    app.BankKeeper,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -22,12 +22,15 @@ import (
 	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	nftmodulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	poolmodulev1 "cosmossdk.io/api/cosmos/protocolpool/module/v1"
+	recurringmodulev1 "cosmossdk.io/api/cosmos/recurring/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
 	upgrademodulev1 "cosmossdk.io/api/cosmos/upgrade/module/v1"
 	vestingmodulev1 "cosmossdk.io/api/cosmos/vesting/module/v1"
 	"cosmossdk.io/depinject/appconfig"
+	_ "cosmossdk.io/x/auth/recurring" // import for side-effects
+	recurringtypes "cosmossdk.io/x/auth/recurring/types"
 	_ "cosmossdk.io/x/auth/tx/config" // import for side-effects
 	authtypes "cosmossdk.io/x/auth/types"
 	_ "cosmossdk.io/x/auth/vesting" // import for side-effects
@@ -110,12 +113,15 @@ var (
 					// CanWithdrawInvariant invariant.
 					// NOTE: staking module is required if HistoricalEntries param > 0
 					BeginBlockers: []string{
+						circuittypes.ModuleName,
 						minttypes.ModuleName,
 						distrtypes.ModuleName,
 						slashingtypes.ModuleName,
 						evidencetypes.ModuleName,
 						stakingtypes.ModuleName,
 						authz.ModuleName,
+						vestingtypes.ModuleName,
+						recurringtypes.ModuleName,
 					},
 					EndBlockers: []string{
 						govtypes.ModuleName,
@@ -149,6 +155,7 @@ var (
 						group.ModuleName,
 						upgradetypes.ModuleName,
 						vestingtypes.ModuleName,
+						recurringtypes.ModuleName,
 						circuittypes.ModuleName,
 						pooltypes.ModuleName,
 					},
@@ -173,6 +180,10 @@ var (
 				Name:   vestingtypes.ModuleName,
 				Config: appconfig.WrapAny(&vestingmodulev1.Module{}),
 			},
+			{
+				Name:   recurringtypes.ModuleName,
+				Config: appconfig.WrapAny(&recurringmodulev1.Module{}),
+			},
 			{
 				Name: banktypes.ModuleName,
 				Config: appconfig.WrapAny(&bankmodulev1.Module{
@@ -18,6 +18,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/auth"
+	"cosmossdk.io/x/auth/recurring"
 	"cosmossdk.io/x/auth/vesting"
 	authzmodule "cosmossdk.io/x/authz/module"
 	"cosmossdk.io/x/bank"
@@ -209,6 +210,7 @@ func TestRunMigrations(t *testing.T) {
 					"group":        group.AppModule{}.ConsensusVersion(),
 					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 					"vesting":      vesting.AppModule{}.ConsensusVersion(),
+					"recurring":    recurring.AppModule{}.ConsensusVersion(),
 					"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
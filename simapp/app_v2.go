@@ -27,6 +27,7 @@ import (
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	govkeeper "cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/vestingproposal"
 	groupkeeper "cosmossdk.io/x/group/keeper"
 	mintkeeper "cosmossdk.io/x/mint/keeper"
 	nftkeeper "cosmossdk.io/x/nft/keeper"
@@ -171,6 +172,8 @@ func NewSimApp(
 				// custom function that implements the minttypes.InflationCalculationFn
 				// interface.
 			),
+			// route the vesting clawback proposals on the legacy gov router
+			depinject.Provide(vestingproposal.ProvideGovHandler),
 		)
 	)
 
@@ -200,6 +203,9 @@ func NewSimApp(
 		panic(err)
 	}
 
+	vestingproposal.RegisterInterfaces(app.interfaceRegistry)
+	vestingproposal.RegisterLegacyAminoCodec(app.legacyAmino)
+
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
 	// already set in the SDK's BaseApp, this shows an example of how to override
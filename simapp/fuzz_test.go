@@ -0,0 +1,117 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// addTxSeeds adds a valid signed transaction, encoded with encode, and a few
+// truncated and bit-flipped variants of it to the seed corpus.
+func addTxSeeds(f *testing.F, app *SimApp, encode func(sdk.Tx) ([]byte, error)) {
+	f.Helper()
+
+	r := rand.New(rand.NewSource(1))
+	priv := secp256k1.GenPrivKey()
+	from, to := sdk.AccAddress(priv.PubKey().Address()), sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	tx, err := simtestutil.GenSignedMockTx(
+		r, app.TxConfig(), []sdk.Msg{banktypes.NewMsgSend(from.String(), to.String(), coins)},
+		coins, simtestutil.DefaultGenTxGas, SimAppChainID, []uint64{0}, []uint64{0}, priv,
+	)
+	require.NoError(f, err)
+
+	bz, err := encode(tx)
+	require.NoError(f, err)
+
+	f.Add(bz)
+	f.Add(bz[:len(bz)/2])
+	for i := 0; i < 10; i++ {
+		mutated := append([]byte(nil), bz...)
+		mutated[r.Intn(len(mutated))] ^= byte(1 << uint(r.Intn(8)))
+		f.Add(mutated)
+	}
+	f.Add([]byte{})
+}
+
+// FuzzTxDecode feeds arbitrary bytes to the application transaction decoder
+// and, when they decode, to CheckTx. Neither of them may panic.
+func FuzzTxDecode(f *testing.F) {
+	app := Setup(f, true)
+	addTxSeeds(f, app, app.TxConfig().TxEncoder())
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		if _, err := app.TxDecode(bz); err != nil {
+			return
+		}
+
+		_, err := app.CheckTx(&abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+	})
+}
+
+// FuzzTxJSONDecode feeds arbitrary bytes to the application JSON transaction
+// decoder, which must not panic.
+func FuzzTxJSONDecode(f *testing.F) {
+	app := Setup(f, true)
+	addTxSeeds(f, app, app.TxConfig().TxJSONEncoder())
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		_, _ = app.TxConfig().TxJSONDecoder()(bz)
+	})
+}
+
+// FuzzLegacyAminoUnmarshalMsg feeds arbitrary bytes to the amino binary and
+// JSON decoders of the application messages, which must not panic.
+func FuzzLegacyAminoUnmarshalMsg(f *testing.F) {
+	app := Setup(f, true)
+	cdc := app.LegacyAmino()
+
+	var msg sdk.Msg = banktypes.NewMsgSend(
+		sdk.AccAddress("from").String(), sdk.AccAddress("to").String(),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+	)
+	f.Add(cdc.MustMarshal(&msg))
+	f.Add(cdc.MustMarshalJSON(&msg))
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var msg sdk.Msg
+		_ = cdc.Unmarshal(bz, &msg)
+		_ = cdc.UnmarshalJSON(bz, &msg)
+	})
+}
+
+// FuzzValidateGenesis feeds arbitrary bytes to the ValidateGenesis method of
+// every application module, which must not panic.
+func FuzzValidateGenesis(f *testing.F) {
+	app := Setup(f, true)
+
+	for _, genesis := range app.DefaultGenesis() {
+		f.Add([]byte(genesis))
+		f.Add([]byte(genesis[:len(genesis)/2]))
+	}
+	f.Add([]byte("{}"))
+	f.Add([]byte("null"))
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		for _, m := range app.ModuleManager.Modules {
+			if mod, ok := m.(module.HasGenesisBasics); ok {
+				_ = mod.ValidateGenesis(app.AppCodec(), app.TxConfig(), json.RawMessage(bz))
+			}
+		}
+	})
+}
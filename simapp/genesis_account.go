@@ -2,8 +2,10 @@ package simapp
 
 import (
 	"errors"
+	"fmt"
 
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -22,6 +24,9 @@ type SimGenesisAccount struct {
 	StartTime        int64     `json:"start_time" yaml:"start_time"`               // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // vesting end time (UNIX Epoch time)
 
+	// periodic vesting account fields
+	VestingPeriods vestingtypes.Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"` // periods of a periodic vesting schedule
+
 	// module account fields
 	ModuleName        string   `json:"module_name" yaml:"module_name"`               // name of the module account
 	ModulePermissions []string `json:"module_permissions" yaml:"module_permissions"` // permissions of module account
@@ -35,6 +40,25 @@ func (sga SimGenesisAccount) Validate() error {
 		}
 	}
 
+	if len(sga.VestingPeriods) > 0 {
+		endTime, err := sga.VestingPeriods.EndTime(sga.StartTime)
+		if err != nil {
+			return err
+		}
+		if endTime != sga.EndTime {
+			return errors.New("vesting end time does not match length of all vesting periods")
+		}
+
+		for i, p := range sga.VestingPeriods {
+			if err := p.Amount.Validate(); err != nil {
+				return fmt.Errorf("period #%d has invalid coins: %w", i, err)
+			}
+		}
+		if total := sga.VestingPeriods.TotalAmount(); !total.Equal(sga.OriginalVesting) {
+			return fmt.Errorf("original vesting coins (%v) does not match the sum of all coins in vesting periods (%v)", sga.OriginalVesting, total)
+		}
+	}
+
 	if sga.ModuleName != "" {
 		ma := authtypes.ModuleAccount{
 			BaseAccount: sga.BaseAccount, Name: sga.ModuleName, Permissions: sga.ModulePermissions,
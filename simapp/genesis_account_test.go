@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/simapp"
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,6 +79,48 @@ func TestSimGenesisAccountValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid basic account with valid vesting periods",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+				VestingPeriods: vestingtypes.Periods{
+					{Length: 1800, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 400))},
+					{Length: 1800, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 600))},
+				},
+			},
+			false,
+		},
+		{
+			"valid basic account with vesting periods not matching the end time",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(2 * time.Hour).Unix(),
+				VestingPeriods: vestingtypes.Periods{
+					{Length: 1800, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 400))},
+					{Length: 1800, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 600))},
+				},
+			},
+			true,
+		},
+		{
+			"valid basic account with vesting periods not matching the original vesting",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+				VestingPeriods: vestingtypes.Periods{
+					{Length: 1800, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 400))},
+					{Length: 1800, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 500))},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,106 @@
+package simapp_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/simapp"
+	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestGenTxFromVestingAccount(t *testing.T) {
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+
+	// the validator account is vesting its whole balance, starting after genesis
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction.MulRaw(10)))
+	vestingAcc, err := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccount(addr, priv.PubKey(), 0, 0), coins,
+		genesisTime.Add(time.Hour).Unix(), genesisTime.Add(24*time.Hour).Unix(),
+	)
+	require.NoError(t, err)
+
+	// the gentx validator is the only validator of the chain
+	genesisState := app.DefaultGenesis()
+	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(
+		authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{vestingAcc}),
+	)
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{{Address: addr.String(), Coins: coins}}
+	bankGenState.Supply = coins
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenState)
+
+	// self-delegate half of the vesting coins in a gentx
+	selfDelegation := sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction.MulRaw(5))
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr).String(), ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription("vesting", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+
+	genTx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)), app.TxConfig(), []sdk.Msg{msg}, sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas, app.ChainID(), []uint64{0}, []uint64{0}, priv,
+	)
+	require.NoError(t, err)
+	genTxBz, err := app.TxConfig().TxJSONEncoder()(genTx)
+	require.NoError(t, err)
+	genesisState[genutiltypes.ModuleName] = app.AppCodec().MustMarshalJSON(genutiltypes.NewGenesisState([]json.RawMessage{genTxBz}))
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Time:            genesisTime,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: app.LastBlockHeight() + 1,
+		Time:   genesisTime,
+		Hash:   app.LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+	authGenState := authtypes.GetGenesisStateFromAppState(app.AppCodec(), appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+
+	var exportedAcc *vestingtypes.ContinuousVestingAccount
+	for _, acc := range accs {
+		if acc.GetAddress().Equals(addr) {
+			exportedAcc = acc.(*vestingtypes.ContinuousVestingAccount)
+		}
+	}
+	require.NotNil(t, exportedAcc)
+	require.Equal(t, sdk.NewCoins(selfDelegation), exportedAcc.DelegatedVesting)
+	require.True(t, exportedAcc.DelegatedFree.IsZero())
+}
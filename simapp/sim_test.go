@@ -19,6 +19,9 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	authzkeeper "cosmossdk.io/x/authz/keeper"
 	"cosmossdk.io/x/feegrant"
 	slashingtypes "cosmossdk.io/x/slashing/types"
@@ -28,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
@@ -98,6 +102,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireVestingInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -146,18 +151,12 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireVestingInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
 	}
 
-	fmt.Printf("exporting genesis...\n")
-
-	exported, err := app.ExportAppStateAndValidators(false, []string{}, []string{})
-	require.NoError(t, err)
-
-	fmt.Printf("importing genesis...\n")
-
 	newDB, newDir, _, _, err := simtestutil.SetupSimulation(config, "leveldb-app-sim-2", "Simulation-2", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
 	require.NoError(t, err, "simulation setup failed")
 
@@ -169,6 +168,111 @@ func TestAppImportExport(t *testing.T) {
 	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, "SimApp", newApp.Name())
 
+	requireImportExportInvariance(t, app, newApp, logger)
+}
+
+// TestAppImportExportMidRun exports the application state halfway through the
+// simulation, imports it into a fresh application and compares both stores,
+// while the simulation still has in-flight state (e.g. queued unbondings).
+func TestAppImportExportMidRun(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	config.Commit = true
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application mid-run import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	app := NewSimApp(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+	if !simcli.FlagSigverifyTxValue {
+		app.SetNotSigverifyTx()
+	}
+
+	exportHeight := int64(config.InitialBlockHeight + config.NumBlocks/2)
+	config.OnBlockCommit = func(height int64) error {
+		if height != exportHeight {
+			return nil
+		}
+
+		fmt.Printf("checking import/export invariance at height %d...\n", height)
+		newApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+		requireImportExportInvariance(t, app, newApp, logger)
+		return nil
+	}
+
+	_, _, err = simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		BlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+}
+
+// requireImportExportInvariance exports the current state of app, imports it
+// into newApp and verifies that every module store holds the same key/value
+// pairs in both applications.
+// requireVestingInvariants checks the vesting invariants on the last committed state of
+// the app. Since x/crisis was removed, the invariants registered by the modules are not
+// run by the chain, so the simulations check them once they complete.
+func requireVestingInvariants(t *testing.T, app *SimApp) {
+	t.Helper()
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	msg, broken := checkVestingInvariants(app, ctx)
+	require.False(t, broken, msg)
+}
+
+// checkVestingInvariants runs the vesting invariants on the state of ctx.
+func checkVestingInvariants(app *SimApp, ctx sdk.Context) (string, bool) {
+	return vesting.DelegatedVestingInvariant(app.AuthKeeper, app.StakingKeeper)(ctx)
+}
+
+func TestCheckVestingInvariants(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	msg, broken := checkVestingInvariants(app, ctx)
+	require.False(t, broken, msg)
+
+	// a vesting account with delegated vesting coins exceeding its original vesting
+	// coins breaks the invariants
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	acc, err := vestingtypes.NewDelayedVestingAccount(app.AuthKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount), coins, 2000)
+	require.NoError(t, err)
+	acc.DelegatedVesting = coins.Add(coins...)
+	app.AuthKeeper.SetAccount(ctx, acc)
+
+	msg, broken = checkVestingInvariants(app, ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, addr.String()+" has delegated vesting 200stake greater than its original vesting 100stake")
+}
+
+func requireImportExportInvariance(t *testing.T, app, newApp *SimApp, logger log.Logger) {
+	t.Helper()
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := app.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
 	var genesisState GenesisState
 	err = json.Unmarshal(exported.AppState, &genesisState)
 	require.NoError(t, err)
@@ -267,6 +371,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireVestingInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -331,9 +436,12 @@ func TestAppStateDeterminism(t *testing.T) {
 	config.AllInvariants = false
 	config.ChainID = SimAppChainID
 
+	// every attempt must commit each block, so that the commit info of every
+	// height can be compared across attempts.
+	config.Commit = true
+
 	numSeeds := 3
 	numTimesToRunPerSeed := 3 // This used to be set to 5, but we've temporarily reduced it to 3 for the sake of faster CI.
-	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
 
 	// We will be overriding the random seed and just run a single simulation on the provided seed value
 	if config.Seed != simcli.DefaultSeedValue {
@@ -363,51 +471,83 @@ func TestAppStateDeterminism(t *testing.T) {
 
 		fmt.Println("config.Seed: ", config.Seed)
 
-		for j := 0; j < numTimesToRunPerSeed; j++ {
-			var logger log.Logger
-			if simcli.FlagVerboseValue {
-				logger = log.NewTestLogger(t)
-			} else {
-				logger = log.NewNopLogger()
+		// run all attempts of the same seed concurrently, so that nondeterminism
+		// caused by scheduling or wall-clock time is more likely to surface.
+		apps := make([]*SimApp, numTimesToRunPerSeed)
+		ok := t.Run(fmt.Sprintf("seed-%d", config.Seed), func(t *testing.T) {
+			for j := 0; j < numTimesToRunPerSeed; j++ {
+				j, config := j, config
+				t.Run(fmt.Sprintf("attempt-%d", j+1), func(t *testing.T) {
+					t.Parallel()
+
+					var logger log.Logger
+					if simcli.FlagVerboseValue {
+						logger = log.NewTestLogger(t)
+					} else {
+						logger = log.NewNopLogger()
+					}
+
+					db := dbm.NewMemDB()
+					app := NewSimApp(logger, db, nil, true, appOptions, interBlockCacheOpt(), baseapp.SetChainID(SimAppChainID))
+					if !simcli.FlagSigverifyTxValue {
+						app.SetNotSigverifyTx()
+					}
+
+					fmt.Printf(
+						"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+						config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+					)
+
+					_, _, err := simulation.SimulateFromSeed(
+						t,
+						os.Stdout,
+						app.BaseApp,
+						simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+						simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+						simtestutil.SimulationOperations(app, app.AppCodec(), config),
+						BlockedAddresses(),
+						config,
+						app.AppCodec(),
+					)
+					require.NoError(t, err)
+
+					simtestutil.PrintStats(db)
+					apps[j] = app
+				})
 			}
+		})
+		require.True(t, ok, "simulation failed for seed %d", config.Seed)
 
-			db := dbm.NewMemDB()
-			app := NewSimApp(logger, db, nil, true, appOptions, interBlockCacheOpt(), baseapp.SetChainID(SimAppChainID))
-			if !simcli.FlagSigverifyTxValue {
-				app.SetNotSigverifyTx()
-			}
+		for j := 1; j < numTimesToRunPerSeed; j++ {
+			requireSameAppState(t, apps[0], apps[j], int64(config.InitialBlockHeight), fmt.Sprintf(
+				"non-determinism in seed %d: %d/%d, attempt: %d/%d", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			))
+		}
+	}
+}
 
-			fmt.Printf(
-				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
-				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
-			)
-
-			_, _, err := simulation.SimulateFromSeed(
-				t,
-				os.Stdout,
-				app.BaseApp,
-				simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
-				simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
-				simtestutil.SimulationOperations(app, app.AppCodec(), config),
-				BlockedAddresses(),
-				config,
-				app.AppCodec(),
-			)
-			require.NoError(t, err)
-
-			if config.Commit {
-				simtestutil.PrintStats(db)
-			}
+// requireSameAppState compares the commit info of every block committed by
+// appA and appB, and fails with a diff of the key/value pairs of the first
+// divergent store at the first divergent height.
+func requireSameAppState(t *testing.T, appA, appB *SimApp, initialHeight int64, msg string) {
+	t.Helper()
 
-			appHash := app.LastCommitID().Hash
-			appHashList[j] = appHash
+	require.Equal(t, appA.LastBlockHeight(), appB.LastBlockHeight(), msg)
 
-			if j != 0 {
-				require.Equal(
-					t, string(appHashList[0]), string(appHashList[j]),
-					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
-				)
-			}
-		}
+	divergence, err := simtestutil.FindStoreHashDivergence(
+		appA.CommitMultiStore(), appB.CommitMultiStore(), initialHeight, appA.LastBlockHeight(),
+	)
+	require.NoError(t, err)
+	if divergence == nil {
+		return
 	}
+
+	storeName := divergence.Stores[0]
+	kvAs, kvBs, err := simtestutil.DiffStoresAtHeight(
+		appA.CommitMultiStore(), appB.CommitMultiStore(), appA.GetKey(storeName), divergence.Height,
+	)
+	require.NoError(t, err)
+
+	t.Fatalf("%s: %s\n%s", msg, divergence,
+		simtestutil.GetSimulationLog(storeName, appA.SimulationManager().StoreDecoders, kvAs, kvBs))
 }
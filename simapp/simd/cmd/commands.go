@@ -14,7 +14,9 @@ import (
 	"cosmossdk.io/simapp"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 	authcmd "cosmossdk.io/x/auth/client/cli"
+	bankcli "cosmossdk.io/x/bank/client/cli"
 	banktypes "cosmossdk.io/x/bank/types"
+	stakingcli "cosmossdk.io/x/staking/client/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/debug"
@@ -49,6 +51,8 @@ func initRootCmd(
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
+		bankcli.AuditSupplyCmd(newApp),
+		stakingcli.ExportValidatorSetCmd(newApp),
 	)
 
 	server.AddCommands(rootCmd, newApp, func(startCmd *cobra.Command) {})
@@ -114,6 +118,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
+		authcmd.GetSignBytesCommand(),
 		authcmd.GetSimulateCmd(),
 	)
 
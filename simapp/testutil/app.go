@@ -0,0 +1,148 @@
+// Package testutil provides an in-process harness running a full SimApp, for
+// module and application integration tests which deliver transactions through
+// the ABCI instead of wiring keepers, stores and contexts by hand.
+package testutil
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/simapp"
+	minttypes "cosmossdk.io/x/mint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBlockTime is the time between two blocks produced by an App.
+const DefaultBlockTime = 5 * time.Second
+
+// GenesisTime is the time of the first block produced by an App.
+var GenesisTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// App is a SimApp driven block by block in-process. Every block it produces is
+// finalized and committed, so the state read through Context is always the
+// state committed at the last height.
+type App struct {
+	*simapp.SimApp
+
+	t         testing.TB
+	blockTime time.Time
+}
+
+// SetupApp returns an App at height 1, with a single bonded validator.
+func SetupApp(t testing.TB) *App {
+	t.Helper()
+
+	app := &App{
+		SimApp:    simapp.Setup(t, false),
+		t:         t,
+		blockTime: GenesisTime,
+	}
+
+	// simapp.Setup finalizes the first block without committing it
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	return app
+}
+
+// Context returns a context on the committed state, for the next height.
+// Writes made with it are committed along with the next block.
+func (a *App) Context() sdk.Context {
+	height := a.LastBlockHeight() + 1
+	return a.NewUncachedContext(false, cmtproto.Header{ChainID: a.ChainID(), Height: height, Time: a.blockTime}).
+		WithHeaderInfo(header.Info{ChainID: a.ChainID(), Height: height, Time: a.blockTime})
+}
+
+// FundAccount mints coins to an account, creating the account if needed.
+func (a *App) FundAccount(addr sdk.AccAddress, coins sdk.Coins) {
+	a.t.Helper()
+
+	ctx := a.Context()
+	require.NoError(a.t, a.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(a.t, a.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+}
+
+// DeliverMsgs signs a transaction of the given messages with the key of their
+// signer and delivers it in a new block. The account of the signer is created
+// by its first transaction. It returns the result of the transaction, and an
+// error if the transaction failed.
+func (a *App) DeliverMsgs(priv cryptotypes.PrivKey, msgs ...sdk.Msg) (*abci.ExecTxResult, error) {
+	a.t.Helper()
+
+	var accNum, accSeq uint64
+	if acc := a.AuthKeeper.GetAccount(a.Context(), priv.PubKey().Address().Bytes()); acc != nil {
+		accNum, accSeq = acc.GetAccountNumber(), acc.GetSequence()
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		a.TxConfig(),
+		msgs,
+		sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas,
+		a.ChainID(),
+		[]uint64{accNum},
+		[]uint64{accSeq},
+		priv,
+	)
+	require.NoError(a.t, err)
+
+	bz, err := a.TxConfig().TxEncoder()(tx)
+	require.NoError(a.t, err)
+
+	res := a.finalizeBlock(bz)
+	require.Len(a.t, res.TxResults, 1)
+
+	result := res.TxResults[0]
+	if result.Code != 0 {
+		return result, errorsmod.ABCIError(result.Codespace, result.Code, result.Log)
+	}
+
+	return result, nil
+}
+
+// AdvanceBlocks produces n empty blocks.
+func (a *App) AdvanceBlocks(n int) {
+	a.t.Helper()
+
+	for i := 0; i < n; i++ {
+		a.finalizeBlock()
+	}
+}
+
+// CheckBalance asserts that the balances of an account are the expected coins.
+func (a *App) CheckBalance(addr sdk.AccAddress, expected sdk.Coins) {
+	a.t.Helper()
+
+	balances := a.BankKeeper.GetAllBalances(a.Context(), addr)
+	require.True(a.t, expected.Equal(balances), "expected balances of %s to be %s, got %s", addr, expected, balances)
+}
+
+// finalizeBlock finalizes and commits a block of the given transactions.
+func (a *App) finalizeBlock(txs ...[]byte) *abci.ResponseFinalizeBlock {
+	a.t.Helper()
+
+	a.blockTime = a.blockTime.Add(DefaultBlockTime)
+	res, err := a.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: a.LastBlockHeight() + 1,
+		Time:   a.blockTime,
+		Hash:   a.LastCommitID().Hash,
+		Txs:    txs,
+	})
+	require.NoError(a.t, err)
+
+	_, err = a.Commit()
+	require.NoError(a.t, err)
+
+	return res
+}
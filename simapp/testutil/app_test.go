@@ -0,0 +1,65 @@
+package testutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/simapp/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDeliverMsgs(t *testing.T) {
+	app := testutil.SetupApp(t)
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	priv := secp256k1.GenPrivKey()
+	from := sdk.AccAddress(priv.PubKey().Address())
+	to := sdk.AccAddress("to")
+	app.FundAccount(from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	app.CheckBalance(from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	send := banktypes.NewMsgSend(from.String(), to.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)))
+	_, err := app.DeliverMsgs(priv, send)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), app.LastBlockHeight())
+	app.CheckBalance(from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)))
+	app.CheckBalance(to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)))
+
+	// a failed transaction is reported, and the sequence of the signer is
+	// still incremented
+	send = banktypes.NewMsgSend(from.String(), to.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	_, err = app.DeliverMsgs(priv, send)
+	require.Error(t, err)
+	app.CheckBalance(from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)))
+
+	validators, err := app.StakingKeeper.GetAllValidators(app.Context())
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+
+	delegate := stakingtypes.NewMsgDelegate(from.String(), validators[0].GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	_, err = app.DeliverMsgs(priv, delegate)
+	require.NoError(t, err)
+	app.CheckBalance(from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)))
+
+	delegations, err := app.StakingKeeper.GetDelegatorDelegations(app.Context(), from, 10)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.Equal(t, sdkmath.NewInt(100), validators[0].TokensFromShares(delegations[0].Shares).TruncateInt())
+}
+
+func TestAdvanceBlocks(t *testing.T) {
+	app := testutil.SetupApp(t)
+
+	app.AdvanceBlocks(3)
+	require.Equal(t, int64(4), app.LastBlockHeight())
+
+	ctx := app.Context()
+	require.Equal(t, int64(5), ctx.HeaderInfo().Height)
+	require.Equal(t, testutil.GenesisTime.Add(3*testutil.DefaultBlockTime), ctx.HeaderInfo().Time)
+}
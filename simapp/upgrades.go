@@ -5,6 +5,8 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
+	recurringtypes "cosmossdk.io/x/auth/recurring/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	protocolpooltypes "cosmossdk.io/x/protocolpool/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -38,6 +40,8 @@ func (app SimApp) RegisterUpgradeHandlers() {
 			Added: []string{
 				accounts.ModuleName,
 				protocolpooltypes.ModuleName,
+				vestingtypes.StoreKey,
+				recurringtypes.StoreKey,
 			},
 			Deleted: []string{
 				crisistypes.ModuleName, // The SDK discontinued the crisis module in v0.51.0
@@ -0,0 +1,66 @@
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/simapp/testutil"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestClawbackDelegatedVesting claws back a clawback vesting account whose
+// coins are all delegated, and checks that the pending clawback is returned to
+// the funder once undelegated.
+func TestClawbackDelegatedVesting(t *testing.T) {
+	app := testutil.SetupApp(t)
+
+	ctx := app.Context()
+	params, err := app.StakingKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.UnbondingTime = 10 * time.Second
+	require.NoError(t, app.StakingKeeper.Params.Set(ctx, params))
+
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	val := validators[0].GetOperator()
+
+	funderPriv := secp256k1.GenPrivKey()
+	funder := sdk.AccAddress(funderPriv.PubKey().Address())
+	app.FundAccount(funder, stakeCoins(100))
+
+	// the account starts vesting in a year, and delegates all its coins
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	startTime := testutil.GenesisTime.Add(365 * 24 * time.Hour).Unix()
+	create := vestingtypes.NewMsgCreateVestingAccount(funder, addr, stakeCoins(100), startTime, startTime+1000, false)
+	create.Clawback = true
+	_, err = app.DeliverMsgs(funderPriv, create)
+	require.NoError(t, err)
+	_, err = app.DeliverMsgs(priv, stakingtypes.NewMsgDelegate(addr.String(), val, stakeCoins(100)[0]))
+	require.NoError(t, err)
+
+	// the delegated coins are pending until undelegated
+	_, err = app.DeliverMsgs(funderPriv, vestingtypes.NewMsgClawback(funder, addr, false))
+	require.NoError(t, err)
+	acc := app.AuthKeeper.GetAccount(app.Context(), addr).(*vestingtypes.ClawbackVestingAccount)
+	require.Equal(t, stakeCoins(100), acc.PendingClawback)
+	app.AdvanceBlocks(3)
+	app.CheckBalance(funder, nil)
+
+	// once the unbonding completes, the pending clawback is returned to the funder
+	_, err = app.DeliverMsgs(priv, stakingtypes.NewMsgUndelegate(addr.String(), val, stakeCoins(100)[0]))
+	require.NoError(t, err)
+	app.AdvanceBlocks(3)
+
+	app.CheckBalance(funder, stakeCoins(100))
+	app.CheckBalance(addr, nil)
+	acc = app.AuthKeeper.GetAccount(app.Context(), addr).(*vestingtypes.ClawbackVestingAccount)
+	require.Empty(t, acc.PendingClawback)
+}
@@ -0,0 +1,84 @@
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/simapp/testutil"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestProportionalUndelegation creates vesting accounts with and without
+// proportional undelegation, delegating 100 vesting and 100 free coins, and
+// checks how an undelegation of 50 coins is attributed once it completes.
+func TestProportionalUndelegation(t *testing.T) {
+	testCases := []struct {
+		name         string
+		proportional bool
+		expFree      sdk.Coins
+		expVesting   sdk.Coins
+	}{
+		{
+			name:         "free coins first",
+			proportional: false,
+			expFree:      stakeCoins(50),
+			expVesting:   stakeCoins(100),
+		},
+		{
+			name:         "proportional",
+			proportional: true,
+			expFree:      stakeCoins(75),
+			expVesting:   stakeCoins(75),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := testutil.SetupApp(t)
+
+			ctx := app.Context()
+			params, err := app.StakingKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			params.UnbondingTime = 10 * time.Second
+			require.NoError(t, app.StakingKeeper.Params.Set(ctx, params))
+
+			validators, err := app.StakingKeeper.GetAllValidators(ctx)
+			require.NoError(t, err)
+			require.Len(t, validators, 1)
+			val := validators[0].GetOperator()
+
+			funderPriv := secp256k1.GenPrivKey()
+			funder := sdk.AccAddress(funderPriv.PubKey().Address())
+			app.FundAccount(funder, stakeCoins(100))
+
+			// the account vests in a year, and has 100 more free coins
+			priv := secp256k1.GenPrivKey()
+			addr := sdk.AccAddress(priv.PubKey().Address())
+			endTime := testutil.GenesisTime.Add(365 * 24 * time.Hour).Unix()
+			create := vestingtypes.NewMsgCreateVestingAccount(funder, addr, stakeCoins(100), 0, endTime, true)
+			create.ProportionalUndelegation = tc.proportional
+			_, err = app.DeliverMsgs(funderPriv, create)
+			require.NoError(t, err)
+			app.FundAccount(addr, stakeCoins(100))
+
+			_, err = app.DeliverMsgs(priv, stakingtypes.NewMsgDelegate(addr.String(), val, stakeCoins(200)[0]))
+			require.NoError(t, err)
+			_, err = app.DeliverMsgs(priv, stakingtypes.NewMsgUndelegate(addr.String(), val, stakeCoins(50)[0]))
+			require.NoError(t, err)
+			app.AdvanceBlocks(3)
+
+			app.CheckBalance(addr, stakeCoins(50))
+			acc, ok := app.AuthKeeper.GetAccount(app.Context(), addr).(*vestingtypes.DelayedVestingAccount)
+			require.True(t, ok)
+			require.Equal(t, tc.proportional, acc.ProportionalUndelegation)
+			require.True(t, tc.expFree.Equal(acc.DelegatedFree), "expected delegated free %s, got %s", tc.expFree, acc.DelegatedFree)
+			require.True(t, tc.expVesting.Equal(acc.DelegatedVesting), "expected delegated vesting %s, got %s", tc.expVesting, acc.DelegatedVesting)
+		})
+	}
+}
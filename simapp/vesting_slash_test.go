@@ -0,0 +1,180 @@
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/simapp/testutil"
+	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// vestingSlashFixture is a vesting account with 100 vesting and 100 free
+// coins, delegating its vesting coins to the genesis validator, and its free
+// coins to a second validator, to be slashed.
+type vestingSlashFixture struct {
+	app        *testutil.App
+	priv       cryptotypes.PrivKey
+	addr       sdk.AccAddress
+	val1, val2 string
+	slashed    sdk.ValAddress
+}
+
+func setupVestingSlashFixture(t *testing.T) vestingSlashFixture {
+	t.Helper()
+
+	app := testutil.SetupApp(t)
+
+	ctx := app.Context()
+	params, err := app.StakingKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.UnbondingTime = 10 * time.Second
+	require.NoError(t, app.StakingKeeper.Params.Set(ctx, params))
+
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	val1 := validators[0].GetOperator()
+
+	// create a second validator, to be slashed
+	valPriv := secp256k1.GenPrivKey()
+	valAddr := sdk.AccAddress(valPriv.PubKey().Address())
+	app.FundAccount(valAddr, stakeCoins(10))
+	createValidator, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(valAddr).String(), ed25519.GenPrivKey().PubKey(), stakeCoins(10)[0],
+		stakingtypes.NewDescription("slashed", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = app.DeliverMsgs(valPriv, createValidator)
+	require.NoError(t, err)
+	val2 := sdk.ValAddress(valAddr).String()
+
+	// the account has 100 vesting and 100 free coins
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	ctx = app.Context()
+	bacc := app.AuthKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+	dva, err := vestingtypes.NewDelayedVestingAccount(bacc, stakeCoins(100), testutil.GenesisTime.Add(365*24*time.Hour).Unix())
+	require.NoError(t, err)
+	app.AuthKeeper.SetAccount(ctx, dva)
+	app.FundAccount(addr, stakeCoins(200))
+
+	// the vesting coins are delegated to the first validator, and the free
+	// coins to the second one
+	_, err = app.DeliverMsgs(priv,
+		stakingtypes.NewMsgDelegate(addr.String(), val1, stakeCoins(100)[0]),
+		stakingtypes.NewMsgDelegate(addr.String(), val2, stakeCoins(100)[0]),
+	)
+	require.NoError(t, err)
+
+	f := vestingSlashFixture{app: app, priv: priv, addr: addr, val1: val1, val2: val2, slashed: sdk.ValAddress(valAddr)}
+	f.requireDelegated(t, stakeCoins(100), stakeCoins(100))
+	return f
+}
+
+// slash slashes the second validator by 50% for an infraction at the given
+// height, when it had the given tokens.
+func (f vestingSlashFixture) slash(t *testing.T, infractionHeight, tokens int64) {
+	t.Helper()
+
+	ctx := f.app.Context()
+	validator, err := f.app.StakingKeeper.GetValidator(ctx, f.slashed)
+	require.NoError(t, err)
+	require.True(t, validator.IsBonded())
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	_, err = f.app.StakingKeeper.Slash(ctx, consAddr, infractionHeight, tokens, sdkmath.LegacyNewDecWithPrec(5, 1))
+	require.NoError(t, err)
+}
+
+// requireDelegated asserts the delegations tracked by the vesting account.
+func (f vestingSlashFixture) requireDelegated(t *testing.T, free, vesting sdk.Coins) {
+	t.Helper()
+
+	acc, ok := f.app.AuthKeeper.GetAccount(f.app.Context(), f.addr).(*vestingtypes.DelayedVestingAccount)
+	require.True(t, ok)
+	require.True(t, free.Equal(acc.DelegatedFree), "expected delegated free %s, got %s", free, acc.DelegatedFree)
+	require.True(t, vesting.Equal(acc.DelegatedVesting), "expected delegated vesting %s, got %s", vesting, acc.DelegatedVesting)
+}
+
+// stakeCoins returns the given number of consensus power units of the bond denom.
+func stakeCoins(n int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction.MulRaw(n)))
+}
+
+// TestVestingUndelegationAfterSlash slashes the validator holding the free
+// coins by 50%, and checks that the tracked delegations of the account match
+// its actual delegations once each unbonding completes.
+func TestVestingUndelegationAfterSlash(t *testing.T) {
+	f := setupVestingSlashFixture(t)
+
+	// slash the second validator, which has 110 tokens, by 50%
+	f.slash(t, f.app.Context().BlockHeight(), 110)
+
+	// undelegate from the slashed validator: the 50 coins lost are attributed
+	// to the delegated vesting coins
+	_, err := f.app.DeliverMsgs(f.priv, stakingtypes.NewMsgUndelegate(f.addr.String(), f.val2, stakeCoins(50)[0]))
+	require.NoError(t, err)
+	f.app.AdvanceBlocks(3)
+
+	f.requireDelegated(t, stakeCoins(50), stakeCoins(50))
+	f.app.CheckBalance(f.addr, stakeCoins(50))
+
+	// undelegate from the other validator, leaving no delegation tracked
+	_, err = f.app.DeliverMsgs(f.priv, stakingtypes.NewMsgUndelegate(f.addr.String(), f.val1, stakeCoins(100)[0]))
+	require.NoError(t, err)
+	f.app.AdvanceBlocks(3)
+
+	f.requireDelegated(t, nil, nil)
+	f.app.CheckBalance(f.addr, stakeCoins(150))
+}
+
+// TestVestingRedelegationAfterSlash redelegates half of the free coins from
+// the validator to be slashed, slashes it by 50% for an infraction before the
+// redelegation, and checks that the tokens lost by the redelegation and by the
+// remaining delegation are reconciled once the redelegation completes, and
+// that two unbondings completing in the same block leave no delegation tracked.
+func TestVestingRedelegationAfterSlash(t *testing.T) {
+	f := setupVestingSlashFixture(t)
+
+	infractionHeight := f.app.Context().BlockHeight()
+	_, err := f.app.DeliverMsgs(f.priv, stakingtypes.NewMsgBeginRedelegate(f.addr.String(), f.val2, f.val1, stakeCoins(50)[0]))
+	require.NoError(t, err)
+
+	// at the infraction, the second validator had 110 tokens: the 25 slashed
+	// from the redelegation are unbonded from the first validator, and the 30
+	// left are slashed from the 60 tokens of the second validator
+	f.slash(t, infractionHeight, 110)
+
+	delegations, err := f.app.StakingKeeper.GetDelegatorBonded(f.app.Context(), f.addr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(150).AmountOf(sdk.DefaultBondDenom), delegations)
+	f.requireDelegated(t, stakeCoins(100), stakeCoins(100))
+
+	// once the redelegation completes, the 50 coins lost are attributed to
+	// the delegated vesting coins
+	f.app.AdvanceBlocks(3)
+	f.requireDelegated(t, stakeCoins(100), stakeCoins(50))
+
+	// undelegate everything, completing both unbondings in the same block
+	_, err = f.app.DeliverMsgs(f.priv,
+		stakingtypes.NewMsgUndelegate(f.addr.String(), f.val1, stakeCoins(125)[0]),
+		stakingtypes.NewMsgUndelegate(f.addr.String(), f.val2, stakeCoins(25)[0]),
+	)
+	require.NoError(t, err)
+	f.app.AdvanceBlocks(3)
+
+	f.requireDelegated(t, nil, nil)
+	f.app.CheckBalance(f.addr, stakeCoins(150))
+}
@@ -2,6 +2,7 @@ package tx_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -16,6 +17,7 @@ import (
 	authclient "cosmossdk.io/x/auth/client"
 	authtest "cosmossdk.io/x/auth/client/testutil"
 	"cosmossdk.io/x/auth/migrations/legacytx"
+	authsigning "cosmossdk.io/x/auth/signing"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -1109,6 +1111,86 @@ func (s *E2ETestSuite) TestTxDecodeAmino_GRPCGateway() {
 	}
 }
 
+func (s *E2ETestSuite) TestTxSignBytes_GRPC() {
+	val := s.network.GetValidators()[0]
+	txBuilder := s.mkTxBuilder()
+	protoTx, err := txBuilder.GetTx().(interface{ AsTx() (*tx.Tx, error) }).AsTx()
+	s.Require().NoError(err)
+
+	expSignBytes, err := authsigning.GetLegacyAminoJSONSignBytes(context.Background(), val.GetClientCtx().TxConfig.SignModeHandler(), "test-chain", 7, 3, txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.TxSignBytesRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.TxSignBytesRequest{}, true, "invalid empty tx"},
+		{"empty chain-id", &tx.TxSignBytesRequest{Tx: protoTx}, true, "invalid empty chain-id"},
+		{"valid tx request", &tx.TxSignBytesRequest{Tx: protoTx, ChainId: "test-chain", AccountNumber: 7, Sequence: 3}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.TxSignBytes(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				s.Require().Empty(res)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(expSignBytes, res.SignBytes)
+				hash := sha256.Sum256(expSignBytes)
+				s.Require().Equal(hash[:], res.Sha256)
+			}
+		})
+	}
+}
+
+func (s *E2ETestSuite) TestTxSignBytes_GRPCGateway() {
+	val := s.network.GetValidators()[0]
+	txBuilder := s.mkTxBuilder()
+	protoTx, err := txBuilder.GetTx().(interface{ AsTx() (*tx.Tx, error) }).AsTx()
+	s.Require().NoError(err)
+
+	expSignBytes, err := authsigning.GetLegacyAminoJSONSignBytes(context.Background(), val.GetClientCtx().TxConfig.SignModeHandler(), "test-chain", 7, 3, txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.TxSignBytesRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"empty request", &tx.TxSignBytesRequest{}, true, "invalid empty tx"},
+		{"empty chain-id", &tx.TxSignBytesRequest{Tx: protoTx}, true, "invalid empty chain-id"},
+		{"valid tx request", &tx.TxSignBytesRequest{Tx: protoTx, ChainId: "test-chain", AccountNumber: 7, Sequence: 3}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			req, err := val.GetClientCtx().Codec.MarshalJSON(tc.req)
+			s.Require().NoError(err)
+
+			res, err := testutil.PostRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/sign_bytes", val.GetAPIAddress()), "application/json", req)
+			s.Require().NoError(err)
+			if tc.expErr {
+				s.Require().Contains(string(res), tc.expErrMsg)
+			} else {
+				var result tx.TxSignBytesResponse
+				err := val.GetClientCtx().Codec.UnmarshalJSON(res, &result)
+				s.Require().NoError(err)
+				s.Require().Equal(expSignBytes, result.SignBytes)
+				hash := sha256.Sum256(expSignBytes)
+				s.Require().Equal(hash[:], result.Sha256)
+			}
+		})
+	}
+}
+
 func TestE2ETestSuite(t *testing.T) {
 	suite.Run(t, new(E2ETestSuite))
 }
@@ -36,6 +36,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
 
@@ -172,6 +173,62 @@ func (s *CLITestSuite) TestCLISignBatch() {
 	s.Require().EqualError(err, "required flag(s) \"sequence\" not set")
 }
 
+func (s *CLITestSuite) TestCLISignBatchSequences() {
+	generatedStd, err := s.createBankMsg(s.clientCtx, s.val,
+		sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10))), clitestutil.TestTxConfig{GenOnly: true})
+	s.Require().NoError(err)
+
+	txLine := strings.TrimSpace(generatedStd.String()) + "\n"
+	batchFile := testutil.WriteToNewTempFile(s.T(), strings.Repeat(txLine, 3))
+	defer batchFile.Close()
+	s.clientCtx.HomeDir = strings.Replace(s.clientCtx.HomeDir, "simd", "simcli", 1)
+
+	testCases := []struct {
+		name    string
+		args    []string
+		expSeqs []uint64
+	}{
+		{
+			name:    "online, from the account sequence",
+			expSeqs: []uint64{7, 8, 9},
+		},
+		{
+			name:    "online, from the given sequence",
+			args:    []string{fmt.Sprintf("--%s=12", flags.FlagSequence)},
+			expSeqs: []uint64{12, 13, 14},
+		},
+		{
+			name:    "offline",
+			args:    []string{"--offline", fmt.Sprintf("--%s=1", flags.FlagAccountNumber), fmt.Sprintf("--%s=3", flags.FlagSequence)},
+			expSeqs: []uint64{3, 4, 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientCtx := s.clientCtx.WithAccountRetriever(client.MockAccountRetriever{ReturnAccNum: 1, ReturnAccSeq: 7})
+			args := append([]string{fmt.Sprintf("--%s=%s", flags.FlagChainID, s.clientCtx.ChainID), "--signature-only"}, tc.args...)
+			out, err := authtestutil.TxSignBatchExec(clientCtx, s.val, batchFile.Name(), args...)
+			s.Require().NoError(err)
+
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			s.Require().Len(lines, len(tc.expSeqs))
+			for i, line := range lines {
+				sigs, err := s.clientCtx.TxConfig.UnmarshalSignatureJSON([]byte(line))
+				s.Require().NoError(err)
+				s.Require().Len(sigs, 1)
+				s.Require().Equal(tc.expSeqs[i], sigs[0].Sequence)
+			}
+		})
+	}
+
+	// a malformed tx aborts the batch with its line number
+	malformedFile := testutil.WriteToNewTempFile(s.T(), txLine+txLine+"{\"body\":\n"+txLine)
+	defer malformedFile.Close()
+	_, err = authtestutil.TxSignBatchExec(s.clientCtx, s.val, malformedFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, s.clientCtx.ChainID))
+	s.Require().ErrorContains(err, "couldn't decode the transaction on line 3")
+}
+
 func (s *CLITestSuite) TestCLISignBatchTotalFees() {
 	txCfg := s.clientCtx.TxConfig
 	s.clientCtx.HomeDir = strings.Replace(s.clientCtx.HomeDir, "simd", "simcli", 1)
@@ -547,15 +604,145 @@ func (s *CLITestSuite) TestCLIMultisignInsufficientCosigners() {
 	sign1File := testutil.WriteToNewTempFile(s.T(), account1Signature.String())
 	defer sign1File.Close()
 
-	multiSigWith1Signature, err := authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, multiGeneratedTxFile.Name(), sign1File.Name())
+	_, err = authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, multiGeneratedTxFile.Name(), sign1File.Name())
+	s.Require().ErrorContains(err, "got 1 valid signatures, multisig key multi requires 2")
+}
+
+func (s *CLITestSuite) TestCLIMultisignValidatesSignatures() {
+	// Generate a 2-of-3 multisig.
+	var (
+		addrs   []sdk.AccAddress
+		pubKeys []cryptotypes.PubKey
+	)
+	for _, name := range []string{"newAccount1", "newAccount2", "dummyAccount"} {
+		record, err := s.clientCtx.Keyring.Key(name)
+		s.Require().NoError(err)
+		addr, err := record.GetAddress()
+		s.Require().NoError(err)
+		pubKey, err := record.GetPubKey()
+		s.Require().NoError(err)
+		addrs = append(addrs, addr)
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	multisigRecord, err := s.clientCtx.Keyring.SaveMultisig("multi2of3", kmultisig.NewLegacyAminoPubKey(2, pubKeys))
+	s.Require().NoError(err)
+	addr, err := multisigRecord.GetAddress()
 	s.Require().NoError(err)
 
-	// Save tx to file
-	multiSigWith1SignatureFile := testutil.WriteToNewTempFile(s.T(), multiSigWith1Signature.String())
-	defer multiSigWith1SignatureFile.Close()
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr.String(),
+		ToAddress:   s.val.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+	}
 
-	_, err = authtestutil.TxValidateSignaturesExec(s.clientCtx, multiSigWith1SignatureFile.Name())
-	s.Require().Error(err)
+	// Generate multisig transaction.
+	multiGeneratedTx, err := clitestutil.SubmitTestTx(s.clientCtx, msgSend, addr, clitestutil.TestTxConfig{GenOnly: true})
+	s.Require().NoError(err)
+
+	multiGeneratedTxFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String())
+	defer multiGeneratedTxFile.Close()
+
+	// Sign with each key of the multisig, and with the first key for the wrong sequence.
+	s.clientCtx.HomeDir = strings.Replace(s.clientCtx.HomeDir, "simd", "simcli", 1)
+	var signatures, sigFiles []string
+	for _, signer := range addrs {
+		signature, err := authtestutil.TxSignExec(s.clientCtx, signer, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+		s.Require().NoError(err)
+		sigFile := testutil.WriteToNewTempFile(s.T(), signature.String())
+		defer sigFile.Close()
+		signatures = append(signatures, signature.String())
+		sigFiles = append(sigFiles, sigFile.Name())
+	}
+
+	wrongSequenceSignature, err := authtestutil.TxSignExec(
+		s.clientCtx, addrs[0], multiGeneratedTxFile.Name(), "--multisig", addr.String(),
+		"--offline", "--account-number=0", "--sequence=1",
+	)
+	s.Require().NoError(err)
+	wrongSequenceFile := testutil.WriteToNewTempFile(s.T(), wrongSequenceSignature.String())
+	defer wrongSequenceFile.Close()
+
+	testCases := []struct {
+		name     string
+		sigFiles []string
+		expErr   string
+		expSigs  []int
+	}{
+		{
+			name:     "exactly the threshold, in any order",
+			sigFiles: []string{sigFiles[2], sigFiles[0]},
+			expSigs:  []int{0, 2},
+		},
+		{
+			name:     "more signatures than the threshold, the first keys sign",
+			sigFiles: []string{sigFiles[2], sigFiles[1], sigFiles[0]},
+			expSigs:  []int{0, 1},
+		},
+		{
+			name:     "mismatched sequence",
+			sigFiles: []string{wrongSequenceFile.Name(), sigFiles[1]},
+			expErr:   fmt.Sprintf("signature of %s in %s: signed for sequence 1, expected 0", addrs[0], wrongSequenceFile.Name()),
+		},
+		{
+			name:     "duplicate signer",
+			sigFiles: []string{sigFiles[1], sigFiles[1]},
+			expErr:   fmt.Sprintf("signature of %s in %s: duplicate signer, already signed in %s", addrs[1], sigFiles[1], sigFiles[1]),
+		},
+		{
+			name:     "below the threshold",
+			sigFiles: []string{sigFiles[1]},
+			expErr:   "got 1 valid signatures, multisig key multi2of3 requires 2",
+		},
+		{
+			name:     "stdin used twice",
+			sigFiles: []string{"-", "-"},
+			expErr:   "only one of the transaction and signature files can be read from stdin",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			out, err := authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, multiGeneratedTxFile.Name(), tc.sigFiles...)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.requireMultisigSigners(out.Bytes(), tc.expSigs)
+		})
+	}
+
+	// The second signature is read from stdin.
+	cmd := authcli.GetMultiSignCommand()
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, s.clientCtx.ChainID),
+		multiGeneratedTxFile.Name(), multisigRecord.Name, sigFiles[0], "-",
+	})
+	in, out := testutil.ApplyMockIO(cmd)
+	in.Reset(signatures[1])
+	clientCtx := s.clientCtx.WithOutput(out)
+	s.Require().NoError(cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+	s.requireMultisigSigners(out.Bytes(), []int{0, 1})
+}
+
+// requireMultisigSigners checks that the multisig signature of the given tx holds the
+// signatures of the keys at the given indexes.
+func (s *CLITestSuite) requireMultisigSigners(txJSON []byte, indexes []int) {
+	theTx, err := s.clientCtx.TxConfig.TxJSONDecoder()(txJSON)
+	s.Require().NoError(err)
+	txBuilder, err := s.clientCtx.TxConfig.WrapTxBuilder(theTx)
+	s.Require().NoError(err)
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Len(sigs, 1)
+
+	multisigData, ok := sigs[0].Data.(*signingtypes.MultiSignatureData)
+	s.Require().True(ok)
+	s.Require().Len(multisigData.Signatures, len(indexes))
+	for _, index := range indexes {
+		s.Require().True(multisigData.BitArray.GetIndex(index))
+	}
 }
 
 func (s *CLITestSuite) TestCLIEncode() {
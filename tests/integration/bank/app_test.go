@@ -1,6 +1,7 @@
 package bank_test
 
 import (
+	"context"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -12,6 +13,7 @@ import (
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	_ "cosmossdk.io/x/auth"
+	authsign "cosmossdk.io/x/auth/signing"
 	_ "cosmossdk.io/x/auth/tx/config"
 	authtypes "cosmossdk.io/x/auth/types"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
@@ -27,13 +29,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	cdctestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
 )
 
@@ -454,3 +460,130 @@ func TestMsgSetSendEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestSimulateGasWithoutSignatures(t *testing.T) {
+	privs := make([]cryptotypes.PrivKey, 5)
+	pubKeys := make([]cryptotypes.PubKey, 5)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		pubKeys[i] = privs[i].PubKey()
+	}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(3, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigPubKey.Address())
+
+	singleSigAcc := authtypes.NewBaseAccountWithAddress(addr1)
+	multisigAcc := authtypes.NewBaseAccountWithAddress(multisigAddr)
+	require.NoError(t, multisigAcc.SetPubKey(multisigPubKey))
+
+	s := createTestSuite(t, []authtypes.GenesisAccount{singleSigAcc, multisigAcc})
+	baseApp := s.App.BaseApp
+	ctx := baseApp.NewContext(false)
+	require.NoError(t, testutil.FundAccount(ctx, s.BankKeeper, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100))))
+	require.NoError(t, testutil.FundAccount(ctx, s.BankKeeper, multisigAddr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100))))
+	_, err := baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: baseApp.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = baseApp.Commit()
+	require.NoError(t, err)
+
+	signModeHandler := s.TxConfig.SignModeHandler()
+	testCases := []struct {
+		name   string
+		signer sdk.AccAddress
+		// sign returns the signature of the tx, given its sign bytes in the given sign mode
+		signMode signing.SignMode
+		sign     func(signBytes []byte) signing.SignatureV2
+	}{
+		{
+			name:     "single signature, unknown pubkey",
+			signer:   addr1,
+			signMode: signing.SignMode_SIGN_MODE_DIRECT,
+			sign: func(signBytes []byte) signing.SignatureV2 {
+				sig, err := priv1.Sign(signBytes)
+				require.NoError(t, err)
+				return signing.SignatureV2{
+					PubKey: priv1.PubKey(),
+					Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+				}
+			},
+		},
+		{
+			name:     "3-of-5 multisig, pubkey of the account",
+			signer:   multisigAddr,
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			sign: func(signBytes []byte) signing.SignatureV2 {
+				multisigData := multisig.NewMultisig(len(pubKeys))
+				for _, i := range []int{1, 2, 4} {
+					sig, err := privs[i].Sign(signBytes)
+					require.NoError(t, err)
+					sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: sig}
+					require.NoError(t, multisig.AddSignatureFromPubKey(multisigData, sigData, pubKeys[i], pubKeys))
+				}
+				return signing.SignatureV2{PubKey: multisigPubKey, Data: multisigData}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			acc := s.AccountKeeper.GetAccount(baseApp.NewContext(true), tc.signer)
+			newTx := func() client.TxBuilder {
+				txBuilder := s.TxConfig.NewTxBuilder()
+				require.NoError(t, txBuilder.SetMsgs(types.NewMsgSend(tc.signer.String(), addr2.String(), halfCoins)))
+				txBuilder.SetGasLimit(simtestutil.DefaultGenTxGas)
+				return txBuilder
+			}
+
+			// the tx to simulate has neither signature nor pubkey
+			simTx := newTx()
+			require.NoError(t, simTx.SetSignatures(signing.SignatureV2{
+				PubKey:   priv2.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: tc.signMode},
+				Sequence: acc.GetSequence(),
+			}))
+			simTxBytes, err := s.TxConfig.TxEncoder()(simTx.GetTx())
+			require.NoError(t, err)
+			var txRaw txtypes.TxRaw
+			require.NoError(t, txRaw.Unmarshal(simTxBytes))
+			var authInfo txtypes.AuthInfo
+			require.NoError(t, authInfo.Unmarshal(txRaw.AuthInfoBytes))
+			authInfo.SignerInfos[0].PublicKey = nil
+			txRaw.AuthInfoBytes, err = authInfo.Marshal()
+			require.NoError(t, err)
+			simTxBytes, err = txRaw.Marshal()
+			require.NoError(t, err)
+			simGasInfo, _, err := baseApp.Simulate(simTxBytes)
+			require.NoError(t, err)
+
+			// the same tx, signed
+			signedTx := newTx()
+			sig := tc.sign(nil)
+			sig.Sequence = acc.GetSequence()
+			require.NoError(t, signedTx.SetSignatures(sig))
+			signBytes, err := authsign.GetSignBytesAdapter(context.Background(), signModeHandler, tc.signMode, authsign.SignerData{
+				Address:       tc.signer.String(),
+				ChainID:       baseApp.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+				PubKey:        sig.PubKey,
+			}, signedTx.GetTx())
+			require.NoError(t, err)
+			sig = tc.sign(signBytes)
+			sig.Sequence = acc.GetSequence()
+			require.NoError(t, signedTx.SetSignatures(sig))
+			signedTxBytes, err := s.TxConfig.TxEncoder()(signedTx.GetTx())
+			require.NoError(t, err)
+
+			res, err := baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: baseApp.LastBlockHeight() + 1, Txs: [][]byte{signedTxBytes}})
+			require.NoError(t, err)
+			require.Len(t, res.TxResults, 1)
+			require.Zero(t, res.TxResults[0].Code, res.TxResults[0].Log)
+			_, err = baseApp.Commit()
+			require.NoError(t, err)
+
+			// the simulated gas is within 1% of the gas used by the signed tx
+			gasUsed := uint64(res.TxResults[0].GasUsed)
+			t.Logf("simulated %d, used %d", simGasInfo.GasUsed, gasUsed)
+			require.InDelta(t, gasUsed, simGasInfo.GasUsed, float64(gasUsed)/100)
+		})
+	}
+}
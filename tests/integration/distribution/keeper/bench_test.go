@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func BenchmarkWithdrawDelegationRewards(b *testing.B) {
+	b.ReportAllocs()
+
+	f := initFixture(b)
+	if err := f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+	if err := f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()); err != nil {
+		panic(err)
+	}
+
+	// the distribution hooks initialize the rewards of the validator and of
+	// the delegation, as they would in an application
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	validator := stakingtestutil.NewValidator(b, f.valAddr, PKS[0])
+	if err := f.stakingKeeper.SetValidator(f.sdkCtx, validator); err != nil {
+		panic(err)
+	}
+	if err := f.stakingKeeper.SetValidatorByConsAddr(f.sdkCtx, validator); err != nil {
+		panic(err)
+	}
+	if err := f.distrKeeper.Hooks().AfterValidatorCreated(f.sdkCtx, f.valAddr); err != nil {
+		panic(err)
+	}
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	bondAmt := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, 10)
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, delAddr))
+	if err := f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))); err != nil {
+		panic(err)
+	}
+	if err := f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, delAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))); err != nil {
+		panic(err)
+	}
+	if _, err := f.stakingKeeper.Delegate(f.sdkCtx, delAddr, bondAmt, stakingtypes.Unbonded, validator, true); err != nil {
+		panic(err)
+	}
+
+	// the distribution module holds the rewards allocated by every iteration
+	rewards := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	total, _ := rewards.MulDec(math.LegacyNewDec(int64(b.N))).TruncateDecimal()
+	if err := f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, total); err != nil {
+		panic(err)
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, f.valAddr)
+		if err != nil {
+			panic(err)
+		}
+		if err := f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, rewards); err != nil {
+			panic(err)
+		}
+		b.StartTimer()
+
+		if _, err := f.distrKeeper.WithdrawDelegationRewards(f.sdkCtx, delAddr, f.valAddr); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func BenchmarkDelegate(b *testing.B) {
+	b.ReportAllocs()
+
+	f, _, valAddrs, vals := initValidators(b, 100, 1, []int64{100})
+	if err := f.stakingKeeper.SetValidator(f.sdkCtx, vals[0]); err != nil {
+		panic(err)
+	}
+
+	bondDenom, err := f.stakingKeeper.BondDenom(f.sdkCtx)
+	if err != nil {
+		panic(err)
+	}
+
+	// every iteration delegates from a new delegator, so that the benchmark
+	// measures the creation of a delegation rather than the update of one
+	bondAmt := math.NewInt(1000)
+	delegators := make([]sdk.AccAddress, b.N)
+	for i := range delegators {
+		delegators[i] = sdk.AccAddress(fmt.Sprintf("delegator%d", i))
+		f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, delegators[i]))
+		err := banktestutil.FundAccount(f.sdkCtx, f.bankKeeper, delegators[i], sdk.NewCoins(sdk.NewCoin(bondDenom, bondAmt)))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, valAddrs[0])
+		if err != nil {
+			panic(err)
+		}
+
+		if _, err := f.stakingKeeper.Delegate(f.sdkCtx, delegators[n], bondAmt, types.Unbonded, validator, true); err != nil {
+			panic(err)
+		}
+	}
+}
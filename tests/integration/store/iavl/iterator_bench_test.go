@@ -0,0 +1,72 @@
+package iavl_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// newCommittedStore returns an IAVL store holding numKeys keys, committed at
+// its first version.
+func newCommittedStore(b *testing.B, numKeys int, disableFastNode bool) storetypes.CommitKVStore {
+	b.Helper()
+
+	store, err := iavl.LoadStore(dbm.NewMemDB(), log.NewNopLogger(), storetypes.NewKVStoreKey("bench"), storetypes.CommitID{}, iavl.DefaultIAVLCacheSize, disableFastNode, metrics.NewNoOpMetrics())
+	require.NoError(b, err)
+
+	value := make([]byte, 32)
+	for i := 0; i < numKeys; i++ {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, uint64(i))
+		store.Set(key, value)
+	}
+	store.Commit()
+
+	return store
+}
+
+func BenchmarkIAVLIterator(b *testing.B) {
+	for _, numKeys := range []int{1000, 10000} {
+		for _, disableFastNode := range []bool{false, true} {
+			b.Run(fmt.Sprintf("keys=%d/fastnode=%t", numKeys, !disableFastNode), func(b *testing.B) {
+				b.ReportAllocs()
+				store := newCommittedStore(b, numKeys, disableFastNode)
+
+				b.ResetTimer()
+				for n := 0; n < b.N; n++ {
+					iter := store.Iterator(nil, nil)
+					for ; iter.Valid(); iter.Next() {
+						_ = iter.Value()
+					}
+					iter.Close()
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkIAVLReverseIterator(b *testing.B) {
+	for _, numKeys := range []int{1000, 10000} {
+		b.Run(fmt.Sprintf("keys=%d", numKeys), func(b *testing.B) {
+			b.ReportAllocs()
+			store := newCommittedStore(b, numKeys, false)
+
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				iter := store.ReverseIterator(nil, nil)
+				for ; iter.Valid(); iter.Next() {
+					_ = iter.Value()
+				}
+				iter.Close()
+			}
+		})
+	}
+}
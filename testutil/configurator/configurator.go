@@ -19,6 +19,7 @@ import (
 	nftmodulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	paramsmodulev1 "cosmossdk.io/api/cosmos/params/module/v1"
 	poolmodulev1 "cosmossdk.io/api/cosmos/protocolpool/module/v1"
+	recurringmodulev1 "cosmossdk.io/api/cosmos/recurring/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
@@ -63,6 +64,7 @@ func defaultConfig() *Config {
 			"consensus",
 			testutil.ParamsModuleName,
 			"vesting",
+			"recurring",
 			testutil.CircuitModuleName,
 		},
 		EndBlockersOrder: []string{
@@ -83,6 +85,7 @@ func defaultConfig() *Config {
 			"consensus",
 			testutil.UpgradeModuleName,
 			"vesting",
+			"recurring",
 			testutil.CircuitModuleName,
 			testutil.ProtocolPoolModuleName,
 		},
@@ -104,6 +107,7 @@ func defaultConfig() *Config {
 			"consensus",
 			testutil.UpgradeModuleName,
 			"vesting",
+			"recurring",
 			testutil.CircuitModuleName,
 			testutil.ProtocolPoolModuleName,
 		},
@@ -240,6 +244,15 @@ func VestingModule() ModuleOption {
 	}
 }
 
+func RecurringModule() ModuleOption {
+	return func(config *Config) {
+		config.ModuleConfigs["recurring"] = &appv1alpha1.ModuleConfig{
+			Name:   "recurring",
+			Config: appconfig.WrapAny(&recurringmodulev1.Module{}),
+		}
+	}
+}
+
 func GovModule() ModuleOption {
 	return func(config *Config) {
 		config.ModuleConfigs[testutil.GovModuleName] = &appv1alpha1.ModuleConfig{
@@ -0,0 +1,106 @@
+package sims
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// commitInfoReader is implemented by multistores that persist the commit
+// information of every committed version, such as rootmulti.Store.
+type commitInfoReader interface {
+	GetCommitInfo(ver int64) (*storetypes.CommitInfo, error)
+}
+
+// StoreHashDivergence describes the first committed height at which two
+// applications, simulated from the same seed, committed different state.
+type StoreHashDivergence struct {
+	Height int64
+	// Stores holds the sorted names of the stores whose commit hashes differ.
+	Stores []string
+}
+
+func (d StoreHashDivergence) String() string {
+	return fmt.Sprintf("app hash diverged at height %d in stores %v", d.Height, d.Stores)
+}
+
+// FindStoreHashDivergence compares, block by block, the per-store commit hashes
+// of a and b for every height in [fromHeight, toHeight]. It returns the first
+// divergence found, or nil when both multistores committed identical state.
+// Both multistores must have committed every height in the range.
+func FindStoreHashDivergence(a, b storetypes.CommitMultiStore, fromHeight, toHeight int64) (*StoreHashDivergence, error) {
+	readerA, okA := a.(commitInfoReader)
+	readerB, okB := b.(commitInfoReader)
+	if !okA || !okB {
+		return nil, fmt.Errorf("multistores %T and %T do not expose commit info", a, b)
+	}
+
+	for height := fromHeight; height <= toHeight; height++ {
+		infoA, err := readerA.GetCommitInfo(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read commit info of store A at height %d: %w", height, err)
+		}
+
+		infoB, err := readerB.GetCommitInfo(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read commit info of store B at height %d: %w", height, err)
+		}
+
+		if bytes.Equal(infoA.Hash(), infoB.Hash()) {
+			continue
+		}
+
+		return &StoreHashDivergence{
+			Height: height,
+			Stores: divergentStores(infoA, infoB),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// divergentStores returns the names of the stores whose hashes differ, or
+// that are only present in one of the commit infos.
+func divergentStores(infoA, infoB *storetypes.CommitInfo) []string {
+	hashes := make(map[string][]byte, len(infoA.StoreInfos))
+	for _, si := range infoA.StoreInfos {
+		hashes[si.Name] = si.GetHash()
+	}
+
+	var stores []string
+	for _, si := range infoB.StoreInfos {
+		hashA, ok := hashes[si.Name]
+		if !ok || !bytes.Equal(hashA, si.GetHash()) {
+			stores = append(stores, si.Name)
+		}
+		delete(hashes, si.Name)
+	}
+
+	for name := range hashes {
+		stores = append(stores, name)
+	}
+
+	sort.Strings(stores)
+	return stores
+}
+
+// DiffStoresAtHeight returns the key/value pairs of the store identified by
+// key that differ between a and b at the given committed height.
+func DiffStoresAtHeight(a, b storetypes.CommitMultiStore, key storetypes.StoreKey, height int64) (kvAs, kvBs []kv.Pair, err error) {
+	cacheA, err := a.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load store A at height %d: %w", height, err)
+	}
+
+	cacheB, err := b.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load store B at height %d: %w", height, err)
+	}
+
+	kvAs, kvBs = DiffKVStores(cacheA.GetKVStore(key), cacheB.GetKVStore(key), nil)
+	return kvAs, kvBs, nil
+}
@@ -0,0 +1,74 @@
+package sims
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestFindStoreHashDivergence(t *testing.T) {
+	key1 := storetypes.NewKVStoreKey("store1")
+	key2 := storetypes.NewKVStoreKey("store2")
+
+	newStore := func() *rootmulti.Store {
+		db := dbm.NewMemDB()
+		ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		ms.MountStoreWithDB(key1, storetypes.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(key2, storetypes.StoreTypeIAVL, nil)
+		require.NoError(t, ms.LoadLatestVersion())
+		return ms
+	}
+
+	a, b := newStore(), newStore()
+	for _, ms := range []*rootmulti.Store{a, b} {
+		ms.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+		ms.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+		ms.Commit()
+	}
+
+	divergence, err := FindStoreHashDivergence(a, b, 1, 1)
+	require.NoError(t, err)
+	require.Nil(t, divergence)
+
+	// height 2 only diverges in store2
+	a.GetKVStore(key2).Set([]byte("k3"), []byte("a"))
+	b.GetKVStore(key2).Set([]byte("k3"), []byte("b"))
+	a.Commit()
+	b.Commit()
+
+	// height 3 diverges in both stores
+	a.GetKVStore(key1).Set([]byte("k4"), []byte("a"))
+	a.Commit()
+	b.Commit()
+
+	divergence, err = FindStoreHashDivergence(a, b, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, &StoreHashDivergence{Height: 2, Stores: []string{"store2"}}, divergence)
+
+	kvAs, kvBs, err := DiffStoresAtHeight(a, b, key2, 2)
+	require.NoError(t, err)
+	require.Len(t, kvAs, 1)
+	require.Equal(t, []byte("k3"), kvAs[0].Key)
+	require.Equal(t, []byte("a"), kvAs[0].Value)
+	require.Equal(t, []byte("b"), kvBs[0].Value)
+
+	// store1 did not diverge at height 2
+	kvAs, kvBs, err = DiffStoresAtHeight(a, b, key1, 2)
+	require.NoError(t, err)
+	require.Empty(t, kvAs)
+	require.Empty(t, kvBs)
+
+	divergence, err = FindStoreHashDivergence(a, b, 3, 3)
+	require.NoError(t, err)
+	require.Equal(t, &StoreHashDivergence{Height: 3, Stores: []string{"store1", "store2"}}, divergence)
+
+	// height 4 was never committed
+	_, err = FindStoreHashDivergence(a, b, 4, 4)
+	require.Error(t, err)
+}
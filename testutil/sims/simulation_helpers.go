@@ -195,11 +195,12 @@ func getDiffFromKVPair(kvAs, kvBs []kv.Pair) (diffA, diffB []kv.Pair) {
 		if kvBValue, ok := index[string(kvA.Key)]; !ok {
 			diffA = append(diffA, kvA)
 			diffB = append(diffB, kv.Pair{Key: kvA.Key}) // the key is missing from kvB so we append a pair with an empty value
-		} else if !bytes.Equal(kvA.Value, kvBValue) {
-			diffA = append(diffA, kvA)
-			diffB = append(diffB, kv.Pair{Key: kvA.Key, Value: kvBValue})
 		} else {
-			// values are equal, so we remove the key from the index
+			if !bytes.Equal(kvA.Value, kvBValue) {
+				diffA = append(diffA, kvA)
+				diffB = append(diffB, kv.Pair{Key: kvA.Key, Value: kvBValue})
+			}
+			// the key is present in both stores, so we remove it from the index
 			delete(index, string(kvA.Key))
 		}
 	}
@@ -18,6 +18,7 @@ import (
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -103,11 +104,11 @@ func AppStateFnWithExtendedCbs(
 			if err != nil {
 				panic(err)
 			}
-			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, genesisState)
+			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, genesisState, config.VestingAccounts)
 
 		default:
 			appParams := make(simtypes.AppParams)
-			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, genesisState)
+			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, genesisState, config.VestingAccounts)
 		}
 
 		rawState := make(map[string]json.RawMessage)
@@ -186,7 +187,8 @@ func AppStateFnWithExtendedCbs(
 }
 
 // AppStateRandomizedFn creates calls each module's GenesisState generator function
-// and creates the simulation params
+// and creates the simulation params. The vesting accounts configuration is
+// passed on to the generators of the genesis accounts.
 func AppStateRandomizedFn(
 	simManager *module.SimulationManager,
 	r *rand.Rand,
@@ -195,6 +197,7 @@ func AppStateRandomizedFn(
 	genesisTimestamp time.Time,
 	appParams simtypes.AppParams,
 	genesisState map[string]json.RawMessage,
+	vestingAccounts simtypes.VestingAccountsConfig,
 ) (json.RawMessage, []simtypes.Account) {
 	numAccs := int64(len(accs))
 	// generate a random amount of initial stake coins and a random initial
@@ -205,11 +208,26 @@ func AppStateRandomizedFn(
 	)
 	appParams.GetOrGenerate(
 		StakePerAccount, &initialStake, r,
-		func(r *rand.Rand) { initialStake = sdk.DefaultPowerReduction.AddRaw(r.Int63n(1e12)) },
+		func(r *rand.Rand) {
+			// from time to time, start from the smallest stake that still yields
+			// consensus power, so that low-stake edge cases are exercised
+			if r.Intn(10) == 0 {
+				initialStake = sdk.DefaultPowerReduction
+				return
+			}
+			initialStake = sdk.DefaultPowerReduction.AddRaw(r.Int63n(1e12))
+		},
 	)
 	appParams.GetOrGenerate(
 		InitiallyBondedValidators, &numInitiallyBonded, r,
-		func(r *rand.Rand) { numInitiallyBonded = int64(r.Intn(299) + 1) },
+		func(r *rand.Rand) {
+			// from time to time, start from a single bonded validator
+			if r.Intn(20) == 0 {
+				numInitiallyBonded = 1
+				return
+			}
+			numInitiallyBonded = int64(r.Intn(299) + 1)
+		},
 	)
 
 	if numInitiallyBonded > numAccs {
@@ -226,15 +244,16 @@ func AppStateRandomizedFn(
 	)
 
 	simState := &module.SimulationState{
-		AppParams:    appParams,
-		Cdc:          cdc,
-		Rand:         r,
-		GenState:     genesisState,
-		Accounts:     accs,
-		InitialStake: initialStake,
-		NumBonded:    numInitiallyBonded,
-		BondDenom:    sdk.DefaultBondDenom,
-		GenTimestamp: genesisTimestamp,
+		AppParams:       appParams,
+		Cdc:             cdc,
+		Rand:            r,
+		GenState:        genesisState,
+		Accounts:        accs,
+		InitialStake:    initialStake,
+		NumBonded:       numInitiallyBonded,
+		BondDenom:       sdk.DefaultBondDenom,
+		GenTimestamp:    genesisTimestamp,
+		VestingAccounts: vestingAccounts,
 	}
 
 	simManager.GenerateGenesisStates(simState)
@@ -248,7 +267,9 @@ func AppStateRandomizedFn(
 }
 
 // AppStateFromGenesisFileFn util function to generate the genesis AppState
-// from a genesis.json file.
+// from a genesis.json file, such as the one exported from a live network.
+// Every genesis account is mapped to a random simulation key. The on-chain
+// public key of the accounts which have one is replaced by the simulation key.
 func AppStateFromGenesisFileFn(r io.Reader, cdc codec.JSONCodec, genesisFile string) (genutiltypes.AppGenesis, []simtypes.Account, error) {
 	file, err := os.Open(filepath.Clean(genesisFile))
 	if err != nil {
@@ -275,6 +296,7 @@ func AppStateFromGenesisFileFn(r io.Reader, cdc codec.JSONCodec, genesisFile str
 	}
 
 	newAccs := make([]simtypes.Account, len(authGenesis.Accounts))
+	rekeyed := false
 	for i, acc := range authGenesis.Accounts {
 		// Pick a random private key, since we don't know the actual key
 		// This should be fine as it's only used for mock CometBFT validators
@@ -291,10 +313,31 @@ func AppStateFromGenesisFileFn(r io.Reader, cdc codec.JSONCodec, genesisFile str
 			return *genesis, nil, fmt.Errorf("expected account")
 		}
 
+		// accounts which already have a public key on chain are mapped to the
+		// simulation key, so that the transactions they sign during the
+		// simulation pass signature verification.
+		if a.GetPubKey() != nil {
+			if err := a.SetPubKey(privKey.PubKey()); err != nil {
+				return *genesis, nil, err
+			}
+
+			if authGenesis.Accounts[i], err = codectypes.NewAnyWithValue(a); err != nil {
+				return *genesis, nil, err
+			}
+			rekeyed = true
+		}
+
 		// create simulator accounts
 		simAcc := simtypes.Account{PrivKey: privKey, PubKey: privKey.PubKey(), Address: a.GetAddress(), ConsKey: ed25519.GenPrivKeyFromSecret(privkeySeed)}
 		newAccs[i] = simAcc
 	}
 
+	if rekeyed {
+		appState[testutil.AuthModuleName] = cdc.MustMarshalJSON(&authGenesis)
+		if genesis.AppState, err = json.Marshal(appState); err != nil {
+			return *genesis, nil, err
+		}
+	}
+
 	return *genesis, newAccs, nil
 }
@@ -0,0 +1,67 @@
+package sims
+
+import (
+	"encoding/json"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestAppStateFromGenesisFileFn(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	// an account with a public key, as found on a live network, and one without
+	withPubKey := secp256k1.GenPrivKey().PubKey()
+	accs := authtypes.GenesisAccounts{
+		authtypes.NewBaseAccount(sdk.AccAddress(withPubKey.Address()), withPubKey, 0, 3),
+		authtypes.NewBaseAccountWithAddress(sdk.AccAddress("without_pubkey______")),
+	}
+	packed, err := authtypes.PackAccounts(accs)
+	require.NoError(t, err)
+
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), accs)
+	authGenesis.Accounts = packed
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		authtypes.ModuleName: cdc.MustMarshalJSON(authGenesis),
+	})
+	require.NoError(t, err)
+
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genutiltypes.NewAppGenesisWithVersion("exported-chain", appState).SaveAs(genesisFile))
+
+	genesis, simAccs, err := AppStateFromGenesisFileFn(rand.New(rand.NewSource(1)), cdc, genesisFile)
+	require.NoError(t, err)
+	require.Equal(t, "exported-chain", genesis.ChainID)
+	require.Len(t, simAccs, 2)
+
+	var rawState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genesis.AppState, &rawState))
+
+	var gotAuthGenesis authtypes.GenesisState
+	cdc.MustUnmarshalJSON(rawState[authtypes.ModuleName], &gotAuthGenesis)
+	gotAccs, err := authtypes.UnpackAccounts(gotAuthGenesis.Accounts)
+	require.NoError(t, err)
+
+	// the account with a public key is mapped to the simulation key
+	require.Equal(t, accs[0].GetAddress(), simAccs[0].Address)
+	require.True(t, simAccs[0].PubKey.Equals(gotAccs[0].GetPubKey()))
+	require.Equal(t, uint64(3), gotAccs[0].GetSequence())
+
+	// the account without a public key is left untouched
+	require.Equal(t, accs[1].GetAddress(), simAccs[1].Address)
+	require.Nil(t, gotAccs[1].GetPubKey())
+}
@@ -120,7 +120,10 @@ func SignCheckDeliver(
 		require.NotNil(t, res)
 	} else {
 		require.Error(t, err)
-		require.Nil(t, res)
+		// with a post handler, a result without message responses is returned
+		if res != nil {
+			require.Empty(t, res.MsgResponses)
+		}
 	}
 
 	bz, err := txCfg.TxEncoder()(tx)
@@ -143,3 +143,89 @@ func BenchmarkSumOfCoinAdds(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkCoinsSub(b *testing.B) {
+	benchmarkingFunc := func(numCoinsA, numCoinsB int) func(b *testing.B) {
+		return func(b *testing.B) {
+			b.Helper()
+			b.ReportAllocs()
+			coinsA := Coins(make([]Coin, numCoinsA))
+			coinsB := Coins(make([]Coin, numCoinsB))
+
+			for i := 0; i < numCoinsA; i++ {
+				coinsA[i] = NewCoin(coinName(i), math.NewInt(int64(i+1)*2))
+			}
+			for i := 0; i < numCoinsB; i++ {
+				coinsB[i] = NewCoin(coinName(i), math.NewInt(int64(i+1)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				coinsA.Sub(coinsB...)
+			}
+		}
+	}
+
+	benchmarkSizes := [][]int{{1, 1}, {5, 5}, {20, 5}, {1000, 1}, {1000, 1000}}
+	for i := 0; i < len(benchmarkSizes); i++ {
+		sizeA := benchmarkSizes[i][0]
+		sizeB := benchmarkSizes[i][1]
+		b.Run(fmt.Sprintf("sizes: A_%d, B_%d", sizeA, sizeB), benchmarkingFunc(sizeA, sizeB))
+	}
+}
+
+func BenchmarkCoinsIsAllGTE(b *testing.B) {
+	benchmarkingFunc := func(numCoinsA, numCoinsB int) func(b *testing.B) {
+		return func(b *testing.B) {
+			b.Helper()
+			b.ReportAllocs()
+			coinsA := Coins(make([]Coin, numCoinsA))
+			coinsB := Coins(make([]Coin, numCoinsB))
+
+			for i := 0; i < numCoinsA; i++ {
+				coinsA[i] = NewCoin(coinName(i), math.NewInt(int64(i+1)*2))
+			}
+			for i := 0; i < numCoinsB; i++ {
+				coinsB[i] = NewCoin(coinName(i), math.NewInt(int64(i+1)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				coinsA.IsAllGTE(coinsB)
+			}
+		}
+	}
+
+	benchmarkSizes := [][]int{{1, 1}, {5, 5}, {20, 5}, {1000, 1}, {1000, 1000}}
+	for i := 0; i < len(benchmarkSizes); i++ {
+		sizeA := benchmarkSizes[i][0]
+		sizeB := benchmarkSizes[i][1]
+		b.Run(fmt.Sprintf("sizes: A_%d, B_%d", sizeA, sizeB), benchmarkingFunc(sizeA, sizeB))
+	}
+}
+
+func BenchmarkCoinsValidate(b *testing.B) {
+	benchmarkingFunc := func(numCoins int) func(b *testing.B) {
+		return func(b *testing.B) {
+			b.Helper()
+			b.ReportAllocs()
+			coins := Coins(make([]Coin, numCoins))
+
+			for i := 0; i < numCoins; i++ {
+				coins[i] = NewCoin(coinName(i), math.NewInt(int64(i+1)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = coins.Validate()
+			}
+		}
+	}
+
+	for _, size := range []int{1, 5, 20, 1000} {
+		b.Run(fmt.Sprintf("size: %d", size), benchmarkingFunc(size))
+	}
+}
@@ -140,6 +140,10 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrWrongAccountNumber defines an error where a signature was made over an
+	// account number other than the signer's actual account number.
+	ErrWrongAccountNumber = errorsmod.Register(RootCodespace, 42, "incorrect account number")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
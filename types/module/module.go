@@ -203,6 +203,53 @@ func NewManagerFromMap(moduleMap map[string]appmodule.AppModule) *Manager {
 	}
 }
 
+// RegisterModules registers additional modules, for instance contributed by
+// external packages, after the creation of the manager. The modules are
+// appended, sorted by name, to every execution order, which the app can then
+// override with the SetOrder* methods; those still check that no module is
+// forgotten. An error is returned if a module name is already registered, in
+// which case no module is registered.
+func (m *Manager) RegisterModules(moduleMap map[string]appmodule.AppModule) error {
+	names := make([]string, 0, len(moduleMap))
+	for name := range moduleMap {
+		if _, ok := m.Modules[name]; ok {
+			return fmt.Errorf("module %s is already registered", name)
+		}
+
+		names = append(names, name)
+	}
+
+	// Sort the modules by name. Given that we are using a map above we can't guarantee the order.
+	sort.Strings(names)
+
+	var preBlockNames []string
+	for _, name := range names {
+		m.Modules[name] = moduleMap[name]
+		if _, ok := moduleMap[name].(appmodule.HasPreBlocker); ok {
+			preBlockNames = append(preBlockNames, name)
+		}
+	}
+
+	m.OrderInitGenesis = appendModuleNames(m.OrderInitGenesis, names)
+	m.OrderExportGenesis = appendModuleNames(m.OrderExportGenesis, names)
+	m.OrderPreBlockers = appendModuleNames(m.OrderPreBlockers, preBlockNames)
+	m.OrderBeginBlockers = appendModuleNames(m.OrderBeginBlockers, names)
+	m.OrderEndBlockers = appendModuleNames(m.OrderEndBlockers, names)
+	m.OrderPrepareCheckStaters = appendModuleNames(m.OrderPrepareCheckStaters, names)
+	m.OrderPrecommiters = appendModuleNames(m.OrderPrecommiters, names)
+	if m.OrderMigrations != nil {
+		m.OrderMigrations = appendModuleNames(m.OrderMigrations, names)
+	}
+
+	return nil
+}
+
+// appendModuleNames appends names to a copy of order, as the orders of a
+// manager may share their backing array.
+func appendModuleNames(order, names []string) []string {
+	return append(order[:len(order):len(order)], names...)
+}
+
 // SetOrderInitGenesis sets the order of init genesis calls
 func (m *Manager) SetOrderInitGenesis(moduleNames ...string) {
 	m.assertNoForgottenModules("SetOrderInitGenesis", moduleNames, func(moduleName string) bool {
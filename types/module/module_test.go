@@ -104,6 +104,34 @@ func TestManagerOrderSetters(t *testing.T) {
 	require.Equal(t, []string{"module3", "module2", "module1"}, mm.OrderPrecommiters)
 }
 
+func TestManager_RegisterModules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+	mockAppModule1 := mock.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mock.NewMockCoreAppModule(mockCtrl)
+	mockAppModule3 := mock.NewMockCoreAppModule(mockCtrl)
+
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mm := module.NewManager(mockAppModule1)
+	mm.SetOrderEndBlockers("module1")
+
+	err := mm.RegisterModules(map[string]appmodule.AppModule{"module1": mockAppModule2, "module3": mockAppModule3})
+	require.ErrorContains(t, err, "module module1 is already registered")
+	require.Equal(t, 1, len(mm.Modules))
+
+	require.NoError(t, mm.RegisterModules(map[string]appmodule.AppModule{"module3": mockAppModule3, "module2": mockAppModule2}))
+	require.Equal(t, 3, len(mm.Modules))
+	require.Equal(t, []string{"module1", "module2", "module3"}, mm.OrderInitGenesis)
+	require.Equal(t, []string{"module1", "module2", "module3"}, mm.OrderEndBlockers)
+	require.Equal(t, []string{}, mm.OrderPreBlockers)
+
+	require.PanicsWithValue(t, "all modules must be defined when setting SetOrderBeginBlockers, missing: [module2 module3]", func() {
+		mm.SetOrderBeginBlockers("module1")
+	})
+	mm.SetOrderBeginBlockers("module3", "module1", "module2")
+	require.Equal(t, []string{"module3", "module1", "module2"}, mm.OrderBeginBlockers)
+}
+
 func TestManager_RegisterInvariants(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -23,6 +23,13 @@ type AppModuleSimulation interface {
 	// register a func to decode the each module's defined types from their corresponding store key
 	RegisterStoreDecoder(simulation.StoreDecoderRegistry)
 
+	HasWeightedOperations
+}
+
+// HasWeightedOperations defines the randomized operations (i.e msgs) a module
+// contributes to the simulator. Modules implementing only this interface are
+// still picked up by NewSimulationManagerFromAppModules.
+type HasWeightedOperations interface {
 	// simulation operations (i.e msgs) with their respective weight
 	WeightedOperations(simState SimulationState) []simulation.WeightedOperation
 }
@@ -61,7 +68,8 @@ func NewSimulationManager(modules ...AppModuleSimulation) *SimulationManager {
 // First it sets any SimulationModule provided by overrideModules, and ignores any AppModule
 // with the same moduleName.
 // Then it attempts to cast every provided AppModule into an AppModuleSimulation.
-// If the cast succeeds, its included, otherwise it is excluded.
+// If the cast succeeds, its included. Modules only implementing HasWeightedOperations
+// are included without genesis or store decoder simulation, all others are excluded.
 func NewSimulationManagerFromAppModules(modules map[string]appmodule.AppModule, overrideModules map[string]AppModuleSimulation) *SimulationManager {
 	simModules := []AppModuleSimulation{}
 	appModuleNamesSorted := make([]string, 0, len(modules))
@@ -79,8 +87,11 @@ func NewSimulationManagerFromAppModules(modules map[string]appmodule.AppModule,
 			simModules = append(simModules, simModule)
 		} else {
 			appModule := modules[moduleName]
-			if simModule, ok := appModule.(AppModuleSimulation); ok {
+			switch simModule := appModule.(type) {
+			case AppModuleSimulation:
 				simModules = append(simModules, simModule)
+			case HasWeightedOperations:
+				simModules = append(simModules, operationsOnlyModule{simModule})
 			}
 			// cannot cast, so we continue
 		}
@@ -88,6 +99,25 @@ func NewSimulationManagerFromAppModules(modules map[string]appmodule.AppModule,
 	return NewSimulationManager(simModules...)
 }
 
+// operationsOnlyModule adapts a module that only contributes weighted
+// operations to the AppModuleSimulation interface.
+type operationsOnlyModule struct {
+	HasWeightedOperations
+}
+
+func (operationsOnlyModule) GenerateGenesisState(*SimulationState) {}
+
+func (operationsOnlyModule) RegisterStoreDecoder(simulation.StoreDecoderRegistry) {}
+
+// ProposalMsgs forwards to the wrapped module when it implements HasProposalMsgs.
+func (m operationsOnlyModule) ProposalMsgs(simState SimulationState) []simulation.WeightedProposalMsg {
+	if module, ok := m.HasWeightedOperations.(HasProposalMsgs); ok {
+		return module.ProposalMsgs(simState)
+	}
+
+	return nil
+}
+
 // Deprecated: Use GetProposalMsgs instead.
 // GetProposalContents returns each module's proposal content generator function
 // with their default operation weight and key.
@@ -144,17 +174,18 @@ func (sm *SimulationManager) WeightedOperations(simState SimulationState) []simu
 // GenesisState generator function
 type SimulationState struct {
 	AppParams         simulation.AppParams
-	Cdc               codec.JSONCodec                // application codec
-	TxConfig          client.TxConfig                // Shared TxConfig; this is expensive to create and stateless, so create it once up front.
-	Rand              *rand.Rand                     // random number
-	GenState          map[string]json.RawMessage     // genesis state
-	Accounts          []simulation.Account           // simulation accounts
-	InitialStake      sdkmath.Int                    // initial coins per account
-	NumBonded         int64                          // number of initially bonded accounts
-	BondDenom         string                         // denom to be used as default
-	GenTimestamp      time.Time                      // genesis timestamp
-	UnbondTime        time.Duration                  // staking unbond time stored to use it as the slashing maximum evidence duration
-	LegacyParamChange []simulation.LegacyParamChange // simulated parameter changes from modules
+	Cdc               codec.JSONCodec                  // application codec
+	TxConfig          client.TxConfig                  // Shared TxConfig; this is expensive to create and stateless, so create it once up front.
+	Rand              *rand.Rand                       // random number
+	GenState          map[string]json.RawMessage       // genesis state
+	Accounts          []simulation.Account             // simulation accounts
+	InitialStake      sdkmath.Int                      // initial coins per account
+	NumBonded         int64                            // number of initially bonded accounts
+	BondDenom         string                           // denom to be used as default
+	GenTimestamp      time.Time                        // genesis timestamp
+	UnbondTime        time.Duration                    // staking unbond time stored to use it as the slashing maximum evidence duration
+	VestingAccounts   simulation.VestingAccountsConfig // vesting accounts to create in the genesis accounts
+	LegacyParamChange []simulation.LegacyParamChange   // simulated parameter changes from modules
 	//nolint:staticcheck //	legacy used for testing
 	LegacyProposalContents []simulation.WeightedProposalContent // proposal content generator functions with their default weight and app sim key
 	ProposalMsgs           []simulation.WeightedProposalMsg     // proposal msg generator functions with their default weight and app sim key
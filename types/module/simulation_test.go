@@ -0,0 +1,51 @@
+package module_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+type operationsOnlyAppModule struct {
+	name string
+}
+
+func (operationsOnlyAppModule) IsAppModule()        {}
+func (operationsOnlyAppModule) IsOnePerModuleType() {}
+
+func (m operationsOnlyAppModule) WeightedOperations(module.SimulationState) []simtypes.WeightedOperation {
+	return []simtypes.WeightedOperation{
+		simulation.NewWeightedOperation(7, func(*rand.Rand, *baseapp.BaseApp, sdk.Context, []simtypes.Account, string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+			return simtypes.NoOpMsg(m.name, "noop", ""), nil, nil
+		}),
+	}
+}
+
+func TestNewSimulationManagerFromAppModulesOperationsOnly(t *testing.T) {
+	modules := map[string]appmodule.AppModule{
+		"ops":  operationsOnlyAppModule{name: "ops"},
+		"none": struct{ appmodule.AppModule }{},
+	}
+
+	sm := module.NewSimulationManagerFromAppModules(modules, nil)
+	require.Len(t, sm.Modules, 1)
+
+	// modules without genesis or store decoder simulation are still safe to use
+	sm.RegisterStoreDecoders()
+	require.Empty(t, sm.StoreDecoders)
+	sm.GenerateGenesisStates(&module.SimulationState{})
+	require.Empty(t, sm.GetProposalMsgs(module.SimulationState{}))
+
+	ops := sm.WeightedOperations(module.SimulationState{})
+	require.Len(t, ops, 1)
+	require.Equal(t, 7, ops[0].Weight())
+}
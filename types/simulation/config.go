@@ -1,5 +1,10 @@
 package simulation
 
+import (
+	"fmt"
+	"time"
+)
+
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
 	GenesisFile string // custom simulation genesis file; cannot be used with params file
@@ -9,6 +14,9 @@ type Config struct {
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ExportReportPath   string // custom file path to save the simulation report JSON
+	ExportEventLogPath string // custom file path to stream the simulation event log, as JSON lines
+	ReplayFile         string // replay file of a failed simulation; re-executes it up to its failing block with store tracing
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -25,4 +33,53 @@ type Config struct {
 
 	DBBackend   string // custom db backend type
 	BlockMaxGas int64  // custom max gas for block
+
+	// OnBlockCommit, when set, is called with the block height after every block
+	// committed by the simulation. It is only called when Commit is enabled. An
+	// error returned by the callback stops the simulation.
+	OnBlockCommit func(height int64) error
+
+	VestingAccounts VestingAccountsConfig // vesting accounts created in the randomized genesis
+}
+
+// VestingAccountsConfig configures the vesting accounts created in the
+// randomized genesis. The zero value selects DefaultVestingAccountsConfig.
+type VestingAccountsConfig struct {
+	Fraction    float64       // fraction of the genesis accounts, other than the initial validators, created as vesting accounts
+	MinDuration time.Duration // minimum length of their vesting schedule
+	MaxDuration time.Duration // maximum length of their vesting schedule
+}
+
+// DefaultVestingAccountsConfig returns the default configuration of the vesting
+// accounts of the randomized genesis: half of the accounts vest, within 30 days.
+func DefaultVestingAccountsConfig() VestingAccountsConfig {
+	return VestingAccountsConfig{
+		Fraction:    0.5,
+		MinDuration: time.Second,
+		MaxDuration: 30 * 24 * time.Hour,
+	}
+}
+
+// OrDefault returns the configuration, or DefaultVestingAccountsConfig if it
+// is the zero value.
+func (c VestingAccountsConfig) OrDefault() VestingAccountsConfig {
+	if c == (VestingAccountsConfig{}) {
+		return DefaultVestingAccountsConfig()
+	}
+
+	return c
+}
+
+// Validate checks that the fraction is within [0, 1] and the durations form a
+// positive range.
+func (c VestingAccountsConfig) Validate() error {
+	if c.Fraction < 0 || c.Fraction > 1 {
+		return fmt.Errorf("vesting accounts fraction must be between 0 and 1, got %v", c.Fraction)
+	}
+
+	if c.MinDuration < time.Second || c.MaxDuration < c.MinDuration {
+		return fmt.Errorf("invalid vesting duration range [%s, %s]", c.MinDuration, c.MaxDuration)
+	}
+
+	return nil
 }
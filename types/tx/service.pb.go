@@ -1121,6 +1121,140 @@ func (m *TxDecodeAminoResponse) GetAminoJson() string {
 	return ""
 }
 
+// TxSignBytesRequest is the request type for the Service.TxSignBytes
+// RPC method.
+//
+// Since: cosmos-sdk 0.51
+type TxSignBytesRequest struct {
+	// tx is the transaction to sign.
+	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// chain_id is the chain-id the transaction is signed for.
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account_number is the account number of the signer.
+	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the account sequence of the signer.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *TxSignBytesRequest) Reset()         { *m = TxSignBytesRequest{} }
+func (m *TxSignBytesRequest) String() string { return proto.CompactTextString(m) }
+func (*TxSignBytesRequest) ProtoMessage()    {}
+func (*TxSignBytesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{18}
+}
+func (m *TxSignBytesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSignBytesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSignBytesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSignBytesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSignBytesRequest.Merge(m, src)
+}
+func (m *TxSignBytesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSignBytesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSignBytesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSignBytesRequest proto.InternalMessageInfo
+
+func (m *TxSignBytesRequest) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TxSignBytesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *TxSignBytesRequest) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *TxSignBytesRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// TxSignBytesResponse is the response type for the Service.TxSignBytes
+// RPC method.
+//
+// Since: cosmos-sdk 0.51
+type TxSignBytesResponse struct {
+	// sign_bytes are the canonical JSON sign bytes of the transaction.
+	SignBytes []byte `protobuf:"bytes,1,opt,name=sign_bytes,json=signBytes,proto3" json:"sign_bytes,omitempty"`
+	// sha256 is the SHA256 hash of the sign bytes.
+	Sha256 []byte `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (m *TxSignBytesResponse) Reset()         { *m = TxSignBytesResponse{} }
+func (m *TxSignBytesResponse) String() string { return proto.CompactTextString(m) }
+func (*TxSignBytesResponse) ProtoMessage()    {}
+func (*TxSignBytesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{19}
+}
+func (m *TxSignBytesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSignBytesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSignBytesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSignBytesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSignBytesResponse.Merge(m, src)
+}
+func (m *TxSignBytesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSignBytesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSignBytesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSignBytesResponse proto.InternalMessageInfo
+
+func (m *TxSignBytesResponse) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (m *TxSignBytesResponse) GetSha256() []byte {
+	if m != nil {
+		return m.Sha256
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
@@ -1142,90 +1276,99 @@ func init() {
 	proto.RegisterType((*TxEncodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxEncodeAminoResponse")
 	proto.RegisterType((*TxDecodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxDecodeAminoRequest")
 	proto.RegisterType((*TxDecodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxDecodeAminoResponse")
+	proto.RegisterType((*TxSignBytesRequest)(nil), "cosmos.tx.v1beta1.TxSignBytesRequest")
+	proto.RegisterType((*TxSignBytesResponse)(nil), "cosmos.tx.v1beta1.TxSignBytesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x18, 0x66, 0x6d, 0x83, 0xcd, 0x6b, 0x08, 0xce, 0x40, 0xc0, 0x38, 0xc4, 0x98, 0x0d, 0x1f, 0x8e,
-	0x55, 0xbc, 0x0a, 0x4d, 0xaa, 0x24, 0xaa, 0x54, 0xe1, 0x8f, 0x50, 0x92, 0x26, 0x44, 0x6b, 0xaa,
-	0x28, 0x55, 0x25, 0x6b, 0x6d, 0x4f, 0xec, 0x6d, 0xec, 0x5d, 0xe3, 0x19, 0xd0, 0x5a, 0x14, 0xb5,
-	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x0f, 0xfd, 0x0f, 0xfd, 0x25, 0x3d, 0x46, 0xea, 0xa5, 0xc7, 0x0a,
-	0x7a, 0xea, 0xa9, 0x52, 0xff, 0x40, 0xb5, 0x33, 0xb3, 0xb6, 0xd7, 0xde, 0xc5, 0x90, 0x0b, 0xcc,
-	0xc7, 0xf3, 0xbe, 0xcf, 0x33, 0xef, 0xcc, 0x3c, 0x3b, 0x86, 0xd5, 0xaa, 0x49, 0x5a, 0x26, 0x51,
-	0xa8, 0xa5, 0x9c, 0xdc, 0xaf, 0x60, 0xaa, 0xdd, 0x57, 0x08, 0xee, 0x9c, 0xe8, 0x55, 0x9c, 0x6d,
-	0x77, 0x4c, 0x6a, 0xa2, 0x9b, 0x1c, 0x90, 0xa5, 0x56, 0x56, 0x00, 0x12, 0x2b, 0x75, 0xd3, 0xac,
-	0x37, 0xb1, 0xa2, 0xb5, 0x75, 0x45, 0x33, 0x0c, 0x93, 0x6a, 0x54, 0x37, 0x0d, 0xc2, 0x03, 0x12,
-	0x77, 0x45, 0xc6, 0x8a, 0x46, 0xb0, 0xa2, 0x55, 0xaa, 0x7a, 0x2f, 0xb1, 0xdd, 0x11, 0xa0, 0xc4,
-	0x28, 0x2d, 0xb5, 0xc4, 0x5c, 0x66, 0x30, 0xc1, 0xd1, 0x31, 0xee, 0x74, 0x7b, 0x98, 0xb6, 0x56,
-	0xd7, 0x0d, 0xc6, 0x26, 0xb0, 0x2b, 0x14, 0x1b, 0x35, 0xdc, 0x69, 0xe9, 0x06, 0x55, 0x68, 0xb7,
-	0x8d, 0x89, 0x52, 0x69, 0x9a, 0xd5, 0x77, 0xbe, 0xb3, 0xec, 0x2f, 0x9f, 0x95, 0xff, 0x93, 0x00,
-	0xed, 0x61, 0x7a, 0x68, 0x91, 0xe2, 0x09, 0x36, 0xa8, 0x8a, 0x8f, 0x8e, 0x31, 0xa1, 0x28, 0x01,
-	0x53, 0xd8, 0xee, 0x93, 0xb8, 0x94, 0x0a, 0xa6, 0xa7, 0x73, 0x81, 0xb8, 0xa4, 0x8a, 0x11, 0xf4,
-	0x0c, 0xa0, 0x2f, 0x21, 0x1e, 0x48, 0x49, 0xe9, 0xe8, 0xce, 0x66, 0x56, 0x54, 0xc8, 0xd6, 0x9b,
-	0x65, 0x7a, 0x9d, 0x4a, 0x65, 0x5f, 0x69, 0x75, 0x2c, 0xf2, 0xb2, 0x3c, 0x03, 0xd1, 0xe8, 0x21,
-	0x44, 0xcc, 0x4e, 0x0d, 0x77, 0xca, 0x95, 0x6e, 0x3c, 0x98, 0x92, 0xd2, 0x37, 0x76, 0x12, 0xd9,
-	0x91, 0x5a, 0x67, 0x0f, 0x6c, 0x48, 0xae, 0xab, 0x86, 0x4d, 0xde, 0x40, 0x08, 0x42, 0x6d, 0xad,
-	0x8e, 0xe3, 0xa1, 0x94, 0x94, 0x0e, 0xa9, 0xac, 0x8d, 0x16, 0x60, 0xb2, 0xa9, 0xb7, 0x74, 0x1a,
-	0x9f, 0x64, 0x83, 0xbc, 0x63, 0x8f, 0x32, 0x35, 0xf1, 0xa9, 0x94, 0x94, 0x9e, 0x56, 0x79, 0x47,
-	0xfe, 0x47, 0x82, 0x79, 0xd7, 0xaa, 0x49, 0xdb, 0x34, 0x08, 0x46, 0x5b, 0x10, 0xa4, 0x16, 0x5f,
-	0x73, 0x74, 0xe7, 0x96, 0x87, 0x92, 0x43, 0x4b, 0xb5, 0x11, 0x68, 0x0f, 0x66, 0xa8, 0x55, 0xee,
-	0x88, 0x38, 0x12, 0x0f, 0xb0, 0x88, 0x75, 0x57, 0x15, 0xd8, 0x4e, 0x0f, 0x04, 0x0a, 0xb0, 0x1a,
-	0xa5, 0xbd, 0x36, 0x41, 0xcf, 0x5d, 0xc5, 0x0c, 0xb2, 0x62, 0x6e, 0x8d, 0x2d, 0x26, 0x8f, 0x1e,
-	0xa9, 0xe6, 0x02, 0x4c, 0x52, 0x93, 0x6a, 0x4d, 0x51, 0x17, 0xde, 0x91, 0x31, 0xa0, 0x5c, 0xc7,
-	0xd4, 0x6a, 0x55, 0x8d, 0x50, 0x5b, 0x06, 0xdf, 0xe1, 0x65, 0x88, 0x50, 0xab, 0x5c, 0xe9, 0x52,
-	0x6c, 0xaf, 0x57, 0x4a, 0xcf, 0xa8, 0x61, 0x6a, 0xe5, 0xec, 0x2e, 0x7a, 0x00, 0xa1, 0x96, 0x59,
-	0xc3, 0x6c, 0x6b, 0x6f, 0xec, 0xa4, 0x3c, 0xca, 0xd0, 0xcb, 0xf7, 0xc2, 0xac, 0x61, 0x95, 0xa1,
-	0xe5, 0xaf, 0x61, 0xde, 0x45, 0x23, 0x4a, 0x5a, 0x84, 0xe8, 0x40, 0xa5, 0x18, 0xd5, 0x55, 0x0b,
-	0x05, 0xfd, 0x42, 0xc9, 0xaf, 0x61, 0xae, 0xa4, 0xb7, 0x8e, 0x9b, 0x1a, 0x75, 0xce, 0x12, 0xba,
-	0x07, 0x01, 0x6a, 0x89, 0x84, 0xde, 0x7b, 0xc5, 0x0a, 0x14, 0xa0, 0x96, 0x6b, 0xb1, 0x01, 0xd7,
-	0x62, 0xe5, 0x1f, 0x25, 0x88, 0xf5, 0x33, 0x0b, 0xd1, 0x9f, 0x42, 0xa4, 0xae, 0x91, 0xb2, 0x6e,
-	0xbc, 0x35, 0x05, 0xc1, 0x9a, 0xbf, 0xe2, 0x3d, 0x8d, 0xec, 0x1b, 0x6f, 0x4d, 0x35, 0x5c, 0xe7,
-	0x0d, 0xf4, 0x08, 0xa6, 0x3a, 0x98, 0x1c, 0x37, 0xa9, 0xb8, 0x1c, 0x29, 0xff, 0x58, 0x95, 0xe1,
-	0x54, 0x81, 0x97, 0x65, 0x98, 0x61, 0xc7, 0xd2, 0x59, 0x22, 0x82, 0x50, 0x43, 0x23, 0x0d, 0xa6,
-	0x61, 0x5a, 0x65, 0x6d, 0xf9, 0x0c, 0x66, 0x05, 0x46, 0x88, 0xdd, 0x18, 0x5b, 0x07, 0x56, 0x83,
-	0xa1, 0x8d, 0x08, 0x7c, 0xe0, 0x46, 0x58, 0xb0, 0xb8, 0x87, 0x69, 0xce, 0x36, 0x98, 0xd7, 0x3a,
-	0x6d, 0x1c, 0x5a, 0xc4, 0x11, 0xbb, 0x08, 0x53, 0x0d, 0xac, 0xd7, 0x1b, 0x94, 0x69, 0x09, 0xaa,
-	0xa2, 0x87, 0x9e, 0x7e, 0xb8, 0x5f, 0x0c, 0x9e, 0x6e, 0xf9, 0x5f, 0x09, 0x96, 0x46, 0xa8, 0xaf,
-	0x7b, 0x71, 0x1f, 0x40, 0x84, 0x99, 0x63, 0x59, 0xaf, 0x09, 0x29, 0xcb, 0xd9, 0xbe, 0x41, 0x66,
-	0xb9, 0x35, 0x32, 0x8a, 0xfd, 0x82, 0x1a, 0x66, 0xd0, 0xfd, 0x1a, 0xda, 0x86, 0x49, 0xd6, 0x14,
-	0x17, 0x74, 0xc9, 0x27, 0x44, 0xe5, 0x28, 0xb4, 0xe7, 0x5a, 0x71, 0xe8, 0x5a, 0x97, 0xda, 0xb5,
-	0xe4, 0x8f, 0x60, 0xee, 0xd0, 0x2a, 0xe0, 0xaa, 0x7d, 0xcb, 0xc6, 0xde, 0x5b, 0xf9, 0x31, 0xc4,
-	0xfa, 0xe8, 0x6b, 0x1d, 0x0e, 0xf9, 0x91, 0x4d, 0x54, 0x34, 0x06, 0x89, 0xae, 0x18, 0xb9, 0x6d,
-	0x93, 0x3a, 0x91, 0x82, 0xf4, 0x12, 0x8d, 0x0f, 0x61, 0xc1, 0x81, 0xef, 0xb6, 0x74, 0xc3, 0x74,
-	0xd8, 0xee, 0x00, 0x68, 0x76, 0xbf, 0xfc, 0x0d, 0x31, 0x0d, 0x71, 0xde, 0xa7, 0xd9, 0xc8, 0x33,
-	0x62, 0x1a, 0xf2, 0x13, 0xb8, 0x35, 0x14, 0x26, 0xa8, 0xd6, 0x60, 0x86, 0xc7, 0x55, 0x74, 0x43,
-	0xeb, 0x74, 0x05, 0x5d, 0x94, 0x8d, 0xe5, 0xd8, 0x90, 0xfc, 0xd8, 0xa6, 0xe4, 0x65, 0x71, 0x51,
-	0x5e, 0x21, 0xf4, 0x13, 0x9b, 0xd6, 0x15, 0x2a, 0x68, 0x2f, 0x97, 0x9b, 0xf9, 0x1c, 0xc2, 0xe2,
-	0x9b, 0x85, 0xe2, 0xb0, 0x70, 0xa0, 0x16, 0x8a, 0x6a, 0x39, 0xf7, 0xa6, 0xfc, 0xe5, 0xcb, 0xd2,
-	0xab, 0x62, 0x7e, 0xff, 0xe9, 0x7e, 0xb1, 0x10, 0x9b, 0x40, 0x31, 0x98, 0xe9, 0xcd, 0xec, 0x96,
-	0xf2, 0x31, 0x09, 0xdd, 0x84, 0xd9, 0xde, 0x48, 0xa1, 0x58, 0xca, 0xc7, 0x02, 0x99, 0xef, 0x25,
-	0x98, 0x75, 0xb9, 0x2d, 0x4a, 0x42, 0x22, 0xa7, 0x1e, 0xec, 0x16, 0xf2, 0xbb, 0xa5, 0xc3, 0xf2,
-	0x8b, 0x83, 0x42, 0x71, 0x28, 0xed, 0x0a, 0x2c, 0x0c, 0xcd, 0xe7, 0xbe, 0x38, 0xc8, 0x3f, 0x8f,
-	0x49, 0x89, 0x40, 0x44, 0x42, 0x4b, 0x30, 0x3f, 0x34, 0x5b, 0x7a, 0xf3, 0x32, 0x1f, 0x0b, 0xd8,
-	0x3a, 0x87, 0x26, 0x76, 0xd9, 0x4c, 0x70, 0xe7, 0xb7, 0x69, 0x08, 0x97, 0xf8, 0x73, 0x08, 0x9d,
-	0x42, 0xc4, 0x31, 0x4b, 0x24, 0x7b, 0x1c, 0x8a, 0x21, 0x8f, 0x4e, 0xdc, 0xbd, 0x14, 0x23, 0x2c,
-	0x65, 0xf3, 0x87, 0x3f, 0xfe, 0xfe, 0x25, 0x90, 0x7a, 0x22, 0x65, 0xe4, 0xdb, 0x8a, 0xc7, 0x53,
-	0xcc, 0x21, 0x3c, 0x82, 0x49, 0xe6, 0x7c, 0x68, 0xd5, 0x23, 0xeb, 0xa0, 0x6f, 0x26, 0x52, 0xfe,
-	0x00, 0xc1, 0xb9, 0xc1, 0x38, 0x57, 0xd1, 0x1d, 0xc5, 0xeb, 0x11, 0x46, 0x94, 0x53, 0xdb, 0x6b,
-	0xcf, 0xd0, 0x77, 0x10, 0x1d, 0xf8, 0xa8, 0xa1, 0x8d, 0xcb, 0xbe, 0x85, 0x7d, 0xfa, 0xcd, 0x71,
-	0x30, 0x21, 0x62, 0x8d, 0x89, 0xb8, 0x6d, 0x2f, 0x7c, 0xd1, 0x5b, 0x07, 0xfa, 0x16, 0xa2, 0x03,
-	0x0f, 0x15, 0x4f, 0x01, 0xa3, 0xcf, 0x37, 0x4f, 0x01, 0x1e, 0xef, 0x1d, 0x39, 0xc9, 0x04, 0xc4,
-	0x91, 0x1f, 0xfb, 0xaf, 0x12, 0xcc, 0x0d, 0x59, 0x2e, 0xba, 0xe7, 0x9d, 0xdb, 0xe3, 0x8b, 0x90,
-	0xc8, 0x5c, 0x05, 0x2a, 0xa4, 0x6c, 0x33, 0x29, 0x5b, 0x68, 0xc3, 0x67, 0x43, 0x98, 0xb3, 0x2a,
-	0xa7, 0xfc, 0x9b, 0x72, 0x86, 0xba, 0x10, 0x71, 0x6e, 0xa6, 0xe7, 0x41, 0x1c, 0xb2, 0x4d, 0xcf,
-	0x83, 0x38, 0x6c, 0x96, 0xf2, 0x3a, 0xd3, 0x90, 0xb4, 0xf7, 0x63, 0xd9, 0x43, 0x46, 0x8d, 0xd3,
-	0x31, 0x6a, 0xee, 0x45, 0x3e, 0xd4, 0x2e, 0x23, 0xf5, 0xa1, 0x76, 0x5b, 0xe6, 0x38, 0x6a, 0xcc,
-	0xe9, 0x7e, 0x92, 0x60, 0xd6, 0xe5, 0x83, 0x68, 0xeb, 0x92, 0xe4, 0x83, 0x6e, 0x97, 0x48, 0x8f,
-	0x07, 0x0a, 0x29, 0x19, 0x26, 0x65, 0xdd, 0x96, 0xb2, 0xea, 0x2b, 0x45, 0x61, 0x66, 0x27, 0x04,
-	0x0d, 0x38, 0xa4, 0x8f, 0xa0, 0x51, 0xfb, 0xf5, 0x11, 0xe4, 0x61, 0xb6, 0xe3, 0x04, 0xf1, 0x6d,
-	0xe1, 0x82, 0x72, 0x9f, 0xfd, 0x7e, 0x9e, 0x94, 0xde, 0x9f, 0x27, 0xa5, 0xbf, 0xce, 0x93, 0xd2,
-	0xcf, 0x17, 0xc9, 0x89, 0xf7, 0x17, 0xc9, 0x89, 0x3f, 0x2f, 0x92, 0x13, 0x5f, 0x6d, 0xd4, 0x75,
-	0xda, 0x38, 0xae, 0x64, 0xab, 0x66, 0xcb, 0x49, 0xc2, 0xff, 0x6d, 0x93, 0xda, 0x3b, 0xe7, 0x97,
-	0x91, 0x55, 0x99, 0x62, 0xbf, 0x8b, 0x3e, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa8, 0xd7,
-	0x97, 0x14, 0x0e, 0x00, 0x00,
+	// 1351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x3a, 0x4e, 0xec, 0x3c, 0x27, 0x60, 0x26, 0x01, 0xcc, 0x02, 0x8e, 0x59, 0x48, 0x62,
+	0xa2, 0xc6, 0x2b, 0x52, 0x40, 0x80, 0x2a, 0x55, 0x71, 0x6c, 0xd2, 0xf0, 0x2f, 0x68, 0xed, 0x0a,
+	0x51, 0x55, 0xb2, 0xd6, 0xde, 0xc1, 0xde, 0x12, 0xcf, 0x04, 0xcf, 0x18, 0xad, 0x45, 0x51, 0xab,
+	0x1e, 0x7a, 0xe8, 0xa1, 0xaa, 0xd4, 0x43, 0xd5, 0xcf, 0xd1, 0x2f, 0xd1, 0x23, 0x52, 0x2f, 0x3d,
+	0x56, 0xa4, 0xa7, 0x9e, 0x2a, 0xf5, 0x0b, 0x54, 0x3b, 0x3b, 0x6b, 0xef, 0x3a, 0x6b, 0x3b, 0xe1,
+	0x92, 0xcc, 0x9f, 0xdf, 0x7b, 0xbf, 0xdf, 0x7b, 0x33, 0xf3, 0xde, 0x1a, 0x96, 0x1b, 0x94, 0xb5,
+	0x29, 0xd3, 0xb9, 0xa3, 0xbf, 0xbe, 0x51, 0xc7, 0xdc, 0xbc, 0xa1, 0x33, 0xdc, 0x79, 0x6d, 0x37,
+	0x70, 0xe1, 0xa0, 0x43, 0x39, 0x45, 0x67, 0x3c, 0x40, 0x81, 0x3b, 0x05, 0x09, 0x50, 0x2f, 0x35,
+	0x29, 0x6d, 0xee, 0x63, 0xdd, 0x3c, 0xb0, 0x75, 0x93, 0x10, 0xca, 0x4d, 0x6e, 0x53, 0xc2, 0x3c,
+	0x03, 0xf5, 0xaa, 0xf4, 0x58, 0x37, 0x19, 0xd6, 0xcd, 0x7a, 0xc3, 0xee, 0x3b, 0x76, 0x27, 0x12,
+	0xa4, 0x1e, 0xa5, 0xe5, 0x8e, 0xdc, 0x5b, 0x0f, 0x3a, 0x78, 0xd5, 0xc5, 0x9d, 0x5e, 0x1f, 0x73,
+	0x60, 0x36, 0x6d, 0x22, 0xd8, 0x24, 0xf6, 0x12, 0xc7, 0xc4, 0xc2, 0x9d, 0xb6, 0x4d, 0xb8, 0xce,
+	0x7b, 0x07, 0x98, 0xe9, 0xf5, 0x7d, 0xda, 0x78, 0x39, 0x72, 0x57, 0xfc, 0xf5, 0x76, 0xb5, 0xff,
+	0x14, 0x40, 0x3b, 0x98, 0x57, 0x1d, 0x56, 0x7e, 0x8d, 0x09, 0x37, 0xf0, 0xab, 0x2e, 0x66, 0x1c,
+	0xa9, 0x30, 0x8b, 0xdd, 0x39, 0xcb, 0x28, 0xb9, 0xe9, 0xfc, 0x5c, 0x31, 0x96, 0x51, 0x0c, 0xb9,
+	0x82, 0x1e, 0x00, 0x0c, 0x24, 0x64, 0x62, 0x39, 0x25, 0x9f, 0xda, 0x5c, 0x2d, 0xc8, 0x0c, 0xb9,
+	0x7a, 0x0b, 0x42, 0xaf, 0x9f, 0xa9, 0xc2, 0x53, 0xb3, 0x89, 0xa5, 0x5f, 0xe1, 0x27, 0x60, 0x8d,
+	0x6e, 0x41, 0x92, 0x76, 0x2c, 0xdc, 0xa9, 0xd5, 0x7b, 0x99, 0xe9, 0x9c, 0x92, 0x3f, 0xb5, 0xa9,
+	0x16, 0x8e, 0xe4, 0xba, 0xb0, 0xe7, 0x42, 0x8a, 0x3d, 0x23, 0x41, 0xbd, 0x01, 0x42, 0x10, 0x3f,
+	0x30, 0x9b, 0x38, 0x13, 0xcf, 0x29, 0xf9, 0xb8, 0x21, 0xc6, 0x68, 0x09, 0x66, 0xf6, 0xed, 0xb6,
+	0xcd, 0x33, 0x33, 0x62, 0xd1, 0x9b, 0xb8, 0xab, 0x42, 0x4d, 0x66, 0x36, 0xa7, 0xe4, 0xe7, 0x0c,
+	0x6f, 0xa2, 0xfd, 0xa3, 0xc0, 0x62, 0x28, 0x6a, 0x76, 0x40, 0x09, 0xc3, 0x68, 0x0d, 0xa6, 0xb9,
+	0xe3, 0xc5, 0x9c, 0xda, 0x3c, 0x1b, 0xa1, 0xa4, 0xea, 0x18, 0x2e, 0x02, 0xed, 0xc0, 0x3c, 0x77,
+	0x6a, 0x1d, 0x69, 0xc7, 0x32, 0x31, 0x61, 0x71, 0x2d, 0x94, 0x05, 0x71, 0xd2, 0x01, 0x43, 0x09,
+	0x36, 0x52, 0xbc, 0x3f, 0x66, 0xe8, 0x61, 0x28, 0x99, 0xd3, 0x22, 0x99, 0x6b, 0x13, 0x93, 0xe9,
+	0x59, 0x1f, 0xc9, 0xe6, 0x12, 0xcc, 0x70, 0xca, 0xcd, 0x7d, 0x99, 0x17, 0x6f, 0xa2, 0x61, 0x40,
+	0xc5, 0x0e, 0x35, 0xad, 0x86, 0xc9, 0x78, 0xd5, 0x91, 0x27, 0x81, 0x2e, 0x40, 0x92, 0x3b, 0xb5,
+	0x7a, 0x8f, 0x63, 0x37, 0x5e, 0x25, 0x3f, 0x6f, 0x24, 0xb8, 0x53, 0x74, 0xa7, 0xe8, 0x26, 0xc4,
+	0xdb, 0xd4, 0xc2, 0xe2, 0x68, 0x4f, 0x6d, 0xe6, 0x22, 0xd2, 0xd0, 0xf7, 0xf7, 0x98, 0x5a, 0xd8,
+	0x10, 0x68, 0xed, 0x4b, 0x58, 0x0c, 0xd1, 0xc8, 0x94, 0x96, 0x21, 0x15, 0xc8, 0x94, 0xa0, 0x3a,
+	0x6e, 0xa2, 0x60, 0x90, 0x28, 0xed, 0x19, 0x9c, 0xae, 0xd8, 0xed, 0xee, 0xbe, 0xc9, 0xfd, 0xbb,
+	0x84, 0xae, 0x43, 0x8c, 0x3b, 0xd2, 0x61, 0xf4, 0x59, 0x89, 0x04, 0xc5, 0xb8, 0x13, 0x0a, 0x36,
+	0x16, 0x0a, 0x56, 0xfb, 0x41, 0x81, 0xf4, 0xc0, 0xb3, 0x14, 0xfd, 0x09, 0x24, 0x9b, 0x26, 0xab,
+	0xd9, 0xe4, 0x05, 0x95, 0x04, 0x57, 0x46, 0x2b, 0xde, 0x31, 0xd9, 0x2e, 0x79, 0x41, 0x8d, 0x44,
+	0xd3, 0x1b, 0xa0, 0x3b, 0x30, 0xdb, 0xc1, 0xac, 0xbb, 0xcf, 0xe5, 0xe3, 0xc8, 0x8d, 0xb6, 0x35,
+	0x04, 0xce, 0x90, 0x78, 0x4d, 0x83, 0x79, 0x71, 0x2d, 0xfd, 0x10, 0x11, 0xc4, 0x5b, 0x26, 0x6b,
+	0x09, 0x0d, 0x73, 0x86, 0x18, 0x6b, 0x6f, 0x61, 0x41, 0x62, 0xa4, 0xd8, 0x95, 0x89, 0x79, 0x10,
+	0x39, 0x18, 0x3a, 0x88, 0xd8, 0x07, 0x1e, 0x84, 0x03, 0xe7, 0x76, 0x30, 0x2f, 0xba, 0x05, 0xe6,
+	0x99, 0xcd, 0x5b, 0x55, 0x87, 0xf9, 0x62, 0xcf, 0xc1, 0x6c, 0x0b, 0xdb, 0xcd, 0x16, 0x17, 0x5a,
+	0xa6, 0x0d, 0x39, 0x43, 0xf7, 0x3f, 0xbc, 0x5e, 0x04, 0x6f, 0xb7, 0xf6, 0xaf, 0x02, 0xe7, 0x8f,
+	0x50, 0x9f, 0xf4, 0xe1, 0xde, 0x84, 0xa4, 0x28, 0x8e, 0x35, 0xdb, 0x92, 0x52, 0x2e, 0x14, 0x06,
+	0x05, 0xb2, 0xe0, 0x95, 0x46, 0x41, 0xb1, 0x5b, 0x32, 0x12, 0x02, 0xba, 0x6b, 0xa1, 0x0d, 0x98,
+	0x11, 0x43, 0xf9, 0x40, 0xcf, 0x8f, 0x30, 0x31, 0x3c, 0x14, 0xda, 0x09, 0x45, 0x1c, 0x3f, 0xd1,
+	0xa3, 0x0e, 0x85, 0xfc, 0x11, 0x9c, 0xae, 0x3a, 0x25, 0xdc, 0xa0, 0x96, 0x9f, 0x91, 0x31, 0xef,
+	0x56, 0xbb, 0x0b, 0xe9, 0x01, 0xfa, 0x44, 0x97, 0x43, 0xbb, 0xe3, 0x12, 0x95, 0x49, 0x90, 0xe8,
+	0x98, 0x96, 0x1b, 0x90, 0x1e, 0x58, 0x4a, 0xd2, 0x31, 0x1a, 0x6f, 0xc1, 0x92, 0x0f, 0xdf, 0x6a,
+	0xdb, 0x84, 0xfa, 0x6c, 0x97, 0x01, 0x4c, 0x77, 0x5e, 0xfb, 0x8a, 0x51, 0x22, 0xef, 0xfb, 0x9c,
+	0x58, 0x79, 0xc0, 0x28, 0xd1, 0xee, 0xc1, 0xd9, 0x21, 0x33, 0x49, 0x75, 0x05, 0xe6, 0x3d, 0xbb,
+	0xba, 0x4d, 0xcc, 0x4e, 0x4f, 0xd2, 0xa5, 0xc4, 0x5a, 0x51, 0x2c, 0x69, 0x77, 0x61, 0xc9, 0x4f,
+	0x4b, 0x88, 0xf2, 0x18, 0xa6, 0xb7, 0xe1, 0xec, 0x90, 0xa9, 0xa4, 0x9d, 0x20, 0xf7, 0x57, 0x05,
+	0x50, 0xd5, 0xa9, 0xd8, 0x4d, 0x22, 0xa2, 0x3e, 0x59, 0x4a, 0xdd, 0xf4, 0x35, 0x5a, 0xa6, 0x4d,
+	0xfc, 0x3b, 0x3a, 0x67, 0x24, 0xc4, 0x7c, 0xd7, 0x42, 0x2b, 0x70, 0xca, 0x6c, 0x34, 0x68, 0x97,
+	0xf0, 0x1a, 0xe9, 0xb6, 0xeb, 0xb8, 0x23, 0x6e, 0x64, 0xdc, 0x58, 0x90, 0xab, 0x4f, 0xc4, 0x22,
+	0x52, 0x21, 0xc9, 0x5c, 0x4e, 0xd2, 0xf0, 0x7b, 0x64, 0x7f, 0xae, 0x3d, 0x82, 0xc5, 0x90, 0xb4,
+	0x41, 0x44, 0xcc, 0x6e, 0x92, 0xd0, 0xa9, 0xcd, 0x31, 0x1f, 0xe6, 0x3e, 0x6e, 0xd6, 0x32, 0x37,
+	0x6f, 0xdd, 0x96, 0xf5, 0x53, 0xce, 0xd6, 0x3f, 0x83, 0x84, 0xec, 0xce, 0x28, 0x03, 0x4b, 0x7b,
+	0x46, 0xa9, 0x6c, 0xd4, 0x8a, 0xcf, 0x6b, 0x9f, 0x3f, 0xa9, 0x3c, 0x2d, 0x6f, 0xef, 0xde, 0xdf,
+	0x2d, 0x97, 0xd2, 0x53, 0x28, 0x0d, 0xf3, 0xfd, 0x9d, 0xad, 0xca, 0x76, 0x5a, 0x41, 0x67, 0x60,
+	0xa1, 0xbf, 0x52, 0x2a, 0x57, 0xb6, 0xd3, 0xb1, 0xf5, 0x6f, 0x15, 0x58, 0x08, 0xf5, 0x15, 0x94,
+	0x05, 0xb5, 0x68, 0xec, 0x6d, 0x95, 0xb6, 0xb7, 0x2a, 0xd5, 0xda, 0xe3, 0xbd, 0x52, 0x79, 0xc8,
+	0xed, 0x25, 0x58, 0x1a, 0xda, 0x2f, 0x3e, 0xda, 0xdb, 0x7e, 0x98, 0x56, 0xd4, 0x58, 0x52, 0x41,
+	0xe7, 0x61, 0x71, 0x68, 0xb7, 0xf2, 0xfc, 0xc9, 0x76, 0x3a, 0xe6, 0xea, 0x1c, 0xda, 0xd8, 0x12,
+	0x3b, 0xd3, 0x9b, 0xbf, 0x01, 0x24, 0x2a, 0xde, 0x87, 0x1f, 0x7a, 0x03, 0x49, 0xbf, 0x2d, 0x20,
+	0x2d, 0xe2, 0xac, 0x86, 0xba, 0x91, 0x7a, 0x75, 0x2c, 0x46, 0x16, 0xcf, 0xd5, 0xef, 0xfe, 0xf8,
+	0xfb, 0xe7, 0x58, 0xee, 0x9e, 0xb2, 0xae, 0x5d, 0xd4, 0x23, 0x3e, 0x3a, 0x7d, 0xc2, 0x57, 0x30,
+	0x23, 0x6a, 0x3c, 0x5a, 0x8e, 0xf0, 0x1a, 0xec, 0x10, 0x6a, 0x6e, 0x34, 0x40, 0x72, 0xae, 0x08,
+	0xce, 0x65, 0x74, 0x59, 0x8f, 0xfa, 0xdc, 0x64, 0xfa, 0x1b, 0xb7, 0xab, 0xbc, 0x45, 0xdf, 0x40,
+	0x2a, 0xd0, 0xbe, 0xd1, 0xca, 0xb8, 0xae, 0x3f, 0xa0, 0x5f, 0x9d, 0x04, 0x93, 0x22, 0xae, 0x08,
+	0x11, 0x17, 0xdd, 0xc0, 0xcf, 0x45, 0xeb, 0x40, 0x5f, 0x43, 0x2a, 0xf0, 0x49, 0x16, 0x29, 0xe0,
+	0xe8, 0x87, 0xaa, 0xba, 0x3a, 0x09, 0x26, 0x05, 0x64, 0x85, 0x80, 0x0c, 0x1a, 0xc5, 0xfe, 0x8b,
+	0x02, 0xa7, 0x87, 0x9a, 0x0b, 0xba, 0x1e, 0xed, 0x3b, 0xa2, 0xf7, 0xa9, 0xeb, 0xc7, 0x81, 0x4a,
+	0x29, 0x1b, 0x42, 0xca, 0x1a, 0x5a, 0x19, 0x71, 0x20, 0xa2, 0x87, 0xe8, 0x6f, 0xbc, 0xee, 0xf9,
+	0x16, 0xf5, 0x20, 0xe9, 0xd7, 0xa0, 0xc8, 0x8b, 0x38, 0xd4, 0x20, 0xd4, 0xab, 0x63, 0x31, 0x52,
+	0xc3, 0x35, 0xa1, 0x21, 0xeb, 0x9e, 0xc7, 0x85, 0x08, 0x19, 0x96, 0x47, 0x27, 0xa8, 0xcb, 0x64,
+	0x0c, 0x75, 0x99, 0x4c, 0xa6, 0x2e, 0x93, 0x93, 0x50, 0x63, 0x8f, 0xee, 0x47, 0x05, 0x16, 0x42,
+	0x15, 0x1f, 0xad, 0x8d, 0x71, 0x1e, 0xac, 0xeb, 0x6a, 0x7e, 0x32, 0x50, 0x4a, 0x59, 0x17, 0x52,
+	0xae, 0xb9, 0x52, 0x96, 0x47, 0x4a, 0xd1, 0x45, 0x59, 0x97, 0x82, 0x4a, 0x78, 0x92, 0xa0, 0x12,
+	0x3e, 0xa6, 0xa0, 0x12, 0x3e, 0xb1, 0x20, 0x0b, 0x07, 0x04, 0x7d, 0xaf, 0x40, 0x2a, 0x50, 0xc8,
+	0x23, 0x1f, 0xcc, 0xd1, 0x1e, 0xa4, 0xae, 0x4e, 0x82, 0x49, 0x29, 0x79, 0x21, 0x45, 0x73, 0xa5,
+	0x5c, 0x8e, 0x2c, 0x55, 0x7e, 0xaf, 0x28, 0x7e, 0xfa, 0xfb, 0xfb, 0xac, 0xf2, 0xee, 0x7d, 0x56,
+	0xf9, 0xeb, 0x7d, 0x56, 0xf9, 0xe9, 0x30, 0x3b, 0xf5, 0xee, 0x30, 0x3b, 0xf5, 0xe7, 0x61, 0x76,
+	0xea, 0x8b, 0x95, 0xa6, 0xcd, 0x5b, 0xdd, 0x7a, 0xa1, 0x41, 0xdb, 0xbe, 0x0b, 0xef, 0xdf, 0x06,
+	0xb3, 0x5e, 0xfa, 0x3f, 0x46, 0x9d, 0xfa, 0xac, 0xf8, 0x29, 0xfa, 0xf1, 0xff, 0x03, 0x00, 0x3b,
+	0xa0, 0x2b, 0x06, 0x87, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1268,6 +1411,11 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// TxSignBytes returns the canonical SIGN_MODE_LEGACY_AMINO_JSON sign bytes of a
+	// transaction, the bytes hardware wallets sign, along with their SHA256 hash.
+	//
+	// Since: cosmos-sdk 0.51
+	TxSignBytes(ctx context.Context, in *TxSignBytesRequest, opts ...grpc.CallOption) (*TxSignBytesResponse, error)
 }
 
 type serviceClient struct {
@@ -1359,6 +1507,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) TxSignBytes(ctx context.Context, in *TxSignBytesRequest, opts ...grpc.CallOption) (*TxSignBytesResponse, error) {
+	out := new(TxSignBytesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/TxSignBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -1389,6 +1546,11 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// TxSignBytes returns the canonical SIGN_MODE_LEGACY_AMINO_JSON sign bytes of a
+	// transaction, the bytes hardware wallets sign, along with their SHA256 hash.
+	//
+	// Since: cosmos-sdk 0.51
+	TxSignBytes(context.Context, *TxSignBytesRequest) (*TxSignBytesResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1422,6 +1584,9 @@ func (*UnimplementedServiceServer) TxEncodeAmino(ctx context.Context, req *TxEnc
 func (*UnimplementedServiceServer) TxDecodeAmino(ctx context.Context, req *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (*UnimplementedServiceServer) TxSignBytes(ctx context.Context, req *TxSignBytesRequest) (*TxSignBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxSignBytes not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1589,6 +1754,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_TxSignBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxSignBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TxSignBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/TxSignBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TxSignBytes(ctx, req.(*TxSignBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1629,6 +1812,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "TxSignBytes",
+			Handler:    _Service_TxSignBytes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -2367,6 +2554,95 @@ func (m *TxDecodeAminoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxSignBytesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSignBytesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSignBytesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSignBytesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSignBytesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSignBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -2678,6 +2954,46 @@ func (m *TxDecodeAminoResponse) Size() (n int) {
 	return n
 }
 
+func (m *TxSignBytesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovService(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovService(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *TxSignBytesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4668,6 +4984,280 @@ func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxSignBytesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSignBytesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSignBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSignBytesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSignBytesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSignBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = append(m.Sha256[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha256 == nil {
+				m.Sha256 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
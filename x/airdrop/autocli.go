@@ -0,0 +1,53 @@
+package airdrop
+
+import (
+	"fmt"
+
+	airdropv1 "cosmossdk.io/api/cosmos/airdrop/v1"
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: airdropv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current airdrop parameters",
+				},
+				{
+					RpcMethod:      "ClaimRecord",
+					Use:            "claim-record [address]",
+					Short:          "Query the allocation of an address, the actions it completed and the amount it can claim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: airdropv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Claim",
+					Use:       "claim --from [claimant]",
+					Short:     "Claim the unlocked part of an airdrop allocation",
+					Long:      "Claim the unlocked part of an airdrop allocation. The first claim of an allocation which is not in the genesis state must provide the allocated amount and its merkle proof.",
+					Example:   fmt.Sprintf("%s tx airdrop claim --amount 1000stake --proof <base64-node> --proof <base64-node> --from mykey", version.AppName),
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update airdrop module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update airdrop module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query airdrop params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx airdrop update-params-proposal '{ "claim_deadline": "2025-01-01T00:00:00Z", "actions": ["ACTION_VOTE", "ACTION_DELEGATE"] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
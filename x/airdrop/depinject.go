@@ -0,0 +1,70 @@
+package airdrop
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/airdrop/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/x/airdrop/keeper"
+	"cosmossdk.io/x/airdrop/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	govtypes "cosmossdk.io/x/gov/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(
+		&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config      *modulev1.Module
+	Environment appmodule.Environment
+	Cdc         codec.Codec
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	AirdropKeeper keeper.Keeper
+	Module        appmodule.AppModule
+	StakingHooks  stakingtypes.StakingHooksWrapper
+	GovHooks      govtypes.GovHooksWrapper
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	as, err := in.AccountKeeper.AddressCodec().BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.AccountKeeper, in.BankKeeper, as)
+	m := NewAppModule(in.Cdc, k)
+
+	return ModuleOutputs{
+		AirdropKeeper: k,
+		Module:        m,
+		StakingHooks:  stakingtypes.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		GovHooks:      govtypes.GovHooksWrapper{GovHooks: k.Hooks()},
+	}
+}
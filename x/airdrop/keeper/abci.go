@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/airdrop/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker claws back the unclaimed funds once the claim deadline passed.
+// They are sent to the clawback address, or burned if there is none.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.DeadlinePassed(k.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return nil
+	}
+
+	clawedBack, err := k.isClawedBack(ctx)
+	if err != nil || clawedBack {
+		return err
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	unclaimed := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	if err := k.clawback(ctx, params.ClawbackAddress, moduleAddr, unclaimed); err != nil {
+		return err
+	}
+
+	if err := k.ClawedBack.Set(ctx, true); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeClawback,
+		event.NewAttribute(types.AttributeKeyRecipient, params.ClawbackAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, unclaimed.String()),
+	)
+}
+
+// clawback sends the unclaimed funds to the clawback address, or burns them if
+// the clawback address is empty.
+func (k Keeper) clawback(ctx context.Context, clawbackAddress string, moduleAddr sdk.AccAddress, unclaimed sdk.Coins) error {
+	if unclaimed.IsZero() {
+		return nil
+	}
+
+	if clawbackAddress == "" {
+		return k.bankKeeper.BurnCoins(ctx, moduleAddr, unclaimed)
+	}
+
+	recipient, err := k.addressCodec.StringToBytes(clawbackAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, unclaimed)
+}
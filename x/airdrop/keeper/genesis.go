@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/airdrop/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the airdrop module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, record := range data.ClaimRecords {
+		addr, err := k.addressCodec.StringToBytes(record.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.ClaimRecords.Set(ctx, addr, record); err != nil {
+			return err
+		}
+	}
+
+	for _, completed := range data.CompletedActions {
+		addr, err := k.addressCodec.StringToBytes(completed.Address)
+		if err != nil {
+			return err
+		}
+		for _, action := range completed.Actions {
+			if err := k.CompletedActions.Set(ctx, collections.Join(sdk.AccAddress(addr), int32(action))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return k.ClawedBack.Set(ctx, data.ClawedBack)
+}
+
+// ExportGenesis returns the airdrop module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []types.ClaimRecord
+	err = k.ClaimRecords.Walk(ctx, nil, func(_ sdk.AccAddress, record types.ClaimRecord) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var completed []types.CompletedActions
+	err = k.CompletedActions.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, int32]) (bool, error) {
+		addr, err := k.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		if n := len(completed); n > 0 && completed[n-1].Address == addr {
+			completed[n-1].Actions = append(completed[n-1].Actions, types.Action(key.K2()))
+		} else {
+			completed = append(completed, types.CompletedActions{Address: addr, Actions: []types.Action{types.Action(key.K2())}})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clawedBack, err := k.isClawedBack(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, records, completed, clawedBack), nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/airdrop/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/airdrop QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the airdrop module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ClaimRecord returns the allocation of an address, the actions it completed
+// and the amount it can claim.
+func (q queryServer) ClaimRecord(ctx context.Context, req *types.QueryClaimRecordRequest) (*types.QueryClaimRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	completed, err := q.k.GetCompletedActions(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryClaimRecordResponse{CompletedActions: completed, Claimable: sdk.NewCoins()}
+	record, err := q.k.ClaimRecords.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return res, nil
+		}
+		return nil, err
+	}
+	res.ClaimRecord = &record
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := q.k.isClaimPeriod(ctx, params)
+	if err != nil || !ok {
+		return res, err
+	}
+
+	res.Claimable, err = q.k.Claimable(ctx, params, addr, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/airdrop/types"
+	govtypes "cosmossdk.io/x/gov/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ govtypes.GovHooks         = Hooks{}
+	_ stakingtypes.StakingHooks = Hooks{}
+)
+
+// Hooks wrapper struct for airdrop keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the airdrop hooks, recording the governance and staking actions
+// which unlock the allocations.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// completeAction records that addr completed the given action, if the action
+// unlocks a part of the allocations and the address may have an allocation.
+func (k Keeper) completeAction(ctx context.Context, addr sdk.AccAddress, action types.Action) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.HasAction(action) {
+		return nil
+	}
+
+	ok, err := k.isClaimPeriod(ctx, params)
+	if err != nil || !ok {
+		return err
+	}
+
+	// without a merkle root, only the addresses of the genesis state have an
+	// allocation
+	if !params.HasMerkleRoot() {
+		has, err := k.ClaimRecords.Has(ctx, addr)
+		if err != nil || !has {
+			return err
+		}
+	}
+
+	key := collections.Join(addr, int32(action))
+	if has, err := k.CompletedActions.Has(ctx, key); err != nil || has {
+		return err
+	}
+	if err := k.CompletedActions.Set(ctx, key); err != nil {
+		return err
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCompleteAction,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyAction, action.String()),
+	)
+}
+
+// AfterProposalVote records the vote action of the voter.
+func (h Hooks) AfterProposalVote(ctx context.Context, _ uint64, voterAddr sdk.AccAddress) error {
+	return h.k.completeAction(ctx, voterAddr, types.ActionVote)
+}
+
+// AfterDelegationModified records the delegate action of the delegator.
+func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.k.completeAction(ctx, delAddr, types.ActionDelegate)
+}
+
+func (Hooks) AfterProposalSubmission(context.Context, uint64) error { return nil }
+
+func (Hooks) AfterProposalDeposit(context.Context, uint64, sdk.AccAddress) error { return nil }
+
+func (Hooks) AfterProposalFailedMinDeposit(context.Context, uint64) error { return nil }
+
+func (Hooks) AfterProposalVotingPeriodEnded(context.Context, uint64) error { return nil }
+
+func (Hooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error { return nil }
+
+func (Hooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+
+func (Hooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (Hooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (Hooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (Hooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (Hooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (Hooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (Hooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, math.LegacyDec) error {
+	return nil
+}
+
+func (Hooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
+
+func (Hooks) AfterConsensusPubKeyUpdate(context.Context, cryptotypes.PubKey, cryptotypes.PubKey, sdk.Coin) error {
+	return nil
+}
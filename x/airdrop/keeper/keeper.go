@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/airdrop/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the airdrop store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	environment   appmodule.Environment
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	addressCodec  address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// ClaimRecords contains the allocations of the addresses
+	ClaimRecords collections.Map[sdk.AccAddress, types.ClaimRecord]
+	// CompletedActions contains the actions completed by the addresses
+	CompletedActions collections.KeySet[collections.Pair[sdk.AccAddress, int32]]
+	// ClawedBack defines whether the unclaimed funds were clawed back
+	ClawedBack collections.Item[bool]
+}
+
+// NewKeeper creates a new airdrop Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:              cdc,
+		environment:      env,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		addressCodec:     ak.AddressCodec(),
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ClaimRecords:     collections.NewMap(sb, types.ClaimRecordsPrefix, "claim_records", sdk.AccAddressKey, codec.CollValue[types.ClaimRecord](cdc)),
+		CompletedActions: collections.NewKeySet(sb, types.CompletedActionsPrefix, "completed_actions", collections.PairKeyCodec(sdk.AccAddressKey, collections.Int32Key)),
+		ClawedBack:       collections.NewItem(sb, types.ClawedBackKey, "clawed_back", collections.BoolValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/airdrop module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetCompletedActions returns the actions completed by an address.
+func (k Keeper) GetCompletedActions(ctx context.Context, addr sdk.AccAddress) ([]types.Action, error) {
+	var actions []types.Action
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, int32](addr)
+	err := k.CompletedActions.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, int32]) (bool, error) {
+		actions = append(actions, types.Action(key.K2()))
+		return false, nil
+	})
+
+	return actions, err
+}
+
+// Claimable returns the part of the allocation of an address which it can
+// claim, given the actions it completed.
+func (k Keeper) Claimable(ctx context.Context, params types.Params, addr sdk.AccAddress, record types.ClaimRecord) (sdk.Coins, error) {
+	completed := 0
+	for _, action := range params.Actions {
+		has, err := k.CompletedActions.Has(ctx, collections.Join(addr, int32(action)))
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			completed++
+		}
+	}
+
+	return record.Claimable(completed, len(params.Actions)), nil
+}
+
+// isClaimPeriod returns true if allocations can still be claimed.
+func (k Keeper) isClaimPeriod(ctx context.Context, params types.Params) (bool, error) {
+	if params.DeadlinePassed(k.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return false, nil
+	}
+
+	clawedBack, err := k.isClawedBack(ctx)
+	return !clawedBack, err
+}
+
+// isClawedBack returns true if the unclaimed funds were clawed back.
+func (k Keeper) isClawedBack(ctx context.Context) (bool, error) {
+	clawedBack, err := k.ClawedBack.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+
+	return clawedBack, err
+}
@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/airdrop"
+	"cosmossdk.io/x/airdrop/keeper"
+	airdroptestutil "cosmossdk.io/x/airdrop/testutil"
+	"cosmossdk.io/x/airdrop/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *airdroptestutil.MockBankKeeper
+	now         time.Time
+
+	moduleAddr  sdk.AccAddress
+	claimant    sdk.AccAddress
+	claimantStr string
+	other       sdk.AccAddress
+	otherStr    string
+	allocation  sdk.Coins
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, airdrop.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.now})
+
+	ac := address.NewBech32Codec("cosmos")
+	s.moduleAddr = sdk.AccAddress(types.ModuleName)
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := airdroptestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(s.moduleAddr).AnyTimes()
+	s.bankKeeper = airdroptestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	var err error
+	s.claimant = sdk.AccAddress("claimant")
+	s.claimantStr, err = ac.BytesToString(s.claimant)
+	s.Require().NoError(err)
+	s.other = sdk.AccAddress("other")
+	s.otherStr, err = ac.BytesToString(s.other)
+	s.Require().NoError(err)
+	s.allocation = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	s.initGenesis(types.Params{ClaimDeadline: s.now.Add(24 * time.Hour)})
+}
+
+func (s *KeeperTestSuite) initGenesis(params types.Params) {
+	gs := types.NewGenesisState(params, []types.ClaimRecord{{Address: s.claimantStr, Amount: s.allocation}}, nil, false)
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+}
+
+func (s *KeeperTestSuite) TestClaim() {
+	_, err := s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.otherStr})
+	s.Require().ErrorIs(err, types.ErrNoAllocation)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.claimant, s.allocation).Return(nil)
+	res, err := s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.claimantStr})
+	s.Require().NoError(err)
+	s.Require().Equal(s.allocation, res.Claimed)
+
+	record, err := s.keeper.ClaimRecords.Get(s.ctx, s.claimant)
+	s.Require().NoError(err)
+	s.Require().Equal(s.allocation, record.Claimed)
+
+	_, err = s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.claimantStr})
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+}
+
+func (s *KeeperTestSuite) TestClaimGatedOnActions() {
+	s.initGenesis(types.Params{
+		ClaimDeadline: s.now.Add(24 * time.Hour),
+		Actions:       []types.Action{types.ActionVote, types.ActionDelegate},
+	})
+
+	_, err := s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.claimantStr})
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	// actions of addresses without an allocation are not recorded
+	hooks := s.keeper.Hooks()
+	s.Require().NoError(hooks.AfterProposalVote(s.ctx, 1, s.other))
+	actions, err := s.keeper.GetCompletedActions(s.ctx, s.other)
+	s.Require().NoError(err)
+	s.Require().Empty(actions)
+
+	s.Require().NoError(hooks.AfterProposalVote(s.ctx, 1, s.claimant))
+	s.Require().NoError(hooks.AfterProposalVote(s.ctx, 2, s.claimant))
+
+	half := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	qres, err := s.queryServer.ClaimRecord(s.ctx, &types.QueryClaimRecordRequest{Address: s.claimantStr})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Action{types.ActionVote}, qres.CompletedActions)
+	s.Require().Equal(half, qres.Claimable)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.claimant, half).Return(nil).Times(2)
+	res, err := s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.claimantStr})
+	s.Require().NoError(err)
+	s.Require().Equal(half, res.Claimed)
+
+	s.Require().NoError(hooks.AfterDelegationModified(s.ctx, s.claimant, sdk.ValAddress("validator")))
+	res, err = s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.claimantStr})
+	s.Require().NoError(err)
+	s.Require().Equal(half, res.Claimed)
+}
+
+func (s *KeeperTestSuite) TestClaimWithMerkleProof() {
+	otherAllocation := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250))
+	leaves := [][]byte{
+		types.MerkleLeaf(s.other, otherAllocation),
+		types.MerkleLeaf(sdk.AccAddress("third"), s.allocation),
+		types.MerkleLeaf(sdk.AccAddress("fourth"), s.allocation),
+	}
+	s.initGenesis(types.Params{ClaimDeadline: s.now.Add(24 * time.Hour), MerkleRoot: types.MerkleRoot(leaves)})
+	proof := types.MerkleProof(leaves, 0)
+
+	_, err := s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.otherStr, Amount: s.allocation, Proof: proof})
+	s.Require().ErrorIs(err, types.ErrInvalidProof)
+	_, err = s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.otherStr, Amount: otherAllocation, Proof: proof[1:]})
+	s.Require().ErrorIs(err, types.ErrInvalidProof)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.other, otherAllocation).Return(nil)
+	res, err := s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.otherStr, Amount: otherAllocation, Proof: proof})
+	s.Require().NoError(err)
+	s.Require().Equal(otherAllocation, res.Claimed)
+
+	// the allocation is recorded on its first claim
+	_, err = s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.otherStr})
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+}
+
+func (s *KeeperTestSuite) TestClawback() {
+	// nothing happens before the deadline
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(24 * time.Hour)})
+	_, err := s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.claimantStr})
+	s.Require().ErrorIs(err, types.ErrClaimDeadlinePassed)
+
+	unclaimed := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), s.moduleAddr).Return(unclaimed)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(s.moduleAddr), unclaimed).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	clawedBack, err := s.keeper.ClawedBack.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(clawedBack)
+
+	// the funds are only clawed back once
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	// a new deadline re-opens the claims
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: govModuleNameStr,
+		Params:    types.Params{ClaimDeadline: s.now.Add(48 * time.Hour), ClawbackAddress: s.otherStr},
+	})
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.claimant, s.allocation).Return(nil)
+	_, err = s.msgServer.Claim(s.ctx, &types.MsgClaim{Claimant: s.claimantStr})
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(48 * time.Hour)})
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), s.moduleAddr).Return(unclaimed)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.other, unclaimed).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.claimantStr, Params: types.DefaultParams()})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: govModuleNameStr,
+		Params:    types.Params{Actions: []types.Action{types.ActionVote, types.ActionVote}},
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: govModuleNameStr,
+		Params:    types.Params{ClawbackAddress: "invalid"},
+	})
+	s.Require().Error(err)
+
+	params := types.Params{ClaimDeadline: s.now.Add(time.Hour), Actions: []types.Action{types.ActionDelegate}}
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestExportGenesis() {
+	s.initGenesis(types.Params{ClaimDeadline: s.now.Add(24 * time.Hour), Actions: []types.Action{types.ActionVote, types.ActionDelegate}})
+	s.Require().NoError(s.keeper.Hooks().AfterProposalVote(s.ctx, 1, s.claimant))
+	s.Require().NoError(s.keeper.Hooks().AfterDelegationModified(s.ctx, s.claimant, sdk.ValAddress("validator")))
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.ClaimRecords, 1)
+	s.Require().Equal([]types.CompletedActions{{
+		Address: s.claimantStr,
+		Actions: []types.Action{types.ActionVote, types.ActionDelegate},
+	}}, gs.CompletedActions)
+	s.Require().False(gs.ClawedBack)
+}
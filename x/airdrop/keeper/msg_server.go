@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/airdrop/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/airdrop MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// Claim releases the unlocked and not yet claimed part of the allocation of
+// the claimant. An allocation which is not in the genesis state is proven
+// against the merkle root on its first claim.
+func (ms msgServer) Claim(ctx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
+	claimant, err := ms.addressCodec.StringToBytes(msg.Claimant)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid claimant address: %s", err)
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := ms.isClaimPeriod(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, types.ErrClaimDeadlinePassed
+	}
+
+	record, err := ms.ClaimRecords.Get(ctx, claimant)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		if !params.HasMerkleRoot() {
+			return nil, errorsmod.Wrap(types.ErrNoAllocation, msg.Claimant)
+		}
+		if !msg.Amount.IsValid() {
+			return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+		}
+		if !types.VerifyMerkleProof(params.MerkleRoot, types.MerkleLeaf(claimant, msg.Amount), msg.Proof) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidProof, "allocation of %s to %s", msg.Amount, msg.Claimant)
+		}
+		record = types.ClaimRecord{Address: msg.Claimant, Amount: msg.Amount}
+	case err != nil:
+		return nil, err
+	}
+
+	claimable, err := ms.Claimable(ctx, params, claimant, record)
+	if err != nil {
+		return nil, err
+	}
+	if claimable.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNothingToClaim, "claimed %s of %s", record.Claimed, record.Amount)
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimant, claimable); err != nil {
+		return nil, err
+	}
+
+	record.Claimed = record.Claimed.Add(claimable...)
+	if err := ms.ClaimRecords.Set(ctx, claimant, record); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeClaim,
+		event.NewAttribute(types.AttributeKeyClaimant, msg.Claimant),
+		event.NewAttribute(sdk.AttributeKeyAmount, claimable.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimResponse{Claimed: claimable}, nil
+}
+
+// UpdateParams updates the params. Setting a claim deadline which has not
+// passed yet re-opens the claims of an airdrop which was clawed back.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	if msg.Params.ClawbackAddress != "" {
+		if _, err := ms.addressCodec.StringToBytes(msg.Params.ClawbackAddress); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid clawback address: %s", err)
+		}
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if !msg.Params.DeadlinePassed(ms.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		if err := ms.ClawedBack.Set(ctx, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
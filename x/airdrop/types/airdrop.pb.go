@@ -0,0 +1,897 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/airdrop/v1/airdrop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Action is an action which unlocks a part of an airdrop allocation.
+type Action int32
+
+const (
+	// ACTION_UNSPECIFIED defines a no-op action.
+	ActionUnspecified Action = 0
+	// ACTION_VOTE is a vote on a governance proposal.
+	ActionVote Action = 1
+	// ACTION_DELEGATE is a delegation to a validator.
+	ActionDelegate Action = 2
+)
+
+var Action_name = map[int32]string{
+	0: "ACTION_UNSPECIFIED",
+	1: "ACTION_VOTE",
+	2: "ACTION_DELEGATE",
+}
+
+var Action_value = map[string]int32{
+	"ACTION_UNSPECIFIED": 0,
+	"ACTION_VOTE":        1,
+	"ACTION_DELEGATE":    2,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e74971eed948418a, []int{0}
+}
+
+// Params defines the parameters for the x/airdrop module.
+type Params struct {
+	// merkle_root is the root of the merkle tree of the allocations claimable
+	// with a proof, in addition to the allocations of the genesis state. It is
+	// empty if all the allocations are in the genesis state.
+	MerkleRoot []byte `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// claim_deadline is the time after which allocations can no longer be
+	// claimed and unclaimed funds are clawed back.
+	ClaimDeadline time.Time `protobuf:"bytes,2,opt,name=claim_deadline,json=claimDeadline,proto3,stdtime" json:"claim_deadline"`
+	// actions are the actions each unlocking an equal part of the allocations.
+	// The whole allocation is unlocked on claim if empty.
+	Actions []Action `protobuf:"varint,3,rep,packed,name=actions,proto3,enum=cosmos.airdrop.v1.Action" json:"actions,omitempty"`
+	// clawback_address is the address receiving the unclaimed funds after the
+	// claim deadline. The unclaimed funds are burned if empty.
+	ClawbackAddress string `protobuf:"bytes,4,opt,name=clawback_address,json=clawbackAddress,proto3" json:"clawback_address,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e74971eed948418a, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *Params) GetClaimDeadline() time.Time {
+	if m != nil {
+		return m.ClaimDeadline
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetActions() []Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *Params) GetClawbackAddress() string {
+	if m != nil {
+		return m.ClawbackAddress
+	}
+	return ""
+}
+
+// ClaimRecord is the allocation of an address and the amount it claimed.
+type ClaimRecord struct {
+	// address is the address of the claimant.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the allocation of the address.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// claimed is the part of the allocation already claimed.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
+func (m *ClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*ClaimRecord) ProtoMessage()    {}
+func (*ClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e74971eed948418a, []int{1}
+}
+func (m *ClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRecord.Merge(m, src)
+}
+func (m *ClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRecord proto.InternalMessageInfo
+
+func (m *ClaimRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ClaimRecord) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.airdrop.v1.Action", Action_name, Action_value)
+	proto.RegisterType((*Params)(nil), "cosmos.airdrop.v1.Params")
+	proto.RegisterType((*ClaimRecord)(nil), "cosmos.airdrop.v1.ClaimRecord")
+}
+
+func init() { proto.RegisterFile("cosmos/airdrop/v1/airdrop.proto", fileDescriptor_e74971eed948418a) }
+
+var fileDescriptor_e74971eed948418a = []byte{
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xbf, 0x4f, 0xdb, 0x40,
+	0x18, 0xcd, 0x25, 0x28, 0xa8, 0x17, 0x08, 0xe1, 0x44, 0x25, 0x63, 0x55, 0x8e, 0xc5, 0xd2, 0x28,
+	0x12, 0xb6, 0x02, 0x52, 0x87, 0x6e, 0xf9, 0x61, 0x2a, 0xa4, 0x0a, 0x90, 0x09, 0x0c, 0x5d, 0xac,
+	0xb3, 0x7d, 0xb8, 0xa7, 0xd8, 0xbe, 0xc8, 0x77, 0xd0, 0xa2, 0xfe, 0x01, 0xad, 0x32, 0xb1, 0x74,
+	0xa9, 0xc4, 0xd4, 0xa5, 0xea, 0xc4, 0xd0, 0xa5, 0xff, 0x01, 0x23, 0xea, 0xd4, 0xa9, 0x54, 0x30,
+	0xf0, 0x6f, 0x54, 0xf6, 0x9d, 0x69, 0xa5, 0x0e, 0xdd, 0xba, 0x58, 0xe7, 0xef, 0x7b, 0xdf, 0xf7,
+	0xde, 0xbd, 0x77, 0xb0, 0x1d, 0x30, 0x9e, 0x30, 0x6e, 0x63, 0x9a, 0x85, 0x19, 0x9b, 0xda, 0x27,
+	0xbd, 0xf2, 0x68, 0x4d, 0x33, 0x26, 0x18, 0x5a, 0x96, 0x00, 0xab, 0xac, 0x9e, 0xf4, 0xf4, 0x95,
+	0x88, 0x45, 0xac, 0xe8, 0xda, 0xf9, 0x49, 0x02, 0xf5, 0x76, 0xc4, 0x58, 0x14, 0x13, 0xbb, 0xf8,
+	0xf3, 0x8f, 0x8f, 0x6c, 0x41, 0x13, 0xc2, 0x05, 0x4e, 0xd4, 0x26, 0x7d, 0x55, 0x6e, 0xf2, 0xe4,
+	0xa4, 0x5a, 0x2b, 0x5b, 0x86, 0x52, 0xe1, 0x63, 0x4e, 0xec, 0x93, 0x9e, 0x4f, 0x04, 0xee, 0xd9,
+	0x01, 0xa3, 0xa9, 0xea, 0x2f, 0xe3, 0x84, 0xa6, 0xcc, 0x2e, 0xbe, 0xb2, 0xb4, 0xf6, 0xbe, 0x0a,
+	0xeb, 0x7b, 0x38, 0xc3, 0x09, 0x47, 0x6d, 0xd8, 0x48, 0x48, 0x36, 0x89, 0x89, 0x97, 0x31, 0x26,
+	0x34, 0x60, 0x82, 0xce, 0x82, 0x0b, 0x65, 0xc9, 0x65, 0x4c, 0xa0, 0x3d, 0xd8, 0x0c, 0x62, 0x4c,
+	0x13, 0x2f, 0x24, 0x38, 0x8c, 0x69, 0x4a, 0xb4, 0xaa, 0x09, 0x3a, 0x8d, 0x0d, 0xdd, 0x92, 0x9a,
+	0xad, 0x52, 0xb3, 0x35, 0x2e, 0x35, 0x0f, 0x16, 0x2f, 0x7f, 0xb4, 0x2b, 0x67, 0xd7, 0x6d, 0xf0,
+	0xe9, 0xee, 0xa2, 0x0b, 0xdc, 0xc5, 0x62, 0xc1, 0x48, 0xcd, 0xa3, 0x4d, 0x38, 0x8f, 0x03, 0x41,
+	0x59, 0xca, 0xb5, 0x9a, 0x59, 0xeb, 0x34, 0x37, 0x56, 0xad, 0xbf, 0x7c, 0xb2, 0xfa, 0x05, 0xc2,
+	0x2d, 0x91, 0x68, 0x08, 0x5b, 0x41, 0x8c, 0x5f, 0xf9, 0x38, 0x98, 0x78, 0x38, 0x0c, 0x33, 0xc2,
+	0xb9, 0x36, 0x67, 0x82, 0xce, 0x83, 0x81, 0xf6, 0xed, 0xcb, 0xfa, 0x8a, 0x5a, 0xd0, 0x97, 0x9d,
+	0x7d, 0x91, 0xd1, 0x34, 0x72, 0x97, 0xca, 0x09, 0x55, 0x7e, 0x6a, 0xcc, 0xee, 0x2e, 0xba, 0xca,
+	0xca, 0x75, 0x1e, 0x4e, 0xee, 0x93, 0x93, 0x66, 0xac, 0x7d, 0xad, 0xc2, 0xc6, 0x30, 0xd7, 0xea,
+	0x92, 0x80, 0x65, 0x21, 0xda, 0x80, 0xf3, 0x25, 0x17, 0xf8, 0x07, 0x57, 0x09, 0x44, 0xa7, 0xb0,
+	0x8e, 0x13, 0x76, 0x9c, 0x0a, 0xad, 0x6a, 0xd6, 0x3a, 0x8d, 0xdf, 0x97, 0xcb, 0xf3, 0xb1, 0x54,
+	0x3e, 0xd6, 0x90, 0xd1, 0x74, 0xb0, 0x95, 0xdb, 0xf4, 0xf9, 0xba, 0xdd, 0x89, 0xa8, 0x78, 0x79,
+	0xec, 0x5b, 0x01, 0x4b, 0x54, 0xb4, 0xf6, 0x1f, 0x1a, 0xc5, 0xe9, 0x94, 0xf0, 0x62, 0x80, 0x7f,
+	0xb8, 0xbb, 0xe8, 0x2e, 0xc4, 0x24, 0xc2, 0xc1, 0xa9, 0x97, 0x27, 0xcc, 0xa5, 0xbf, 0x8a, 0x10,
+	0xbd, 0x81, 0xf3, 0x85, 0xd3, 0x24, 0xd4, 0x6a, 0xff, 0x8b, 0xbb, 0x64, 0xec, 0xbe, 0x05, 0xb0,
+	0x2e, 0x43, 0x43, 0xeb, 0x10, 0xf5, 0x87, 0xe3, 0xed, 0xdd, 0x1d, 0xef, 0x60, 0x67, 0x7f, 0xcf,
+	0x19, 0x6e, 0x6f, 0x6d, 0x3b, 0xa3, 0x56, 0x45, 0x7f, 0x38, 0x3b, 0x37, 0x97, 0x25, 0xe6, 0x20,
+	0xe5, 0x53, 0x12, 0xd0, 0x23, 0x4a, 0xc2, 0xfc, 0x09, 0x2a, 0xf8, 0xe1, 0xee, 0xd8, 0x69, 0x01,
+	0xbd, 0x39, 0x3b, 0x37, 0xa1, 0xc4, 0x1d, 0x32, 0x41, 0xd0, 0x63, 0xb8, 0xa4, 0x00, 0x23, 0xe7,
+	0xb9, 0xf3, 0xac, 0x3f, 0x76, 0x5a, 0x55, 0x1d, 0xcd, 0xce, 0xcd, 0xa6, 0x04, 0x8d, 0x48, 0xae,
+	0x4b, 0x10, 0x7d, 0xee, 0xdd, 0x47, 0xa3, 0x32, 0x78, 0x72, 0x79, 0x63, 0x80, 0xab, 0x1b, 0x03,
+	0xfc, 0xbc, 0x31, 0xc0, 0xd9, 0xad, 0x51, 0xb9, 0xba, 0x35, 0x2a, 0xdf, 0x6f, 0x8d, 0xca, 0x8b,
+	0x47, 0xf2, 0x6a, 0x3c, 0x9c, 0x58, 0x94, 0xd9, 0xaf, 0xef, 0xe3, 0x2f, 0xae, 0xe9, 0xd7, 0x8b,
+	0x97, 0xbc, 0xf9, 0x6b, 0x00, 0xbe, 0x71, 0x2e, 0xd1, 0xd7, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClawbackAddress) > 0 {
+		i -= len(m.ClawbackAddress)
+		copy(dAtA[i:], m.ClawbackAddress)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.ClawbackAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Actions) > 0 {
+		dAtA2 := make([]byte, len(m.Actions)*10)
+		var j1 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAirdrop(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClaimDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimDeadline):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAirdrop(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAirdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAirdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAirdrop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAirdrop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimDeadline)
+	n += 1 + l + sovAirdrop(uint64(l))
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovAirdrop(uint64(e))
+		}
+		n += 1 + sovAirdrop(uint64(l)) + l
+	}
+	l = len(m.ClawbackAddress)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovAirdrop(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovAirdrop(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAirdrop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAirdrop(x uint64) (n int) {
+	return sovAirdrop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClaimDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAirdrop
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAirdrop
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAirdrop
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAirdrop
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAirdrop
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAirdrop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAirdrop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAirdrop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAirdrop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAirdrop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAirdrop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAirdrop = fmt.Errorf("proto: unexpected end of group")
+)
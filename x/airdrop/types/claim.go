@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the claim record.
+func (r ClaimRecord) Validate() error {
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount of %s: %w", r.Address, err)
+	}
+	if r.Amount.IsZero() {
+		return fmt.Errorf("amount of %s cannot be zero", r.Address)
+	}
+	if err := r.Claimed.Validate(); err != nil {
+		return fmt.Errorf("invalid claimed amount of %s: %w", r.Address, err)
+	}
+	if !r.Amount.IsAllGTE(r.Claimed) {
+		return fmt.Errorf("claimed amount of %s exceeds its allocation", r.Address)
+	}
+
+	return nil
+}
+
+// Unlocked returns the part of the allocation unlocked by completing the given
+// number of actions out of total. The whole allocation is unlocked if there is
+// no action to complete.
+func (r ClaimRecord) Unlocked(completed, total int) sdk.Coins {
+	if total == 0 || completed >= total {
+		return r.Amount
+	}
+
+	unlocked := sdk.NewCoins()
+	for _, coin := range r.Amount {
+		amount := coin.Amount.MulRaw(int64(completed)).QuoRaw(int64(total))
+		unlocked = unlocked.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return unlocked
+}
+
+// Claimable returns the part of the allocation unlocked by completing the given
+// number of actions out of total and not claimed yet.
+func (r ClaimRecord) Claimable(completed, total int) sdk.Coins {
+	claimable, _ := r.Unlocked(completed, total).SafeSub(r.Claimed...)
+	return claimable
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/airdrop/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgClaim{}, "cosmos-sdk/airdrop/MsgClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/airdrop/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgClaim{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
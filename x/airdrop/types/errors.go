@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/airdrop module sentinel errors
+var (
+	ErrInvalidSigner       = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrNoAllocation        = errors.Register(ModuleName, 2, "no airdrop allocation")
+	ErrInvalidProof        = errors.Register(ModuleName, 3, "invalid merkle proof")
+	ErrClaimDeadlinePassed = errors.Register(ModuleName, 4, "claim deadline passed")
+	ErrNothingToClaim      = errors.Register(ModuleName, 5, "nothing to claim")
+)
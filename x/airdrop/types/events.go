@@ -0,0 +1,13 @@
+package types
+
+// airdrop module event types
+const (
+	EventTypeClaim          = "claim"
+	EventTypeCompleteAction = "complete_action"
+	EventTypeClawback       = "clawback"
+
+	AttributeKeyClaimant  = "claimant"
+	AttributeKeyAddress   = "address"
+	AttributeKeyAction    = "action"
+	AttributeKeyRecipient = "recipient"
+)
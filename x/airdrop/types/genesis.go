@@ -0,0 +1,59 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, records []ClaimRecord, completed []CompletedActions, clawedBack bool) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		ClaimRecords:     records,
+		CompletedActions: completed,
+		ClawedBack:       clawedBack,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil, false)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.ClaimRecords))
+	for _, record := range gs.ClaimRecords {
+		if record.Address == "" {
+			return fmt.Errorf("claim record address cannot be empty")
+		}
+		if _, ok := seen[record.Address]; ok {
+			return fmt.Errorf("duplicate claim record for %s", record.Address)
+		}
+		seen[record.Address] = struct{}{}
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen = make(map[string]struct{}, len(gs.CompletedActions))
+	for _, completed := range gs.CompletedActions {
+		if completed.Address == "" {
+			return fmt.Errorf("completed actions address cannot be empty")
+		}
+		if _, ok := seen[completed.Address]; ok {
+			return fmt.Errorf("duplicate completed actions for %s", completed.Address)
+		}
+		seen[completed.Address] = struct{}{}
+
+		for _, action := range completed.Actions {
+			if _, ok := Action_name[int32(action)]; !ok || action == ActionUnspecified {
+				return fmt.Errorf("invalid action %s completed by %s", action, completed.Address)
+			}
+		}
+	}
+
+	return nil
+}
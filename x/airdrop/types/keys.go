@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "airdrop"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey              = collections.NewPrefix(0)
+	ClaimRecordsPrefix     = collections.NewPrefix(1)
+	CompletedActionsPrefix = collections.NewPrefix(2)
+	ClawedBackKey          = collections.NewPrefix(3)
+)
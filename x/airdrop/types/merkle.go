@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MerkleLeaf returns the merkle tree leaf of the allocation of amount to the
+// given address: the SHA-256 hash of the address bytes followed by the string
+// representation of the amount.
+func MerkleLeaf(addr []byte, amount sdk.Coins) []byte {
+	h := sha256.New()
+	h.Write(addr)
+	h.Write([]byte(amount.String()))
+	return h.Sum(nil)
+}
+
+// MerkleRoot returns the root of the merkle tree of the given leaves. A parent
+// is the hash of its two children in ascending byte order, and the last node of
+// a level with an odd number of nodes is promoted to the next level.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+
+	return level[0]
+}
+
+// MerkleProof returns the proof of the leaf at the given index of the merkle
+// tree of the given leaves, as built by MerkleRoot.
+func MerkleProof(leaves [][]byte, index int) [][]byte {
+	var proof [][]byte
+
+	level := leaves
+	for len(level) > 1 {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextMerkleLevel(level)
+		index /= 2
+	}
+
+	return proof
+}
+
+// VerifyMerkleProof returns true if the given proof proves the inclusion of
+// leaf in the merkle tree with the given root.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashMerklePair(node, sibling)
+	}
+
+	return bytes.Equal(node, root)
+}
+
+func nextMerkleLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, hashMerklePair(level[i], level[i+1]))
+	}
+
+	return next
+}
+
+func hashMerklePair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	h := sha256.New()
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/airdrop/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMerkleProof(t *testing.T) {
+	for n := 1; n <= 7; n++ {
+		leaves := make([][]byte, n)
+		for i := range leaves {
+			leaves[i] = types.MerkleLeaf(sdk.AccAddress(fmt.Sprintf("addr%d", i)), sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1))))
+		}
+		root := types.MerkleRoot(leaves)
+
+		for i, leaf := range leaves {
+			proof := types.MerkleProof(leaves, i)
+			require.True(t, types.VerifyMerkleProof(root, leaf, proof), "leaf %d of %d", i, n)
+			require.False(t, types.VerifyMerkleProof(root, types.MerkleLeaf(sdk.AccAddress("other"), nil), proof))
+		}
+	}
+}
+
+func TestClaimRecordClaimable(t *testing.T) {
+	record := types.ClaimRecord{
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Claimed: sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+	}
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 67)), record.Claimable(0, 0))
+	require.True(t, record.Claimable(1, 3).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 33)), record.Claimable(2, 3))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 67)), record.Claimable(3, 3))
+}
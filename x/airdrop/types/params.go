@@ -0,0 +1,59 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+)
+
+// DefaultParams returns default x/airdrop module parameters. There is no
+// merkle root, no claim deadline and no action to complete.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if len(p.MerkleRoot) != 0 && len(p.MerkleRoot) != sha256.Size {
+		return fmt.Errorf("merkle root must be %d bytes long, got %d", sha256.Size, len(p.MerkleRoot))
+	}
+
+	seen := make(map[Action]struct{}, len(p.Actions))
+	for _, action := range p.Actions {
+		if _, ok := Action_name[int32(action)]; !ok || action == ActionUnspecified {
+			return fmt.Errorf("invalid action %s", action)
+		}
+		if _, ok := seen[action]; ok {
+			return fmt.Errorf("duplicate action %s", action)
+		}
+		seen[action] = struct{}{}
+	}
+
+	return nil
+}
+
+// HasDeadline returns true if allocations can only be claimed until a deadline.
+func (p Params) HasDeadline() bool {
+	return !p.ClaimDeadline.IsZero()
+}
+
+// DeadlinePassed returns true if the claim deadline passed at the given time.
+func (p Params) DeadlinePassed(now time.Time) bool {
+	return p.HasDeadline() && !now.Before(p.ClaimDeadline)
+}
+
+// HasAction returns true if the given action unlocks a part of the allocations.
+func (p Params) HasAction(action Action) bool {
+	for _, a := range p.Actions {
+		if a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasMerkleRoot returns true if allocations can be claimed with a merkle proof.
+func (p Params) HasMerkleRoot() bool {
+	return len(p.MerkleRoot) > 0
+}
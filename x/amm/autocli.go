@@ -0,0 +1,92 @@
+package amm
+
+import (
+	"fmt"
+
+	ammv1 "cosmossdk.io/api/cosmos/amm/v1"
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: ammv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current amm parameters",
+				},
+				{
+					RpcMethod:      "Pool",
+					Use:            "pool [pool_id]",
+					Short:          "Query a liquidity pool",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}},
+				},
+				{
+					RpcMethod: "Pools",
+					Use:       "pools",
+					Short:     "Query all the liquidity pools",
+				},
+				{
+					RpcMethod:      "PoolByDenoms",
+					Use:            "pool-by-denoms [denom_a] [denom_b]",
+					Short:          "Query the liquidity pool of two denoms",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom_a"}, {ProtoField: "denom_b"}},
+				},
+				{
+					RpcMethod:      "EstimateSwapExactIn",
+					Use:            "estimate-swap [pool_id] [token_in]",
+					Short:          "Estimate the amount returned by swapping an exact amount in a pool",
+					Example:        fmt.Sprintf("%s query amm estimate-swap 1 1000stake", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}, {ProtoField: "token_in"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: ammv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreatePool",
+					Use:            "create-pool [liquidity] --from [creator]",
+					Short:          "Create a liquidity pool of two denoms with an initial liquidity",
+					Example:        fmt.Sprintf("%s tx amm create-pool 1000000stake,1000000uatom --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "liquidity", Varargs: true}},
+				},
+				{
+					RpcMethod:      "AddLiquidity",
+					Use:            "add-liquidity [pool_id] [max_amounts] --from [depositor]",
+					Short:          "Deposit liquidity in a pool, at most the given amounts",
+					Example:        fmt.Sprintf("%s tx amm add-liquidity 1 1000stake,1000uatom --min-shares 900 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}, {ProtoField: "max_amounts", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RemoveLiquidity",
+					Use:            "remove-liquidity [pool_id] [shares] --from [withdrawer]",
+					Short:          "Burn shares of a pool against their part of its reserves",
+					Example:        fmt.Sprintf("%s tx amm remove-liquidity 1 1000 --min-amounts 900stake,900uatom --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}, {ProtoField: "shares"}},
+				},
+				{
+					RpcMethod:      "SwapExactIn",
+					Use:            "swap [pool_id] [token_in] --from [sender]",
+					Short:          "Swap an exact amount of a denom of a pool for the other denom",
+					Example:        fmt.Sprintf("%s tx amm swap 1 1000stake --min-token-out 990 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}, {ProtoField: "token_in"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update amm module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update amm module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query amm params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx amm update-params-proposal '{ "swap_fee": "0.003000000000000000", "pool_creation_fee": [] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
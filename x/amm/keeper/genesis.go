@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/amm/types"
+)
+
+// InitGenesis initializes the amm module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	if err := k.NextPoolID.Set(ctx, data.NextPoolId); err != nil {
+		return err
+	}
+
+	for _, pool := range data.Pools {
+		if err := k.setPool(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the amm module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPoolID, err := k.NextPoolID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []types.Pool
+	err = k.Pools.Walk(ctx, nil, func(_ uint64, pool types.Pool) (bool, error) {
+		pools = append(pools, pool)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, pools, nextPoolID), nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/amm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/amm QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the amm module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Pool returns a pool.
+func (q queryServer) Pool(ctx context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pool, err := q.k.GetPool(ctx, req.PoolId)
+	if err != nil {
+		if errors.Is(err, types.ErrPoolNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+// Pools returns all the pools.
+func (q queryServer) Pools(ctx context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pools, pageRes, err := query.CollectionPaginate(ctx, q.k.Pools, req.Pagination, func(_ uint64, pool types.Pool) (types.Pool, error) {
+		return pool, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolsResponse{Pools: pools, Pagination: pageRes}, nil
+}
+
+// PoolByDenoms returns the pool of two denoms.
+func (q queryServer) PoolByDenoms(ctx context.Context, req *types.QueryPoolByDenomsRequest) (*types.QueryPoolByDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.DenomA); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := sdk.ValidateDenom(req.DenomB); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pool, err := q.k.GetPoolByDenoms(ctx, req.DenomA, req.DenomB)
+	if err != nil {
+		if errors.Is(err, types.ErrPoolNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryPoolByDenomsResponse{Pool: pool}, nil
+}
+
+// EstimateSwapExactIn returns the amount returned by swapping an exact amount
+// of a denom of a pool at the current reserves and swap fee.
+func (q queryServer) EstimateSwapExactIn(ctx context.Context, req *types.QueryEstimateSwapExactInRequest) (*types.QueryEstimateSwapExactInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pool, err := q.k.GetPool(ctx, req.PoolId)
+	if err != nil {
+		if errors.Is(err, types.ErrPoolNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := pool.SwapExactIn(tokenIn, params.SwapFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateSwapExactInResponse{TokenOut: tokenOut}, nil
+}
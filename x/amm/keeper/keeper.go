@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/amm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Keeper of the amm store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	bankKeeper   types.BankKeeper
+	addressCodec address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema     collections.Schema
+	Params     collections.Item[types.Params]
+	NextPoolID collections.Sequence
+	// Pools contains the liquidity pools
+	Pools collections.Map[uint64, types.Pool]
+	// PoolsByDenoms indexes the pools by their sorted denoms
+	PoolsByDenoms collections.Map[collections.Pair[string, string], uint64]
+}
+
+// NewKeeper creates a new amm Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:           cdc,
+		environment:   env,
+		bankKeeper:    bk,
+		addressCodec:  ak.AddressCodec(),
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		NextPoolID:    collections.NewSequence(sb, types.NextPoolIDKey, "next_pool_id"),
+		Pools:         collections.NewMap(sb, types.PoolsPrefix, "pools", collections.Uint64Key, codec.CollValue[types.Pool](cdc)),
+		PoolsByDenoms: collections.NewMap(sb, types.PoolsByDenomsPrefix, "pools_by_denoms", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/amm module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetPool returns a pool, or ErrPoolNotFound.
+func (k Keeper) GetPool(ctx context.Context, id uint64) (types.Pool, error) {
+	pool, err := k.Pools.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return pool, errorsmod.Wrapf(types.ErrPoolNotFound, "pool %d", id)
+	}
+
+	return pool, err
+}
+
+// GetPoolByDenoms returns the pool of two denoms, given in any order, or
+// ErrPoolNotFound.
+func (k Keeper) GetPoolByDenoms(ctx context.Context, denomA, denomB string) (types.Pool, error) {
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+
+	id, err := k.PoolsByDenoms.Get(ctx, collections.Join(denomA, denomB))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Pool{}, errorsmod.Wrapf(types.ErrPoolNotFound, "no pool of %s and %s", denomA, denomB)
+		}
+		return types.Pool{}, err
+	}
+
+	return k.Pools.Get(ctx, id)
+}
+
+// setPool stores a pool and indexes it by denoms.
+func (k Keeper) setPool(ctx context.Context, pool types.Pool) error {
+	if err := k.Pools.Set(ctx, pool.Id, pool); err != nil {
+		return err
+	}
+
+	denomA, denomB := pool.Denoms()
+	return k.PoolsByDenoms.Set(ctx, collections.Join(denomA, denomB), pool.Id)
+}
+
+// removePool removes an emptied pool and its index.
+func (k Keeper) removePool(ctx context.Context, pool types.Pool) error {
+	if err := k.Pools.Remove(ctx, pool.Id); err != nil {
+		return err
+	}
+
+	denomA, denomB := pool.Denoms()
+	return k.PoolsByDenoms.Remove(ctx, collections.Join(denomA, denomB))
+}
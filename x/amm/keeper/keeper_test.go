@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/amm"
+	"cosmossdk.io/x/amm/keeper"
+	ammtestutil "cosmossdk.io/x/amm/testutil"
+	"cosmossdk.io/x/amm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *ammtestutil.MockBankKeeper
+
+	creator    sdk.AccAddress
+	creatorStr string
+	liquidity  sdk.Coins
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, amm.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := ammtestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	s.bankKeeper = ammtestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	var err error
+	s.creator = sdk.AccAddress("creator")
+	s.creatorStr, err = ac.BytesToString(s.creator)
+	s.Require().NoError(err)
+
+	s.liquidity = sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(1000)), sdk.NewCoin("stake", sdkmath.NewInt(4000)))
+}
+
+func (s *KeeperTestSuite) createPool() uint64 {
+	shares := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(1), sdkmath.NewInt(2000)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.creator, types.ModuleName, s.liquidity).Return(nil)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, shares).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.creator, shares).Return(nil)
+
+	res, err := s.msgServer.CreatePool(s.ctx, &types.MsgCreatePool{Creator: s.creatorStr, Liquidity: s.liquidity})
+	s.Require().NoError(err)
+	s.Require().Equal(shares[0], res.Shares)
+
+	return res.PoolId
+}
+
+func (s *KeeperTestSuite) TestCreatePool() {
+	id := s.createPool()
+	s.Require().Equal(uint64(1), id)
+
+	pool, err := s.keeper.GetPoolByDenoms(s.ctx, "stake", "atom")
+	s.Require().NoError(err)
+	s.Require().Equal(s.liquidity, pool.Reserves)
+	s.Require().Equal(sdkmath.NewInt(2000), pool.TotalShares)
+
+	// only one pool per pair of denoms
+	_, err = s.msgServer.CreatePool(s.ctx, &types.MsgCreatePool{Creator: s.creatorStr, Liquidity: s.liquidity})
+	s.Require().ErrorIs(err, types.ErrPoolExists)
+
+	_, err = s.msgServer.CreatePool(s.ctx, &types.MsgCreatePool{Creator: s.creatorStr, Liquidity: s.liquidity[:1]})
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidity)
+}
+
+func (s *KeeperTestSuite) TestCreatePoolFee() {
+	params := types.DefaultParams()
+	params.PoolCreationFee = sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10)))
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.creator, types.ModuleName, params.PoolCreationFee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, params.PoolCreationFee).Return(nil)
+	s.createPool()
+}
+
+func (s *KeeperTestSuite) TestAddAndRemoveLiquidity() {
+	id := s.createPool()
+	depositor := sdk.AccAddress("depositor")
+	depositorStr, err := address.NewBech32Codec("cosmos").BytesToString(depositor)
+	s.Require().NoError(err)
+
+	// the deposit is limited by the atom reserve
+	maxAmounts := sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)), sdk.NewCoin("stake", sdkmath.NewInt(1000)))
+	deposit := sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)), sdk.NewCoin("stake", sdkmath.NewInt(400)))
+	shares := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(id), sdkmath.NewInt(200)))
+
+	_, err = s.msgServer.AddLiquidity(s.ctx, &types.MsgAddLiquidity{Depositor: depositorStr, PoolId: id, MaxAmounts: maxAmounts, MinShares: sdkmath.NewInt(201)})
+	s.Require().ErrorIs(err, types.ErrSlippage)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), depositor, types.ModuleName, deposit).Return(nil)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, shares).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, depositor, shares).Return(nil)
+	res, err := s.msgServer.AddLiquidity(s.ctx, &types.MsgAddLiquidity{Depositor: depositorStr, PoolId: id, MaxAmounts: maxAmounts, MinShares: sdkmath.NewInt(200)})
+	s.Require().NoError(err)
+	s.Require().Equal(deposit, res.Deposit)
+	s.Require().Equal(shares[0], res.Shares)
+
+	pool, err := s.keeper.GetPool(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.liquidity.Add(deposit...), pool.Reserves)
+	s.Require().Equal(sdkmath.NewInt(2200), pool.TotalShares)
+
+	_, err = s.msgServer.RemoveLiquidity(s.ctx, &types.MsgRemoveLiquidity{Withdrawer: depositorStr, PoolId: id, Shares: sdkmath.NewInt(200), MinAmounts: deposit.Add(deposit...)})
+	s.Require().ErrorIs(err, types.ErrSlippage)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), depositor, types.ModuleName, shares).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, shares).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, depositor, deposit).Return(nil)
+	removeRes, err := s.msgServer.RemoveLiquidity(s.ctx, &types.MsgRemoveLiquidity{Withdrawer: depositorStr, PoolId: id, Shares: sdkmath.NewInt(200), MinAmounts: deposit})
+	s.Require().NoError(err)
+	s.Require().Equal(deposit, removeRes.Withdrawal)
+
+	// the pool is removed once all its shares are burned
+	creatorShares := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(id), sdkmath.NewInt(2000)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.creator, types.ModuleName, creatorShares).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, creatorShares).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.creator, s.liquidity).Return(nil)
+	_, err = s.msgServer.RemoveLiquidity(s.ctx, &types.MsgRemoveLiquidity{Withdrawer: s.creatorStr, PoolId: id, Shares: sdkmath.NewInt(2000)})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.GetPool(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrPoolNotFound)
+	_, err = s.keeper.GetPoolByDenoms(s.ctx, "atom", "stake")
+	s.Require().ErrorIs(err, types.ErrPoolNotFound)
+}
+
+func (s *KeeperTestSuite) TestSwapExactIn() {
+	id := s.createPool()
+	sender := sdk.AccAddress("sender")
+	senderStr, err := address.NewBech32Codec("cosmos").BytesToString(sender)
+	s.Require().NoError(err)
+	tokenIn := sdk.NewCoin("atom", sdkmath.NewInt(100))
+	tokenOut := sdk.NewCoin("stake", sdkmath.NewInt(362))
+
+	estimate, err := s.queryServer.EstimateSwapExactIn(s.ctx, &types.QueryEstimateSwapExactInRequest{PoolId: id, TokenIn: tokenIn.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(tokenOut, estimate.TokenOut)
+
+	_, err = s.msgServer.SwapExactIn(s.ctx, &types.MsgSwapExactIn{Sender: senderStr, PoolId: id, TokenIn: tokenIn, MinTokenOut: sdkmath.NewInt(363)})
+	s.Require().ErrorIs(err, types.ErrSlippage)
+
+	_, err = s.msgServer.SwapExactIn(s.ctx, &types.MsgSwapExactIn{Sender: senderStr, PoolId: id, TokenIn: sdk.NewCoin("uosmo", sdkmath.NewInt(100))})
+	s.Require().ErrorIs(err, types.ErrInvalidSwap)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sender, types.ModuleName, sdk.NewCoins(tokenIn)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sender, sdk.NewCoins(tokenOut)).Return(nil)
+	res, err := s.msgServer.SwapExactIn(s.ctx, &types.MsgSwapExactIn{Sender: senderStr, PoolId: id, TokenIn: tokenIn, MinTokenOut: sdkmath.NewInt(362)})
+	s.Require().NoError(err)
+	s.Require().Equal(tokenOut, res.TokenOut)
+
+	// the swap fee stays in the pool, so the product of the reserves grows
+	pool, err := s.keeper.GetPool(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(1100)), sdk.NewCoin("stake", sdkmath.NewInt(3638))), pool.Reserves)
+	s.Require().True(pool.Reserves[0].Amount.Mul(pool.Reserves[1].Amount).GT(sdkmath.NewInt(4000000)))
+}
+
+func (s *KeeperTestSuite) TestSwapExactInInsufficientFunds() {
+	id := s.createPool()
+	tokenIn := sdk.NewCoin("atom", sdkmath.NewInt(100))
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.creator, types.ModuleName, sdk.NewCoins(tokenIn)).Return(sdkerrors.ErrInsufficientFunds)
+	_, err := s.msgServer.SwapExactIn(s.ctx, &types.MsgSwapExactIn{Sender: s.creatorStr, PoolId: id, TokenIn: tokenIn})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the reserves of the pool are unchanged
+	pool, err := s.keeper.GetPool(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.liquidity, pool.Reserves)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.SwapFee = sdkmath.LegacyNewDecWithPrec(1, 2)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.creatorStr, Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	params.SwapFee = sdkmath.LegacyOneDec()
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().Error(err)
+
+	params.SwapFee = sdkmath.LegacyNewDecWithPrec(1, 2)
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params.SwapFee, res.Params.SwapFee)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.createPool()
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.Pools, 1)
+	s.Require().Equal(uint64(2), gs.NextPoolId)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	res, err := s.queryServer.PoolByDenoms(s.ctx, &types.QueryPoolByDenomsRequest{DenomA: "stake", DenomB: "atom"})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Pool.Id)
+}
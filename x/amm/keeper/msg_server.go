@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/amm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/amm MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreatePool creates a pool of two denoms, burning the pool creation fee, and
+// mints shares of the pool to its creator for the initial liquidity.
+func (ms msgServer) CreatePool(ctx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
+	creator, err := ms.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	id, err := ms.NextPoolID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, shares, err := types.NewPool(id, msg.Liquidity)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidLiquidity, err.Error())
+	}
+
+	denomA, denomB := pool.Denoms()
+	if _, err := ms.GetPoolByDenoms(ctx, denomA, denomB); err == nil {
+		return nil, errorsmod.Wrapf(types.ErrPoolExists, "pool of %s and %s", denomA, denomB)
+	} else if !errorsmod.IsOf(err, types.ErrPoolNotFound) {
+		return nil, err
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.PoolCreationFee.IsZero() {
+		if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, params.PoolCreationFee); err != nil {
+			return nil, err
+		}
+		if err := ms.bankKeeper.BurnCoins(ctx, types.ModuleName, params.PoolCreationFee); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := ms.NextPoolID.Next(ctx); err != nil {
+		return nil, err
+	}
+	if err := ms.setPool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	sharesCoin := sdk.NewCoin(pool.ShareDenom(), shares)
+	if err := ms.deposit(ctx, creator, msg.Liquidity, sharesCoin); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreatePool,
+		event.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		event.NewAttribute(types.AttributeKeyAmount, msg.Liquidity.String()),
+		event.NewAttribute(types.AttributeKeyShares, sharesCoin.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePoolResponse{PoolId: id, Shares: sharesCoin}, nil
+}
+
+// AddLiquidity deposits liquidity in a pool against shares of the pool.
+func (ms msgServer) AddLiquidity(ctx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
+	depositor, err := ms.addressCodec.StringToBytes(msg.Depositor)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+
+	pool, err := ms.GetPool(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, deposit, err := pool.Deposit(msg.MaxAmounts)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidLiquidity, err.Error())
+	}
+	if !msg.MinShares.IsNil() && shares.LT(msg.MinShares) {
+		return nil, errorsmod.Wrapf(types.ErrSlippage, "deposit mints %s shares, less than %s", shares, msg.MinShares)
+	}
+
+	pool.Reserves = pool.Reserves.Add(deposit...)
+	pool.TotalShares = pool.TotalShares.Add(shares)
+	if err := ms.setPool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	sharesCoin := sdk.NewCoin(pool.ShareDenom(), shares)
+	if err := ms.deposit(ctx, depositor, deposit, sharesCoin); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAddLiquidity,
+		event.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+		event.NewAttribute(types.AttributeKeyAccount, msg.Depositor),
+		event.NewAttribute(types.AttributeKeyAmount, deposit.String()),
+		event.NewAttribute(types.AttributeKeyShares, sharesCoin.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddLiquidityResponse{Deposit: deposit, Shares: sharesCoin}, nil
+}
+
+// RemoveLiquidity burns shares of a pool against their part of its reserves.
+// A pool whose shares are all burned is removed.
+func (ms msgServer) RemoveLiquidity(ctx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
+	withdrawer, err := ms.addressCodec.StringToBytes(msg.Withdrawer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid withdrawer address: %s", err)
+	}
+
+	pool, err := ms.GetPool(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Shares.IsNil() {
+		return nil, errorsmod.Wrap(types.ErrInvalidShares, "shares cannot be empty")
+	}
+	withdrawal, err := pool.Withdraw(msg.Shares)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidShares, err.Error())
+	}
+	if !withdrawal.IsAllGTE(msg.MinAmounts) {
+		return nil, errorsmod.Wrapf(types.ErrSlippage, "withdrawal %s is less than %s", withdrawal, msg.MinAmounts)
+	}
+
+	sharesCoin := sdk.NewCoin(pool.ShareDenom(), msg.Shares)
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, withdrawer, types.ModuleName, sdk.NewCoins(sharesCoin)); err != nil {
+		return nil, err
+	}
+	if err := ms.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sharesCoin)); err != nil {
+		return nil, err
+	}
+	if !withdrawal.IsZero() {
+		if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, withdrawal); err != nil {
+			return nil, err
+		}
+	}
+
+	pool.TotalShares = pool.TotalShares.Sub(msg.Shares)
+	if pool.TotalShares.IsZero() {
+		err = ms.removePool(ctx, pool)
+	} else {
+		pool.Reserves = pool.Reserves.Sub(withdrawal...)
+		err = ms.setPool(ctx, pool)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRemoveLiquidity,
+		event.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+		event.NewAttribute(types.AttributeKeyAccount, msg.Withdrawer),
+		event.NewAttribute(types.AttributeKeyAmount, withdrawal.String()),
+		event.NewAttribute(types.AttributeKeyShares, sharesCoin.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveLiquidityResponse{Withdrawal: withdrawal}, nil
+}
+
+// SwapExactIn swaps an exact amount of a denom of a pool for the other denom.
+// The swap fee stays in the reserves of the pool.
+func (ms msgServer) SwapExactIn(ctx context.Context, msg *types.MsgSwapExactIn) (*types.MsgSwapExactInResponse, error) {
+	sender, err := ms.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	pool, err := ms.GetPool(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := pool.SwapExactIn(msg.TokenIn, params.SwapFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSwap, err.Error())
+	}
+	if !msg.MinTokenOut.IsNil() && tokenOut.Amount.LT(msg.MinTokenOut) {
+		return nil, errorsmod.Wrapf(types.ErrSlippage, "swap returns %s, less than %s", tokenOut, msg.MinTokenOut)
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.TokenIn)); err != nil {
+		return nil, err
+	}
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(tokenOut)); err != nil {
+		return nil, err
+	}
+
+	pool.Reserves = pool.Reserves.Add(msg.TokenIn).Sub(tokenOut)
+	if err := ms.setPool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSwap,
+		event.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+		event.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+		event.NewAttribute(types.AttributeKeyTokenIn, msg.TokenIn.String()),
+		event.NewAttribute(types.AttributeKeyTokenOut, tokenOut.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactInResponse{TokenOut: tokenOut}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// deposit transfers liquidity from an account to the module account, and
+// mints the given shares to the account.
+func (ms msgServer) deposit(ctx context.Context, addr sdk.AccAddress, liquidity sdk.Coins, shares sdk.Coin) error {
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, liquidity); err != nil {
+		return err
+	}
+	if err := ms.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shares)); err != nil {
+		return err
+	}
+
+	return ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(shares))
+}
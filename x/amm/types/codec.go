@@ -0,0 +1,34 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/amm/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgCreatePool{}, "cosmos-sdk/amm/MsgCreatePool")
+	legacy.RegisterAminoMsg(cdc, &MsgAddLiquidity{}, "cosmos-sdk/amm/MsgAddLiquidity")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveLiquidity{}, "cosmos-sdk/amm/MsgRemoveLiquidity")
+	legacy.RegisterAminoMsg(cdc, &MsgSwapExactIn{}, "cosmos-sdk/amm/MsgSwapExactIn")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/amm/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreatePool{},
+		&MsgAddLiquidity{},
+		&MsgRemoveLiquidity{},
+		&MsgSwapExactIn{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
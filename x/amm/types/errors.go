@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/amm module sentinel errors
+var (
+	ErrInvalidSigner    = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrPoolNotFound     = errors.Register(ModuleName, 2, "pool not found")
+	ErrPoolExists       = errors.Register(ModuleName, 3, "pool already exists")
+	ErrInvalidLiquidity = errors.Register(ModuleName, 4, "invalid liquidity")
+	ErrInvalidShares    = errors.Register(ModuleName, 5, "invalid shares")
+	ErrInvalidSwap      = errors.Register(ModuleName, 6, "invalid swap")
+	ErrSlippage         = errors.Register(ModuleName, 7, "slippage limit exceeded")
+)
@@ -0,0 +1,17 @@
+package types
+
+// amm module event types
+const (
+	EventTypeCreatePool      = "create_pool"
+	EventTypeAddLiquidity    = "add_liquidity"
+	EventTypeRemoveLiquidity = "remove_liquidity"
+	EventTypeSwap            = "swap"
+
+	AttributeKeyPoolID   = "pool_id"
+	AttributeKeyCreator  = "creator"
+	AttributeKeyAccount  = "account"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyShares   = "shares"
+	AttributeKeyTokenIn  = "token_in"
+	AttributeKeyTokenOut = "token_out"
+)
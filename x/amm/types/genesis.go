@@ -0,0 +1,47 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, pools []Pool, nextPoolID uint64) *GenesisState {
+	return &GenesisState{Params: params, Pools: pools, NextPoolId: nextPoolID}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 1)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextPoolId == 0 {
+		return fmt.Errorf("next pool id must be positive")
+	}
+
+	seen := make(map[uint64]struct{}, len(gs.Pools))
+	pairs := make(map[[2]string]struct{}, len(gs.Pools))
+	for _, p := range gs.Pools {
+		if p.Id == 0 || p.Id >= gs.NextPoolId {
+			return fmt.Errorf("pool id %d must be positive and lower than the next pool id %d", p.Id, gs.NextPoolId)
+		}
+		if _, ok := seen[p.Id]; ok {
+			return fmt.Errorf("duplicate pool %d", p.Id)
+		}
+		seen[p.Id] = struct{}{}
+
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		denomA, denomB := p.Denoms()
+		if _, ok := pairs[[2]string{denomA, denomB}]; ok {
+			return fmt.Errorf("duplicate pool of %s and %s", denomA, denomB)
+		}
+		pairs[[2]string{denomA, denomB}] = struct{}{}
+	}
+
+	return nil
+}
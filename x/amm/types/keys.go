@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "amm"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey           = collections.NewPrefix(0)
+	NextPoolIDKey       = collections.NewPrefix(1)
+	PoolsPrefix         = collections.NewPrefix(2)
+	PoolsByDenomsPrefix = collections.NewPrefix(3)
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default x/amm module parameters. Swaps pay a 0.3% fee
+// to the liquidity providers, and pools can be created for free.
+func DefaultParams() Params {
+	return Params{
+		SwapFee:         sdkmath.LegacyNewDecWithPrec(3, 3),
+		PoolCreationFee: sdk.NewCoins(),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.SwapFee.IsNil() || p.SwapFee.IsNegative() || p.SwapFee.GTE(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("swap fee must be in [0, 1): %s", p.SwapFee)
+	}
+	if err := p.PoolCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid pool creation fee: %w", err)
+	}
+
+	return nil
+}
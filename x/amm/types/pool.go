@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ShareDenom returns the denom of the share token of a pool.
+func ShareDenom(poolID uint64) string {
+	return fmt.Sprintf("%s/pool/%d", ModuleName, poolID)
+}
+
+// NewPool creates a new pool with its initial liquidity, and returns it with
+// the shares minted to its creator: the geometric mean of the liquidity.
+func NewPool(id uint64, liquidity sdk.Coins) (Pool, sdkmath.Int, error) {
+	if err := validateReserves(liquidity); err != nil {
+		return Pool{}, sdkmath.Int{}, err
+	}
+
+	product := liquidity[0].Amount.Mul(liquidity[1].Amount)
+	shares := sdkmath.NewIntFromBigInt(new(big.Int).Sqrt(product.BigInt()))
+	if !shares.IsPositive() {
+		return Pool{}, sdkmath.Int{}, fmt.Errorf("liquidity %s mints no shares", liquidity)
+	}
+
+	return Pool{Id: id, Reserves: liquidity, TotalShares: shares}, shares, nil
+}
+
+// ShareDenom returns the denom of the share token of the pool.
+func (p Pool) ShareDenom() string {
+	return ShareDenom(p.Id)
+}
+
+// Denoms returns the two denoms of the pool, sorted.
+func (p Pool) Denoms() (string, string) {
+	return p.Reserves[0].Denom, p.Reserves[1].Denom
+}
+
+// Validate performs a basic validation of the pool.
+func (p Pool) Validate() error {
+	if err := validateReserves(p.Reserves); err != nil {
+		return fmt.Errorf("invalid reserves of pool %d: %w", p.Id, err)
+	}
+	if p.TotalShares.IsNil() || !p.TotalShares.IsPositive() {
+		return fmt.Errorf("total shares of pool %d must be positive", p.Id)
+	}
+
+	return nil
+}
+
+// Deposit returns the shares minted for a deposit of at most maxAmounts, and
+// the actual deposit: the amounts of the two denoms in proportion to the
+// reserves of the pool for these shares, rounded up.
+func (p Pool) Deposit(maxAmounts sdk.Coins) (sdkmath.Int, sdk.Coins, error) {
+	if !maxAmounts.IsValid() || len(maxAmounts) != 2 || !maxAmounts.DenomsSubsetOf(p.Reserves) {
+		return sdkmath.Int{}, nil, fmt.Errorf("deposit %s must be in the two denoms of pool %d", maxAmounts, p.Id)
+	}
+
+	shares := sdkmath.Int{}
+	for _, reserve := range p.Reserves {
+		s := maxAmounts.AmountOf(reserve.Denom).Mul(p.TotalShares).Quo(reserve.Amount)
+		if shares.IsNil() || s.LT(shares) {
+			shares = s
+		}
+	}
+	if !shares.IsPositive() {
+		return sdkmath.Int{}, nil, fmt.Errorf("deposit %s mints no shares", maxAmounts)
+	}
+
+	deposit := make(sdk.Coins, 0, len(p.Reserves))
+	for _, reserve := range p.Reserves {
+		amount := sdkmath.LegacyNewDecFromInt(shares.Mul(reserve.Amount)).QuoInt(p.TotalShares).Ceil().TruncateInt()
+		deposit = append(deposit, sdk.NewCoin(reserve.Denom, amount))
+	}
+
+	return shares, deposit, nil
+}
+
+// Withdraw returns the part of the reserves of the pool for the given shares,
+// rounded down.
+func (p Pool) Withdraw(shares sdkmath.Int) (sdk.Coins, error) {
+	if !shares.IsPositive() || shares.GT(p.TotalShares) {
+		return nil, fmt.Errorf("shares must be positive and at most the total shares %s of pool %d", p.TotalShares, p.Id)
+	}
+
+	withdrawal := sdk.NewCoins()
+	for _, reserve := range p.Reserves {
+		withdrawal = withdrawal.Add(sdk.NewCoin(reserve.Denom, reserve.Amount.Mul(shares).Quo(p.TotalShares)))
+	}
+
+	return withdrawal, nil
+}
+
+// SwapExactIn returns the amount of the other denom of the pool received for
+// tokenIn, such that the product of the reserves is kept once the swap fee is
+// deducted from tokenIn:
+//
+//	tokenOut = reserveOut * tokenIn * (1 - swapFee) / (reserveIn + tokenIn * (1 - swapFee))
+func (p Pool) SwapExactIn(tokenIn sdk.Coin, swapFee sdkmath.LegacyDec) (sdk.Coin, error) {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("invalid token in %s", tokenIn)
+	}
+
+	denomA, denomB := p.Denoms()
+	var reserveIn, reserveOut sdk.Coin
+	switch tokenIn.Denom {
+	case denomA:
+		reserveIn, reserveOut = p.Reserves[0], p.Reserves[1]
+	case denomB:
+		reserveIn, reserveOut = p.Reserves[1], p.Reserves[0]
+	default:
+		return sdk.Coin{}, fmt.Errorf("pool %d has no %s reserve", p.Id, tokenIn.Denom)
+	}
+
+	amountIn := sdkmath.LegacyNewDecFromInt(tokenIn.Amount).Mul(sdkmath.LegacyOneDec().Sub(swapFee))
+	amountOut := amountIn.MulInt(reserveOut.Amount).Quo(amountIn.Add(sdkmath.LegacyNewDecFromInt(reserveIn.Amount))).TruncateInt()
+	if !amountOut.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("token in %s is too small to swap", tokenIn)
+	}
+
+	return sdk.NewCoin(reserveOut.Denom, amountOut), nil
+}
+
+func validateReserves(reserves sdk.Coins) error {
+	if len(reserves) != 2 {
+		return fmt.Errorf("expected two denoms, got %s", reserves)
+	}
+
+	return reserves.Validate()
+}
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/amm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewPool(t *testing.T) {
+	pool, shares, err := types.NewPool(1, sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 1000)))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), shares)
+	require.Equal(t, shares, pool.TotalShares)
+	require.Equal(t, "amm/pool/1", pool.ShareDenom())
+	require.NoError(t, pool.Validate())
+
+	_, _, err = types.NewPool(1, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	require.Error(t, err)
+	_, _, err = types.NewPool(1, sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uosmo", 10)))
+	require.Error(t, err)
+}
+
+func TestPoolDeposit(t *testing.T) {
+	pool, _, err := types.NewPool(1, sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 700)))
+	require.NoError(t, err)
+
+	// shares are rounded down and the deposit rounded up, never above the
+	// maximum amounts
+	shares, deposit, err := pool.Deposit(sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(65), shares)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 43), sdk.NewInt64Coin("stake", 100)), deposit)
+
+	_, _, err = pool.Deposit(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	require.Error(t, err)
+	_, _, err = pool.Deposit(sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("uosmo", 100)))
+	require.Error(t, err)
+	_, _, err = pool.Deposit(sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 1)))
+	require.Error(t, err)
+}
+
+func TestPoolWithdraw(t *testing.T) {
+	pool, shares, err := types.NewPool(1, sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 700)))
+	require.NoError(t, err)
+
+	withdrawal, err := pool.Withdraw(shares)
+	require.NoError(t, err)
+	require.Equal(t, pool.Reserves, withdrawal)
+
+	withdrawal, err = pool.Withdraw(sdkmath.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), withdrawal)
+
+	_, err = pool.Withdraw(shares.AddRaw(1))
+	require.Error(t, err)
+	_, err = pool.Withdraw(sdkmath.ZeroInt())
+	require.Error(t, err)
+}
+
+func TestPoolSwapExactIn(t *testing.T) {
+	pool, _, err := types.NewPool(1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 1000)))
+	require.NoError(t, err)
+
+	out, err := pool.SwapExactIn(sdk.NewInt64Coin("atom", 1000), sdkmath.LegacyZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 500), out)
+
+	out, err = pool.SwapExactIn(sdk.NewInt64Coin("stake", 1000), sdkmath.LegacyNewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("atom", 333), out)
+
+	_, err = pool.SwapExactIn(sdk.NewInt64Coin("atom", 1), sdkmath.LegacyNewDecWithPrec(5, 1))
+	require.Error(t, err)
+	_, err = pool.SwapExactIn(sdk.NewInt64Coin("uosmo", 1000), sdkmath.LegacyZeroDec())
+	require.Error(t, err)
+}
@@ -258,6 +258,24 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 			false,
 			sdkerrors.ErrUnauthorized,
 		},
+		{
+			"new tx signed with account number 0",
+			func(suite *AnteTestSuite) TestCaseArgs {
+				accs := suite.CreateTestAccounts(1)
+				msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(nil)
+
+				return TestCaseArgs{
+					accNums: []uint64{0},
+					accSeqs: []uint64{0},
+					msgs:    []sdk.Msg{msg},
+					privs:   []cryptotypes.PrivKey{accs[0].priv},
+				}
+			},
+			false,
+			false,
+			sdkerrors.ErrWrongAccountNumber,
+		},
 		{
 			"new tx with another signer and incorrect account numbers",
 			func(suite *AnteTestSuite) TestCaseArgs {
@@ -906,6 +924,25 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 			false,
 			sdkerrors.ErrUnauthorized,
 		},
+		{
+			"test empty chainID",
+			func(suite *AnteTestSuite) TestCaseArgs {
+				suite.ctx = suite.ctx.WithChainID("test-chain")
+				accs := suite.CreateTestAccounts(1)
+				msg0 := testdata.NewTestMsg(accs[0].acc.GetAddress())
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+				return TestCaseArgs{
+					chainID:   "",
+					feeAmount: feeAmount,
+					gasLimit:  gasLimit,
+					msgs:      []sdk.Msg{msg0},
+				}.WithAccountsInfo(accs)
+			},
+			false,
+			false,
+			sdkerrors.ErrInvalidChainID,
+		},
 		{
 			"test wrong accSeqs",
 			func(suite *AnteTestSuite) TestCaseArgs {
@@ -1211,6 +1248,34 @@ func TestCountSubkeys(t *testing.T) {
 
 func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	testCases := []TestCase{
+		{
+			"test signatures at the limit",
+			func(suite *AnteTestSuite) TestCaseArgs {
+				accs := suite.CreateTestAccounts(7)
+				var (
+					addrs   []sdk.AccAddress
+					privs   []cryptotypes.PrivKey
+					accNums []uint64
+				)
+				for i := 0; i < 7; i++ {
+					addrs = append(addrs, accs[i].acc.GetAddress())
+					privs = append(privs, accs[i].priv)
+					accNums = append(accNums, accs[i].acc.GetAccountNumber())
+				}
+
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+				return TestCaseArgs{
+					accNums: accNums,
+					accSeqs: []uint64{0, 0, 0, 0, 0, 0, 0},
+					msgs:    []sdk.Msg{testdata.NewTestMsg(addrs...)},
+					privs:   privs,
+				}
+			},
+			false,
+			true,
+			nil,
+		},
 		{
 			"test rejection logic",
 			func(suite *AnteTestSuite) TestCaseArgs {
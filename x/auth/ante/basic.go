@@ -3,12 +3,9 @@ package ante
 import (
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
-	"cosmossdk.io/x/auth/migrations/legacytx"
 	authsigning "cosmossdk.io/x/auth/signing"
 
-	"github.com/cosmos/cosmos-sdk/codec/legacy"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -77,10 +74,14 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool,
 // slightly over estimated due to the fact that any given signing account may need
 // to be retrieved from state.
 //
+// In simulate mode, the size of the signatures missing from the tx is estimated
+// with the SimulationSignatureSize param, for each signature of the signing key:
+// one for a single key, and as many as its threshold for a multisig. When the tx
+// does not carry the public key of a signer, the size of the public key of its
+// account, if any, is added as well.
+//
 // CONTRACT: If exec mode = simulate, then signatures must either be completely filled
 // in or empty.
-// CONTRACT: To use this decorator, signatures of transaction must be represented
-// as legacytx.StdSignature otherwise simulate mode will incorrectly estimate gas cost.
 type ConsumeTxSizeGasDecorator struct {
 	ak AccountKeeper
 }
@@ -107,37 +108,42 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ b
 		if err != nil {
 			return ctx, err
 		}
-		n := len(sigs)
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return ctx, err
+		}
 
-		for i, signer := range sigs {
+		for i, sig := range sigs {
 			// if signature is already filled in, no need to simulate gas cost
-			if i < n && !isIncompleteSignature(sigs[i].Data) {
+			if !isIncompleteSignature(sig.Data) {
 				continue
 			}
 
-			var pubkey cryptotypes.PubKey
-
-			// use placeholder simSecp256k1Pubkey if sig is nil
-			if signer.PubKey == nil {
-				pubkey = simSecp256k1Pubkey
-			} else {
-				pubkey = signer.PubKey
+			var cost storetypes.Gas
+			pubkey := sig.PubKey
+			if pubkey == nil {
+				// the signed tx will carry the pubkey of the signer, use the one
+				// of its account if known, or the placeholder simSecp256k1Pubkey.
+				// The signed tx does not read the account here, so neither does
+				// the gas meter of the simulation.
+				if i < len(signers) {
+					infiniteGasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+					if acc := cgts.ak.GetAccount(infiniteGasCtx, signers[i]); acc != nil {
+						pubkey = acc.GetPubKey()
+					}
+				}
+				if pubkey == nil {
+					pubkey = simSecp256k1Pubkey
+				}
+
+				pkAny, err := codectypes.NewAnyWithValue(pubkey)
+				if err != nil {
+					return ctx, err
+				}
+				cost += storetypes.Gas(pkAny.Size() + 2)
 			}
 
-			// use stdsignature to mock the size of a full signature
-			simSig := legacytx.StdSignature{ //nolint:staticcheck // SA1019: legacytx.StdSignature is deprecated
-				Signature: simSecp256k1Sig[:],
-				PubKey:    pubkey,
-			}
-
-			sigBz := legacy.Cdc.MustMarshal(simSig)
-			cost := storetypes.Gas(len(sigBz) + 6)
-
-			// If the pubkey is a multi-signature pubkey, then we estimate for the maximum
-			// number of signers.
-			if _, ok := pubkey.(*multisig.LegacyAminoPubKey); ok {
-				cost *= params.TxSigLimit
-			}
+			cost += storetypes.Gas(countSignatures(simulatedSignatureData(pubkey))) * params.SimulationSignatureSize
 
 			ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*cost, "txSize")
 		}
@@ -8,7 +8,9 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
 
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -92,6 +94,20 @@ func TestValidateMemo(t *testing.T) {
 	// require small memos pass ValidateMemo Decorator
 	_, err = antehandler(suite.ctx, validTx, false)
 	require.Nil(t, err, "ValidateBasicDecorator returned error on valid tx. err: %v", err)
+
+	// require memos of exactly MaxMemoCharacters to pass, and longer ones to be rejected
+	maxMemoCharacters := int(suite.accountKeeper.GetParams(suite.ctx).MaxMemoCharacters)
+	suite.txBuilder.SetMemo(strings.Repeat("a", maxMemoCharacters))
+	limitTx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, limitTx, false)
+	require.NoError(t, err)
+
+	suite.txBuilder.SetMemo(strings.Repeat("a", maxMemoCharacters+1))
+	overLimitTx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, overLimitTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrMemoTooLarge)
 }
 
 func TestConsumeGasForTxSize(t *testing.T) {
@@ -179,6 +195,55 @@ func TestConsumeGasForTxSize(t *testing.T) {
 	}
 }
 
+func TestConsumeGasForTxSizeSimulationSignatureSize(t *testing.T) {
+	_, pubKey1, _ := testdata.KeyTestPubAddr()
+	_, pubKey2, _ := testdata.KeyTestPubAddr()
+	_, pubKey3, _ := testdata.KeyTestPubAddr()
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pubKey1, pubKey2, pubKey3})
+
+	testCases := []struct {
+		name          string
+		sigV2         signing.SignatureV2
+		numSignatures uint64
+	}{
+		{"single key", signing.SignatureV2{PubKey: pubKey1, Data: &signing.SingleSignatureData{}}, 1},
+		{"2-of-3 multisig", signing.SignatureV2{PubKey: multisigPubKey, Data: multisig.NewMultisig(3)}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// simulatedGas returns the gas consumed by the decorator in simulate mode,
+			// given the estimated size of each signature
+			simulatedGas := func(simulationSignatureSize uint64) storetypes.Gas {
+				suite := SetupTestSuite(t, true)
+				params := suite.accountKeeper.GetParams(suite.ctx)
+				params.SimulationSignatureSize = simulationSignatureSize
+				require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(tc.sigV2.PubKey.Address()))))
+				require.NoError(t, txBuilder.SetSignatures(tc.sigV2))
+				tx := txBuilder.GetTx()
+				txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+				require.NoError(t, err)
+
+				ctx := suite.ctx.WithTxBytes(txBytes).WithExecMode(sdk.ExecModeSimulate)
+				antehandler := sdk.ChainAnteDecorators(ante.NewConsumeGasForTxSizeDecorator(suite.accountKeeper))
+				beforeGas := ctx.GasMeter().GasConsumed()
+				ctx, err = antehandler(ctx, tx, true)
+				require.NoError(t, err)
+				return ctx.GasMeter().GasConsumed() - beforeGas
+			}
+
+			// both sizes are encoded in the same number of bytes, so that reading
+			// the params costs the same gas
+			gas1, gas101 := simulatedGas(1), simulatedGas(101)
+			expectedGas := tc.numSignatures * 100 * authtypes.DefaultTxSizeCostPerByte
+			require.Equal(t, expectedGas, gas101-gas1)
+		})
+	}
+}
+
 func TestTxHeightTimeoutDecorator(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 
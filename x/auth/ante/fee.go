@@ -2,9 +2,13 @@ package ante
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -49,12 +53,16 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
 	}
 
+	fee := feeTx.GetFee()
+	if err := checkAllowedFeeDenoms(dfd.accountKeeper.GetParams(ctx), fee); err != nil {
+		return ctx, err
+	}
+
 	var (
 		priority int64
 		err      error
 	)
 
-	fee := feeTx.GetFee()
 	if ctx.ExecMode() != sdk.ExecModeSimulate {
 		fee, priority, err = dfd.txFeeChecker(ctx, tx)
 		if err != nil {
@@ -65,11 +73,34 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	newCtx := ctx.WithPriority(priority).WithValue(deductedFeeKey{}, fee)
 
 	return next(newCtx, tx, ctx.ExecMode() == sdk.ExecModeSimulate)
 }
 
+// deductedFeeKey is the context key of the fee deducted by the DeductFeeDecorator.
+type deductedFeeKey struct{}
+
+// DeductedFee returns the fee deducted by the DeductFeeDecorator of the transaction,
+// which is the fee returned by its TxFeeChecker and may differ from the fee of the
+// transaction, or nil if the DeductFeeDecorator did not run.
+func DeductedFee(ctx context.Context) sdk.Coins {
+	fee, _ := ctx.Value(deductedFeeKey{}).(sdk.Coins)
+	return fee
+}
+
+// checkAllowedFeeDenoms returns an error if the fee is paid in a denom that is not
+// in the AllowedFeeDenoms param.
+func checkAllowedFeeDenoms(params types.Params, fee sdk.Coins) error {
+	for _, coin := range fee {
+		if !params.IsAllowedFeeDenom(coin.Denom) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee denom %s is not allowed, allowed fee denoms: %s", coin.Denom, strings.Join(params.AllowedFeeDenoms, ", "))
+		}
+	}
+
+	return nil
+}
+
 func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
@@ -103,6 +134,10 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	// deduct the fees
 	if !fee.IsZero() {
+		if err := dfd.checkLockedFees(ctx, deductFeesFrom, fee); err != nil {
+			return err
+		}
+
 		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFrom, fee)
 		if err != nil {
 			return err
@@ -121,6 +156,33 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	return nil
 }
 
+// vestingAccount is implemented by vesting accounts, whose locked coins cannot
+// pay fees.
+type vestingAccount interface {
+	LockedCoins(blockTime time.Time) sdk.Coins
+}
+
+// checkLockedFees returns an insufficient funds error if the fee payer is a
+// vesting account whose spendable coins, its balance minus its locked coins at
+// the block time, do not cover the fee.
+func (dfd DeductFeeDecorator) checkLockedFees(ctx sdk.Context, feePayer sdk.AccAddress, fee sdk.Coins) error {
+	vacc, ok := dfd.accountKeeper.GetAccount(ctx, feePayer).(vestingAccount)
+	if !ok {
+		return nil
+	}
+
+	locked := vacc.LockedCoins(ctx.HeaderInfo().Time)
+	for _, coin := range fee {
+		balance := dfd.bankKeeper.GetBalance(ctx, feePayer, coin.Denom)
+		spendable := balance.Amount.Sub(locked.AmountOf(coin.Denom))
+		if spendable.LT(coin.Amount) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "locked coins cannot pay fees: spendable %s%s is smaller than %s", math.MaxInt(spendable, math.ZeroInt()), coin.Denom, coin)
+		}
+	}
+
+	return nil
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -2,13 +2,16 @@ package ante_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -105,6 +108,73 @@ func TestEnsureMempoolFees(t *testing.T) {
 	require.Equal(t, int64(10), newCtx.Priority())
 }
 
+func TestDeductFeeDecorator_AllowedFeeDenoms(t *testing.T) {
+	testCases := []struct {
+		name             string
+		allowedFeeDenoms []string
+		fee              sdk.Coins
+		expErr           string
+	}{
+		{
+			name: "all denoms allowed",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("dust", 1)),
+		},
+		{
+			name:             "allowed denoms",
+			allowedFeeDenoms: []string{"atom", "stake"},
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 10)),
+		},
+		{
+			name:             "one disallowed denom",
+			allowedFeeDenoms: []string{"atom", "stake"},
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("dust", 1)),
+			expErr:           "fee denom dust is not allowed, allowed fee denoms: atom, stake: invalid coins",
+		},
+		{
+			name:             "no fee",
+			allowedFeeDenoms: []string{"atom"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := SetupTestSuite(t, true)
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+			params := s.accountKeeper.GetParams(s.ctx)
+			params.AllowedFeeDenoms = tc.allowedFeeDenoms
+			require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+			mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+			antehandler := sdk.ChainAnteDecorators(mfd)
+
+			accs := s.CreateTestAccounts(1)
+			require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+			s.txBuilder.SetFeeAmount(tc.fee)
+			s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+			tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			if tc.expErr == "" && !tc.fee.IsZero() {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, tc.fee).Return(nil).Times(2)
+			}
+
+			// the fee denoms are checked in simulation mode as well
+			for _, execMode := range []sdk.ExecMode{sdk.ExecModeFinalize, sdk.ExecModeSimulate} {
+				_, err = antehandler(s.ctx.WithExecMode(execMode), tx, execMode == sdk.ExecModeSimulate)
+				if tc.expErr != "" {
+					require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+					require.EqualError(t, err, tc.expErr)
+					continue
+				}
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDeductFees(t *testing.T) {
 	s := SetupTestSuite(t, false)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
@@ -137,3 +207,84 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeesVestingAccount(t *testing.T) {
+	start := time.Unix(1000, 0)
+	end := time.Unix(3000, 0)
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	testCases := map[string]struct {
+		newAccount func(*authtypes.BaseAccount) (sdk.AccountI, error)
+		blockTime  time.Time
+		fee        sdk.Coins
+		expErr     bool
+	}{
+		"delayed vesting account before maturity": {
+			newAccount: func(bacc *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewDelayedVestingAccount(bacc, stake(100), end.Unix())
+			},
+			blockTime: start,
+			fee:       stake(10),
+			expErr:    true,
+		},
+		"delayed vesting account after maturity": {
+			newAccount: func(bacc *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewDelayedVestingAccount(bacc, stake(100), end.Unix())
+			},
+			blockTime: end,
+			fee:       stake(10),
+		},
+		"continuous vesting account halfway, fee exceeding the vested coins": {
+			newAccount: func(bacc *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewContinuousVestingAccount(bacc, stake(100), start.Unix(), end.Unix())
+			},
+			blockTime: time.Unix(2000, 0),
+			fee:       stake(100),
+			expErr:    true,
+		},
+		"continuous vesting account halfway, fee within the vested coins": {
+			newAccount: func(bacc *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewContinuousVestingAccount(bacc, stake(100), start.Unix(), end.Unix())
+			},
+			blockTime: time.Unix(2000, 0),
+			fee:       stake(50),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := SetupTestSuite(t, false)
+			vestingtypes.RegisterInterfaces(s.encCfg.InterfaceRegistry)
+			s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: tc.blockTime})
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+			accs := s.CreateTestAccounts(1)
+			vacc, err := tc.newAccount(accs[0].acc.(*authtypes.BaseAccount))
+			require.NoError(t, err)
+			s.accountKeeper.SetAccount(s.ctx, vacc)
+
+			require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(vacc.GetAddress())))
+			s.txBuilder.SetFeeAmount(tc.fee)
+			s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+			tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			// the account holds its whole original vesting
+			s.bankKeeper.EXPECT().GetBalance(gomock.Any(), vacc.GetAddress(), "stake").Return(sdk.NewInt64Coin("stake", 100))
+			if !tc.expErr {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), vacc.GetAddress(), authtypes.FeeCollectorName, tc.fee).Return(nil)
+			}
+
+			dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil)
+			_, err = sdk.ChainAnteDecorators(dfd)(s.ctx, tx, false)
+			if tc.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+				require.Contains(t, err.Error(), "locked coins cannot pay fees")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -280,14 +280,21 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 	pubKey cryptotypes.PubKey,
 	signature signing.SignatureV2,
 ) error {
-	if ctx.ExecMode() == sdk.ExecModeSimulate && pubKey == nil {
-		pubKey = simSecp256k1Pubkey
+	data := signature.Data
+	if ctx.ExecMode() == sdk.ExecModeSimulate {
+		if pubKey == nil {
+			pubKey = simSecp256k1Pubkey
+		}
+		// consume the gas of the complete signature the tx will be signed with
+		if isIncompleteSignature(data) {
+			data = simulatedSignatureData(pubKey)
+		}
 	}
 
-	// make a SignatureV2 with PubKey filled in from above
+	// make a SignatureV2 with PubKey and Data filled in from above
 	signature = signing.SignatureV2{
 		PubKey:   pubKey,
-		Data:     signature.Data,
+		Data:     data,
 		Sequence: signature.Sequence,
 	}
 
@@ -356,6 +363,10 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 	txData := adaptableTx.GetSigningTxData()
 	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 	if err != nil {
+		if diagErr := svd.diagnoseSigMismatch(ctx, pubKey, signerData, sig, txData); diagErr != nil {
+			return diagErr
+		}
+
 		var errMsg string
 		if OnlyLegacyAminoSigners(sig.Data) {
 			// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
@@ -370,6 +381,65 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 	return nil
 }
 
+// diagnoseSigMismatch is called when a signature fails verification against the
+// expected signer data, and returns a precise error if the signature is valid for
+// a commonly mistaken account number or chain-id. The sequence is carried by the
+// tx and checked against the account before the signature is verified, but the
+// account number and chain-id are only signed over, so a mismatch with the
+// stored account number or the chain-id can only be detected by verifying the
+// signature again. This is done for exactly two cases:
+//   - the account number 0, used for accounts that do not exist yet, with the
+//     expected chain-id, failing with ErrWrongAccountNumber;
+//   - the empty chain-id, used when none is set, with the expected account
+//     number, failing with ErrInvalidChainID.
+//
+// Any other account number or chain-id, or both being wrong at once, is not
+// detected, and nil is returned so that the mismatch is reported as a generic
+// signature verification failure. Only single key signatures are diagnosed, and
+// each verification consumes the signature verification gas on the tx gas
+// meter, so that a failed signature pays for the work it takes.
+func (svd SigVerificationDecorator) diagnoseSigMismatch(
+	ctx sdk.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	sig signing.SignatureV2,
+	txData txsigning.TxData,
+) error {
+	if _, ok := sig.Data.(*signing.SingleSignatureData); !ok {
+		return nil
+	}
+
+	if signerData.AccountNumber != 0 {
+		if err := svd.consumeSignatureGas(ctx, pubKey, sig); err != nil {
+			return err
+		}
+		wrongAccNum := signerData
+		wrongAccNum.AccountNumber = 0
+		if authsigning.VerifySignature(ctx, pubKey, wrongAccNum, sig.Data, svd.signModeHandler, txData) == nil {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrWrongAccountNumber,
+				"account number mismatch, expected %d, got %d", signerData.AccountNumber, wrongAccNum.AccountNumber,
+			)
+		}
+	}
+
+	if signerData.ChainID != "" {
+		if err := svd.consumeSignatureGas(ctx, pubKey, sig); err != nil {
+			return err
+		}
+		wrongChainID := signerData
+		wrongChainID.ChainID = ""
+		if authsigning.VerifySignature(ctx, pubKey, wrongChainID, sig.Data, svd.signModeHandler, txData) == nil {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidChainID,
+				"chain-id mismatch, expected %s, got an empty chain-id", signerData.ChainID,
+			)
+		}
+	}
+
+	return nil
+}
+
 // setPubKey will attempt to set the pubkey for the account given the list of available public keys.
 // This must be called only in case the account has not a pubkey set yet.
 func (svd SigVerificationDecorator) setPubKey(ctx sdk.Context, acc sdk.AccountI, txPubKey cryptotypes.PubKey) error {
@@ -520,6 +590,8 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 }
 
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubKey signature.
+// Each signature of the multisig costs the verification cost of its key, plus the
+// SigVerifyCostMultisigPerSignature param.
 func ConsumeMultisignatureVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubKey multisig.PubKey,
 	params types.Params, accSeq uint64,
@@ -536,6 +608,7 @@ func ConsumeMultisignatureVerificationGas(
 		if !sig.BitArray.GetIndex(i) {
 			continue
 		}
+		meter.ConsumeGas(params.SigVerifyCostMultisigPerSignature, "ante verify: multisig signature")
 		sigV2 := signing.SignatureV2{
 			PubKey:   pubKey.GetPubKeys()[i],
 			Data:     sig.Signatures[sigIndex],
@@ -560,6 +633,7 @@ func multisignatureSimulationVerificationGas(
 	params types.Params, accSeq uint64,
 ) error {
 	for i := 0; i < len(sig.Signatures); i++ {
+		meter.ConsumeGas(params.SigVerifyCostMultisigPerSignature, "ante verify: multisig signature")
 		sigV2 := signing.SignatureV2{
 			PubKey:   pubKey.GetPubKeys()[i],
 			Data:     sig.Signatures[i],
@@ -575,6 +649,40 @@ func multisignatureSimulationVerificationGas(
 	return nil
 }
 
+// simulatedSignatureData returns placeholder signature data for a signature of the
+// given key, used in simulate mode in place of the missing signatures. A multisig is
+// signed by as many of its keys as its threshold.
+func simulatedSignatureData(pubKey cryptotypes.PubKey) signing.SignatureData {
+	multisigPubKey, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return &signing.SingleSignatureData{Signature: simSecp256k1Sig[:]}
+	}
+
+	keys := multisigPubKey.GetPubKeys()
+	data := multisig.NewMultisig(len(keys))
+	for i := 0; i < int(multisigPubKey.Threshold) && i < len(keys); i++ {
+		multisig.AddSignature(data, simulatedSignatureData(keys[i]), i)
+	}
+
+	return data
+}
+
+// countSignatures returns the number of single signatures of the signature data,
+// counting recursively those of a multisig.
+func countSignatures(data signing.SignatureData) int {
+	multiData, ok := data.(*signing.MultiSignatureData)
+	if !ok {
+		return 1
+	}
+
+	n := 0
+	for _, sig := range multiData.Signatures {
+		n += countSignatures(sig)
+	}
+
+	return n
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) sdk.AccountI {
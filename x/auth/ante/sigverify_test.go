@@ -10,6 +10,7 @@ import (
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/keeper"
 	"cosmossdk.io/x/auth/migrations/legacytx"
 	authsign "cosmossdk.io/x/auth/signing"
 	authtx "cosmossdk.io/x/auth/tx"
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -39,7 +41,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
-	expectedCost1 := expectedGasCostByKeys(pkSet1)
+	expectedCost1 := expectedGasCostByKeys(pkSet1) + uint64(len(pkSet1))*p.SigVerifyCostMultisigPerSignature
 	for i := 0; i < len(pkSet1); i++ {
 		stdSig := legacytx.StdSignature{PubKey: pkSet1[i], Signature: sigSet1[i]} //nolint:staticcheck // SA1019: legacytx.StdSignature is deprecated
 		sigV2, err := legacytx.StdSignatureToSignatureV2(suite.clientCtx.LegacyAmino, stdSig)
@@ -48,7 +50,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	simulationExpectedCost := expectedGasCostByKeys(pkSet1[:multisigKey1.Threshold])
+	simulationExpectedCost := expectedGasCostByKeys(pkSet1[:multisigKey1.Threshold]) + uint64(multisigKey1.Threshold)*p.SigVerifyCostMultisigPerSignature
 	simulationMultiSignatureData := make([]signing.SignatureData, 0, multisigKey1.Threshold)
 	for i := uint32(0); i < multisigKey1.Threshold; i++ {
 		simulationMultiSignatureData = append(simulationMultiSignatureData, &signing.SingleSignatureData{})
@@ -236,6 +238,86 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestConsumeMultisignatureVerificationGas(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	msg := []byte{1, 2, 3, 4}
+
+	// a 7-of-10 multisig signed by 7 of its keys
+	pubKeys, sigs := generatePubKeysAndSignatures(10, msg, false)
+	multisigKey := kmultisig.NewLegacyAminoPubKey(7, pubKeys)
+	multisignature := multisig.NewMultisig(len(pubKeys))
+	for i := 0; i < 7; i++ {
+		stdSig := legacytx.StdSignature{PubKey: pubKeys[i], Signature: sigs[i]} //nolint:staticcheck // SA1019: legacytx.StdSignature is deprecated
+		sigV2, err := legacytx.StdSignatureToSignatureV2(suite.clientCtx.LegacyAmino, stdSig)
+		require.NoError(t, err)
+		require.NoError(t, multisig.AddSignatureV2(multisignature, sigV2, pubKeys))
+	}
+	sigV2 := signing.SignatureV2{PubKey: multisigKey, Data: multisignature}
+
+	params := types.DefaultParams()
+	meter := storetypes.NewInfiniteGasMeter()
+	require.NoError(t, ante.DefaultSigVerificationGasConsumer(meter, sigV2, params))
+	require.Equal(t, 7*(params.SigVerifyCostSecp256k1+params.SigVerifyCostMultisigPerSignature), meter.GasConsumed())
+
+	// the costs are read from the given params
+	params.SigVerifyCostSecp256k1 *= 2
+	params.SigVerifyCostMultisigPerSignature = 500
+	meter = storetypes.NewInfiniteGasMeter()
+	require.NoError(t, ante.DefaultSigVerificationGasConsumer(meter, sigV2, params))
+	require.Equal(t, 7*(2*types.DefaultSigVerifyCostSecp256k1+500), meter.GasConsumed())
+}
+
+func TestSigVerificationAfterPubKeyRotation(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithIsSigverifyTx(true)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	newPriv := secp256k1.GenPrivKey()
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(priv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	msg, err := types.NewMsgRotatePubKey(addr.String(), newPriv.PubKey())
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(suite.accountKeeper).RotatePubKey(suite.ctx, msg)
+	require.NoError(t, err)
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	// the signatures are verified against the stored public key, not the one
+	// whose address is the account address
+	for _, tc := range []struct {
+		name      string
+		priv      cryptotypes.PrivKey
+		shouldErr bool
+	}{
+		{"previous key", priv, true},
+		{"new key", newPriv, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(ctx, []cryptotypes.PrivKey{tc.priv}, []uint64{acc.GetAccountNumber()}, []uint64{1}, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			_, err = antehandler(ctx.WithTxBytes(txBytes), tx, false)
+			if tc.shouldErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -401,3 +483,89 @@ func TestAnteHandlerChecks(t *testing.T) {
 		})
 	}
 }
+
+func TestSigVerificationMismatchGas(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithIsSigverifyTx(true).WithChainID("test-chain")
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(priv.PubKey()))
+	require.NoError(t, acc.SetAccountNumber(7))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	// the gas consumer counts the signature verifications, each consuming
+	// 1000 gas
+	var verifications int
+	countingGasConsumer := func(meter storetypes.GasMeter, _ signing.SignatureV2, _ types.Params) error {
+		verifications++
+		meter.ConsumeGas(1000, "signature verification")
+		return nil
+	}
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), countingGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	newTx := func(ctx sdk.Context, accNum uint64, chainID string) sdk.Context {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(ctx, []cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{0}, chainID, signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return ctx.WithTxBytes(txBytes)
+	}
+
+	// the gas consumed by the last case, a mismatch not diagnosed
+	var mismatchGas storetypes.Gas
+	for _, tc := range []struct {
+		name             string
+		accNum           uint64
+		chainID          string
+		expErr           error
+		expVerifications int
+	}{
+		{"valid signature", 7, "test-chain", nil, 1},
+		{"account number 0", 0, "test-chain", sdkerrors.ErrWrongAccountNumber, 2},
+		{"empty chain-id", 7, "", sdkerrors.ErrInvalidChainID, 3},
+		{"account number 0 and empty chain-id", 0, "", sdkerrors.ErrUnauthorized, 3},
+		{"other mismatch", 8, "other-chain", sdkerrors.ErrUnauthorized, 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			verifications = 0
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = newTx(ctx, tc.accNum, tc.chainID)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			_, err := antehandler(ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			// each verification made to diagnose a mismatch consumes the
+			// signature verification gas on the tx gas meter
+			require.Equal(t, tc.expVerifications, verifications)
+			require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), storetypes.Gas(1000*tc.expVerifications))
+			mismatchGas = ctx.GasMeter().GasConsumed()
+		})
+	}
+
+	// the diagnosis runs out of gas once the gas limit is reached, in its last
+	// verification
+	verifications = 0
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = newTx(ctx, 8, "other-chain")
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(mismatchGas - 500))
+	require.Panics(t, func() {
+		defer func() {
+			_, ok := recover().(storetypes.ErrorOutOfGas)
+			require.True(t, ok)
+			panic("out of gas")
+		}()
+		_, _ = antehandler(ctx, suite.txBuilder.GetTx(), false)
+	})
+	require.Equal(t, 3, verifications)
+}
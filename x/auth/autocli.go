@@ -21,6 +21,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Accounts",
 					Use:       "accounts",
 					Short:     "Query all the accounts",
+					Long:      "Query all the accounts, paginated with --page, --limit and --offset. The total number of matching accounts is returned with --page-count-total, and --vesting-only restricts the accounts to the vesting accounts.",
 				},
 				{
 					RpcMethod:      "Account",
@@ -83,10 +84,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
 					Short:          "Submit a proposal to update auth module params. Note: the entire params must be provided.",
-					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0 }'`, version.AppName),
+					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0, "max_pub_key_rotations": 0, "pub_key_rotation_gas_cost": 0, "sig_verify_cost_multisig_per_signature": 0, "simulation_signature_size": 0, "allowed_fee_denoms": [], "max_refund_rate": "0" }'`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pubkey [address] [new-pubkey]",
+					Short:          "Replace the public key of an account, signing with its current key. Note: the account sequence is increased, and the transactions of the account must then be signed with the new key",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pubkey mykey {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtDcuH4cX1eaxZrJ5shheLG3tXPAoV4awoIZmNQtQxmf"}`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "new_pub_key"}},
+				},
 			},
 		},
 	}
@@ -18,7 +18,11 @@ func GetDecodeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "decode [protobuf-byte-string]",
 		Short: "Decode a binary encoded transaction string",
-		Args:  cobra.ExactArgs(1),
+		Long: `Decode a transaction encoded with the encode command, or taken from the mempool or block results,
+and print it as JSON with its messages, fee, memo and signatures.
+Nodes serve the same decoding on the POST /cosmos/tx/v1beta1/decode endpoint, and on
+POST /cosmos/tx/v1beta1/decode/amino for Amino-encoded transactions.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			var txBytes []byte
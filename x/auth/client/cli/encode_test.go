@@ -1,8 +1,10 @@
 package cli_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,9 +14,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	_ "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestGetCommandEncode(t *testing.T) {
@@ -81,3 +87,59 @@ func TestGetCommandDecode(t *testing.T) {
 	cmd.SetArgs([]string{base64Encoded})
 	require.NoError(t, cmd.ExecuteContext(ctx))
 }
+
+func TestGetCommandEncodeDecodeRoundTrip(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txConfig := encodingConfig.TxConfig
+
+	// Build a signed transaction with several messages
+	priv1, priv2 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr1, addr2 := sdk.AccAddress(priv1.PubKey().Address()), sdk.AccAddress(priv2.PubKey().Address())
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr2), testdata.NewTestMsg(addr1, addr2)))
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	builder.SetMemo("foomemo")
+	builder.SetTimeoutHeight(100)
+	require.NoError(t, builder.SetSignatures(
+		signing.SignatureV2{PubKey: priv1.PubKey(), Data: &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature1")}, Sequence: 3},
+		signing.SignatureV2{PubKey: priv2.PubKey(), Data: &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte("signature2")}, Sequence: 7},
+	))
+	jsonEncoded, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	run := func(cmdArgs ...string) (string, error) {
+		var out bytes.Buffer
+		clientCtx := client.Context{}.
+			WithTxConfig(txConfig).
+			WithCodec(encodingConfig.Codec).
+			WithOutput(&out)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		cmd := cli.GetEncodeCommand()
+		if cmdArgs[0] == "decode" {
+			cmd = cli.GetDecodeCommand()
+		}
+		_ = testutil.ApplyMockIODiscardOutErr(cmd)
+		cmd.SetArgs(cmdArgs[1:])
+		err := cmd.ExecuteContext(ctx)
+		return strings.TrimSpace(out.String()), err
+	}
+
+	// encode the JSON transaction, then decode it back
+	txFile := testutil.WriteToNewTempFile(t, string(jsonEncoded))
+	encoded, err := run("encode", txFile.Name())
+	require.NoError(t, err)
+
+	decoded, err := run("decode", encoded)
+	require.NoError(t, err)
+	require.Equal(t, string(jsonEncoded), decoded)
+
+	// invalid input is rejected with an error
+	_, err = run("decode", "not base64!")
+	require.ErrorContains(t, err, "illegal base64 data")
+
+	_, err = run("decode", base64.StdEncoding.EncodeToString([]byte("not a transaction")))
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	authclient "cosmossdk.io/x/auth/client"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// GetSignBytesCommand returns the sign-bytes command to take a JSONified transaction and
+// output the canonical bytes to sign with SIGN_MODE_LEGACY_AMINO_JSON, as hardware wallets do.
+func GetSignBytesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-bytes [file]",
+		Short: "Output the canonical sign bytes of a transaction generated offline",
+		Long: `Output the canonical sign bytes of a transaction created with the --generate-only flag.
+Read a transaction from [file] and output the canonical JSON bytes signed for it in
+SIGN_MODE_LEGACY_AMINO_JSON, the sign mode of hardware wallets, by the account with the
+given account number and sequence, along with the SHA256 hash of these bytes. Both are
+encoded as base64.
+If you supply a dash (-) argument in place of an input filename, the command reads from standard input.`,
+		Example: "$ <appd> tx sign-bytes tx.json --chain-id=test-chain --account-number=1 --sequence=0",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID == "" {
+				return errors.New("a chain-id is required to compute the sign bytes")
+			}
+
+			accNum, err := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+			if err != nil {
+				return err
+			}
+			sequence, err := cmd.Flags().GetUint64(flags.FlagSequence)
+			if err != nil {
+				return err
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			signBytes, err := authsigning.GetLegacyAminoJSONSignBytes(
+				cmd.Context(), clientCtx.TxConfig.SignModeHandler(), clientCtx.ChainID, accNum, sequence, tx,
+			)
+			if err != nil {
+				return err
+			}
+
+			hash := sha256.Sum256(signBytes)
+			return clientCtx.PrintProto(&txtypes.TxSignBytesResponse{
+				SignBytes: signBytes,
+				Sha256:    hash[:],
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().Uint64P(flags.FlagAccountNumber, "a", 0, "The account number of the signing account")
+	cmd.Flags().Uint64P(flags.FlagSequence, "s", 0, "The sequence number of the signing account")
+	_ = cmd.MarkFlagRequired(flags.FlagAccountNumber)
+	_ = cmd.MarkFlagRequired(flags.FlagSequence)
+
+	return cmd
+}
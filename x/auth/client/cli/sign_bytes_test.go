@@ -0,0 +1,116 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+
+	"cosmossdk.io/x/auth"
+	"cosmossdk.io/x/auth/client/cli"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestGetCommandSignBytes(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	stakingtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txConfig := encodingConfig.TxConfig
+
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	val := sdk.ValAddress([]byte("validator___________"))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 150))
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		fee    sdk.Coins
+		memo   string
+		golden string
+	}{
+		{
+			"bank send",
+			banktypes.NewMsgSend(from.String(), to.String(), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			fee,
+			"foomemo",
+			"sign-bytes-send.golden",
+		},
+		{
+			"staking delegate",
+			stakingtypes.NewMsgDelegate(from.String(), val.String(), sdk.NewInt64Coin("stake", 1000)),
+			fee,
+			"",
+			"sign-bytes-delegate.golden",
+		},
+		{
+			"staking undelegate with an empty fee",
+			stakingtypes.NewMsgUndelegate(from.String(), val.String(), sdk.NewInt64Coin("stake", 500)),
+			sdk.NewCoins(),
+			"",
+			"sign-bytes-undelegate.golden",
+		},
+	}
+
+	run := func(txFileName string, cmdArgs ...string) (*txtypes.TxSignBytesResponse, error) {
+		var out bytes.Buffer
+		clientCtx := client.Context{}.
+			WithTxConfig(txConfig).
+			WithCodec(encodingConfig.Codec).
+			WithOutput(&out)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		cmd := cli.GetSignBytesCommand()
+		_ = testutil.ApplyMockIODiscardOutErr(cmd)
+		cmd.SetArgs(append([]string{txFileName}, cmdArgs...))
+		if err := cmd.ExecuteContext(ctx); err != nil {
+			return nil, err
+		}
+
+		var res txtypes.TxSignBytesResponse
+		require.NoError(t, encodingConfig.Codec.UnmarshalJSON(out.Bytes(), &res))
+		return &res, nil
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			builder.SetGasLimit(200000)
+			builder.SetFeeAmount(tc.fee)
+			builder.SetMemo(tc.memo)
+			jsonEncoded, err := txConfig.TxJSONEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			txFile := testutil.WriteToNewTempFile(t, string(jsonEncoded))
+
+			res, err := run(txFile.Name(), "--chain-id=test-chain", "--account-number=7", "--sequence=3")
+			require.NoError(t, err)
+			golden.Assert(t, string(res.SignBytes), tc.golden)
+			hash := sha256.Sum256(res.SignBytes)
+			require.Equal(t, hash[:], res.Sha256)
+		})
+	}
+
+	// the chain-id, account number and sequence are required
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testCases[0].msg))
+	jsonEncoded, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txFile := testutil.WriteToNewTempFile(t, string(jsonEncoded))
+
+	_, err = run(txFile.Name(), "--account-number=7", "--sequence=3")
+	require.ErrorContains(t, err, "a chain-id is required")
+	_, err = run(txFile.Name(), "--chain-id=test-chain", "--sequence=3")
+	require.ErrorContains(t, err, "account-number")
+}
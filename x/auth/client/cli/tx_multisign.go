@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -37,6 +40,12 @@ func GetMultiSignCommand() *cobra.Command {
 Read one or more signatures from one or more [signature] file, generate a multisig signature compliant to the
 multisig key [name], and attach the key name to the transaction read from [file].
 
+The signature files can be given in any order. Each signature is checked against the transaction, its chain-id,
+account number and sequence before the multisig signature is generated, and the command fails on the first invalid
+signature, naming its signer and file. If more signatures than the threshold of the multisig key are given, the
+signatures of its first keys, in the order of the multisig key, are used. Either [file] or one [signature] can be
+"-" to read it from stdin.
+
 Example:
 $ %s tx multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
 
@@ -71,6 +80,9 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 		name := args[1]
 		sigsRaw := args[2:]
 		_ = cmd.Flags().Set(flags.FlagFrom, args[1])
+		if err := checkStdinUse(file, sigsRaw); err != nil {
+			return err
+		}
 
 		clientCtx, err := client.GetClientTxContext(cmd)
 		if err != nil {
@@ -109,8 +121,10 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			return err
 		}
 
-		multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", name)
+		}
 		if !clientCtx.Offline {
 			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
 			if err != nil {
@@ -120,18 +134,40 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
 		}
 
-		// read each signature and add it to the multisig if valid
-		for i := 0; i < len(sigsRaw); i++ {
-			sigs, err := unmarshalSignatureJSON(clientCtx, sigsRaw[i])
-			if err != nil {
-				return err
-			}
+		if txFactory.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
 
-			if txFactory.ChainID() == "" {
-				return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		builtTx := txBuilder.GetTx()
+		adaptableTx, ok := builtTx.(signing.V2AdaptableTx)
+		if !ok {
+			return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", builtTx)
+		}
+		txData := adaptableTx.GetSigningTxData()
+
+		// read each signature and check it against the sign bytes of the tx, by the
+		// index of its key in the multisig key
+		keys := multisigPub.GetPubKeys()
+		validSigs := make(map[int]multisigSignature, len(keys))
+		for _, sigFile := range sigsRaw {
+			sigs, err := unmarshalSignatureJSON(cmd, clientCtx, sigFile)
+			if err != nil {
+				return fmt.Errorf("couldn't read signature file %s: %w", sigFile, err)
 			}
 
 			for _, sig := range sigs {
+				signer := sdk.AccAddress(sig.PubKey.Address())
+				index := multisigKeyIndex(keys, sig.PubKey)
+				if index == -1 {
+					return fmt.Errorf("signature of %s in %s: signing key is not a part of multisig key %s", signer, sigFile, name)
+				}
+				if prev, ok := validSigs[index]; ok {
+					return fmt.Errorf("signature of %s in %s: duplicate signer, already signed in %s", signer, sigFile, prev.file)
+				}
+				if sig.Sequence != txFactory.Sequence() {
+					return fmt.Errorf("signature of %s in %s: signed for sequence %d, expected %d", signer, sigFile, sig.Sequence, txFactory.Sequence())
+				}
+
 				anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
 				if err != nil {
 					return err
@@ -140,29 +176,40 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 					ChainID:       txFactory.ChainID(),
 					AccountNumber: txFactory.AccountNumber(),
 					Sequence:      txFactory.Sequence(),
-					Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+					Address:       signer.String(),
 					PubKey: &anypb.Any{
 						TypeUrl: anyPk.TypeUrl,
 						Value:   anyPk.Value,
 					},
 				}
-				builtTx := txBuilder.GetTx()
-				adaptableTx, ok := builtTx.(signing.V2AdaptableTx)
-				if !ok {
-					return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", builtTx)
-				}
-				txData := adaptableTx.GetSigningTxData()
-
 				err = signing.VerifySignature(cmd.Context(), sig.PubKey, txSignerData, sig.Data,
 					txCfg.SignModeHandler(), txData)
 				if err != nil {
-					addr, _ := sdk.AccAddressFromHexUnsafe(sig.PubKey.Address().String())
-					return fmt.Errorf("couldn't verify signature for address %s", addr)
+					return fmt.Errorf(
+						"signature of %s in %s: couldn't verify signature for chain-id %s, account number %d and sequence %d: %w",
+						signer, sigFile, txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence(), err,
+					)
 				}
 
-				if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
-					return err
-				}
+				validSigs[index] = multisigSignature{sig: sig, file: sigFile}
+			}
+		}
+
+		threshold := int(multisigPub.Threshold)
+		if len(validSigs) < threshold {
+			return fmt.Errorf("got %d valid signatures, multisig key %s requires %d", len(validSigs), name, threshold)
+		}
+
+		// add the signatures of the first keys of the multisig up to its threshold
+		multisigSig := multisig.NewMultisig(len(keys))
+		for index := 0; index < len(keys) && len(multisigSig.Signatures) < threshold; index++ {
+			sig, ok := validSigs[index]
+			if !ok {
+				continue
+			}
+
+			if err := multisig.AddSignatureV2(multisigSig, sig.sig, keys); err != nil {
+				return err
 			}
 		}
 
@@ -378,9 +425,51 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
-func unmarshalSignatureJSON(clientCtx client.Context, filename string) (sigs []signingtypes.SignatureV2, err error) {
+// multisigSignature is a signature of one of the keys of a multisig key, along with the
+// file it was read from.
+type multisigSignature struct {
+	sig  signingtypes.SignatureV2
+	file string
+}
+
+// multisigKeyIndex returns the index of pubKey in the keys of a multisig key, or -1 if
+// it is not one of them.
+func multisigKeyIndex(keys []cryptotypes.PubKey, pubKey cryptotypes.PubKey) int {
+	for i, key := range keys {
+		if key.Equals(pubKey) {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkStdinUse returns an error if more than one of the tx and signature files is "-",
+// as stdin can only be read once.
+func checkStdinUse(txFile string, sigFiles []string) error {
+	stdin := 0
+	if txFile == "-" {
+		stdin++
+	}
+	for _, sigFile := range sigFiles {
+		if sigFile == "-" {
+			stdin++
+		}
+	}
+	if stdin > 1 {
+		return errors.New("only one of the transaction and signature files can be read from stdin")
+	}
+	return nil
+}
+
+// unmarshalSignatureJSON reads the signatures of the given file. Can pass "-" to read from stdin.
+func unmarshalSignatureJSON(cmd *cobra.Command, clientCtx client.Context, filename string) (sigs []signingtypes.SignatureV2, err error) {
 	var bytes []byte
-	if bytes, err = os.ReadFile(filename); err != nil {
+	if filename == "-" {
+		bytes, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		bytes, err = os.ReadFile(filename)
+	}
+	if err != nil {
 		return
 	}
 	return clientCtx.TxConfig.UnmarshalSignatureJSON(bytes)
@@ -43,8 +43,12 @@ it is required to set such parameters manually. Note, invalid values will cause
 the transaction to fail. The sequence will be incremented automatically for each
 transaction that is signed.
 
-If --account-number or --sequence flag is used when offline=false, they are ignored and 
-overwritten by the default flag values.
+Otherwise, the account number and sequence are queried from the signing account, or the
+multisig account with --multisig. The --account-number flag is then ignored, while the
+--sequence flag sets the sequence of the first transaction instead of the queried one.
+
+The command stops at the first transaction that cannot be decoded or signed, reporting
+its line number.
 
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig
 account key. It implies --signature-only.
@@ -95,23 +99,35 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		if !clientCtx.Offline && multisigKey == "" {
-			from, err := cmd.Flags().GetString(flags.FlagFrom)
-			if err != nil {
-				return err
+		if !clientCtx.Offline {
+			signer := multisigKey
+			if signer == "" {
+				signer, err = cmd.Flags().GetString(flags.FlagFrom)
+				if err != nil {
+					return err
+				}
 			}
 
-			fromAddr, _, _, err := client.GetFromFields(clientCtx, txFactory.Keybase(), from)
+			signerAddr, _, _, err := client.GetFromFields(clientCtx, txFactory.Keybase(), signer)
 			if err != nil {
 				return err
 			}
 
-			fromAcc, err := txFactory.AccountRetriever().GetAccount(clientCtx, fromAddr)
+			accNum, seq, err := txFactory.AccountRetriever().GetAccountNumberSequence(clientCtx, signerAddr)
 			if err != nil {
 				return err
 			}
 
-			txFactory = txFactory.WithAccountNumber(fromAcc.GetAccountNumber()).WithSequence(fromAcc.GetSequence())
+			// the sequence of the first tx can still be set, e.g. to sign txs following
+			// ones not yet included in a block
+			if cmd.Flags().Changed(flags.FlagSequence) {
+				seq, err = cmd.Flags().GetUint64(flags.FlagSequence)
+				if err != nil {
+					return err
+				}
+			}
+
+			txFactory = txFactory.WithAccountNumber(accNum).WithSequence(seq)
 		}
 
 		appendMessagesToSingleTx, _ := cmd.Flags().GetBool(flagAppend)
@@ -185,7 +201,7 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 				from, _ := cmd.Flags().GetString(flags.FlagFrom)
 				err = sigTxOrMultisig(clientCtx, txBuilder, txFactory, from, multisigKey)
 				if err != nil {
-					return err
+					return fmt.Errorf("couldn't sign the transaction on line %d: %w", scanner.Line(), err)
 				}
 
 				printSigOnly, _ := cmd.Flags().GetBool(flagSigOnly)
@@ -235,7 +251,7 @@ func multisigSign(clientCtx client.Context, txBuilder client.TxBuilder, txFactor
 		multisigAddr,
 		clientCtx.FromName,
 		txBuilder,
-		clientCtx.Offline,
+		true,
 		true,
 	); err != nil {
 		return err
@@ -186,25 +186,31 @@ type BatchScanner struct {
 	*bufio.Scanner
 	theTx        sdk.Tx
 	cfg          client.TxConfig
+	line         int
 	unmarshalErr error
 }
 
 // Tx returns the most recent Tx unmarshalled by a call to Scan.
 func (bs BatchScanner) Tx() sdk.Tx { return bs.theTx }
 
-// UnmarshalErr returns the first unmarshalling error that was encountered by the scanner.
+// UnmarshalErr returns the first unmarshalling error that was encountered by the scanner,
+// along with the number of the line it was encountered on.
 func (bs BatchScanner) UnmarshalErr() error { return bs.unmarshalErr }
 
+// Line returns the number of the line of the most recent Tx, starting from 1.
+func (bs BatchScanner) Line() int { return bs.line }
+
 // Scan advances the Scanner to the next line.
 func (bs *BatchScanner) Scan() bool {
 	if !bs.Scanner.Scan() {
 		return false
 	}
 
+	bs.line++
 	tx, err := bs.cfg.TxJSONDecoder()(bs.Bytes())
 	bs.theTx = tx
 	if err != nil && bs.unmarshalErr == nil {
-		bs.unmarshalErr = err
+		bs.unmarshalErr = fmt.Errorf("couldn't decode the transaction on line %d: %w", bs.line, err)
 		return false
 	}
 
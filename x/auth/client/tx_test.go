@@ -165,11 +165,12 @@ func TestBatchScanner_Scan(t *testing.T) {
 		wantScannerError   bool
 		wantUnmarshalError bool
 		numTxs             int
+		line               int
 	}{
-		{"good batch", goodBatchOf3Txs, false, false, 3},
-		{"malformed", malformedBatch, false, true, 1},
-		{"missing trailing newline", batchOf2TxsWithNoNewline, false, false, 2},
-		{"empty line", batchWithEmptyLine, false, true, 1},
+		{"good batch", goodBatchOf3Txs, false, false, 3, 3},
+		{"malformed", malformedBatch, false, true, 1, 2},
+		{"missing trailing newline", batchOf2TxsWithNoNewline, false, false, 2, 2},
+		{"empty line", batchWithEmptyLine, false, true, 1, 2},
 	}
 
 	for _, tt := range tests {
@@ -182,7 +183,11 @@ func TestBatchScanner_Scan(t *testing.T) {
 			}
 			require.Equal(t, tt.wantScannerError, scanner.Err() != nil)
 			require.Equal(t, tt.wantUnmarshalError, scanner.UnmarshalErr() != nil)
+			if tt.wantUnmarshalError {
+				require.ErrorContains(t, scanner.UnmarshalErr(), fmt.Sprintf("on line %d", tt.line))
+			}
 			require.Equal(t, tt.numTxs, i)
+			require.Equal(t, tt.line, scanner.Line())
 		})
 	}
 }
@@ -5,8 +5,12 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewAccountWithAddress implements AccountKeeperI.
@@ -35,10 +39,25 @@ func (ak AccountKeeper) HasAccount(ctx context.Context, addr sdk.AccAddress) boo
 	return has
 }
 
-// GetAccount implements AccountKeeperI.
+// GetAccount implements AccountKeeperI. The account is read from the store like
+// with Accounts.Get, but is only decoded if it is not in the account cache of the
+// context, if any.
 func (ak AccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
-	acc, err := ak.Accounts.Get(ctx, addr)
-	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+	key, err := collections.EncodeKeyWithPrefix(types.AddressStoreKeyPrefix, ak.Accounts.KeyCodec(), addr)
+	if err != nil {
+		panic(err)
+	}
+
+	bz, err := ak.environment.KVStoreService.OpenKVStore(ctx).Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+
+	acc, err := accountCacheFromContext(ctx).get(addr, bz, ak.cdc, ak.Accounts.ValueCodec().Decode)
+	if err != nil {
 		panic(err)
 	}
 	return acc
@@ -46,6 +65,7 @@ func (ak AccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk
 
 // SetAccount implements AccountKeeperI.
 func (ak AccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
+	accountCacheFromContext(ctx).remove(acc.GetAddress())
 	err := ak.Accounts.Set(ctx, acc.GetAddress(), acc)
 	if err != nil {
 		panic(err)
@@ -55,8 +75,50 @@ func (ak AccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
 // RemoveAccount removes an account for the account mapper store.
 // NOTE: this will cause supply invariant violation if called
 func (ak AccountKeeper) RemoveAccount(ctx context.Context, acc sdk.AccountI) {
+	accountCacheFromContext(ctx).remove(acc.GetAddress())
 	err := ak.Accounts.Remove(ctx, acc.GetAddress())
 	if err != nil {
 		panic(err)
 	}
 }
+
+// RotatePubKey replaces the public key of an account with the new public key, which must
+// not be the public key of another existing account. It counts the rotation against the
+// MaxPubKeyRotations param, consumes the PubKeyRotationGasCost param, and increases the
+// account sequence so that the transactions signed with the previous key for the next
+// sequence become invalid.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, acc sdk.AccountI, newPubKey cryptotypes.PubKey) error {
+	addr := acc.GetAddress()
+
+	// a public key controls a single account, so the new public key must not be
+	// the public key of another existing account.
+	newPubKeyAddr := sdk.AccAddress(newPubKey.Address())
+	if !newPubKeyAddr.Equals(addr) && ak.HasAccount(ctx, newPubKeyAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "the new public key is the public key of the existing account %s", newPubKeyAddr)
+	}
+
+	params, err := ak.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	rotations, err := ak.PubKeyRotations.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if rotations >= params.MaxPubKeyRotations {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s has reached the maximum of %d public key rotations", addr, params.MaxPubKeyRotations)
+	}
+
+	ak.environment.GasService.GetGasMeter(ctx).Consume(params.PubKeyRotationGasCost, "rotate pubkey")
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return err
+	}
+	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+		return err
+	}
+	ak.SetAccount(ctx, acc)
+
+	return ak.PubKeyRotations.Set(ctx, addr, rotations+1)
+}
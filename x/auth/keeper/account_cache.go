@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// accountCacheKey is the context key of the account cache.
+type accountCacheKey struct{}
+
+// accountCache keeps the encoding of the accounts decoded from the store along with
+// their concrete type. When an account is read again with the same encoding, which was
+// then already checked by the codec, it is unmarshalled directly into its concrete type,
+// skipping the resolution of the Any and the unknown fields checks that make most of the
+// decoding cost.
+//
+// The cache lives in a context, set by WithAccountCache, and so is scoped to the
+// execution of a single transaction. An entry is only used when the encoding read from
+// the store is the one it was made from, so the accounts still go through the (gas
+// metered) store of the context, and the cache returns the same accounts whichever
+// branch of the store they are read from. Each read returns a new account, so callers
+// can modify it without affecting the cache.
+type accountCache struct {
+	accounts map[string]cachedAccount
+}
+
+type cachedAccount struct {
+	bz  []byte
+	typ reflect.Type
+}
+
+// WithAccountCache returns a copy of the context with a new account cache, used by
+// GetAccount for the accounts read with the returned context and its children.
+func WithAccountCache(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(accountCacheKey{}, &accountCache{accounts: make(map[string]cachedAccount)})
+}
+
+// accountCacheFromContext returns the account cache of the context, or nil if it has
+// none.
+func accountCacheFromContext(ctx context.Context) *accountCache {
+	c, _ := ctx.Value(accountCacheKey{}).(*accountCache)
+	return c
+}
+
+// get returns the account encoded as bz at the given address, decoding it with decode
+// unless its encoding is cached. A nil cache decodes every account.
+func (c *accountCache) get(
+	addr sdk.AccAddress, bz []byte, unpacker codectypes.AnyUnpacker, decode func([]byte) (sdk.AccountI, error),
+) (sdk.AccountI, error) {
+	if c == nil {
+		return decode(bz)
+	}
+
+	if cached, ok := c.accounts[string(addr)]; ok && bytes.Equal(cached.bz, bz) {
+		return cached.unmarshal(unpacker)
+	}
+
+	acc, err := decode(bz)
+	if err != nil {
+		return nil, err
+	}
+	c.accounts[string(addr)] = cachedAccount{bz: bytes.Clone(bz), typ: reflect.TypeOf(acc).Elem()}
+
+	return acc, nil
+}
+
+// remove drops the account at the given address from the cache.
+func (c *accountCache) remove(addr sdk.AccAddress) {
+	if c == nil {
+		return
+	}
+	delete(c.accounts, string(addr))
+}
+
+// unmarshal unmarshals the cached encoding into a new account of the cached type.
+func (ca cachedAccount) unmarshal(unpacker codectypes.AnyUnpacker) (sdk.AccountI, error) {
+	var accAny codectypes.Any
+	if err := accAny.Unmarshal(ca.bz); err != nil {
+		return nil, err
+	}
+
+	acc := reflect.New(ca.typ).Interface().(sdk.AccountI)
+	if err := proto.Unmarshal(accAny.Value, acc); err != nil {
+		return nil, err
+	}
+	if err := codectypes.UnpackInterfaces(acc, unpacker); err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
@@ -221,7 +221,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 	suite.Require().NoError(err)
 
 	req := &types.QueryParamsRequest{}
-	testdata.DeterministicIterations(suite.T(), suite.ctx, req, suite.queryClient.Params, 1042, false)
+	testdata.DeterministicIterations(suite.T(), suite.ctx, req, suite.queryClient.Params, 1081, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCQueryAccountInfo() {
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// This file exists in the keeper package to expose some private things
+// for the purpose of testing in the keeper_test package.
+
+// IsAccountCached returns true if the account at the given address is in the
+// account cache of the context.
+func IsAccountCached(ctx context.Context, addr sdk.AccAddress) bool {
+	c := accountCacheFromContext(ctx)
+	if c == nil {
+		return false
+	}
+	_, ok := c.accounts[string(addr)]
+	return ok
+}
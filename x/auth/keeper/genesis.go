@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,10 @@ import (
 // CONTRACT: old coins from the FeeCollectionKeeper need to be transferred through
 // a genesis port script to the new fee collector account
 func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState) error {
+	// a genesis created before MaxRefundRate existed has no rate, which disables the refunds
+	if data.Params.MaxRefundRate.IsNil() {
+		data.Params.MaxRefundRate = math.LegacyZeroDec()
+	}
 	if err := ak.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -35,11 +40,22 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, r := range data.PubKeyRotations {
+		addr, err := ak.addressCodec.StringToBytes(r.Address)
+		if err != nil {
+			return err
+		}
+		if err := ak.PubKeyRotations.Set(ctx, addr, r.Rotations); err != nil {
+			return err
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 	return nil
 }
 
-// ExportGenesis returns a GenesisState for a given context and keeper
+// ExportGenesis returns a GenesisState for a given context and keeper. The accounts are
+// sorted by account number, so that they are imported in the same order.
 func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
 	params := ak.GetParams(ctx)
 
@@ -52,5 +68,18 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, types.SanitizeGenesisAccounts(genAccounts))
+	err = ak.PubKeyRotations.Walk(ctx, nil, func(key sdk.AccAddress, rotations uint64) (stop bool, err error) {
+		addr, err := ak.addressCodec.BytesToString(key)
+		if err != nil {
+			return true, err
+		}
+		genState.PubKeyRotations = append(genState.PubKeyRotations, types.PubKeyRotations{Address: addr, Rotations: rotations})
+		return false, nil
+	})
+	return genState, err
 }
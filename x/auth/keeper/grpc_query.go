@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/x/auth/types"
+	vestexported "cosmossdk.io/x/auth/vesting/exported"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,10 +54,20 @@ func (s queryServer) Accounts(ctx context.Context, req *types.QueryAccountsReque
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	accounts, pageRes, err := query.CollectionPaginate(
+	// only the vesting accounts are returned when vesting_only is set
+	var predicate func(sdk.AccAddress, sdk.AccountI) (bool, error)
+	if req.VestingOnly {
+		predicate = func(_ sdk.AccAddress, value sdk.AccountI) (bool, error) {
+			_, ok := value.(vestexported.VestingAccount)
+			return ok, nil
+		}
+	}
+
+	accounts, pageRes, err := query.CollectionFilteredPaginate(
 		ctx,
 		s.k.Accounts,
 		req.Pagination,
+		predicate,
 		func(_ sdk.AccAddress, value sdk.AccountI) (*codectypes.Any, error) {
 			return codectypes.NewAnyWithValue(value)
 		},
@@ -10,9 +10,11 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const addrStr = "cosmos13c3d4wq2t22dl0dstraf8jc3f902e3fsy9n3wv"
@@ -51,6 +53,56 @@ func (suite *KeeperTestSuite) TestGRPCQueryAccounts() {
 				suite.Subset(addresses, []sdk.AccAddress{first, second})
 			},
 		},
+		{
+			"empty store",
+			func() {
+				req = &types.QueryAccountsRequest{Pagination: &query.PageRequest{CountTotal: true}}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Empty(res.Accounts)
+				suite.Require().Zero(res.Pagination.Total)
+				suite.Require().Nil(res.Pagination.NextKey)
+			},
+		},
+		{
+			"exact page boundary",
+			func() {
+				suite.accountKeeper.SetAccount(suite.ctx,
+					suite.accountKeeper.NewAccountWithAddress(suite.ctx, first))
+				suite.accountKeeper.SetAccount(suite.ctx,
+					suite.accountKeeper.NewAccountWithAddress(suite.ctx, second))
+				req = &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Len(res.Accounts, 2)
+				suite.Require().Equal(uint64(2), res.Pagination.Total)
+				suite.Require().Nil(res.Pagination.NextKey)
+			},
+		},
+		{
+			"vesting only",
+			func() {
+				suite.accountKeeper.SetAccount(suite.ctx,
+					suite.accountKeeper.NewAccountWithAddress(suite.ctx, first))
+				baseAcc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, second).(*types.BaseAccount)
+				vestingAcc, err := vestingtypes.NewDelayedVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1000)
+				suite.Require().NoError(err)
+				suite.accountKeeper.SetAccount(suite.ctx, vestingAcc)
+				req = &types.QueryAccountsRequest{Pagination: &query.PageRequest{CountTotal: true}, VestingOnly: true}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Len(res.Accounts, 1)
+				suite.Require().Equal(uint64(1), res.Pagination.Total)
+
+				var account sdk.AccountI
+				suite.Require().NoError(suite.encCfg.InterfaceRegistry.UnpackAny(res.Accounts[0], &account))
+				suite.Require().Equal(second, account.GetAddress())
+				suite.Require().IsType(&vestingtypes.DelayedVestingAccount{}, account)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
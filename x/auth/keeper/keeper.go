@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -100,6 +101,8 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// PubKeyRotations key: AccAddr | value: number of pubkey rotations of the account
+	PubKeyRotations collections.Map[sdk.AccAddress, uint64]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -122,16 +125,17 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
 	ak := AccountKeeper{
-		addressCodec:  ac,
-		bech32Prefix:  bech32Prefix,
-		environment:   env,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
-		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		addressCodec:    ac,
+		bech32Prefix:    bech32Prefix,
+		environment:     env,
+		proto:           proto,
+		cdc:             cdc,
+		permAddrs:       permAddrs,
+		authority:       authority,
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:   collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Accounts:        collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		PubKeyRotations: collections.NewMap(sb, types.PubKeyRotationsKeyPrefix, "pub_key_rotations", sdk.AccAddressKey, collections.Uint64Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -267,11 +271,15 @@ func (ak AccountKeeper) getBech32Prefix() (string, error) {
 	return ak.bech32Prefix, nil
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. A MaxRefundRate missing from the stored
+// parameters, as in parameters set before it existed, is returned as zero.
 func (ak AccountKeeper) GetParams(ctx context.Context) (params types.Params) {
 	params, err := ak.Params.Get(ctx)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		panic(err)
 	}
+	if params.MaxRefundRate.IsNil() {
+		params.MaxRefundRate = math.LegacyZeroDec()
+	}
 	return params
 }
@@ -65,3 +65,64 @@ func BenchmarkAccountMapperSetAccount(b *testing.B) {
 		accountKeeper.SetAccount(ctx, acc)
 	}
 }
+
+// BenchmarkAccountMapperBlockOfSends reads and updates the accounts of a block of 500
+// sends, reading each account several times per tx like the ante handler and x/bank do.
+// Each tx of the cached case runs with its own account cache, as set by the
+// SetUpContextDecorator.
+func BenchmarkAccountMapperBlockOfSends(b *testing.B) {
+	const txs = 500
+
+	benchmarks := []struct {
+		name  string
+		txCtx func(sdk.Context) sdk.Context
+	}{
+		{
+			name:  "cached",
+			txCtx: keeper.WithAccountCache,
+		},
+		{
+			name:  "uncached",
+			txCtx: func(ctx sdk.Context) sdk.Context { return ctx },
+		},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			var accountKeeper keeper.AccountKeeper
+			app, err := simtestutil.Setup(
+				depinject.Configs(
+					depinject.Supply(log.NewNopLogger()),
+					testutil.AppConfig,
+				), &accountKeeper)
+			require.NoError(b, err)
+
+			ctx := app.BaseApp.NewContext(false)
+
+			senders := make([]sdk.AccAddress, txs)
+			recipients := make([]sdk.AccAddress, txs)
+			for i := 0; i < txs; i++ {
+				senders[i] = sdk.AccAddress([]byte{1, byte(i >> 8), byte(i)})
+				recipients[i] = sdk.AccAddress([]byte{2, byte(i >> 8), byte(i)})
+				accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, senders[i]))
+				accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, recipients[i]))
+			}
+
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				for i := 0; i < txs; i++ {
+					txCtx := bm.txCtx(ctx)
+					for j := 0; j < 3; j++ {
+						accountKeeper.GetAccount(txCtx, senders[i])
+					}
+					accountKeeper.GetAccount(txCtx, recipients[i])
+
+					acc := accountKeeper.GetAccount(txCtx, senders[i])
+					require.NoError(b, acc.SetSequence(acc.GetSequence()+1))
+					accountKeeper.SetAccount(txCtx, acc)
+				}
+			}
+		})
+	}
+}
@@ -13,11 +13,14 @@ import (
 	authcodec "cosmossdk.io/x/auth/codec"
 	"cosmossdk.io/x/auth/keeper"
 	"cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,7 +50,7 @@ type KeeperTestSuite struct {
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
-	suite.encCfg = moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	suite.encCfg = moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
@@ -232,3 +235,128 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	// vesting and base accounts with account numbers in another order than their addresses
+	var accts []sdk.AccountI
+	for i, accNum := range []uint64{4, 0, 3, 1, 2} {
+		pubKey := secp256k1.GenPrivKey().PubKey()
+		baseAcc := types.NewBaseAccount(sdk.AccAddress(pubKey.Address()), pubKey, accNum, uint64(i))
+		if i%2 == 0 {
+			accts = append(accts, baseAcc)
+			continue
+		}
+		vestingAcc, err := vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1000, 2000)
+		suite.Require().NoError(err)
+		accts = append(accts, vestingAcc)
+	}
+
+	genState := types.GenesisState{Params: types.DefaultParams()}
+	for _, acct := range accts {
+		genState.Accounts = append(genState.Accounts, codectypes.UnsafePackAny(acct))
+	}
+	genState.PubKeyRotations = []types.PubKeyRotations{{Address: accts[0].GetAddress().String(), Rotations: 2}}
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(suite.ctx, genState))
+
+	exported, err := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.PubKeyRotations, exported.PubKeyRotations)
+	exportedAccts, err := types.UnpackAccounts(exported.Accounts)
+	suite.Require().NoError(err)
+
+	// the accounts keep their account number and are exported in account number order,
+	// the fee collector being created after them
+	suite.Require().Len(exportedAccts, len(accts)+1)
+	for i, acc := range exportedAccts {
+		suite.Require().Equal(uint64(i), acc.GetAccountNumber())
+	}
+	for _, acct := range accts {
+		suite.Require().Equal(acct, exportedAccts[acct.GetAccountNumber()])
+	}
+
+	exportedJSON, err := suite.encCfg.Codec.MarshalJSON(exported)
+	suite.Require().NoError(err)
+
+	// importing the exported genesis in a new chain exports the same genesis
+	suite.SetupTest()
+	var imported types.GenesisState
+	suite.Require().NoError(suite.encCfg.Codec.UnmarshalJSON(exportedJSON, &imported))
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(suite.ctx, imported))
+	suite.Require().Equal(uint64(len(exportedAccts)), suite.accountKeeper.NextAccountNumber(suite.ctx))
+
+	reexported, err := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	reexportedJSON, err := suite.encCfg.Codec.MarshalJSON(reexported)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exportedJSON, reexportedJSON)
+}
+
+func (suite *KeeperTestSuite) TestGetAccountCache() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(pubKey))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	// the cached reads consume the same gas and return the same account as the first one
+	suite.ctx = keeper.WithAccountCache(suite.ctx)
+	ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	first := suite.accountKeeper.GetAccount(ctx, addr)
+	gas := ctx.GasMeter().GasConsumed()
+	second := suite.accountKeeper.GetAccount(ctx, addr)
+	suite.Require().Equal(2*gas, ctx.GasMeter().GasConsumed())
+	suite.Require().Equal(acc, first)
+	suite.Require().Equal(first, second)
+	suite.Require().True(pubKey.Equals(second.GetPubKey()))
+
+	// each read returns a new account
+	suite.Require().NoError(first.SetSequence(10))
+	suite.Require().Zero(suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+
+	// an account modified in a discarded branch of the store is unchanged
+	cacheCtx, write := suite.ctx.CacheContext()
+	suite.accountKeeper.SetAccount(cacheCtx, first)
+	suite.Require().Equal(uint64(10), suite.accountKeeper.GetAccount(cacheCtx, addr).GetSequence())
+	suite.Require().Zero(suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+	suite.Require().Equal(uint64(10), suite.accountKeeper.GetAccount(cacheCtx, addr).GetSequence())
+
+	// and modified once the branch is written
+	write()
+	suite.Require().Equal(uint64(10), suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+
+	// accounts of other types are read again the same way
+	vestingAcc, err := vestingtypes.NewContinuousVestingAccount(first.(*types.BaseAccount), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1000, 2000)
+	suite.Require().NoError(err)
+	suite.accountKeeper.SetAccount(suite.ctx, vestingAcc)
+	suite.Require().Equal(vestingAcc, suite.accountKeeper.GetAccount(suite.ctx, addr))
+	suite.Require().Equal(vestingAcc, suite.accountKeeper.GetAccount(suite.ctx, addr))
+
+	suite.accountKeeper.RemoveAccount(suite.ctx, vestingAcc)
+	suite.Require().Nil(suite.accountKeeper.GetAccount(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestAccountCacheScope() {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	// an account read in a tx is cached for the rest of the tx only
+	txCtx := keeper.WithAccountCache(suite.ctx)
+	suite.accountKeeper.GetAccount(txCtx, addr)
+	suite.Require().True(keeper.IsAccountCached(txCtx, addr))
+	suite.Require().False(keeper.IsAccountCached(suite.ctx, addr))
+
+	nextTxCtx := keeper.WithAccountCache(suite.ctx)
+	suite.Require().False(keeper.IsAccountCached(nextTxCtx, addr))
+	suite.Require().True(keeper.IsAccountCached(txCtx, addr))
+
+	// setting or removing an account drops it from the cache
+	suite.accountKeeper.SetAccount(txCtx, acc)
+	suite.Require().False(keeper.IsAccountCached(txCtx, addr))
+	suite.accountKeeper.GetAccount(txCtx, addr)
+	suite.Require().True(keeper.IsAccountCached(txCtx, addr))
+	suite.accountKeeper.RemoveAccount(txCtx, acc)
+	suite.Require().False(keeper.IsAccountCached(txCtx, addr))
+	suite.Require().Nil(suite.accountKeeper.GetAccount(txCtx, addr))
+	suite.Require().False(keeper.IsAccountCached(txCtx, addr))
+}
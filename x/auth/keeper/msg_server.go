@@ -4,7 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -37,3 +42,48 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RotatePubKey replaces the public key of an account with the new public key, as done by
+// AccountKeeper.RotatePubKey.
+func (ms msgServer) RotatePubKey(ctx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "empty new public key")
+	}
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", msg.NewPubKey.GetCachedValue())
+	}
+
+	acc := ms.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key to rotate", msg.Address)
+	}
+	if oldPubKey.Equals(newPubKey) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "the new public key is the current public key of the account")
+	}
+
+	if err := ms.ak.RotatePubKey(ctx, acc, newPubKey); err != nil {
+		return nil, err
+	}
+
+	if err := ms.ak.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRotatePubKey,
+		event.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		event.NewAttribute(types.AttributeKeyOldPubKey, oldPubKey.Address().String()),
+		event.NewAttribute(types.AttributeKeyNewPubKey, newPubKey.Address().String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
@@ -2,6 +2,11 @@ package keeper_test
 
 import (
 	"cosmossdk.io/x/auth/types"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +114,73 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	s.Require().NoError(s.accountKeeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	})
+	singleKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(multisigKey.Address())
+
+	acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.Require().NoError(acc.SetPubKey(multisigKey))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	rotate := func(pubKey cryptotypes.PubKey) error {
+		msg, err := types.NewMsgRotatePubKey(addr.String(), pubKey)
+		s.Require().NoError(err)
+		_, err = s.msgServer.RotatePubKey(s.ctx, msg)
+		return err
+	}
+
+	// rotate the multisig key to a single key
+	s.Require().NoError(rotate(singleKey))
+	acc = s.accountKeeper.GetAccount(s.ctx, addr)
+	s.Require().True(singleKey.Equals(acc.GetPubKey()))
+	s.Require().Equal(uint64(1), acc.GetSequence())
+
+	events := s.ctx.EventManager().Events()
+	event := events[len(events)-1]
+	s.Require().Equal(types.EventTypeRotatePubKey, event.Type)
+	oldPubKey, _ := event.GetAttribute(types.AttributeKeyOldPubKey)
+	s.Require().Equal(multisigKey.Address().String(), oldPubKey.Value)
+	newPubKey, _ := event.GetAttribute(types.AttributeKeyNewPubKey)
+	s.Require().Equal(singleKey.Address().String(), newPubKey.Value)
+
+	// the current key and the key of another account are rejected
+	s.Require().ErrorContains(rotate(singleKey), "is the current public key")
+	otherKey := secp256k1.GenPrivKey().PubKey()
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, sdk.AccAddress(otherKey.Address())))
+	s.Require().ErrorContains(rotate(otherKey), "public key of the existing account")
+
+	// rotate back to the multisig key, whose address is the account address
+	s.Require().NoError(rotate(multisigKey))
+	acc = s.accountKeeper.GetAccount(s.ctx, addr)
+	s.Require().True(multisigKey.Equals(acc.GetPubKey()))
+	s.Require().Equal(uint64(2), acc.GetSequence())
+
+	rotations, err := s.accountKeeper.PubKeyRotations.Get(s.ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), rotations)
+
+	// the number of rotations is limited
+	params := types.DefaultParams()
+	params.MaxPubKeyRotations = 2
+	s.Require().NoError(s.accountKeeper.Params.Set(s.ctx, params))
+	s.Require().ErrorContains(rotate(singleKey), "maximum of 2 public key rotations")
+
+	// an account must exist and have a public key
+	unknown := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg, err := types.NewMsgRotatePubKey(unknown.String(), singleKey)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotatePubKey(s.ctx, msg)
+	s.Require().ErrorContains(err, "does not exist")
+
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, unknown))
+	_, err = s.msgServer.RotatePubKey(s.ctx, msg)
+	s.Require().ErrorContains(err, "has no public key")
+}
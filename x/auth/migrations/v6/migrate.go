@@ -0,0 +1,35 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+)
+
+// Migrate sets the params added since the consensus version 5, which read as zero
+// in the stored params, to their default values.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if p.MaxPubKeyRotations == 0 {
+		p.MaxPubKeyRotations = types.DefaultMaxPubKeyRotations
+	}
+	if p.PubKeyRotationGasCost == 0 {
+		p.PubKeyRotationGasCost = types.DefaultPubKeyRotationGasCost
+	}
+	if p.SigVerifyCostMultisigPerSignature == 0 {
+		p.SigVerifyCostMultisigPerSignature = types.DefaultSigVerifyCostMultisigPerSignature
+	}
+	if p.SimulationSignatureSize == 0 {
+		p.SimulationSignatureSize = types.DefaultSimulationSignatureSize
+	}
+	if p.MaxRefundRate.IsNil() {
+		p.MaxRefundRate = types.DefaultMaxRefundRate
+	}
+
+	return params.Set(ctx, p)
+}
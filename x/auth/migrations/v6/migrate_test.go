@@ -0,0 +1,47 @@
+package v6
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func TestMigrate(t *testing.T) {
+	kv, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(kv)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// params stored before the consensus version 6
+	legacy := types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+		types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)
+	require.NoError(t, params.Set(ctx, legacy))
+
+	require.NoError(t, Migrate(ctx, params))
+
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMaxPubKeyRotations, got.MaxPubKeyRotations)
+	require.Equal(t, types.DefaultPubKeyRotationGasCost, got.PubKeyRotationGasCost)
+	require.Equal(t, types.DefaultSigVerifyCostMultisigPerSignature, got.SigVerifyCostMultisigPerSignature)
+	require.Equal(t, types.DefaultSimulationSignatureSize, got.SimulationSignatureSize)
+	require.False(t, got.MaxRefundRate.IsNil())
+	require.True(t, got.MaxRefundRate.IsZero())
+	require.Equal(t, legacy.TxSigLimit, got.TxSigLimit)
+
+	// params set since then are kept
+	got.MaxPubKeyRotations = 2
+	require.NoError(t, params.Set(ctx, got))
+	require.NoError(t, Migrate(ctx, params))
+
+	got, err = params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), got.MaxPubKeyRotations)
+}
@@ -1,15 +1,33 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	AccountKeeper ante.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
+
+// NewPostHandler returns a PostHandler that refunds the fee paid for unused gas.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for post builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for post builder")
+	}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
-	postDecorators := []sdk.PostDecorator{}
+	postDecorators := []sdk.PostDecorator{
+		NewRefundDecorator(options.AccountKeeper, options.BankKeeper),
+	}
 
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
@@ -0,0 +1,97 @@
+package posthandler
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RefundDecorator refunds to the fee payer the share of the fee paid for the gas a
+// transaction did not use, unusedGas/gasWanted, capped by the MaxRefundRate param.
+// The refunded fee is the one deducted by the DeductFeeDecorator, as returned by its
+// TxFeeChecker, and no refund is made when it did not run. The refund is sent back
+// from the fee collector, so that the fees distributed at the next block exclude it.
+//
+// No refund is made for a fee paid by a fee granter: the DeductFeeDecorator used its
+// fee allowance for the whole fee, which cannot be given back.
+//
+// The refund is only made when the messages of the transaction succeed, in
+// DeliverTx: in CheckTx the messages are not run, so the unused gas is unknown.
+// The decorator consumes no gas, so that the gas used by a transaction is the same
+// in DeliverTx and in simulations, where it makes no refund.
+// CONTRACT: Tx must implement FeeTx interface to use RefundDecorator
+type RefundDecorator struct {
+	accountKeeper ante.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+func NewRefundDecorator(ak ante.AccountKeeper, bk types.BankKeeper) RefundDecorator {
+	return RefundDecorator{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+func (rd RefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if feeTx.FeeGranter() != nil {
+		return next(ctx, tx, simulate, success)
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumed()
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	params := rd.accountKeeper.GetParams(refundCtx)
+	refund := ComputeRefund(ante.DeductedFee(ctx), feeTx.GetGas(), gasUsed, params.MaxRefundRate)
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	refundTo := feeTx.FeePayer()
+	if err := rd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.FeeCollectorName, refundTo, refund); err != nil {
+		return ctx, errorsmod.Wrapf(err, "failed to refund %s to %s", refund, sdk.AccAddress(refundTo))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeRefund,
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(refundTo).String()),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// ComputeRefund returns the share of the fee refunded for the gas a transaction did not
+// use, unusedGas/gasWanted, capped by maxRefundRate and truncated. A nil maxRefundRate
+// refunds nothing.
+func ComputeRefund(fee sdk.Coins, gasWanted, gasUsed uint64, maxRefundRate math.LegacyDec) sdk.Coins {
+	if maxRefundRate.IsNil() || !maxRefundRate.IsPositive() || gasUsed >= gasWanted || fee.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	unusedGas, wantedGas := math.NewIntFromUint64(gasWanted-gasUsed), math.NewIntFromUint64(gasWanted)
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := math.MinInt(
+			coin.Amount.Mul(unusedGas).Quo(wantedGas),
+			maxRefundRate.MulInt(coin.Amount).TruncateInt(),
+		)
+		refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return refund
+}
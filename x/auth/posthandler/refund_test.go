@@ -0,0 +1,268 @@
+package posthandler_test
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/posthandler"
+	"cosmossdk.io/x/auth/testutil"
+	"cosmossdk.io/x/auth/types"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestComputeRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 15))
+
+	testCases := []struct {
+		name          string
+		fee           sdk.Coins
+		gasWanted     uint64
+		gasUsed       uint64
+		maxRefundRate math.LegacyDec
+		expRefund     sdk.Coins
+	}{
+		{"nil max refund rate", fee, 100, 40, math.LegacyDec{}, sdk.NewCoins()},
+		{"zero max refund rate", fee, 100, 40, math.LegacyZeroDec(), sdk.NewCoins()},
+		{"partial refund", fee, 100, 40, math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 600), sdk.NewInt64Coin("stake", 9))},
+		{"refund capped by the max refund rate", fee, 100, 40, math.LegacyNewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("stake", 7))},
+		{"refund under the max refund rate", fee, 100, 90, math.LegacyNewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 1))},
+		{"truncated refund", fee, 3, 2, math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 333), sdk.NewInt64Coin("stake", 5))},
+		{"all the gas used", fee, 100, 100, math.LegacyOneDec(), sdk.NewCoins()},
+		{"more gas used than wanted", fee, 100, 120, math.LegacyOneDec(), sdk.NewCoins()},
+		{"zero fee", sdk.NewCoins(), 100, 40, math.LegacyOneDec(), sdk.NewCoins()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refund := posthandler.ComputeRefund(tc.fee, tc.gasWanted, tc.gasUsed, tc.maxRefundRate)
+			require.Equal(t, tc.expRefund.String(), refund.String())
+		})
+	}
+}
+
+func TestRefundDecorator(t *testing.T) {
+	payerPriv := secp256k1.GenPrivKey()
+	payer := sdk.AccAddress(payerPriv.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+
+	testCases := []struct {
+		name          string
+		maxRefundRate math.LegacyDec
+		sendAmount    int64
+		expSuccess    bool
+		// expRefund returns the refund expected for the gas wanted and used by the tx
+		expRefund func(gasWanted, gasUsed int64) int64
+	}{
+		{
+			name:          "default params, no refund",
+			maxRefundRate: types.DefaultMaxRefundRate,
+			sendAmount:    10,
+			expSuccess:    true,
+			expRefund:     func(int64, int64) int64 { return 0 },
+		},
+		{
+			name:          "partial refund",
+			maxRefundRate: math.LegacyOneDec(),
+			sendAmount:    10,
+			expSuccess:    true,
+			expRefund: func(gasWanted, gasUsed int64) int64 {
+				return 1000 * (gasWanted - gasUsed) / gasWanted
+			},
+		},
+		{
+			name:          "refund capped by the max refund rate",
+			maxRefundRate: math.LegacyNewDecWithPrec(1, 1),
+			sendAmount:    10,
+			expSuccess:    true,
+			expRefund:     func(int64, int64) int64 { return 100 },
+		},
+		{
+			name:          "failed tx, no refund",
+			maxRefundRate: math.LegacyOneDec(),
+			sendAmount:    1_000_000,
+			expSuccess:    false,
+			expRefund:     func(int64, int64) int64 { return 0 },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				accountKeeper authkeeper.AccountKeeper
+				bankKeeper    bankkeeper.Keeper
+				txConfig      client.TxConfig
+			)
+
+			startupCfg := simtestutil.DefaultStartUpConfig()
+			startupCfg.GenesisAccounts = []simtestutil.GenesisAccount{
+				{GenesisAccount: types.NewBaseAccountWithAddress(payer)},
+			}
+			app, err := simtestutil.SetupWithConfiguration(
+				depinject.Configs(testutil.AppConfig, depinject.Supply(log.NewNopLogger())),
+				startupCfg, &accountKeeper, &bankKeeper, &txConfig,
+			)
+			require.NoError(t, err)
+
+			ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+			params := accountKeeper.GetParams(ctx)
+			params.MaxRefundRate = tc.maxRefundRate
+			require.NoError(t, accountKeeper.Params.Set(ctx, params))
+			require.NoError(t, banktestutil.FundAccount(ctx, bankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 10_000))))
+			feeCollector := accountKeeper.GetModuleAddress(types.FeeCollectorName)
+			feeCollectorBalance := bankKeeper.GetBalance(ctx, feeCollector, "atom").Amount.Int64()
+
+			txResult := deliverSend(t, app, txConfig, accountKeeper.GetAccount(ctx, payer), payerPriv, recipient, tc.sendAmount, fee)
+			require.Equal(t, tc.expSuccess, txResult.IsOK(), txResult.Log)
+
+			refund := tc.expRefund(txResult.GasWanted, txResult.GasUsed)
+			ctx = app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+			sent := int64(0)
+			if tc.expSuccess {
+				sent = tc.sendAmount
+			}
+			require.Equal(t, 10_000-1000+refund-sent, bankKeeper.GetBalance(ctx, payer, "atom").Amount.Int64())
+			// the fee collector, whose balance is distributed as fees, does not keep the refund
+			require.Equal(t, feeCollectorBalance+1000-refund, bankKeeper.GetBalance(ctx, feeCollector, "atom").Amount.Int64())
+
+			var refundEvents []abci.Event
+			for _, event := range txResult.Events {
+				if event.Type == types.EventTypeFeeRefund {
+					refundEvents = append(refundEvents, event)
+				}
+			}
+			if refund == 0 {
+				require.Empty(t, refundEvents)
+				return
+			}
+			require.Len(t, refundEvents, 1)
+			require.Equal(t, []abci.EventAttribute{
+				{Key: types.AttributeKeyRefund, Value: strconv.FormatInt(refund, 10) + "atom", Index: true},
+				{Key: sdk.AttributeKeyFeePayer, Value: payer.String(), Index: true},
+			}, refundEvents[0].Attributes)
+		})
+	}
+}
+
+func TestRefundDecoratorFeeGranter(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		txConfig      client.TxConfig
+	)
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(testutil.AppConfig, depinject.Supply(log.NewNopLogger())),
+		simtestutil.DefaultStartUpConfig(), &accountKeeper, &txConfig,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{}).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithGasMeter(storetypes.NewGasMeter(200_000))
+	params := accountKeeper.GetParams(ctx)
+	params.MaxRefundRate = math.LegacyOneDec()
+	require.NoError(t, accountKeeper.Params.Set(ctx, params))
+
+	builder := txConfig.NewTxBuilder()
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	builder.SetGasLimit(200_000)
+	builder.SetFeePayer(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	builder.SetFeeGranter(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+
+	// the bank keeper mock fails the test if the decorator sends a refund
+	bankKeeper := testutil.NewMockBankKeeper(gomock.NewController(t))
+	decorator := posthandler.NewRefundDecorator(accountKeeper, bankKeeper)
+	_, err = decorator.PostHandle(ctx, builder.GetTx(), false, true, func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRefundDecoratorTxFeeChecker(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		txConfig      client.TxConfig
+	)
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(testutil.AppConfig, depinject.Supply(log.NewNopLogger())),
+		simtestutil.DefaultStartUpConfig(), &accountKeeper, &txConfig,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{}).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithGasMeter(storetypes.NewGasMeter(200_000))
+	params := accountKeeper.GetParams(ctx)
+	params.MaxRefundRate = math.LegacyOneDec()
+	require.NoError(t, accountKeeper.Params.Set(ctx, params))
+
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(payer)))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	builder.SetGasLimit(200_000)
+
+	// the fee checker deducts less than the fee of the tx, and the refund is a share of
+	// the deducted fee: 400atom * (200_000 - 50_000) / 200_000
+	deductedFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 400))
+	feeChecker := func(sdk.Context, sdk.Tx) (sdk.Coins, int64, error) { return deductedFee, 0, nil }
+	bankKeeper := testutil.NewMockBankKeeper(gomock.NewController(t))
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, types.FeeCollectorName, deductedFee).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.FeeCollectorName, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))).Return(nil)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, nil, feeChecker))
+	ctx, err = anteHandler(ctx, builder.GetTx(), false)
+	require.NoError(t, err)
+	require.Equal(t, deductedFee, ante.DeductedFee(ctx))
+	ctx.GasMeter().ConsumeGas(50_000-ctx.GasMeter().GasConsumed(), "msgs")
+
+	postHandler := sdk.ChainPostDecorators(posthandler.NewRefundDecorator(accountKeeper, bankKeeper))
+	_, err = postHandler(ctx, builder.GetTx(), false, true)
+	require.NoError(t, err)
+}
+
+// deliverSend delivers in a new block a tx sending amount atoms from the payer to
+// the recipient, paying the given fee for twice the default gas.
+func deliverSend(
+	t *testing.T, app *runtime.App, txConfig client.TxConfig, payerAcc sdk.AccountI, payerPriv *secp256k1.PrivKey,
+	recipient sdk.AccAddress, amount int64, fee sdk.Coins,
+) *abci.ExecTxResult {
+	t.Helper()
+
+	msg := banktypes.NewMsgSend(payerAcc.GetAddress().String(), recipient.String(), sdk.NewCoins(sdk.NewInt64Coin("atom", amount)))
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)), txConfig, []sdk.Msg{msg}, fee, 2*simtestutil.DefaultGenTxGas, "",
+		[]uint64{payerAcc.GetAccountNumber()}, []uint64{payerAcc.GetSequence()}, payerPriv,
+	)
+	require.NoError(t, err)
+	txBytes, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 1)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	return res.TxResults[0]
+}
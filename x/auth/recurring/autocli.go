@@ -0,0 +1,42 @@
+package recurring
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	recurringv1beta1 "cosmossdk.io/api/cosmos/recurring/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: recurringv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateRecurringPayment",
+					Use:            "create-recurring-payment [recipient] [interval] [amount]",
+					Short:          "Authorize a payment of an amount to a recipient every interval blocks",
+					Long:           "Authorize a payment of an amount to a recipient every interval blocks, the first one being made interval blocks after the transaction. The payments stop after --expiry-height, if set. A payment is skipped when the spendable coins of the payer do not cover it.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "interval"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "CancelRecurringPayment",
+					Use:            "cancel-recurring-payment [id]",
+					Short:          "Cancel a recurring payment of the payer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update recurring module params. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx recurring update-params-proposal '{ "min_interval": "10", "max_payments_per_payer": "10", "max_consecutive_skips": "3", "max_payments_per_block": "200" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package recurring
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/recurring/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/x/auth/keeper"
+	recurringkeeper "cosmossdk.io/x/auth/recurring/keeper"
+	"cosmossdk.io/x/auth/recurring/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Environment   appmodule.Environment
+	Cdc           codec.Codec
+	AccountKeeper keeper.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	RecurringKeeper recurringkeeper.Keeper
+	Module          appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := recurringkeeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	m := NewAppModule(k, in.AccountKeeper, in.BankKeeper)
+
+	return ModuleOutputs{RecurringKeeper: k, Module: m}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/auth/recurring/types"
+)
+
+// InitGenesis stores the params and the recurring payments of the genesis
+// state, and sets the sequence of the ids after the highest of their ids.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	var nextID uint64
+	for _, payment := range data.RecurringPayments {
+		if err := k.setPayment(ctx, payment); err != nil {
+			return err
+		}
+		nextID = max(nextID, payment.Id+1)
+	}
+
+	return k.PaymentID.Set(ctx, nextID)
+}
+
+// ExportGenesis exports the params and the recurring payments.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := []types.RecurringPayment{}
+	err = k.Payments.Walk(ctx, nil, func(_ uint64, payment types.RecurringPayment) (bool, error) {
+		payments = append(payments, payment)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, payments), nil
+}
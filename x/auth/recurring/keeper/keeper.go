@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/recurring/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Keeper stores the recurring payments authorized by their payers, and makes
+// them when they are due.
+type Keeper struct {
+	environment   appmodule.Environment
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    types.BankKeeper
+
+	Schema collections.Schema
+	// PaymentID is the sequence of the ids of the recurring payments
+	PaymentID collections.Sequence
+	// Payments key: id | value: recurring payment
+	Payments collections.Map[uint64, types.RecurringPayment]
+	// PaymentQueue key: next payment height+id | value: none
+	PaymentQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// PaymentsByPayer key: payer+id | value: none
+	PaymentsByPayer collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// Params key: none | value: params
+	Params collections.Item[types.Params]
+}
+
+// NewKeeper creates a recurring Keeper
+func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak authkeeper.AccountKeeper, bk types.BankKeeper) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+
+	k := Keeper{
+		environment:   env,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		PaymentID:     collections.NewSequence(sb, types.PaymentIDPrefix, "payment_id"),
+		Payments: collections.NewMap(
+			sb,
+			types.PaymentsPrefix,
+			"payments",
+			collections.Uint64Key,
+			codec.CollValue[types.RecurringPayment](cdc),
+		),
+		PaymentQueue: collections.NewKeySet(
+			sb,
+			types.PaymentQueuePrefix,
+			"payment_queue",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		PaymentsByPayer: collections.NewKeySet(
+			sb,
+			types.PaymentsByPayerPrefix,
+			"payments_by_payer",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
+		Params: collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// CreatePayment stores a new recurring payment, setting its id, and queues its
+// next payment. It returns the id of the payment.
+func (k Keeper) CreatePayment(ctx context.Context, payment types.RecurringPayment) (uint64, error) {
+	id, err := k.PaymentID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	payment.Id = id
+	return id, k.setPayment(ctx, payment)
+}
+
+// CancelPayment removes the recurring payment of the given payer with the given
+// id, and its next payment from the queue.
+func (k Keeper) CancelPayment(ctx context.Context, payer string, id uint64) error {
+	payment, err := k.Payments.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdkerrors.ErrNotFound.Wrapf("recurring payment %d not found", id)
+		}
+		return err
+	}
+
+	if payment.Payer != payer {
+		return sdkerrors.ErrNotFound.Wrapf("recurring payment %d not found for payer %s", id, payer)
+	}
+
+	return k.removePayment(ctx, payment)
+}
+
+// GetPaymentCount returns the number of recurring payments of the payer.
+func (k Keeper) GetPaymentCount(ctx context.Context, payer sdk.AccAddress) (uint64, error) {
+	var count uint64
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](payer)
+	err := k.PaymentsByPayer.Walk(ctx, rng, func(collections.Pair[sdk.AccAddress, uint64]) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
+
+// ProcessPayments makes the recurring payments due at the current block height,
+// and queues their next payment, unless they expire before it. A payment is
+// made if the spendable coins of the payer cover its amount, and skipped
+// otherwise, the locked coins of vesting accounts never paying for it. A
+// recurring_payment event is emitted with the status of each payment. A
+// recurring payment skipped MaxConsecutiveSkips times in a row is removed, with
+// a remove_recurring_payment event. At most MaxPaymentsPerBlock payments are
+// processed, the remaining ones being processed in the next blocks.
+func (k Keeper) ProcessPayments(ctx context.Context) error {
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	limit := int(params.MaxPaymentsPerBlock)
+
+	var due []collections.Pair[int64, uint64]
+	rng := collections.NewPrefixUntilPairRange[int64, uint64](height)
+	err = k.PaymentQueue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		due = append(due, key)
+		return len(due) == limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		payment, err := k.Payments.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		status, err := k.pay(ctx, payment)
+		if err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeRecurringPayment,
+			event.NewAttribute(types.AttributeKeyID, strconv.FormatUint(payment.Id, 10)),
+			event.NewAttribute(types.AttributeKeyPayer, payment.Payer),
+			event.NewAttribute(types.AttributeKeyRecipient, payment.Recipient),
+			event.NewAttribute(types.AttributeKeyAmount, payment.Amount.String()),
+			event.NewAttribute(types.AttributeKeyStatus, status),
+		); err != nil {
+			return err
+		}
+
+		if err := k.removePayment(ctx, payment); err != nil {
+			return err
+		}
+
+		// a payment which keeps being skipped is removed, so that it does not
+		// stay queued forever
+		if status == types.AttributeValueSkipped {
+			payment.ConsecutiveSkips++
+		} else {
+			payment.ConsecutiveSkips = 0
+		}
+		if payment.ConsecutiveSkips >= params.MaxConsecutiveSkips {
+			if err := k.environment.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeRemoveRecurringPayment,
+				event.NewAttribute(types.AttributeKeyID, strconv.FormatUint(payment.Id, 10)),
+				event.NewAttribute(types.AttributeKeyPayer, payment.Payer),
+				event.NewAttribute(types.AttributeKeySkips, strconv.FormatUint(payment.ConsecutiveSkips, 10)),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the next payment is counted from the current block, so that the
+		// payments processed late are not made twice in a row. A payment whose
+		// next height would overflow is not made again.
+		nextHeight, err := types.NextPaymentHeight(height, payment.Interval)
+		if err != nil {
+			continue
+		}
+		payment.NextHeight = nextHeight
+		if payment.ExpiryHeight != 0 && payment.NextHeight > payment.ExpiryHeight {
+			continue
+		}
+		if err := k.setPayment(ctx, payment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pay makes the payment if the spendable coins of the payer cover its amount,
+// returning its status.
+func (k Keeper) pay(ctx context.Context, payment types.RecurringPayment) (string, error) {
+	payer, err := k.accountKeeper.AddressCodec().StringToBytes(payment.Payer)
+	if err != nil {
+		return "", err
+	}
+	recipient, err := k.accountKeeper.AddressCodec().StringToBytes(payment.Recipient)
+	if err != nil {
+		return "", err
+	}
+
+	if !k.bankKeeper.SpendableCoins(ctx, payer).IsAllGTE(payment.Amount) {
+		return types.AttributeValueSkipped, nil
+	}
+
+	// a payment that cannot be made, e.g. because its denom is no longer
+	// sendable, is skipped without changing the state
+	if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		return k.bankKeeper.SendCoins(ctx, payer, recipient, payment.Amount)
+	}); err != nil {
+		return types.AttributeValueSkipped, nil
+	}
+
+	return types.AttributeValuePaid, nil
+}
+
+func (k Keeper) setPayment(ctx context.Context, payment types.RecurringPayment) error {
+	payer, err := k.accountKeeper.AddressCodec().StringToBytes(payment.Payer)
+	if err != nil {
+		return err
+	}
+	if err := k.Payments.Set(ctx, payment.Id, payment); err != nil {
+		return err
+	}
+	if err := k.PaymentsByPayer.Set(ctx, collections.Join(sdk.AccAddress(payer), payment.Id)); err != nil {
+		return err
+	}
+	return k.PaymentQueue.Set(ctx, collections.Join(payment.NextHeight, payment.Id))
+}
+
+func (k Keeper) removePayment(ctx context.Context, payment types.RecurringPayment) error {
+	payer, err := k.accountKeeper.AddressCodec().StringToBytes(payment.Payer)
+	if err != nil {
+		return err
+	}
+	if err := k.Payments.Remove(ctx, payment.Id); err != nil {
+		return err
+	}
+	if err := k.PaymentsByPayer.Remove(ctx, collections.Join(sdk.AccAddress(payer), payment.Id)); err != nil {
+		return err
+	}
+	return k.PaymentQueue.Remove(ctx, collections.Join(payment.NextHeight, payment.Id))
+}
@@ -0,0 +1,415 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	_ "cosmossdk.io/x/auth"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	_ "cosmossdk.io/x/auth/recurring"
+	"cosmossdk.io/x/auth/recurring/keeper"
+	"cosmossdk.io/x/auth/recurring/types"
+	_ "cosmossdk.io/x/auth/tx/config"
+	authtypes "cosmossdk.io/x/auth/types"
+	_ "cosmossdk.io/x/auth/vesting"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	_ "cosmossdk.io/x/bank"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	_ "cosmossdk.io/x/staking"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"cosmossdk.io/x/auth/recurring"
+)
+
+var (
+	payer     = sdk.AccAddress([]byte("payer________________"))
+	recipient = sdk.AccAddress([]byte("recipient____________"))
+)
+
+var appConfig = configurator.NewAppConfig(
+	configurator.AuthModule(),
+	configurator.BankModule(),
+	configurator.VestingModule(),
+	configurator.RecurringModule(),
+	configurator.StakingModule(),
+	configurator.TxModule(),
+	configurator.ConsensusModule(),
+	configurator.GenutilModule(),
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app             *runtime.App
+	ctx             sdk.Context
+	accountKeeper   authkeeper.AccountKeeper
+	bankKeeper      bankkeeper.Keeper
+	recurringKeeper keeper.Keeper
+	msgServer       types.MsgServer
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app, err := simtestutil.Setup(
+		depinject.Configs(
+			appConfig,
+			depinject.Supply(log.NewNopLogger()),
+		),
+		&s.accountKeeper, &s.bankKeeper, &s.recurringKeeper,
+	)
+	s.Require().NoError(err)
+
+	s.app = app
+	s.ctx = app.BaseApp.NewContext(false).WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1000, 0)})
+	s.msgServer = recurring.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.recurringKeeper)
+
+	// the tests use intervals below the default minimum
+	params := types.DefaultParams()
+	params.MinInterval = 1
+	s.Require().NoError(s.recurringKeeper.Params.Set(s.ctx, params))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, amount int64) {
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.bankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin("foo", amount))))
+}
+
+func (s *KeeperTestSuite) balance(addr sdk.AccAddress) int64 {
+	return s.bankKeeper.GetBalance(s.ctx, addr, "foo").Amount.Int64()
+}
+
+// createPayment creates a recurring payment of amount foo from the payer to the
+// recipient, returning its id.
+func (s *KeeperTestSuite) createPayment(amount int64, interval uint64, expiryHeight int64) uint64 {
+	res, err := s.msgServer.CreateRecurringPayment(s.ctx, types.NewMsgCreateRecurringPayment(
+		payer.String(), recipient.String(), sdk.NewCoins(sdk.NewInt64Coin("foo", amount)), interval, expiryHeight,
+	))
+	s.Require().NoError(err)
+	return res.Id
+}
+
+// processPayments runs ProcessPayments at the given block height, processing at
+// most limit payments, and returns the status of the recurring_payment events of
+// each payment.
+func (s *KeeperTestSuite) processPayments(height int64, limit int) map[string]string {
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: height, Time: time.Unix(1000+height, 0)}).WithEventManager(sdk.NewEventManager())
+	params, err := s.recurringKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.MaxPaymentsPerBlock = uint64(limit)
+	s.Require().NoError(s.recurringKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.recurringKeeper.ProcessPayments(s.ctx))
+
+	statuses := make(map[string]string)
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRecurringPayment {
+			continue
+		}
+		id, ok := event.GetAttribute(types.AttributeKeyID)
+		s.Require().True(ok)
+		status, ok := event.GetAttribute(types.AttributeKeyStatus)
+		s.Require().True(ok)
+		statuses[id.Value] = status.Value
+	}
+	return statuses
+}
+
+func (s *KeeperTestSuite) TestCreateRecurringPayment() {
+	foo := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgCreateRecurringPayment
+		expErr error
+	}{
+		{"invalid payer", types.NewMsgCreateRecurringPayment("invalid", recipient.String(), foo, 5, 0), sdkerrors.ErrInvalidAddress},
+		{"invalid recipient", types.NewMsgCreateRecurringPayment(payer.String(), "invalid", foo, 5, 0), sdkerrors.ErrInvalidAddress},
+		{"empty amount", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), sdk.NewCoins(), 5, 0), sdkerrors.ErrInvalidCoins},
+		{"zero interval", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, 0, 0), sdkerrors.ErrInvalidRequest},
+		{"max uint64 interval", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, math.MaxUint64, 0), sdkerrors.ErrInvalidRequest},
+		{"interval overflowing the height", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, math.MaxInt64, 0), sdkerrors.ErrInvalidRequest},
+		{"negative expiry height", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, 5, -1), sdkerrors.ErrInvalidRequest},
+		{"expiry before the first payment", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, 5, 14), sdkerrors.ErrInvalidRequest},
+		{"blocked recipient", types.NewMsgCreateRecurringPayment(payer.String(), feeCollector.String(), foo, 5, 0), sdkerrors.ErrUnauthorized},
+		{"expiry at the first payment", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, 5, 15), nil},
+		{"no expiry", types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, 5, 0), nil},
+	}
+
+	var nextID uint64
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.CreateRecurringPayment(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(nextID, res.Id)
+			nextID++
+
+			payment, err := s.recurringKeeper.Payments.Get(s.ctx, res.Id)
+			s.Require().NoError(err)
+			s.Require().Equal(types.RecurringPayment{
+				Id:           res.Id,
+				Payer:        tc.msg.Payer,
+				Recipient:    tc.msg.Recipient,
+				Amount:       tc.msg.Amount,
+				Interval:     tc.msg.Interval,
+				NextHeight:   15,
+				ExpiryHeight: tc.msg.ExpiryHeight,
+			}, payment)
+
+			has, err := s.recurringKeeper.PaymentQueue.Has(s.ctx, collections.Join(int64(15), res.Id))
+			s.Require().NoError(err)
+			s.Require().True(has)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreateRecurringPaymentLimits() {
+	params := types.NewParams(5, 2, types.DefaultMaxConsecutiveSkips, types.DefaultMaxPaymentsPerBlock)
+	s.Require().NoError(s.recurringKeeper.Params.Set(s.ctx, params))
+	foo := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+
+	// the interval cannot be below the minimum
+	_, err := s.msgServer.CreateRecurringPayment(s.ctx, types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, 4, 0))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().ErrorContains(err, "below the minimum")
+
+	// a payer cannot have more than the maximum number of payments
+	id := s.createPayment(10, 5, 0)
+	s.createPayment(10, 5, 0)
+	_, err = s.msgServer.CreateRecurringPayment(s.ctx, types.NewMsgCreateRecurringPayment(payer.String(), recipient.String(), foo, 5, 0))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().ErrorContains(err, "maximum of 2 recurring payments")
+
+	// the payments of other payers are not counted
+	_, err = s.msgServer.CreateRecurringPayment(s.ctx, types.NewMsgCreateRecurringPayment(recipient.String(), payer.String(), foo, 5, 0))
+	s.Require().NoError(err)
+
+	// a cancelled payment is no longer counted
+	_, err = s.msgServer.CancelRecurringPayment(s.ctx, types.NewMsgCancelRecurringPayment(payer.String(), id))
+	s.Require().NoError(err)
+	s.createPayment(10, 5, 0)
+	count, err := s.recurringKeeper.GetPaymentCount(s.ctx, payer)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), count)
+}
+
+func (s *KeeperTestSuite) TestCancelRecurringPayment() {
+	id := s.createPayment(10, 5, 0)
+
+	_, err := s.msgServer.CancelRecurringPayment(s.ctx, types.NewMsgCancelRecurringPayment(recipient.String(), id))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = s.msgServer.CancelRecurringPayment(s.ctx, types.NewMsgCancelRecurringPayment(payer.String(), id+1))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = s.msgServer.CancelRecurringPayment(s.ctx, types.NewMsgCancelRecurringPayment(payer.String(), id))
+	s.Require().NoError(err)
+
+	has, err := s.recurringKeeper.Payments.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = s.recurringKeeper.PaymentQueue.Has(s.ctx, collections.Join(int64(15), id))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the cancelled payment is not made
+	s.fund(payer, 100)
+	s.Require().Empty(s.processPayments(15, 10))
+	s.Require().Equal(int64(100), s.balance(payer))
+}
+
+func (s *KeeperTestSuite) TestProcessPayments() {
+	s.fund(payer, 70)
+	id := s.createPayment(30, 5, 25)
+
+	// the payments are made every 5 blocks, until the funds are insufficient
+	s.Require().Empty(s.processPayments(14, 10))
+	s.Require().Equal(map[string]string{"0": types.AttributeValuePaid}, s.processPayments(15, 10))
+	s.Require().Equal(int64(40), s.balance(payer))
+	s.Require().Equal(int64(30), s.balance(recipient))
+
+	s.Require().Empty(s.processPayments(19, 10))
+	s.Require().Equal(map[string]string{"0": types.AttributeValuePaid}, s.processPayments(20, 10))
+	s.Require().Equal(int64(10), s.balance(payer))
+
+	// the payment is skipped, without failing
+	s.Require().Equal(map[string]string{"0": types.AttributeValueSkipped}, s.processPayments(25, 10))
+	s.Require().Equal(int64(10), s.balance(payer))
+	s.Require().Equal(int64(60), s.balance(recipient))
+
+	// the next payment would be after the expiry height, so the payment is removed
+	has, err := s.recurringKeeper.Payments.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	s.fund(payer, 100)
+	s.Require().Empty(s.processPayments(30, 10))
+}
+
+func (s *KeeperTestSuite) TestProcessPaymentsIntervalOverflow() {
+	s.fund(payer, 100)
+	gs := types.DefaultGenesisState()
+	gs.RecurringPayments = []types.RecurringPayment{{
+		Payer:      payer.String(),
+		Recipient:  recipient.String(),
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+		Interval:   math.MaxInt64,
+		NextHeight: 15,
+	}}
+	s.Require().NoError(types.ValidateGenesis(*gs, s.accountKeeper.AddressCodec()))
+	s.Require().NoError(s.recurringKeeper.InitGenesis(s.ctx, gs))
+
+	// the payment is made, but its next height would overflow, so it is removed
+	// instead of being queued at a negative height, due at every block
+	s.Require().Equal(map[string]string{"0": types.AttributeValuePaid}, s.processPayments(15, 10))
+	has, err := s.recurringKeeper.Payments.Has(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	s.Require().Empty(s.processPayments(16, 10))
+	s.Require().Equal(int64(90), s.balance(payer))
+}
+
+func (s *KeeperTestSuite) TestProcessPaymentsLate() {
+	s.fund(payer, 100)
+	s.createPayment(10, 5, 0)
+
+	// a payment processed late is made once, the next one being counted from
+	// the block processing it
+	s.Require().Equal(map[string]string{"0": types.AttributeValuePaid}, s.processPayments(32, 10))
+	s.Require().Empty(s.processPayments(36, 10))
+	s.Require().Equal(map[string]string{"0": types.AttributeValuePaid}, s.processPayments(37, 10))
+	s.Require().Equal(int64(80), s.balance(payer))
+}
+
+func (s *KeeperTestSuite) TestProcessPaymentsLimit() {
+	s.fund(payer, 100)
+	for i := 0; i < 3; i++ {
+		s.createPayment(10, 5, 0)
+	}
+
+	// the payments beyond the limit are made in the next block
+	s.Require().Equal(map[string]string{"0": types.AttributeValuePaid, "1": types.AttributeValuePaid}, s.processPayments(15, 2))
+	s.Require().Equal(map[string]string{"2": types.AttributeValuePaid}, s.processPayments(16, 2))
+	s.Require().Equal(int64(70), s.balance(payer))
+}
+
+func (s *KeeperTestSuite) TestProcessPaymentsConsecutiveSkips() {
+	id := s.createPayment(10, 5, 0)
+
+	// a payment made resets the count of the consecutive skips
+	s.Require().Equal(map[string]string{"0": types.AttributeValueSkipped}, s.processPayments(15, 10))
+	s.Require().Equal(map[string]string{"0": types.AttributeValueSkipped}, s.processPayments(20, 10))
+	s.fund(payer, 10)
+	s.Require().Equal(map[string]string{"0": types.AttributeValuePaid}, s.processPayments(25, 10))
+	payment, err := s.recurringKeeper.Payments.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Zero(payment.ConsecutiveSkips)
+
+	s.Require().Equal(map[string]string{"0": types.AttributeValueSkipped}, s.processPayments(30, 10))
+	s.Require().Equal(map[string]string{"0": types.AttributeValueSkipped}, s.processPayments(35, 10))
+	payment, err = s.recurringKeeper.Payments.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), payment.ConsecutiveSkips)
+
+	// the payment is removed once skipped MaxConsecutiveSkips times in a row
+	s.Require().Equal(map[string]string{"0": types.AttributeValueSkipped}, s.processPayments(40, 10))
+	var removed bool
+	for _, event := range s.ctx.EventManager().Events() {
+		removed = removed || event.Type == types.EventTypeRemoveRecurringPayment
+	}
+	s.Require().True(removed)
+
+	has, err := s.recurringKeeper.Payments.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	count, err := s.recurringKeeper.GetPaymentCount(s.ctx, payer)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+	s.fund(payer, 100)
+	s.Require().Empty(s.processPayments(45, 10))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(20, 5, 2, 100)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: payer.String(), Params: params})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.accountKeeper.GetAuthority(), Params: types.Params{}})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.accountKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	stored, err := s.recurringKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params, stored)
+}
+
+func (s *KeeperTestSuite) TestProcessPaymentsVestingAccount() {
+	// the payer has 100foo still vesting, and 20foo spendable
+	baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, payer).(*authtypes.BaseAccount)
+	bva, err := vestingtypes.NewBaseVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), 2000)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, vestingtypes.NewDelayedVestingAccountRaw(bva))
+	s.fund(payer, 120)
+
+	id := s.createPayment(30, 5, 0)
+
+	// the locked coins never pay for the payment
+	s.Require().Equal(map[string]string{"0": types.AttributeValueSkipped}, s.processPayments(15, 10))
+	s.Require().Equal(int64(120), s.balance(payer))
+	s.Require().Zero(s.balance(recipient))
+
+	_, err = s.msgServer.CancelRecurringPayment(s.ctx, types.NewMsgCancelRecurringPayment(payer.String(), id))
+	s.Require().NoError(err)
+
+	// the spendable coins do
+	s.createPayment(20, 5, 0)
+	s.Require().Equal(map[string]string{"1": types.AttributeValuePaid}, s.processPayments(25, 10))
+	s.Require().Equal(int64(100), s.balance(payer))
+	s.Require().Equal(int64(20), s.balance(recipient))
+	s.Require().Equal(map[string]string{"1": types.AttributeValueSkipped}, s.processPayments(30, 10))
+}
+
+func (s *KeeperTestSuite) TestExportImportGenesis() {
+	s.createPayment(10, 5, 0)
+	id := s.createPayment(20, 7, 100)
+	s.createPayment(30, 3, 0)
+	_, err := s.msgServer.CancelRecurringPayment(s.ctx, types.NewMsgCancelRecurringPayment(payer.String(), id))
+	s.Require().NoError(err)
+
+	gs, err := s.recurringKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(gs.RecurringPayments, 2)
+	s.Require().NoError(types.ValidateGenesis(*gs, s.accountKeeper.AddressCodec()))
+
+	s.SetupTest()
+	s.Require().NoError(s.recurringKeeper.InitGenesis(s.ctx, gs))
+
+	exported, err := s.recurringKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	// the pending payments are queued, and new payments get new ids
+	s.fund(payer, 100)
+	s.Require().Equal(map[string]string{"2": types.AttributeValuePaid}, s.processPayments(13, 10))
+	s.Require().Equal(uint64(3), s.createPayment(10, 5, 0))
+}
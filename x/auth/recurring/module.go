@@ -0,0 +1,113 @@
+package recurring
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/registry"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/keeper"
+	recurringkeeper "cosmossdk.io/x/auth/recurring/keeper"
+	"cosmossdk.io/x/auth/recurring/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var (
+	_ module.AppModule  = AppModule{}
+	_ module.HasName    = AppModule{}
+	_ module.HasGenesis = AppModule{}
+
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasServices     = AppModule{}
+)
+
+// AppModule implementing the AppModule interface.
+type AppModule struct {
+	keeper        recurringkeeper.Keeper
+	accountKeeper keeper.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+func NewAppModule(k recurringkeeper.Keeper, ak keeper.AccountKeeper, bk types.BankKeeper) AppModule {
+	return AppModule{
+		keeper:        k,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (am AppModule) IsAppModule() {}
+
+// Name returns the module's name.
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec registers the module's types with the given codec.
+func (AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the module's interfaces and implementations with
+// the given interface registry.
+func (AppModule) RegisterInterfaces(registry registry.LegacyRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	types.RegisterMsgServer(registrar, NewMsgServerImpl(am.accountKeeper, am.bankKeeper, am.keeper))
+
+	return nil
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the recurring module.
+func (AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the recurring module.
+func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal %s genesis state", types.ModuleName)
+	}
+
+	return types.ValidateGenesis(data, am.accountKeeper.AddressCodec())
+}
+
+// InitGenesis performs genesis initialization for the recurring module.
+func (am AppModule) InitGenesis(ctx context.Context, cdc codec.JSONCodec, bz json.RawMessage) {
+	var gs types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &gs)
+
+	if err := am.keeper.InitGenesis(ctx, &gs); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the recurring module.
+func (am AppModule) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return cdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock makes the recurring payments due at the current block height.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.ProcessPayments(ctx)
+}
+
+// ConsensusVersion implements HasConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
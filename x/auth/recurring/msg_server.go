@@ -0,0 +1,147 @@
+package recurring
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/x/auth/keeper"
+	recurringkeeper "cosmossdk.io/x/auth/recurring/keeper"
+	"cosmossdk.io/x/auth/recurring/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	types.UnimplementedMsgServer
+
+	keeper.AccountKeeper
+	types.BankKeeper
+
+	recurringKeeper recurringkeeper.Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the recurring MsgServer
+// interface, wrapping the corresponding AccountKeeper, BankKeeper and recurring
+// Keeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, rk recurringkeeper.Keeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, recurringKeeper: rk}
+}
+
+var _ types.MsgServer = &msgServer{}
+
+// CreateRecurringPayment stores a recurring payment of the amount from the payer
+// to the recipient, made every interval blocks from the current block until the
+// expiry height.
+func (s msgServer) CreateRecurringPayment(ctx context.Context, msg *types.MsgCreateRecurringPayment) (*types.MsgCreateRecurringPaymentResponse, error) {
+	payer, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Payer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payer address: %s", err)
+	}
+	recipient, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params, err := s.recurringKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Interval < params.MinInterval {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("interval %d is below the minimum of %d blocks", msg.Interval, params.MinInterval)
+	}
+	count, err := s.recurringKeeper.GetPaymentCount(ctx, payer)
+	if err != nil {
+		return nil, err
+	}
+	if count >= params.MaxPaymentsPerPayer {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("payer %s already has the maximum of %d recurring payments", msg.Payer, params.MaxPaymentsPerPayer)
+	}
+
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(recipient) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", msg.Recipient)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	nextHeight, err := types.NextPaymentHeight(sdkCtx.HeaderInfo().Height, msg.Interval)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight < nextHeight {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiry height %d is before the first payment, at height %d", msg.ExpiryHeight, nextHeight)
+	}
+
+	id, err := s.recurringKeeper.CreatePayment(ctx, types.RecurringPayment{
+		Payer:        msg.Payer,
+		Recipient:    msg.Recipient,
+		Amount:       msg.Amount.Sort(),
+		Interval:     msg.Interval,
+		NextHeight:   nextHeight,
+		ExpiryHeight: msg.ExpiryHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateRecurringPayment,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPayer, msg.Payer),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyInterval, strconv.FormatUint(msg.Interval, 10)),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(msg.ExpiryHeight, 10)),
+		),
+	)
+
+	return &types.MsgCreateRecurringPaymentResponse{Id: id}, nil
+}
+
+// CancelRecurringPayment removes a recurring payment of the payer, whose
+// pending payments are not made.
+func (s msgServer) CancelRecurringPayment(ctx context.Context, msg *types.MsgCancelRecurringPayment) (*types.MsgCancelRecurringPaymentResponse, error) {
+	if _, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Payer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payer address: %s", err)
+	}
+
+	if err := s.recurringKeeper.CancelPayment(ctx, msg.Payer, msg.Id); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRecurringPayment,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPayer, msg.Payer),
+		),
+	)
+
+	return &types.MsgCancelRecurringPaymentResponse{}, nil
+}
+
+// UpdateParams updates the params of the recurring module, with the authority
+// of the x/auth module.
+func (s msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if authority := s.AccountKeeper.GetAuthority(); authority != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.recurringKeeper.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
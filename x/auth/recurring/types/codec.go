@@ -0,0 +1,32 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the recurring module messages on the
+// provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateRecurringPayment{}, "cosmos-sdk/MsgCreateRecurringPayment")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRecurringPayment{}, "cosmos-sdk/MsgCancelRecurringPayment")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/recurring/MsgUpdateParams")
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/recurring/Params", nil)
+}
+
+// RegisterInterfaces registers the recurring module messages with the
+// interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateRecurringPayment{},
+		&MsgCancelRecurringPayment{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
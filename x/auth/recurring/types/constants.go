@@ -0,0 +1,30 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module's name.
+	ModuleName = "recurring"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// PaymentIDPrefix is the prefix of the sequence of the recurring payment ids.
+	PaymentIDPrefix = collections.NewPrefix(0)
+
+	// PaymentsPrefix is the prefix of the recurring payments, by id.
+	PaymentsPrefix = collections.NewPrefix(1)
+
+	// PaymentQueuePrefix is the prefix of the queue of the recurring payments,
+	// ordered by the height of their next payment.
+	PaymentQueuePrefix = collections.NewPrefix(2)
+
+	// PaymentsByPayerPrefix is the prefix of the index of the recurring payments
+	// by payer.
+	PaymentsByPayerPrefix = collections.NewPrefix(3)
+
+	// ParamsPrefix is the prefix of the params of the module.
+	ParamsPrefix = collections.NewPrefix(4)
+)
@@ -0,0 +1,24 @@
+package types
+
+// recurring module event types
+const (
+	EventTypeCreateRecurringPayment = "create_recurring_payment"
+	EventTypeCancelRecurringPayment = "cancel_recurring_payment"
+	EventTypeRecurringPayment       = "recurring_payment"
+	EventTypeRemoveRecurringPayment = "remove_recurring_payment"
+
+	AttributeKeyID           = "id"
+	AttributeKeyPayer        = "payer"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyInterval     = "interval"
+	AttributeKeyExpiryHeight = "expiry_height"
+	AttributeKeyStatus       = "status"
+	AttributeKeySkips        = "consecutive_skips"
+
+	// AttributeValuePaid is the status of a payment that was made.
+	AttributeValuePaid = "paid"
+	// AttributeValueSkipped is the status of a payment that was skipped, the
+	// spendable coins of the payer being insufficient.
+	AttributeValueSkipped = "skipped"
+)
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected interface contract the recurring module
+// requires for making the recurring payments.
+type BankKeeper interface {
+	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
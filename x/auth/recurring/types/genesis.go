@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, payments []RecurringPayment) *GenesisState {
+	return &GenesisState{
+		Params:            params,
+		RecurringPayments: payments,
+	}
+}
+
+// DefaultGenesisState returns a default recurring module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []RecurringPayment{})
+}
+
+// ValidateGenesis performs basic validation of the recurring genesis state,
+// returning an error for any failed validation criteria.
+func ValidateGenesis(gs GenesisState, ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[uint64]bool, len(gs.RecurringPayments))
+	for _, payment := range gs.RecurringPayments {
+		if seen[payment.Id] {
+			return fmt.Errorf("duplicate recurring payment %d", payment.Id)
+		}
+		seen[payment.Id] = true
+
+		if err := payment.Validate(ac); err != nil {
+			return fmt.Errorf("invalid recurring payment %d: %w", payment.Id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authcodec "cosmossdk.io/x/auth/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	ac := authcodec.NewBech32Codec("cosmos")
+	payer := sdk.AccAddress([]byte("payer________________")).String()
+	recipient := sdk.AccAddress([]byte("recipient____________")).String()
+
+	validPayment := func(id uint64) RecurringPayment {
+		return RecurringPayment{
+			Id:           id,
+			Payer:        payer,
+			Recipient:    recipient,
+			Amount:       sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+			Interval:     5,
+			NextHeight:   15,
+			ExpiryHeight: 20,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*RecurringPayment)
+		expErr   string
+	}{
+		{"valid", func(*RecurringPayment) {}, ""},
+		{"no expiry", func(p *RecurringPayment) { p.ExpiryHeight = 0 }, ""},
+		{"next payment at the expiry height", func(p *RecurringPayment) { p.NextHeight = 20 }, ""},
+		{"invalid payer", func(p *RecurringPayment) { p.Payer = "invalid" }, "invalid payer address"},
+		{"invalid recipient", func(p *RecurringPayment) { p.Recipient = "invalid" }, "invalid recipient address"},
+		{"empty amount", func(p *RecurringPayment) { p.Amount = sdk.NewCoins() }, "invalid amount"},
+		{"zero interval", func(p *RecurringPayment) { p.Interval = 0 }, "interval must be positive"},
+		{"max uint64 interval", func(p *RecurringPayment) { p.Interval = math.MaxUint64 }, "interval is too large"},
+		{"zero next height", func(p *RecurringPayment) { p.NextHeight = 0 }, "next payment height must be positive"},
+		{"next payment after the expiry height", func(p *RecurringPayment) { p.NextHeight = 21 }, "is after the expiry height"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payment := validPayment(1)
+			tc.malleate(&payment)
+
+			err := ValidateGenesis(*NewGenesisState(DefaultParams(), []RecurringPayment{validPayment(0), payment}), ac)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState(), ac))
+	require.ErrorContains(t, ValidateGenesis(*NewGenesisState(Params{}, nil), ac), "invalid min interval")
+	require.ErrorContains(t, ValidateGenesis(*NewGenesisState(DefaultParams(), []RecurringPayment{validPayment(0), validPayment(0)}), ac), "duplicate recurring payment 0")
+}
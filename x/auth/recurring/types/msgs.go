@@ -0,0 +1,54 @@
+package types
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateRecurringPayment{}
+	_ sdk.Msg = &MsgCancelRecurringPayment{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgCreateRecurringPayment returns a reference to a new MsgCreateRecurringPayment.
+// A zero expiry height makes the payments never expire.
+func NewMsgCreateRecurringPayment(payer, recipient string, amount sdk.Coins, interval uint64, expiryHeight int64) *MsgCreateRecurringPayment {
+	return &MsgCreateRecurringPayment{
+		Payer:        payer,
+		Recipient:    recipient,
+		Amount:       amount,
+		Interval:     interval,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// ValidateBasic performs the stateless checks of a MsgCreateRecurringPayment.
+// The addresses are checked by the message handler, with the address codec of
+// the chain.
+func (msg MsgCreateRecurringPayment) ValidateBasic() error {
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount)
+	}
+	if msg.Interval == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("interval must be positive")
+	}
+	if msg.Interval > math.MaxInt64 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("interval is too large: %d", msg.Interval)
+	}
+	if msg.ExpiryHeight < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiry height cannot be negative")
+	}
+
+	return nil
+}
+
+// NewMsgCancelRecurringPayment returns a reference to a new MsgCancelRecurringPayment.
+func NewMsgCancelRecurringPayment(payer string, id uint64) *MsgCancelRecurringPayment {
+	return &MsgCancelRecurringPayment{
+		Payer: payer,
+		Id:    id,
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import "fmt"
+
+// Default parameter values of the recurring module.
+const (
+	DefaultMinInterval         uint64 = 10
+	DefaultMaxPaymentsPerPayer uint64 = 10
+	DefaultMaxConsecutiveSkips uint64 = 3
+	DefaultMaxPaymentsPerBlock uint64 = 200
+)
+
+// NewParams creates a new Params object.
+func NewParams(minInterval, maxPaymentsPerPayer, maxConsecutiveSkips, maxPaymentsPerBlock uint64) Params {
+	return Params{
+		MinInterval:         minInterval,
+		MaxPaymentsPerPayer: maxPaymentsPerPayer,
+		MaxConsecutiveSkips: maxConsecutiveSkips,
+		MaxPaymentsPerBlock: maxPaymentsPerBlock,
+	}
+}
+
+// DefaultParams returns the default parameters of the recurring module.
+func DefaultParams() Params {
+	return NewParams(DefaultMinInterval, DefaultMaxPaymentsPerPayer, DefaultMaxConsecutiveSkips, DefaultMaxPaymentsPerBlock)
+}
+
+// Validate checks that all the parameters are positive.
+func (p Params) Validate() error {
+	if p.MinInterval == 0 {
+		return fmt.Errorf("invalid min interval: %d", p.MinInterval)
+	}
+	if p.MaxPaymentsPerPayer == 0 {
+		return fmt.Errorf("invalid max payments per payer: %d", p.MaxPaymentsPerPayer)
+	}
+	if p.MaxConsecutiveSkips == 0 {
+		return fmt.Errorf("invalid max consecutive skips: %d", p.MaxConsecutiveSkips)
+	}
+	if p.MaxPaymentsPerBlock == 0 {
+		return fmt.Errorf("invalid max payments per block: %d", p.MaxPaymentsPerBlock)
+	}
+
+	return nil
+}
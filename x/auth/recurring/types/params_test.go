@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params Params
+		expErr string
+	}{
+		{"default", DefaultParams(), ""},
+		{"zero min interval", NewParams(0, 10, 3, 200), "invalid min interval: 0"},
+		{"zero max payments per payer", NewParams(10, 0, 3, 200), "invalid max payments per payer: 0"},
+		{"zero max consecutive skips", NewParams(10, 10, 0, 200), "invalid max consecutive skips: 0"},
+		{"zero max payments per block", NewParams(10, 10, 3, 0), "invalid max payments per block: 0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
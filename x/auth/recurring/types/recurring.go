@@ -0,0 +1,48 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/core/address"
+)
+
+// Validate checks that the recurring payment has valid addresses, a valid
+// positive amount, a positive interval, and a next payment before its expiry.
+func (p RecurringPayment) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(p.Payer); err != nil {
+		return fmt.Errorf("invalid payer address %s: %w", p.Payer, err)
+	}
+	if _, err := ac.StringToBytes(p.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient address %s: %w", p.Recipient, err)
+	}
+	if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+		return fmt.Errorf("invalid amount: %s", p.Amount)
+	}
+	if p.Interval == 0 {
+		return errors.New("interval must be positive")
+	}
+	if p.Interval > math.MaxInt64 {
+		return fmt.Errorf("interval is too large: %d", p.Interval)
+	}
+	if p.NextHeight <= 0 {
+		return fmt.Errorf("next payment height must be positive: %d", p.NextHeight)
+	}
+	if p.ExpiryHeight != 0 && p.NextHeight > p.ExpiryHeight {
+		return fmt.Errorf("next payment height %d is after the expiry height %d", p.NextHeight, p.ExpiryHeight)
+	}
+
+	return nil
+}
+
+// NextPaymentHeight returns the height of the payment following the one at the
+// given height, after the given interval. It returns an error if the height
+// overflows.
+func NextPaymentHeight(height int64, interval uint64) (int64, error) {
+	if interval > uint64(math.MaxInt64-height) {
+		return 0, fmt.Errorf("interval %d from height %d overflows the block height", interval, height)
+	}
+
+	return height + int64(interval), nil
+}
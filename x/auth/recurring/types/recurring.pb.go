@@ -0,0 +1,912 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/recurring/v1beta1/recurring.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecurringPayment defines a payment of a fixed amount, authorized by the payer
+// to be made to the recipient every given number of blocks.
+type RecurringPayment struct {
+	// id is the unique identifier of the recurring payment.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payer is the address of the account paying the amount.
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// recipient is the address of the account receiving the amount.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid at each payment.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// interval is the number of blocks between two payments.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// next_height is the height of the block in which the next payment is made.
+	NextHeight int64 `protobuf:"varint,6,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// expiry_height is the height after which no payment is made anymore. Zero
+	// means that the payments never expire.
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// consecutive_skips is the number of payments skipped since the last payment
+	// made. The recurring payment is removed once it reaches the
+	// max_consecutive_skips param.
+	ConsecutiveSkips uint64 `protobuf:"varint,8,opt,name=consecutive_skips,json=consecutiveSkips,proto3" json:"consecutive_skips,omitempty"`
+}
+
+func (m *RecurringPayment) Reset()         { *m = RecurringPayment{} }
+func (m *RecurringPayment) String() string { return proto.CompactTextString(m) }
+func (*RecurringPayment) ProtoMessage()    {}
+func (*RecurringPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b0db3c75667b64, []int{0}
+}
+func (m *RecurringPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringPayment.Merge(m, src)
+}
+func (m *RecurringPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringPayment proto.InternalMessageInfo
+
+func (m *RecurringPayment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RecurringPayment) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *RecurringPayment) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *RecurringPayment) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *RecurringPayment) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RecurringPayment) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *RecurringPayment) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *RecurringPayment) GetConsecutiveSkips() uint64 {
+	if m != nil {
+		return m.ConsecutiveSkips
+	}
+	return 0
+}
+
+// Params defines the parameters of the recurring module.
+type Params struct {
+	// min_interval is the minimum number of blocks between two payments.
+	MinInterval uint64 `protobuf:"varint,1,opt,name=min_interval,json=minInterval,proto3" json:"min_interval,omitempty"`
+	// max_payments_per_payer is the maximum number of recurring payments of a
+	// payer.
+	MaxPaymentsPerPayer uint64 `protobuf:"varint,2,opt,name=max_payments_per_payer,json=maxPaymentsPerPayer,proto3" json:"max_payments_per_payer,omitempty"`
+	// max_consecutive_skips is the number of consecutive skipped payments after
+	// which a recurring payment is removed.
+	MaxConsecutiveSkips uint64 `protobuf:"varint,3,opt,name=max_consecutive_skips,json=maxConsecutiveSkips,proto3" json:"max_consecutive_skips,omitempty"`
+	// max_payments_per_block is the maximum number of payments processed in a
+	// block, the remaining ones being processed in the next blocks.
+	MaxPaymentsPerBlock uint64 `protobuf:"varint,4,opt,name=max_payments_per_block,json=maxPaymentsPerBlock,proto3" json:"max_payments_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b0db3c75667b64, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinInterval() uint64 {
+	if m != nil {
+		return m.MinInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPaymentsPerPayer() uint64 {
+	if m != nil {
+		return m.MaxPaymentsPerPayer
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConsecutiveSkips() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveSkips
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPaymentsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPaymentsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RecurringPayment)(nil), "cosmos.recurring.v1beta1.RecurringPayment")
+	proto.RegisterType((*Params)(nil), "cosmos.recurring.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/recurring/v1beta1/recurring.proto", fileDescriptor_56b0db3c75667b64)
+}
+
+var fileDescriptor_56b0db3c75667b64 = []byte{
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x1c, 0x8d, 0x9b, 0x34, 0xb4, 0x97, 0x80, 0x5a, 0x53, 0x90, 0x1b, 0x24, 0x27, 0xb4, 0x8b, 0x15,
+	0x54, 0x5b, 0x6d, 0x25, 0x06, 0x24, 0x06, 0x52, 0x09, 0xc1, 0x16, 0xb9, 0x1b, 0x8b, 0x75, 0xb1,
+	0x4f, 0xce, 0x29, 0xb9, 0x3b, 0xeb, 0xee, 0x12, 0xc5, 0x5f, 0x81, 0x89, 0x81, 0x89, 0x4f, 0x80,
+	0x98, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x4c, 0x80, 0x92, 0x21, 0x03, 0x5f, 0x02, 0xf9, 0xee,
+	0x12, 0x47, 0x50, 0x89, 0xc5, 0x7f, 0xde, 0xef, 0xbd, 0xdf, 0xfb, 0xfd, 0xee, 0xd9, 0xc0, 0x8b,
+	0x99, 0x20, 0x4c, 0x04, 0x1c, 0xc5, 0x13, 0xce, 0x31, 0x4d, 0x83, 0xe9, 0xf9, 0x00, 0x49, 0x78,
+	0x5e, 0x22, 0x7e, 0xc6, 0x99, 0x64, 0xb6, 0xa3, 0x99, 0x7e, 0x89, 0x1b, 0x66, 0xeb, 0x28, 0x65,
+	0x29, 0x53, 0xa4, 0xa0, 0x78, 0xd2, 0xfc, 0x96, 0x6b, 0x3a, 0x0f, 0xa0, 0x40, 0x9b, 0xa6, 0x31,
+	0xc3, 0xd4, 0xd4, 0x8f, 0x75, 0x3d, 0xd2, 0x42, 0xd3, 0x5c, 0x97, 0x0e, 0x21, 0xc1, 0x94, 0x05,
+	0xea, 0xaa, 0xa1, 0x93, 0x8f, 0x55, 0x70, 0x10, 0xae, 0x9d, 0xfb, 0x30, 0x27, 0x88, 0x4a, 0xfb,
+	0x01, 0xd8, 0xc1, 0x89, 0x63, 0x75, 0x2c, 0xaf, 0x16, 0xee, 0xe0, 0xc4, 0xf6, 0xc1, 0x6e, 0x06,
+	0x73, 0xc4, 0x9d, 0x9d, 0x8e, 0xe5, 0xed, 0xf7, 0x9c, 0x6f, 0x5f, 0xcf, 0x8e, 0x4c, 0xe3, 0x57,
+	0x49, 0xc2, 0x91, 0x10, 0xd7, 0xb2, 0xd0, 0x87, 0x9a, 0x66, 0x3f, 0x07, 0xfb, 0x1c, 0xc5, 0x38,
+	0xc3, 0x88, 0x4a, 0xa7, 0xfa, 0x1f, 0x4d, 0x49, 0xb5, 0x73, 0x50, 0x87, 0x84, 0x4d, 0xa8, 0x74,
+	0x6a, 0x9d, 0xaa, 0xd7, 0xb8, 0x38, 0xf6, 0x8d, 0xa2, 0xd8, 0x75, 0x7d, 0x2c, 0xfe, 0x15, 0xc3,
+	0xb4, 0xf7, 0xfa, 0xe6, 0x47, 0xbb, 0xf2, 0xe5, 0x67, 0xdb, 0x4b, 0xb1, 0x1c, 0x4e, 0x06, 0x7e,
+	0xcc, 0x88, 0xd9, 0xd5, 0xdc, 0xce, 0x44, 0x32, 0x0a, 0x64, 0x9e, 0x21, 0xa1, 0x04, 0xe2, 0xd3,
+	0x6a, 0xde, 0x6d, 0x8e, 0x51, 0x0a, 0xe3, 0x3c, 0x2a, 0x4e, 0x4b, 0x7c, 0x5e, 0xcd, 0xbb, 0x56,
+	0x68, 0x0c, 0xed, 0x16, 0xd8, 0xc3, 0x54, 0x22, 0x3e, 0x85, 0x63, 0x67, 0x57, 0x2d, 0xbe, 0x79,
+	0xb7, 0xdb, 0xa0, 0x41, 0xd1, 0x4c, 0x46, 0x43, 0x84, 0xd3, 0xa1, 0x74, 0xea, 0x1d, 0xcb, 0xab,
+	0x86, 0xa0, 0x80, 0xde, 0x28, 0xc4, 0x3e, 0x05, 0xf7, 0xd1, 0x2c, 0xc3, 0x3c, 0x5f, 0x53, 0xee,
+	0x29, 0x4a, 0x53, 0x83, 0x86, 0xf4, 0x0c, 0x1c, 0xc6, 0x8c, 0x0a, 0x14, 0x4f, 0x24, 0x9e, 0xa2,
+	0x48, 0x8c, 0x70, 0x26, 0x9c, 0x3d, 0x65, 0x75, 0xb0, 0x55, 0xb8, 0x2e, 0xf0, 0x93, 0xdf, 0x16,
+	0xa8, 0xf7, 0x21, 0x87, 0x44, 0xd8, 0x4f, 0x41, 0x93, 0x60, 0x1a, 0x6d, 0xa6, 0xd3, 0xb1, 0x34,
+	0x08, 0xa6, 0x6f, 0xd7, 0x03, 0x5e, 0x82, 0xc7, 0x04, 0xce, 0xa2, 0x4c, 0xc7, 0x27, 0xa2, 0x0c,
+	0xf1, 0xa8, 0x0c, 0xac, 0x16, 0x3e, 0x24, 0x70, 0x66, 0xb2, 0x15, 0x7d, 0xc4, 0xfb, 0x2a, 0xa4,
+	0x0b, 0xf0, 0xa8, 0x10, 0xfd, 0x3b, 0x53, 0x75, 0xa3, 0xb9, 0xfa, 0x6b, 0xac, 0x3b, 0x8d, 0x06,
+	0x63, 0x16, 0x8f, 0x9c, 0xda, 0x5d, 0x46, 0xbd, 0xa2, 0xf4, 0xa2, 0xf3, 0x7e, 0x35, 0xef, 0x3e,
+	0xd9, 0x4a, 0xa5, 0xfc, 0x27, 0xf4, 0x8a, 0xbd, 0x97, 0x37, 0x0b, 0xd7, 0xba, 0x5d, 0xb8, 0xd6,
+	0xaf, 0x85, 0x6b, 0x7d, 0x58, 0xba, 0x95, 0xdb, 0xa5, 0x5b, 0xf9, 0xbe, 0x74, 0x2b, 0xef, 0x4e,
+	0xb5, 0x4c, 0x24, 0x23, 0x1f, 0xb3, 0x60, 0x16, 0xc0, 0x89, 0x1c, 0x6e, 0xe9, 0x55, 0xbe, 0x83,
+	0xba, 0xfa, 0x94, 0x2f, 0xff, 0x0c, 0x00, 0x73, 0x83, 0xfc, 0xe9, 0x74, 0x03, 0x00, 0x00,
+}
+
+func (m *RecurringPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveSkips != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.ConsecutiveSkips))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecurring(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRecurring(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintRecurring(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPaymentsPerBlock != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.MaxPaymentsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxConsecutiveSkips != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.MaxConsecutiveSkips))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPaymentsPerPayer != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.MaxPaymentsPerPayer))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinInterval != 0 {
+		i = encodeVarintRecurring(dAtA, i, uint64(m.MinInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecurring(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecurring(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecurringPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRecurring(uint64(m.Id))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovRecurring(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRecurring(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRecurring(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovRecurring(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovRecurring(uint64(m.NextHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovRecurring(uint64(m.ExpiryHeight))
+	}
+	if m.ConsecutiveSkips != 0 {
+		n += 1 + sovRecurring(uint64(m.ConsecutiveSkips))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinInterval != 0 {
+		n += 1 + sovRecurring(uint64(m.MinInterval))
+	}
+	if m.MaxPaymentsPerPayer != 0 {
+		n += 1 + sovRecurring(uint64(m.MaxPaymentsPerPayer))
+	}
+	if m.MaxConsecutiveSkips != 0 {
+		n += 1 + sovRecurring(uint64(m.MaxConsecutiveSkips))
+	}
+	if m.MaxPaymentsPerBlock != 0 {
+		n += 1 + sovRecurring(uint64(m.MaxPaymentsPerBlock))
+	}
+	return n
+}
+
+func sovRecurring(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecurring(x uint64) (n int) {
+	return sovRecurring(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecurringPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecurring
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSkips", wireType)
+			}
+			m.ConsecutiveSkips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveSkips |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecurring(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecurring
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInterval", wireType)
+			}
+			m.MinInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPaymentsPerPayer", wireType)
+			}
+			m.MaxPaymentsPerPayer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPaymentsPerPayer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveSkips", wireType)
+			}
+			m.MaxConsecutiveSkips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveSkips |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPaymentsPerBlock", wireType)
+			}
+			m.MaxPaymentsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPaymentsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecurring(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecurring
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecurring(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecurring
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecurring
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecurring
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecurring
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecurring
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecurring        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecurring          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecurring = fmt.Errorf("proto: unexpected end of group")
+)
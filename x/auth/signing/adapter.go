@@ -63,3 +63,27 @@ func GetSignBytesAdapter(
 	// Generate the bytes to be signed.
 	return handlerMap.GetSignBytes(ctx, txSignMode, txSignerData, txData)
 }
+
+// GetLegacyAminoJSONSignBytes returns the canonical SIGN_MODE_LEGACY_AMINO_JSON sign bytes of a transaction for
+// the given chain-id, account number and sequence, the bytes signed by hardware wallets. The signer address is not
+// part of these bytes, so the fee payer of the transaction is given as signer.
+func GetLegacyAminoJSONSignBytes(
+	ctx context.Context,
+	handlerMap *txsigning.HandlerMap,
+	chainID string,
+	accountNumber, sequence uint64,
+	tx sdk.Tx,
+) ([]byte, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("expected tx to be FeeTx, got %T", tx)
+	}
+
+	signerData := SignerData{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		Address:       sdk.AccAddress(feeTx.FeePayer()).String(),
+	}
+	return GetSignBytesAdapter(ctx, handlerMap, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx)
+}
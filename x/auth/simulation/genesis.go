@@ -23,44 +23,98 @@ const (
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
-// It creates a slice of BaseAccount, ContinuousVestingAccount and DelayedVestingAccount.
+// It creates a slice of BaseAccount, ContinuousVestingAccount, DelayedVestingAccount
+// and PeriodicVestingAccount. The fraction of vesting accounts and the length of
+// their schedule are given by the vesting accounts configuration of the
+// simulation state.
 func RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccounts {
+	config := simState.VestingAccounts.OrDefault()
+	if err := config.Validate(); err != nil {
+		panic(err)
+	}
+
 	genesisAccs := make(types.GenesisAccounts, len(simState.Accounts))
 	for i, acc := range simState.Accounts {
 		bacc := types.NewBaseAccountWithAddress(acc.Address)
 
 		// Only consider making a vesting account once the initial bonded validator
 		// set is exhausted due to needing to track DelegatedVesting.
-		if !(int64(i) > simState.NumBonded && simState.Rand.Intn(100) < 50) {
+		if !(int64(i) > simState.NumBonded && simState.Rand.Float64() < config.Fraction) {
 			genesisAccs[i] = bacc
 			continue
 		}
 
-		initialVesting := sdk.NewCoins(sdk.NewInt64Coin(simState.BondDenom, simState.Rand.Int63n(simState.InitialStake.Int64())))
-		var endTime int64
+		genesisAccs[i] = randomVestingAccount(simState, config, bacc)
+	}
 
-		startTime := simState.GenTimestamp.Unix()
+	return genesisAccs
+}
 
-		// Allow for some vesting accounts to vest very quickly while others very slowly.
-		if simState.Rand.Intn(100) < 50 {
-			endTime = int64(simulation.RandIntBetween(simState.Rand, int(startTime)+1, int(startTime+(60*60*24*30))))
-		} else {
-			endTime = int64(simulation.RandIntBetween(simState.Rand, int(startTime)+1, int(startTime+(60*60*12))))
-		}
+// randomVestingAccount returns a continuous, delayed or periodic vesting
+// account, vesting part of the initial stake of the account.
+func randomVestingAccount(simState *module.SimulationState, config simulation.VestingAccountsConfig, bacc *types.BaseAccount) types.GenesisAccount {
+	r := simState.Rand
+	initialVesting := sdk.NewCoins(sdk.NewInt64Coin(simState.BondDenom, 1+r.Int63n(simState.InitialStake.Int64())))
 
-		bva, err := vestingtypes.NewBaseVestingAccount(bacc, initialVesting, endTime)
-		if err != nil {
-			panic(err)
-		}
+	// Allow for some vesting accounts to vest very quickly while others very slowly.
+	minDuration, maxDuration := int64(config.MinDuration.Seconds()), int64(config.MaxDuration.Seconds())
+	if r.Intn(100) < 50 {
+		maxDuration = minDuration + (maxDuration-minDuration)/60
+	}
+	duration := int64(simulation.RandIntBetween(r, int(minDuration), int(maxDuration)+1))
+
+	// Some schedules start before genesis, and are partly vested at genesis.
+	startTime := simState.GenTimestamp.Unix()
+	if r.Intn(100) < 25 {
+		startTime -= r.Int63n(duration)
+	}
+	endTime := startTime + duration
+
+	bva, err := vestingtypes.NewBaseVestingAccount(bacc, initialVesting, endTime)
+	if err != nil {
+		panic(err)
+	}
+
+	switch r.Intn(3) {
+	case 0:
+		return vestingtypes.NewContinuousVestingAccountRaw(bva, startTime)
+
+	case 1:
+		return vestingtypes.NewDelayedVestingAccountRaw(bva)
+
+	default:
+		return vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, randomPeriods(r, initialVesting, duration))
+	}
+}
 
-		if simState.Rand.Intn(100) < 50 {
-			genesisAccs[i] = vestingtypes.NewContinuousVestingAccountRaw(bva, startTime)
-		} else {
-			genesisAccs[i] = vestingtypes.NewDelayedVestingAccountRaw(bva)
+// randomPeriods splits the vesting coins and the duration of a schedule into
+// at most 12 periods. The coins left over by the division vest in the last
+// period.
+func randomPeriods(r *rand.Rand, vesting sdk.Coins, duration int64) vestingtypes.Periods {
+	numPeriods := int64(simulation.RandIntBetween(r, 1, 13))
+	if numPeriods > duration {
+		numPeriods = duration
+	}
+
+	periods := make(vestingtypes.Periods, numPeriods)
+	remaining := vesting
+	for i := range periods {
+		periods[i].Length = duration / numPeriods
+
+		amount := sdk.NewCoins()
+		for _, coin := range vesting {
+			amount = amount.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(numPeriods)))
 		}
+		if int64(i) == numPeriods-1 {
+			periods[i].Length += duration % numPeriods
+			amount = remaining
+		}
+
+		periods[i].Amount = amount
+		remaining = remaining.Sub(amount...)
 	}
 
-	return genesisAccs
+	return periods
 }
 
 // GenMaxMemoChars randomized MaxMemoChars
@@ -110,6 +164,7 @@ func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn type
 
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
 		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+	params.MaxRefundRate = types.DefaultMaxRefundRate
 	genesisAccs := randGenAccountsFn(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
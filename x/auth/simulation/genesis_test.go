@@ -2,17 +2,21 @@ package simulation_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/auth/simulation"
 	"cosmossdk.io/x/auth/types"
+	vestingexported "cosmossdk.io/x/auth/vesting/exported"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -55,3 +59,62 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, uint64(0), genAccounts[2].GetAccountNumber())
 	require.Equal(t, uint64(0), genAccounts[2].GetSequence())
 }
+
+func TestRandomGenesisAccountsVesting(t *testing.T) {
+	genesisTime := time.Unix(1700000000, 0)
+	newSimState := func(config simtypes.VestingAccountsConfig) *module.SimulationState {
+		r := rand.New(rand.NewSource(1))
+		return &module.SimulationState{
+			AppParams:       make(simtypes.AppParams),
+			Rand:            r,
+			NumBonded:       2,
+			Accounts:        simtypes.RandomAccounts(r, 50),
+			InitialStake:    sdkmath.NewInt(1000),
+			BondDenom:       sdk.DefaultBondDenom,
+			GenTimestamp:    genesisTime,
+			VestingAccounts: config,
+		}
+	}
+
+	// every account but the initial validators vests
+	config := simtypes.VestingAccountsConfig{Fraction: 1, MinDuration: time.Hour, MaxDuration: 48 * time.Hour}
+	genAccs := simulation.RandomGenesisAccounts(newSimState(config))
+	require.Len(t, genAccs, 50)
+
+	vestingTypes := make(map[string]bool)
+	for i, acc := range genAccs {
+		require.NoError(t, acc.Validate())
+
+		vacc, ok := acc.(vestingexported.VestingAccount)
+		if i <= 2 {
+			require.False(t, ok, "account %d", i)
+			continue
+		}
+		require.True(t, ok, "account %d", i)
+		vestingTypes[fmt.Sprintf("%T", acc)] = true
+
+		original := vacc.GetOriginalVesting().AmountOf(sdk.DefaultBondDenom)
+		require.True(t, original.IsPositive())
+		require.True(t, original.LTE(sdkmath.NewInt(1000)))
+		require.Greater(t, vacc.GetEndTime(), genesisTime.Unix())
+
+		if startTime := vacc.GetStartTime(); startTime != 0 {
+			duration := vacc.GetEndTime() - startTime
+			require.GreaterOrEqual(t, duration, int64(time.Hour.Seconds()))
+			require.LessOrEqual(t, duration, int64((48 * time.Hour).Seconds()))
+			require.LessOrEqual(t, startTime, genesisTime.Unix())
+		}
+	}
+	require.Len(t, vestingTypes, 3)
+
+	// no account vests
+	config.Fraction = 0
+	for _, acc := range simulation.RandomGenesisAccounts(newSimState(config)) {
+		_, ok := acc.(*types.BaseAccount)
+		require.True(t, ok)
+	}
+
+	// an invalid configuration is rejected
+	config.MaxDuration = time.Minute
+	require.Panics(t, func() { simulation.RandomGenesisAccounts(newSimState(config)) })
+}
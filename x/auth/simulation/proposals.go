@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -40,6 +41,9 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params.TxSizeCostPerByte = uint64(simtypes.RandIntBetween(r, 1, 1000))
 	params.SigVerifyCostED25519 = uint64(simtypes.RandIntBetween(r, 1, 1000))
 	params.SigVerifyCostSecp256k1 = uint64(simtypes.RandIntBetween(r, 1, 1000))
+	params.SigVerifyCostMultisigPerSignature = uint64(simtypes.RandIntBetween(r, 0, 1000))
+	params.SimulationSignatureSize = uint64(simtypes.RandIntBetween(r, 0, 1000))
+	params.MaxRefundRate = math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 101)), 2)
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
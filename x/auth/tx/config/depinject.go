@@ -118,14 +118,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 			// meaning that both `runMsgs` and `postHandler` state will be committed if
 			// both are successful, and both will be reverted if any of the two fails.
 			//
-			// The SDK exposes a default empty postHandlers chain.
+			// The SDK exposes a default postHandlers chain, refunding the fee paid
+			// for unused gas.
 			//
 			// Please note that changing any of the anteHandler or postHandler chain is
 			// likely to be a state-machine breaking change, which needs a coordinated
 			// upgrade.
-			postHandler, err := posthandler.NewPostHandler(
-				posthandler.HandlerOptions{},
-			)
+			postHandler, err := newPostHandler(in)
 			if err != nil {
 				panic(err)
 			}
@@ -161,6 +160,24 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 	return anteHandler, nil
 }
 
+func newPostHandler(in ModuleInputs) (sdk.PostHandler, error) {
+	if in.BankKeeper == nil {
+		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
+	}
+
+	postHandler, err := posthandler.NewPostHandler(
+		posthandler.HandlerOptions{
+			AccountKeeper: in.AccountKeeper,
+			BankKeeper:    in.BankKeeper,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create post handler: %w", err)
+	}
+
+	return postHandler, nil
+}
+
 // NewBankKeeperCoinMetadataQueryFn creates a new Textual struct using the given
 // BankKeeper to retrieve coin metadata.
 //
@@ -34,6 +34,9 @@ func newWrapperFromDecodedTx(addrCodec address.Codec, cdc codec.BinaryCodec, dec
 		return nil, fmt.Errorf("unable to convert messagev2 to messagev1: %w", err)
 	}
 	// set fees
+	if decodedTx.Tx.AuthInfo.Fee == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "missing fee")
+	}
 	fees := make(sdk.Coins, len(decodedTx.Tx.AuthInfo.Fee.Amount))
 	for i, fee := range decodedTx.Tx.AuthInfo.Fee.Amount {
 		amtInt, ok := math.NewIntFromString(fee.Amount)
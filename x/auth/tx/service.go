@@ -2,6 +2,7 @@ package tx
 
 import (
 	"context"
+	"crypto/sha256"
 	"strings"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/x/auth/migrations/legacytx"
+	authsigning "cosmossdk.io/x/auth/signing"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
@@ -310,6 +312,37 @@ func (s txServer) TxDecodeAmino(_ context.Context, req *txtypes.TxDecodeAminoReq
 	}, nil
 }
 
+// TxSignBytes implements the ServiceServer.TxSignBytes RPC method.
+func (s txServer) TxSignBytes(ctx context.Context, req *txtypes.TxSignBytesRequest) (*txtypes.TxSignBytesResponse, error) {
+	if req.Tx == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty chain-id")
+	}
+
+	encodeRes, err := s.TxEncode(ctx, &txtypes.TxEncodeRequest{Tx: req.Tx})
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.clientCtx.TxConfig.TxDecoder()(encodeRes.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := authsigning.GetLegacyAminoJSONSignBytes(ctx, s.clientCtx.TxConfig.SignModeHandler(), req.ChainId, req.AccountNumber, req.Sequence, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(signBytes)
+	return &txtypes.TxSignBytesResponse{
+		SignBytes: signBytes,
+		Sha256:    hash[:],
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
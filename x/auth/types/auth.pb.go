@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -174,6 +175,25 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// max_pub_key_rotations is the maximum number of times the public key of an
+	// account can be rotated with MsgRotatePubKey. Zero disables the rotations.
+	MaxPubKeyRotations uint64 `protobuf:"varint,6,opt,name=max_pub_key_rotations,json=maxPubKeyRotations,proto3" json:"max_pub_key_rotations,omitempty"`
+	// pub_key_rotation_gas_cost is the gas consumed by MsgRotatePubKey, on top of
+	// the gas of the transaction, to discourage abuse.
+	PubKeyRotationGasCost uint64 `protobuf:"varint,7,opt,name=pub_key_rotation_gas_cost,json=pubKeyRotationGasCost,proto3" json:"pub_key_rotation_gas_cost,omitempty"`
+	// sig_verify_cost_multisig_per_signature is the gas consumed for each signature
+	// of a multisig, on top of the verification cost of the signing key.
+	SigVerifyCostMultisigPerSignature uint64 `protobuf:"varint,8,opt,name=sig_verify_cost_multisig_per_signature,json=sigVerifyCostMultisigPerSignature,proto3" json:"sig_verify_cost_multisig_per_signature,omitempty"`
+	// simulation_signature_size is the estimated size in bytes of each signature
+	// missing from a simulated transaction, whose tx size gas is consumed as if the
+	// transaction was signed.
+	SimulationSignatureSize uint64 `protobuf:"varint,9,opt,name=simulation_signature_size,json=simulationSignatureSize,proto3" json:"simulation_signature_size,omitempty"`
+	// allowed_fee_denoms are the denoms transaction fees can be paid in. Empty
+	// allows all denoms.
+	AllowedFeeDenoms []string `protobuf:"bytes,10,rep,name=allowed_fee_denoms,json=allowedFeeDenoms,proto3" json:"allowed_fee_denoms,omitempty"`
+	// max_refund_rate is the maximum share of the fee refunded to the fee payer
+	// for the gas a successful transaction did not use. Zero disables the refunds.
+	MaxRefundRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_refund_rate,json=maxRefundRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_refund_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,6 +264,41 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxPubKeyRotations() uint64 {
+	if m != nil {
+		return m.MaxPubKeyRotations
+	}
+	return 0
+}
+
+func (m *Params) GetPubKeyRotationGasCost() uint64 {
+	if m != nil {
+		return m.PubKeyRotationGasCost
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostMultisigPerSignature() uint64 {
+	if m != nil {
+		return m.SigVerifyCostMultisigPerSignature
+	}
+	return 0
+}
+
+func (m *Params) GetSimulationSignatureSize() uint64 {
+	if m != nil {
+		return m.SimulationSignatureSize
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedFeeDenoms() []string {
+	if m != nil {
+		return m.AllowedFeeDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -254,53 +309,65 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x4f, 0xe3, 0x46,
-	0x18, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x11,
-	0x2a, 0x4e, 0x13, 0x44, 0xa5, 0xe6, 0x46, 0xd2, 0xaa, 0x42, 0x14, 0x8a, 0x1c, 0x2d, 0x07, 0x2e,
-	0xd6, 0xd8, 0x1e, 0xcc, 0x28, 0xb1, 0xc7, 0xeb, 0x19, 0xa3, 0x98, 0xf3, 0x1e, 0xd0, 0x9e, 0x56,
-	0xfb, 0x0b, 0xd8, 0xfd, 0x05, 0x1c, 0xf8, 0x11, 0xab, 0x3d, 0xa1, 0xbd, 0xec, 0x9e, 0xa2, 0x55,
-	0x38, 0x80, 0x56, 0xfb, 0x23, 0x56, 0x9e, 0x71, 0x20, 0x41, 0xb9, 0x44, 0x9e, 0xf7, 0xde, 0xf7,
-	0x7d, 0xef, 0x7b, 0x9e, 0x18, 0x94, 0x6d, 0x42, 0x3d, 0x42, 0x6b, 0x30, 0x62, 0xa7, 0xb5, 0xb3,
-	0xba, 0x85, 0x18, 0xac, 0xf3, 0x83, 0x1e, 0x84, 0x84, 0x11, 0x79, 0x59, 0xf0, 0x3a, 0x87, 0x52,
-	0xbe, 0xb4, 0x04, 0x3d, 0xec, 0x93, 0x1a, 0xff, 0x15, 0xba, 0xd2, 0xaa, 0xd0, 0x99, 0xfc, 0x54,
-	0x4b, 0x8b, 0x04, 0x55, 0x74, 0x89, 0x4b, 0x04, 0x9e, 0x3c, 0x8d, 0x0a, 0x5c, 0x42, 0xdc, 0x1e,
-	0xaa, 0xf1, 0x93, 0x15, 0x9d, 0xd4, 0xa0, 0x1f, 0x0b, 0xaa, 0xf2, 0x26, 0x0b, 0x0a, 0x2d, 0x48,
-	0xd1, 0x8e, 0x6d, 0x93, 0xc8, 0x67, 0x72, 0x03, 0xcc, 0x42, 0xc7, 0x09, 0x11, 0xa5, 0x8a, 0xa4,
-	0x49, 0xd5, 0xb9, 0x96, 0xf2, 0xe1, 0x7a, 0xb3, 0x98, 0xce, 0xd8, 0x11, 0x4c, 0x87, 0x85, 0xd8,
-	0x77, 0x8d, 0x91, 0x50, 0x3e, 0x02, 0xb3, 0x41, 0x64, 0x99, 0x5d, 0x14, 0x2b, 0x59, 0x4d, 0xaa,
-	0x16, 0x1a, 0x45, 0x5d, 0x0c, 0xd4, 0x47, 0x03, 0xf5, 0x1d, 0x3f, 0x6e, 0xad, 0x7f, 0x19, 0xa8,
-	0xc5, 0x20, 0xb2, 0x7a, 0xd8, 0x4e, 0xb4, 0xbf, 0x13, 0x0f, 0x33, 0xe4, 0x05, 0x2c, 0x7e, 0x7b,
-	0x77, 0xb5, 0x01, 0x1e, 0x09, 0x63, 0x26, 0x88, 0xac, 0x3d, 0x14, 0xcb, 0xbf, 0x82, 0x45, 0x28,
-	0x6c, 0x99, 0x7e, 0xe4, 0x59, 0x28, 0x54, 0x72, 0x9a, 0x54, 0xcd, 0x1b, 0x0b, 0x29, 0x7a, 0xc0,
-	0x41, 0xb9, 0x04, 0xbe, 0xa7, 0xe8, 0x79, 0x84, 0x7c, 0x1b, 0x29, 0x79, 0x2e, 0x78, 0x38, 0x37,
-	0xdb, 0x17, 0x97, 0x6a, 0xe6, 0xfe, 0x52, 0xcd, 0xbc, 0xbf, 0xde, 0xfc, 0x65, 0x4a, 0xbc, 0x7a,
-	0xba, 0xf7, 0xee, 0xcb, 0xbb, 0xab, 0x8d, 0x15, 0x21, 0xd8, 0xa4, 0x4e, 0xb7, 0x36, 0x96, 0x49,
-	0xe5, 0xab, 0x04, 0x16, 0xf6, 0x89, 0x13, 0xf5, 0x1e, 0x52, 0xda, 0x05, 0xf3, 0x16, 0xa4, 0xc8,
-	0x4c, 0x8d, 0xf0, 0xa8, 0x0a, 0x0d, 0x4d, 0x9f, 0x36, 0x61, 0xac, 0x53, 0x2b, 0x7f, 0x33, 0x50,
-	0x25, 0xa3, 0x60, 0x8d, 0x05, 0x2e, 0x83, 0xbc, 0x0f, 0x3d, 0xc4, 0x93, 0x9b, 0x33, 0xf8, 0xb3,
-	0xac, 0x81, 0x42, 0x80, 0x42, 0x0f, 0x53, 0x8a, 0x89, 0x4f, 0x95, 0x9c, 0x96, 0xab, 0xce, 0x19,
-	0xe3, 0x50, 0xf3, 0xf8, 0x42, 0xec, 0x54, 0x99, 0x36, 0x71, 0xc2, 0x2b, 0xdf, 0x4c, 0x19, 0xdb,
-	0x6c, 0x82, 0x7d, 0x7d, 0x77, 0xb5, 0xb1, 0xe8, 0x71, 0x64, 0xb4, 0x4c, 0xe5, 0x85, 0x04, 0x7e,
-	0x14, 0xa2, 0x76, 0x88, 0x1c, 0xe4, 0x33, 0x0c, 0x7b, 0xb2, 0x0a, 0x0a, 0xa9, 0x8c, 0xbb, 0xe5,
-	0x77, 0xc3, 0x00, 0x02, 0x3a, 0x48, 0x3c, 0xaf, 0x83, 0x1f, 0x1c, 0x14, 0xe2, 0x33, 0xc8, 0x30,
-	0xf1, 0x93, 0xd7, 0x48, 0x95, 0xac, 0x96, 0xab, 0xce, 0x1b, 0x8b, 0x8f, 0xf0, 0x1e, 0x8a, 0x69,
-	0xf3, 0xb7, 0xc4, 0xd0, 0xda, 0x98, 0xa1, 0x7f, 0x43, 0x12, 0x05, 0xa9, 0x9f, 0xc7, 0x89, 0x95,
-	0x8f, 0x59, 0x30, 0x73, 0x08, 0x43, 0xe8, 0x51, 0x59, 0x07, 0xcb, 0x1e, 0xec, 0x9b, 0x1e, 0xf2,
-	0x88, 0x69, 0x9f, 0xc2, 0x10, 0xda, 0x0c, 0x85, 0xe2, 0x82, 0xe6, 0x8d, 0x25, 0x0f, 0xf6, 0xf7,
-	0x91, 0x47, 0xda, 0x0f, 0x84, 0xac, 0x81, 0x79, 0xd6, 0x37, 0x29, 0x76, 0xcd, 0x1e, 0xf6, 0x30,
-	0xe3, 0xd9, 0xe6, 0x0d, 0xc0, 0xfa, 0x1d, 0xec, 0xfe, 0x97, 0x20, 0xf2, 0x1f, 0xe0, 0x27, 0xae,
-	0x38, 0x47, 0xa6, 0x4d, 0x28, 0x33, 0x03, 0x14, 0x9a, 0x56, 0xcc, 0x50, 0x7a, 0xc3, 0x96, 0x12,
-	0xe9, 0x39, 0x6a, 0x13, 0xca, 0x0e, 0x51, 0xd8, 0x8a, 0x19, 0x92, 0xff, 0x07, 0x3f, 0x27, 0x0d,
-	0xcf, 0x50, 0x88, 0x4f, 0x62, 0x51, 0x84, 0x9c, 0xc6, 0xf6, 0x76, 0xfd, 0x2f, 0x71, 0xe9, 0x5a,
-	0xca, 0x70, 0xa0, 0x16, 0x3b, 0xd8, 0x3d, 0xe2, 0x8a, 0xa4, 0xf4, 0x9f, 0xbf, 0x39, 0x6f, 0x14,
-	0xe9, 0x04, 0x2a, 0xaa, 0xe4, 0x67, 0x60, 0xf5, 0x69, 0x43, 0x8a, 0xec, 0xa0, 0xb1, 0xfd, 0x67,
-	0xb7, 0xae, 0x7c, 0xc7, 0x5b, 0x96, 0x86, 0x03, 0x75, 0x65, 0xa2, 0x65, 0x67, 0xa4, 0x30, 0x56,
-	0xe8, 0x54, 0xbc, 0xb9, 0x76, 0x7f, 0xa9, 0x4a, 0x4f, 0xdf, 0x79, 0x5f, 0x7c, 0x73, 0x44, 0x9c,
-	0xad, 0xad, 0x77, 0xc3, 0xb2, 0x74, 0x33, 0x2c, 0x4b, 0x9f, 0x87, 0x65, 0xe9, 0xd5, 0x6d, 0x39,
-	0x73, 0x73, 0x5b, 0xce, 0x7c, 0xba, 0x2d, 0x67, 0x8e, 0xd3, 0x2f, 0x0b, 0x75, 0xba, 0x3a, 0x26,
-	0xa3, 0x2a, 0x16, 0x07, 0x88, 0x5a, 0x33, 0xfc, 0xbf, 0xbc, 0xf5, 0x2d, 0x00, 0x00, 0xff, 0xff,
-	0x78, 0xbd, 0x49, 0x53, 0xc5, 0x04, 0x00, 0x00,
+	// 922 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x82, 0x03, 0x61, 0x0c, 0x24, 0x4c, 0x0c, 0x59, 0x68, 0xe5, 0x35, 0x48, 0x4d, 0x10,
+	0x0a, 0xeb, 0xda, 0x11, 0x51, 0xeb, 0x1b, 0x86, 0x36, 0x8a, 0x12, 0x52, 0xba, 0x56, 0x73, 0xc8,
+	0xa1, 0xab, 0xd9, 0xdd, 0xc7, 0xb2, 0xc2, 0xb3, 0xb3, 0xdd, 0x99, 0xa5, 0xde, 0x9c, 0x7b, 0x88,
+	0x7a, 0xaa, 0xfa, 0x0b, 0x68, 0x4f, 0x3d, 0x72, 0xe0, 0x47, 0x44, 0x3d, 0xa1, 0x9c, 0xaa, 0x1e,
+	0xac, 0xd6, 0x1c, 0x88, 0xaa, 0xfe, 0x88, 0x68, 0x67, 0xd6, 0xc6, 0x46, 0x5c, 0x2c, 0xcf, 0xf7,
+	0xbe, 0xf7, 0xde, 0xf7, 0xbe, 0x7d, 0x33, 0xa8, 0xe2, 0x32, 0x4e, 0x19, 0xaf, 0x91, 0x44, 0x1c,
+	0xd6, 0x8e, 0xeb, 0x0e, 0x08, 0x52, 0x97, 0x07, 0x33, 0x8a, 0x99, 0x60, 0xf8, 0x9e, 0x8a, 0x9b,
+	0x12, 0xca, 0xe3, 0x2b, 0x0b, 0x84, 0x06, 0x21, 0xab, 0xc9, 0x5f, 0xc5, 0x5b, 0x59, 0x56, 0x3c,
+	0x5b, 0x9e, 0x6a, 0x79, 0x92, 0x0a, 0x95, 0x7d, 0xe6, 0x33, 0x85, 0x67, 0xff, 0x06, 0x09, 0x3e,
+	0x63, 0x7e, 0x07, 0x6a, 0xf2, 0xe4, 0x24, 0x07, 0x35, 0x12, 0xa6, 0x2a, 0xb4, 0xf6, 0xdb, 0x04,
+	0x2a, 0xb5, 0x08, 0x87, 0x6d, 0xd7, 0x65, 0x49, 0x28, 0x70, 0x03, 0x4d, 0x13, 0xcf, 0x8b, 0x81,
+	0x73, 0x5d, 0xab, 0x6a, 0xeb, 0x33, 0x2d, 0xfd, 0xfd, 0xd9, 0x66, 0x39, 0xef, 0xb1, 0xad, 0x22,
+	0x6d, 0x11, 0x07, 0xa1, 0x6f, 0x0d, 0x88, 0xf8, 0x15, 0x9a, 0x8e, 0x12, 0xc7, 0x3e, 0x82, 0x54,
+	0x9f, 0xa8, 0x6a, 0xeb, 0xa5, 0x46, 0xd9, 0x54, 0x0d, 0xcd, 0x41, 0x43, 0x73, 0x3b, 0x4c, 0x5b,
+	0x0f, 0xff, 0xeb, 0x19, 0xe5, 0x28, 0x71, 0x3a, 0x81, 0x9b, 0x71, 0x1f, 0x31, 0x1a, 0x08, 0xa0,
+	0x91, 0x48, 0x7f, 0xbf, 0x3c, 0xdd, 0x40, 0x57, 0x01, 0x6b, 0x2a, 0x4a, 0x9c, 0xe7, 0x90, 0xe2,
+	0xcf, 0xd0, 0x3c, 0x51, 0xb2, 0xec, 0x30, 0xa1, 0x0e, 0xc4, 0xfa, 0x64, 0x55, 0x5b, 0x2f, 0x5a,
+	0x73, 0x39, 0xfa, 0x52, 0x82, 0x78, 0x05, 0xdd, 0xe6, 0xf0, 0x43, 0x02, 0xa1, 0x0b, 0x7a, 0x51,
+	0x12, 0x86, 0xe7, 0xe6, 0xce, 0xdb, 0x13, 0xa3, 0xf0, 0xe1, 0xc4, 0x28, 0xfc, 0x79, 0xb6, 0xf9,
+	0xe9, 0x0d, 0xf6, 0x9a, 0xf9, 0xdc, 0xcf, 0x7e, 0xbe, 0x3c, 0xdd, 0x58, 0x52, 0x84, 0x4d, 0xee,
+	0x1d, 0xd5, 0x46, 0x3c, 0x59, 0xfb, 0x5f, 0x43, 0x73, 0x7b, 0xcc, 0x4b, 0x3a, 0x43, 0x97, 0x9e,
+	0xa1, 0x59, 0x87, 0x70, 0xb0, 0x73, 0x21, 0xd2, 0xaa, 0x52, 0xa3, 0x6a, 0xde, 0xd4, 0x61, 0xa4,
+	0x52, 0xab, 0x78, 0xde, 0x33, 0x34, 0xab, 0xe4, 0x8c, 0x18, 0x8e, 0x51, 0x31, 0x24, 0x14, 0xa4,
+	0x73, 0x33, 0x96, 0xfc, 0x8f, 0xab, 0xa8, 0x14, 0x41, 0x4c, 0x03, 0xce, 0x03, 0x16, 0x72, 0x7d,
+	0xb2, 0x3a, 0xb9, 0x3e, 0x63, 0x8d, 0x42, 0xcd, 0xd7, 0x6f, 0xd5, 0x4c, 0x6b, 0x37, 0x75, 0x1c,
+	0xd3, 0x2a, 0x27, 0xd3, 0x47, 0x26, 0x1b, 0x8b, 0xfe, 0x7a, 0x79, 0xba, 0x31, 0x4f, 0x25, 0x32,
+	0x18, 0x66, 0xed, 0x27, 0x0d, 0xdd, 0x55, 0xa4, 0x9d, 0x18, 0x3c, 0x08, 0x45, 0x40, 0x3a, 0xd8,
+	0x40, 0xa5, 0x9c, 0x26, 0xd5, 0xca, 0xdd, 0xb0, 0x90, 0x82, 0x5e, 0x66, 0x9a, 0x1f, 0xa2, 0x3b,
+	0x1e, 0xc4, 0xc1, 0x31, 0x11, 0x01, 0x0b, 0xb3, 0xcf, 0xc8, 0xf5, 0x89, 0xea, 0xe4, 0xfa, 0xac,
+	0x35, 0x7f, 0x05, 0x3f, 0x87, 0x94, 0x37, 0x1f, 0x64, 0x82, 0x56, 0x47, 0x04, 0x3d, 0x8d, 0x59,
+	0x12, 0xe5, 0x7a, 0xae, 0x3a, 0xae, 0xfd, 0x7b, 0x0b, 0x4d, 0xed, 0x93, 0x98, 0x50, 0x8e, 0x4d,
+	0x74, 0x8f, 0x92, 0xae, 0x4d, 0x81, 0x32, 0xdb, 0x3d, 0x24, 0x31, 0x71, 0x05, 0xc4, 0x6a, 0x41,
+	0x8b, 0xd6, 0x02, 0x25, 0xdd, 0x3d, 0xa0, 0x6c, 0x67, 0x18, 0xc0, 0x55, 0x34, 0x2b, 0xba, 0x36,
+	0x0f, 0x7c, 0xbb, 0x13, 0xd0, 0x40, 0x48, 0x6f, 0x8b, 0x16, 0x12, 0xdd, 0x76, 0xe0, 0xbf, 0xc8,
+	0x10, 0xfc, 0x39, 0x5a, 0x94, 0x8c, 0x37, 0x60, 0xbb, 0x8c, 0x0b, 0x3b, 0x82, 0xd8, 0x76, 0x52,
+	0x01, 0xf9, 0x86, 0x2d, 0x64, 0xd4, 0x37, 0xb0, 0xc3, 0xb8, 0xd8, 0x87, 0xb8, 0x95, 0x0a, 0xc0,
+	0xdf, 0xa0, 0xfb, 0x59, 0xc1, 0x63, 0x88, 0x83, 0x83, 0x54, 0x25, 0x81, 0xd7, 0xd8, 0xda, 0xaa,
+	0x7f, 0xa9, 0x96, 0xae, 0xa5, 0xf7, 0x7b, 0x46, 0xb9, 0x1d, 0xf8, 0xaf, 0x24, 0x23, 0x4b, 0xfd,
+	0x6a, 0x57, 0xc6, 0xad, 0x32, 0x1f, 0x43, 0x55, 0x16, 0xfe, 0x0e, 0x2d, 0x5f, 0x2f, 0xc8, 0xc1,
+	0x8d, 0x1a, 0x5b, 0x4f, 0x8e, 0xea, 0xfa, 0x2d, 0x59, 0x72, 0xa5, 0xdf, 0x33, 0x96, 0xc6, 0x4a,
+	0xb6, 0x07, 0x0c, 0x6b, 0x89, 0xdf, 0x88, 0xe3, 0x3a, 0x5a, 0xcc, 0xbc, 0xca, 0x2f, 0xa4, 0x1d,
+	0x33, 0x21, 0xad, 0xe7, 0xfa, 0x94, 0x9c, 0x0c, 0x53, 0xd2, 0xdd, 0x97, 0xd7, 0xcb, 0x1a, 0x44,
+	0xf0, 0x17, 0x68, 0xf9, 0x3a, 0xdd, 0xf6, 0x09, 0x97, 0x9a, 0xf4, 0x69, 0x99, 0xb6, 0x18, 0x8d,
+	0xe5, 0x3c, 0x25, 0x3c, 0xeb, 0x8a, 0xbf, 0x45, 0x0f, 0xae, 0xcf, 0x40, 0x93, 0x8e, 0x08, 0x32,
+	0x30, 0xb3, 0x94, 0x07, 0x7e, 0x48, 0x44, 0x12, 0x83, 0x7e, 0x5b, 0x96, 0x59, 0x1d, 0x13, 0xbd,
+	0x97, 0x53, 0xf7, 0x21, 0x6e, 0x0f, 0x88, 0xb8, 0x99, 0xd9, 0x42, 0x93, 0x8e, 0x92, 0x31, 0x2c,
+	0x20, 0xbf, 0x95, 0x3e, 0x23, 0xab, 0xdc, 0xbf, 0x22, 0x0c, 0xf3, 0xb2, 0xcf, 0x85, 0x1f, 0x21,
+	0x4c, 0x3a, 0x1d, 0xf6, 0x23, 0x78, 0xf6, 0x01, 0x80, 0xed, 0x41, 0xc8, 0x28, 0xd7, 0x91, 0xbc,
+	0x3e, 0x77, 0xf3, 0xc8, 0xd7, 0x00, 0xbb, 0x12, 0xc7, 0xdf, 0xa3, 0x3b, 0x99, 0x53, 0x31, 0x1c,
+	0x24, 0xa1, 0x67, 0xc7, 0x44, 0x80, 0x5e, 0x92, 0x4f, 0xde, 0x93, 0x77, 0x3d, 0xa3, 0xf0, 0x77,
+	0xcf, 0xf8, 0x44, 0x2d, 0x29, 0xf7, 0x8e, 0xcc, 0x80, 0xd5, 0x28, 0x11, 0x87, 0xe6, 0x0b, 0xf0,
+	0x89, 0x9b, 0xee, 0x82, 0xfb, 0xfe, 0x6c, 0x13, 0xe5, 0x77, 0x6f, 0x17, 0xdc, 0x3f, 0x2e, 0x4f,
+	0x37, 0x34, 0x6b, 0x8e, 0x92, 0xae, 0x25, 0xab, 0x59, 0x44, 0x40, 0x73, 0xf5, 0xc3, 0x89, 0xa1,
+	0x5d, 0xbf, 0x7d, 0x5d, 0xf5, 0xfa, 0xab, 0xc5, 0x6e, 0x3d, 0x7e, 0xd7, 0xaf, 0x68, 0xe7, 0xfd,
+	0x8a, 0xf6, 0x4f, 0xbf, 0xa2, 0xfd, 0x72, 0x51, 0x29, 0x9c, 0x5f, 0x54, 0x0a, 0x7f, 0x5d, 0x54,
+	0x0a, 0xaf, 0x97, 0xc7, 0x7a, 0xe7, 0x59, 0x22, 0x8d, 0x80, 0x3b, 0x53, 0xf2, 0x55, 0x7d, 0xfc,
+	0x71, 0x00, 0x30, 0xab, 0xa5, 0x8d, 0x4f, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +404,29 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.MaxPubKeyRotations != that1.MaxPubKeyRotations {
+		return false
+	}
+	if this.PubKeyRotationGasCost != that1.PubKeyRotationGasCost {
+		return false
+	}
+	if this.SigVerifyCostMultisigPerSignature != that1.SigVerifyCostMultisigPerSignature {
+		return false
+	}
+	if this.SimulationSignatureSize != that1.SimulationSignatureSize {
+		return false
+	}
+	if len(this.AllowedFeeDenoms) != len(that1.AllowedFeeDenoms) {
+		return false
+	}
+	for i := range this.AllowedFeeDenoms {
+		if this.AllowedFeeDenoms[i] != that1.AllowedFeeDenoms[i] {
+			return false
+		}
+	}
+	if !this.MaxRefundRate.Equal(that1.MaxRefundRate) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -501,6 +591,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxRefundRate.Size()
+		i -= size
+		if _, err := m.MaxRefundRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.AllowedFeeDenoms) > 0 {
+		for iNdEx := len(m.AllowedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedFeeDenoms[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.AllowedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.SimulationSignatureSize != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SimulationSignatureSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SigVerifyCostMultisigPerSignature != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostMultisigPerSignature))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PubKeyRotationGasCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyRotationGasCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPubKeyRotations != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxPubKeyRotations))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -626,6 +755,26 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.MaxPubKeyRotations != 0 {
+		n += 1 + sovAuth(uint64(m.MaxPubKeyRotations))
+	}
+	if m.PubKeyRotationGasCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyRotationGasCost))
+	}
+	if m.SigVerifyCostMultisigPerSignature != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostMultisigPerSignature))
+	}
+	if m.SimulationSignatureSize != 0 {
+		n += 1 + sovAuth(uint64(m.SimulationSignatureSize))
+	}
+	if len(m.AllowedFeeDenoms) > 0 {
+		for _, s := range m.AllowedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = m.MaxRefundRate.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -1179,6 +1328,148 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPubKeyRotations", wireType)
+			}
+			m.MaxPubKeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPubKeyRotations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationGasCost", wireType)
+			}
+			m.PubKeyRotationGasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyRotationGasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostMultisigPerSignature", wireType)
+			}
+			m.SigVerifyCostMultisigPerSignature = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostMultisigPerSignature |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulationSignatureSize", wireType)
+			}
+			m.SimulationSignatureSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SimulationSignatureSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFeeDenoms = append(m.AllowedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRefundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -22,10 +22,30 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRotatePubKey{}, "cosmos-sdk/x/auth/MsgRotatePubKey")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
 
+// RegisterAccountTypeCodec registers an account type defined by an application on
+// the provided LegacyAmino codec under the given name, like the account types of
+// this module. It must be called before the codec is sealed.
+func RegisterAccountTypeCodec(cdc *codec.LegacyAmino, o interface{}, name string) {
+	cdc.RegisterConcrete(o, name, nil)
+}
+
+// RegisterAccountImplementations registers account types defined by applications
+// as implementations of the AccountI, sdk.AccountI and GenesisAccount interfaces,
+// so that they can be unpacked from the account store and the genesis state like
+// the account types of this module.
+func RegisterAccountImplementations(registry registry.LegacyRegistry, accounts ...GenesisAccount) {
+	for _, acc := range accounts {
+		registry.RegisterImplementations((*AccountI)(nil), acc)
+		registry.RegisterImplementations((*sdk.AccountI)(nil), acc)
+		registry.RegisterImplementations((*GenesisAccount)(nil), acc)
+	}
+}
+
 // RegisterInterfaces associates protoName with AccountI interface
 // and creates a registry of it's concrete implementations
 func RegisterInterfaces(registry registry.LegacyRegistry) {
@@ -58,5 +78,6 @@ func RegisterInterfaces(registry registry.LegacyRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRotatePubKey{},
 	)
 }
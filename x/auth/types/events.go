@@ -0,0 +1,12 @@
+package types
+
+// auth module event types
+const (
+	EventTypeRotatePubKey = "rotate_pub_key"
+	EventTypeFeeRefund    = "fee_refund"
+
+	AttributeKeyAddress   = "address"
+	AttributeKeyOldPubKey = "old_pub_key"
+	AttributeKeyNewPubKey = "new_pub_key"
+	AttributeKeyRefund    = "refund"
+)
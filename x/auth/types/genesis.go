@@ -8,6 +8,8 @@ import (
 
 	proto "github.com/cosmos/gogoproto/proto"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,6 +59,12 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
 	}
 
+	// a missing MaxRefundRate, which is nil, would be written as 0 and read back as a
+	// zero rate, so it is set to zero for the genesis state to survive a round trip.
+	if genesisState.Params.MaxRefundRate.IsNil() {
+		genesisState.Params.MaxRefundRate = math.LegacyZeroDec()
+	}
+
 	return genesisState
 }
 
@@ -71,11 +79,33 @@ func ValidateGenesis(data GenesisState) error {
 	if err != nil {
 		return err
 	}
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return validatePubKeyRotations(data.PubKeyRotations)
+}
+
+// validatePubKeyRotations returns an error if the rotations have an invalid or
+// duplicate address.
+func validatePubKeyRotations(rotations []PubKeyRotations) error {
+	seen := make(map[string]bool, len(rotations))
+	for _, r := range rotations {
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid pubkey rotations address %s: %w", r.Address, err)
+		}
+		if seen[r.Address] {
+			return fmt.Errorf("duplicate pubkey rotations for address %s", r.Address)
+		}
+		seen[r.Address] = true
+	}
 
-	return ValidateGenAccounts(genAccs)
+	return nil
 }
 
-// SanitizeGenesisAccounts sorts accounts and coin sets.
+// SanitizeGenesisAccounts gives the accounts with a duplicated account number the lowest
+// unused account numbers, the first account seen keeping its number, and sorts the accounts
+// by account number.
 func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 	// Make sure there aren't any duplicated account numbers by fixing the duplicates with the lowest unused values.
 	// seenAccNum = easy lookup for used account numbers.
@@ -122,6 +152,20 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 	return genAccs
 }
 
+// NextGenesisAccountNumber returns the account number following the largest account
+// number of the accounts, or 0 if there are none.
+func NextGenesisAccountNumber(genAccs GenesisAccounts) uint64 {
+	if len(genAccs) == 0 {
+		return 0
+	}
+
+	var maxNum uint64
+	for _, acc := range genAccs {
+		maxNum = max(maxNum, acc.GetAccountNumber())
+	}
+	return maxNum + 1
+}
+
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
 	addrMap := make(map[string]bool, len(accounts))
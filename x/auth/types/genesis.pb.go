@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pub_key_rotations are the numbers of public key rotations of the accounts.
+	PubKeyRotations []PubKeyRotations `protobuf:"bytes,3,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,31 +83,100 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetPubKeyRotations() []PubKeyRotations {
+	if m != nil {
+		return m.PubKeyRotations
+	}
+	return nil
+}
+
+// PubKeyRotations defines the number of public key rotations of an account.
+type PubKeyRotations struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// rotations is the number of public key rotations of the account.
+	Rotations uint64 `protobuf:"varint,2,opt,name=rotations,proto3" json:"rotations,omitempty"`
+}
+
+func (m *PubKeyRotations) Reset()         { *m = PubKeyRotations{} }
+func (m *PubKeyRotations) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotations) ProtoMessage()    {}
+func (*PubKeyRotations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{1}
+}
+func (m *PubKeyRotations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotations.Merge(m, src)
+}
+func (m *PubKeyRotations) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotations) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotations proto.InternalMessageInfo
+
+func (m *PubKeyRotations) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyRotations) GetRotations() uint64 {
+	if m != nil {
+		return m.Rotations
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
+	proto.RegisterType((*PubKeyRotations)(nil), "cosmos.auth.v1beta1.PubKeyRotations")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x92, 0x10, 0xab,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0xfe, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x1b, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x57, 0x9c, 0xf7, 0x5c, 0x01,
-	0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0x32, 0x41,
+	0x10, 0xc7, 0x6f, 0xe1, 0x0b, 0x9f, 0x2c, 0x26, 0x84, 0x93, 0xe2, 0x40, 0x73, 0x22, 0xb1, 0x20,
+	0x26, 0xee, 0x0a, 0xf4, 0x26, 0xd0, 0x58, 0xd8, 0x98, 0xa3, 0xd3, 0x82, 0xec, 0x1d, 0xeb, 0x79,
+	0x41, 0x76, 0x2f, 0xb7, 0x7b, 0xc6, 0x7b, 0x0b, 0x1f, 0xc3, 0xd2, 0xc2, 0x87, 0xa0, 0x24, 0x56,
+	0x36, 0x1a, 0x03, 0x85, 0xaf, 0x61, 0xd8, 0x5d, 0x44, 0x0d, 0xcd, 0x65, 0x6e, 0xe6, 0x37, 0x33,
+	0xff, 0xff, 0x2c, 0x3c, 0x08, 0xb8, 0x98, 0x70, 0x81, 0x49, 0x2a, 0x6f, 0xf0, 0x5d, 0xdb, 0xa7,
+	0x92, 0xb4, 0x71, 0x48, 0x19, 0x15, 0x91, 0x40, 0x71, 0xc2, 0x25, 0xb7, 0x77, 0x34, 0x82, 0x96,
+	0x08, 0x32, 0x48, 0xbd, 0x16, 0x72, 0x1e, 0xde, 0x52, 0xac, 0x10, 0x3f, 0xbd, 0xc6, 0x84, 0x65,
+	0x9a, 0xaf, 0x57, 0x43, 0x1e, 0x72, 0x15, 0xe2, 0x65, 0x64, 0xb2, 0xee, 0xa6, 0x45, 0x6a, 0xa4,
+	0xae, 0x57, 0xc8, 0x24, 0x62, 0x1c, 0xab, 0xaf, 0x49, 0xd5, 0x74, 0xcb, 0x50, 0xcf, 0x32, 0x2a,
+	0xd4, 0x4f, 0xf3, 0x0d, 0xc0, 0xed, 0x33, 0xad, 0x72, 0x20, 0x89, 0xa4, 0xf6, 0x29, 0x2c, 0xc4,
+	0x24, 0x21, 0x13, 0xe1, 0x80, 0x06, 0x68, 0x95, 0x3a, 0xbb, 0x68, 0x83, 0x6a, 0x74, 0xa1, 0x90,
+	0x7e, 0x71, 0xfa, 0xbe, 0x6f, 0x3d, 0x7e, 0x3e, 0x1d, 0x01, 0xcf, 0x74, 0xd9, 0x27, 0x70, 0x8b,
+	0x04, 0x01, 0x4f, 0x99, 0x14, 0x4e, 0xae, 0x91, 0x6f, 0x95, 0x3a, 0x55, 0xa4, 0x2d, 0xa2, 0x95,
+	0x45, 0xd4, 0x63, 0x99, 0xf7, 0x4d, 0xd9, 0x57, 0xb0, 0x12, 0xa7, 0xfe, 0x70, 0x4c, 0xb3, 0x61,
+	0xc2, 0x25, 0x91, 0x11, 0x67, 0xc2, 0xc9, 0xab, 0xd6, 0xc3, 0xcd, 0xcb, 0x53, 0xff, 0x9c, 0x66,
+	0xde, 0x8a, 0xfd, 0xa9, 0xa2, 0x1c, 0xff, 0xae, 0x35, 0x03, 0x58, 0xfe, 0x83, 0xdb, 0x1d, 0xf8,
+	0x9f, 0x8c, 0x46, 0x09, 0x15, 0xda, 0x62, 0xb1, 0xef, 0xbc, 0x3c, 0x1f, 0x57, 0xcd, 0xa2, 0x9e,
+	0xae, 0x0c, 0x64, 0x12, 0xb1, 0xd0, 0x5b, 0x81, 0xf6, 0x1e, 0x2c, 0xae, 0xb5, 0xe5, 0x1a, 0xa0,
+	0xf5, 0xcf, 0x5b, 0x27, 0xfa, 0xdd, 0xe9, 0xdc, 0x05, 0xb3, 0xb9, 0x0b, 0x3e, 0xe6, 0x2e, 0x78,
+	0x58, 0xb8, 0xd6, 0x6c, 0xe1, 0x5a, 0xaf, 0x0b, 0xd7, 0xba, 0x34, 0x97, 0x17, 0xa3, 0x31, 0x8a,
+	0x38, 0xbe, 0xd7, 0x8f, 0x26, 0xb3, 0x98, 0x0a, 0xbf, 0xa0, 0xce, 0xd1, 0xfd, 0x1a, 0x00, 0x74,
+	0x22, 0x7c, 0xc3, 0x39, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +199,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKeyRotations) > 0 {
+		for iNdEx := len(m.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +240,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyRotations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rotations != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Rotations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -179,6 +300,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PubKeyRotations) > 0 {
+		for _, e := range m.PubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PubKeyRotations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Rotations != 0 {
+		n += 1 + sovGenesis(uint64(m.Rotations))
+	}
 	return n
 }
 
@@ -284,6 +427,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotations = append(m.PubKeyRotations, PubKeyRotations{})
+			if err := m.PubKeyRotations[len(m.PubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRotations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRotations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRotations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			m.Rotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rotations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
@@ -34,6 +35,28 @@ func TestSanitize(t *testing.T) {
 
 	require.False(t, genAccs[0].GetAccountNumber() > genAccs[1].GetAccountNumber())
 	require.Equal(t, genAccs[1].GetAddress(), addr1)
+
+	// accounts with a duplicated account number get the lowest unused account numbers,
+	// the first one seen keeping its number
+	authAcc3 := types.NewBaseAccountWithAddress(sdk.AccAddress(bytes.Repeat([]byte{0xff}, 20)))
+	authAcc4 := types.NewBaseAccountWithAddress(sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)))
+	authAcc5 := types.NewBaseAccount(sdk.AccAddress(bytes.Repeat([]byte{0x80}, 20)), nil, 1, 0)
+	authAcc6 := types.NewBaseAccount(sdk.AccAddress(bytes.Repeat([]byte{0x40}, 20)), nil, 4, 0)
+	genAccs = types.SanitizeGenesisAccounts(types.GenesisAccounts{authAcc1, authAcc3, authAcc5, authAcc4, authAcc6})
+	require.Equal(t, types.GenesisAccounts{authAcc3, authAcc1, authAcc4, authAcc5, authAcc6}, genAccs)
+	for i, acc := range genAccs {
+		require.Equal(t, uint64(i), acc.GetAccountNumber())
+	}
+}
+
+func TestNextGenesisAccountNumber(t *testing.T) {
+	require.Zero(t, types.NextGenesisAccountNumber(nil))
+
+	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	require.Equal(t, uint64(1), types.NextGenesisAccountNumber(types.GenesisAccounts{acc1}))
+
+	acc2 := types.NewBaseAccount(sdk.AccAddress(addr2), nil, 7, 0)
+	require.Equal(t, uint64(8), types.NextGenesisAccountNumber(types.GenesisAccounts{acc2, acc1}))
 }
 
 var (
@@ -54,6 +77,18 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisPubKeyRotations(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	genState.PubKeyRotations = []types.PubKeyRotations{{Address: sdk.AccAddress(addr1).String(), Rotations: 1}}
+	require.NoError(t, types.ValidateGenesis(*genState))
+
+	genState.PubKeyRotations = append(genState.PubKeyRotations, types.PubKeyRotations{Address: sdk.AccAddress(addr1).String(), Rotations: 2})
+	require.ErrorContains(t, types.ValidateGenesis(*genState), "duplicate pubkey rotations")
+
+	genState.PubKeyRotations = []types.PubKeyRotations{{Address: "invalid", Rotations: 1}}
+	require.ErrorContains(t, types.ValidateGenesis(*genState), "invalid pubkey rotations address")
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
 	cdc := encodingConfig.Codec
@@ -26,6 +26,9 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// PubKeyRotationsKeyPrefix prefix for the number of pubkey rotations of each account
+	PubKeyRotationsKeyPrefix = collections.NewPrefix(3)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
@@ -0,0 +1,29 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*MsgRotatePubKey)(nil)
+
+// NewMsgRotatePubKey creates a new MsgRotatePubKey instance.
+func NewMsgRotatePubKey(address string, newPubKey cryptotypes.PubKey) (*MsgRotatePubKey, error) {
+	var pkAny *codectypes.Any
+	if newPubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(newPubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotatePubKey{
+		Address:   address,
+		NewPubKey: pkAny,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
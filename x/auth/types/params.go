@@ -2,36 +2,55 @@ package types
 
 import (
 	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Default parameter values
 const (
-	DefaultMaxMemoCharacters      uint64 = 256
-	DefaultTxSigLimit             uint64 = 7
-	DefaultTxSizeCostPerByte      uint64 = 10
-	DefaultSigVerifyCostED25519   uint64 = 590
-	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultMaxMemoCharacters                 uint64 = 256
+	DefaultTxSigLimit                        uint64 = 7
+	DefaultTxSizeCostPerByte                 uint64 = 10
+	DefaultSigVerifyCostED25519              uint64 = 590
+	DefaultSigVerifyCostSecp256k1            uint64 = 1000
+	DefaultMaxPubKeyRotations                uint64 = 5
+	DefaultPubKeyRotationGasCost             uint64 = 50000
+	DefaultSigVerifyCostMultisigPerSignature uint64 = 100
+	DefaultSimulationSignatureSize           uint64 = 66
 )
 
-// NewParams creates a new Params object
+// DefaultMaxRefundRate is the default maximum share of the fee refunded for unused gas,
+// which disables the refunds.
+var DefaultMaxRefundRate = math.LegacyZeroDec()
+
+// NewParams creates a new Params object from the given values, and the default
+// values of the other parameters.
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
-	return Params{
-		MaxMemoCharacters:      maxMemoCharacters,
-		TxSigLimit:             txSigLimit,
-		TxSizeCostPerByte:      txSizeCostPerByte,
-		SigVerifyCostED25519:   sigVerifyCostED25519,
-		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
-	}
+	params := DefaultParams()
+	params.MaxMemoCharacters = maxMemoCharacters
+	params.TxSigLimit = txSigLimit
+	params.TxSizeCostPerByte = txSizeCostPerByte
+	params.SigVerifyCostED25519 = sigVerifyCostED25519
+	params.SigVerifyCostSecp256k1 = sigVerifyCostSecp256k1
+	return params
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		MaxMemoCharacters:      DefaultMaxMemoCharacters,
-		TxSigLimit:             DefaultTxSigLimit,
-		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
-		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
-		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxMemoCharacters:                 DefaultMaxMemoCharacters,
+		TxSigLimit:                        DefaultTxSigLimit,
+		TxSizeCostPerByte:                 DefaultTxSizeCostPerByte,
+		SigVerifyCostED25519:              DefaultSigVerifyCostED25519,
+		SigVerifyCostSecp256k1:            DefaultSigVerifyCostSecp256k1,
+		MaxPubKeyRotations:                DefaultMaxPubKeyRotations,
+		PubKeyRotationGasCost:             DefaultPubKeyRotationGasCost,
+		SigVerifyCostMultisigPerSignature: DefaultSigVerifyCostMultisigPerSignature,
+		SimulationSignatureSize:           DefaultSimulationSignatureSize,
+		MaxRefundRate:                     DefaultMaxRefundRate,
 	}
 }
 
@@ -47,6 +66,11 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// IsAllowedFeeDenom returns true if transaction fees can be paid in the given denom.
+func (p Params) IsAllowedFeeDenom(denom string) bool {
+	return len(p.AllowedFeeDenoms) == 0 || slices.Contains(p.AllowedFeeDenoms, denom)
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -112,6 +136,95 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMaxPubKeyRotations(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid max pubkey rotations: %d", v)
+	}
+
+	return nil
+}
+
+func validatePubKeyRotationGasCost(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid pubkey rotation gas cost: %d", v)
+	}
+
+	return nil
+}
+
+func validateSigVerifyCostMultisigPerSignature(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid multisig per signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
+func validateSimulationSignatureSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid simulation signature size: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxRefundRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil rate, as in params set before the refunds existed, disables the refunds
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max refund rate must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateAllowedFeeDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed fee denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed fee denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +242,24 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateMaxPubKeyRotations(p.MaxPubKeyRotations); err != nil {
+		return err
+	}
+	if err := validatePubKeyRotationGasCost(p.PubKeyRotationGasCost); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostMultisigPerSignature(p.SigVerifyCostMultisigPerSignature); err != nil {
+		return err
+	}
+	if err := validateSimulationSignatureSize(p.SimulationSignatureSize); err != nil {
+		return err
+	}
+	if err := validateAllowedFeeDenoms(p.AllowedFeeDenoms); err != nil {
+		return err
+	}
+	if err := validateMaxRefundRate(p.MaxRefundRate); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 )
 
@@ -25,6 +26,7 @@ func TestParams_Validate(t *testing.T) {
 		wantErr error
 	}{
 		{"default params", types.DefaultParams(), nil},
+		{"valid params", types.NewParams(100, 5, 20, 500, 800), nil},
 		{"invalid tx signature limit", types.NewParams(types.DefaultMaxMemoCharacters, 0, types.DefaultTxSizeCostPerByte,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx signature limit: 0")},
 		{"invalid ED25519 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
@@ -35,6 +37,17 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"invalid max pubkey rotations", withMaxPubKeyRotations(0), fmt.Errorf("invalid max pubkey rotations: 0")},
+		{"invalid pubkey rotation gas cost", withPubKeyRotationGasCost(0), fmt.Errorf("invalid pubkey rotation gas cost: 0")},
+		{"invalid multisig per signature verification cost", withSigVerifyCostMultisigPerSignature(0), fmt.Errorf("invalid multisig per signature verification cost: 0")},
+		{"invalid simulation signature size", withSimulationSignatureSize(0), fmt.Errorf("invalid simulation signature size: 0")},
+		{"allowed fee denoms", withAllowedFeeDenoms("stake", "uatom"), nil},
+		{"invalid allowed fee denom", withAllowedFeeDenoms("stake", "1atom"), fmt.Errorf("invalid allowed fee denom: %w", fmt.Errorf("invalid denom: %s", "1atom"))},
+		{"duplicate allowed fee denom", withAllowedFeeDenoms("stake", "stake"), fmt.Errorf("duplicate allowed fee denom: stake")},
+		{"max refund rate", withMaxRefundRate(math.LegacyNewDecWithPrec(5, 1)), nil},
+		{"full max refund rate", withMaxRefundRate(math.LegacyOneDec()), nil},
+		{"negative max refund rate", withMaxRefundRate(math.LegacyNewDecWithPrec(-1, 1)), fmt.Errorf("max refund rate must be between 0 and 1: -0.100000000000000000")},
+		{"max refund rate above 1", withMaxRefundRate(math.LegacyNewDecWithPrec(15, 1)), fmt.Errorf("max refund rate must be between 0 and 1: 1.500000000000000000")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -50,3 +63,66 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewParams(t *testing.T) {
+	params := types.NewParams(100, 5, 20, 500, 800)
+	require.NoError(t, params.Validate())
+	require.Equal(t, uint64(100), params.MaxMemoCharacters)
+	require.Equal(t, uint64(5), params.TxSigLimit)
+	require.Equal(t, uint64(20), params.TxSizeCostPerByte)
+	require.Equal(t, uint64(500), params.SigVerifyCostED25519)
+	require.Equal(t, uint64(800), params.SigVerifyCostSecp256k1)
+
+	// the other params are not given, and take their default values
+	require.Equal(t, types.DefaultMaxPubKeyRotations, params.MaxPubKeyRotations)
+	require.Equal(t, types.DefaultPubKeyRotationGasCost, params.PubKeyRotationGasCost)
+	require.Equal(t, types.DefaultSigVerifyCostMultisigPerSignature, params.SigVerifyCostMultisigPerSignature)
+	require.Equal(t, types.DefaultSimulationSignatureSize, params.SimulationSignatureSize)
+}
+
+func withMaxPubKeyRotations(rotations uint64) types.Params {
+	params := types.DefaultParams()
+	params.MaxPubKeyRotations = rotations
+	return params
+}
+
+func withPubKeyRotationGasCost(gasCost uint64) types.Params {
+	params := types.DefaultParams()
+	params.PubKeyRotationGasCost = gasCost
+	return params
+}
+
+func withSigVerifyCostMultisigPerSignature(cost uint64) types.Params {
+	params := types.DefaultParams()
+	params.SigVerifyCostMultisigPerSignature = cost
+	return params
+}
+
+func withSimulationSignatureSize(size uint64) types.Params {
+	params := types.DefaultParams()
+	params.SimulationSignatureSize = size
+	return params
+}
+
+func withAllowedFeeDenoms(denoms ...string) types.Params {
+	params := types.DefaultParams()
+	params.AllowedFeeDenoms = denoms
+	return params
+}
+
+func withMaxRefundRate(rate math.LegacyDec) types.Params {
+	params := types.DefaultParams()
+	params.MaxRefundRate = rate
+	return params
+}
+
+func TestParams_IsAllowedFeeDenom(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsAllowedFeeDenom("stake"))
+	require.True(t, params.IsAllowedFeeDenom("dust"))
+
+	params.AllowedFeeDenoms = []string{"stake", "uatom"}
+	require.True(t, params.IsAllowedFeeDenom("stake"))
+	require.True(t, params.IsAllowedFeeDenom("uatom"))
+	require.False(t, params.IsAllowedFeeDenom("dust"))
+}
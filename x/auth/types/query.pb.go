@@ -38,6 +38,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryAccountsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// vesting_only restricts the accounts to the vesting accounts.
+	VestingOnly bool `protobuf:"varint,2,opt,name=vesting_only,json=vestingOnly,proto3" json:"vesting_only,omitempty"`
 }
 
 func (m *QueryAccountsRequest) Reset()         { *m = QueryAccountsRequest{} }
@@ -80,6 +82,13 @@ func (m *QueryAccountsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAccountsRequest) GetVestingOnly() bool {
+	if m != nil {
+		return m.VestingOnly
+	}
+	return false
+}
+
 // QueryAccountsResponse is the response type for the Query/Accounts RPC method.
 //
 // Since: cosmos-sdk 0.43
@@ -988,73 +997,75 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1056 bytes of a gzipped FileDescriptorProto
+	// 1081 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x24, 0x2b, 0xf1, 0xe3, 0x54, 0xb3, 0x80, 0x7a, 0x58, 0x14, 0xdc,
-	0x15, 0x42, 0x1c, 0x88, 0x9c, 0xda, 0x49, 0x2d, 0x36, 0x9e, 0x6c, 0xec, 0xc0, 0x86, 0x2a, 0x17,
+	0x14, 0xc7, 0x33, 0xd9, 0xd2, 0x76, 0x5f, 0xb3, 0x45, 0x9a, 0x66, 0x45, 0x70, 0xdb, 0x24, 0xb8,
+	0xd0, 0xa6, 0x65, 0x6b, 0xd3, 0xa6, 0x2b, 0xf1, 0xe3, 0x14, 0xb3, 0x80, 0x7a, 0x58, 0x08, 0xee,
+	0x0a, 0x21, 0x2e, 0x91, 0x53, 0x3b, 0xa9, 0x45, 0xe3, 0xc9, 0xc6, 0xce, 0xb2, 0xa1, 0xca, 0x01,
 	0x24, 0xa4, 0x5e, 0x90, 0x90, 0xe0, 0x0f, 0xd8, 0x03, 0xe2, 0xbc, 0x48, 0xe5, 0xc6, 0x1f, 0xb0,
 	0xda, 0xd3, 0x0a, 0x2e, 0x9c, 0x10, 0x6a, 0x91, 0xe0, 0xc6, 0xbf, 0x80, 0x32, 0xf3, 0xec, 0xd8,
-	0xed, 0x24, 0x71, 0xd9, 0x9b, 0x33, 0xf3, 0xde, 0xf7, 0x7d, 0xe6, 0xcd, 0xb3, 0xbf, 0x2d, 0x14,
-	0x0e, 0xa9, 0xd7, 0xa1, 0x9e, 0x66, 0xf6, 0xfd, 0x23, 0xed, 0xf3, 0xdd, 0xa6, 0xed, 0x9b, 0xbb,
-	0xda, 0x83, 0xbe, 0xdd, 0x1b, 0xa8, 0xdd, 0x1e, 0xf5, 0x29, 0x59, 0xe5, 0x01, 0xea, 0x28, 0x40,
-	0xc5, 0x00, 0x79, 0x07, 0xb3, 0x9a, 0xa6, 0x67, 0xf3, 0xe8, 0x30, 0xb7, 0x6b, 0xb6, 0x1d, 0xd7,
-	0xf4, 0x1d, 0xea, 0x72, 0x01, 0x39, 0xd3, 0xa6, 0x6d, 0xca, 0x1e, 0xb5, 0xd1, 0x13, 0xae, 0xbe,
-	0xdc, 0xa6, 0xb4, 0x7d, 0xdf, 0xd6, 0xd8, 0xaf, 0x66, 0xbf, 0xa5, 0x99, 0x2e, 0x56, 0x94, 0xd7,
-	0x70, 0xcb, 0xec, 0x3a, 0x9a, 0xe9, 0xba, 0xd4, 0x67, 0x6a, 0x1e, 0xee, 0xe6, 0x45, 0xc0, 0x0c,
-	0x0e, 0x85, 0xf9, 0x7e, 0x83, 0x57, 0x44, 0x78, 0xbe, 0x95, 0xc3, 0xd4, 0x00, 0x38, 0x7a, 0x4e,
-	0xe5, 0x53, 0xc8, 0x7c, 0x38, 0xfa, 0xb9, 0x77, 0x78, 0x48, 0xfb, 0xae, 0xef, 0x19, 0xf6, 0x83,
-	0xbe, 0xed, 0xf9, 0xe4, 0x3d, 0x80, 0xf1, 0x91, 0xb2, 0x52, 0x51, 0x2a, 0x2d, 0x57, 0x36, 0x55,
-	0xd4, 0x1d, 0x9d, 0x5f, 0xe5, 0x2a, 0x88, 0xa2, 0xd6, 0xcd, 0xb6, 0x8d, 0xb9, 0x46, 0x24, 0x53,
-	0x39, 0x95, 0xe0, 0xe6, 0x85, 0x02, 0x5e, 0x97, 0xba, 0x9e, 0x4d, 0x0c, 0x58, 0x32, 0x71, 0x2d,
-	0x2b, 0x15, 0xaf, 0x95, 0x96, 0x2b, 0x19, 0x95, 0xb7, 0x40, 0x0d, 0xba, 0xa3, 0xee, 0xb9, 0x03,
-	0xbd, 0xf8, 0xf4, 0xb4, 0xbc, 0x26, 0xb8, 0x0d, 0x15, 0x15, 0xf7, 0x8d, 0x50, 0x87, 0xbc, 0x1f,
-	0xa3, 0x9e, 0x63, 0xd4, 0x5b, 0x33, 0xa9, 0x39, 0x50, 0x0c, 0xfb, 0x00, 0x56, 0xa3, 0xd4, 0x41,
-	0x57, 0x2a, 0xb0, 0x68, 0x5a, 0x56, 0xcf, 0xf6, 0x3c, 0xd6, 0x92, 0xeb, 0x7a, 0xf6, 0xd7, 0xd3,
-	0x72, 0x06, 0xf5, 0xf7, 0xf8, 0xce, 0x81, 0xdf, 0x73, 0xdc, 0xb6, 0x11, 0x04, 0xbe, 0xb5, 0x74,
-	0xf2, 0xa8, 0x90, 0xfa, 0xe7, 0x51, 0x21, 0xa5, 0x1c, 0xc5, 0x7b, 0x1d, 0x76, 0xa2, 0x0e, 0x8b,
-	0x78, 0x02, 0x6c, 0xf4, 0xff, 0x6d, 0x44, 0x20, 0xa3, 0x64, 0x80, 0xb0, 0x4a, 0x75, 0xb3, 0x67,
-	0x76, 0x82, 0x3b, 0x55, 0xea, 0x78, 0xa8, 0x60, 0x15, 0xcb, 0xbf, 0x09, 0x0b, 0x5d, 0xb6, 0x82,
-	0xd5, 0x73, 0xaa, 0xa8, 0x08, 0x4f, 0xd2, 0xe7, 0x9f, 0xfc, 0x51, 0x48, 0x19, 0x98, 0xa0, 0xac,
-	0x81, 0xcc, 0x14, 0xef, 0x52, 0xab, 0x7f, 0xdf, 0xbe, 0x30, 0x43, 0xca, 0x17, 0x90, 0x13, 0xee,
-	0x62, 0xdd, 0x8f, 0x13, 0x0e, 0xc0, 0xe6, 0xd3, 0xd3, 0xb2, 0x22, 0x42, 0x8a, 0xe9, 0x46, 0xc6,
-	0x40, 0xb9, 0x0d, 0x85, 0xcb, 0x85, 0xf5, 0xc1, 0x07, 0x66, 0x27, 0x98, 0x51, 0x42, 0x60, 0xde,
-	0x35, 0x3b, 0x36, 0xbf, 0x46, 0x83, 0x3d, 0x2b, 0x5f, 0x42, 0x71, 0x72, 0x1a, 0x42, 0x7f, 0x94,
-	0xec, 0xae, 0x92, 0x32, 0x87, 0x37, 0x76, 0x13, 0x56, 0x75, 0xfb, 0xf0, 0xa8, 0x5a, 0xa9, 0xf7,
-	0xec, 0x96, 0xf3, 0x30, 0x68, 0xe1, 0xdb, 0x90, 0x89, 0x2f, 0x23, 0xc6, 0x06, 0xdc, 0x68, 0xb2,
-	0xf5, 0x46, 0x97, 0x6d, 0xe0, 0x39, 0xd2, 0xcd, 0x48, 0xb0, 0xa2, 0x43, 0x0e, 0x67, 0x52, 0x1f,
-	0xf8, 0xb6, 0x77, 0x8f, 0xe2, 0x68, 0x62, 0x0b, 0x36, 0xe0, 0x06, 0xce, 0x68, 0xa3, 0x39, 0xda,
-	0x67, 0x1a, 0x69, 0x23, 0x6d, 0x46, 0x72, 0x94, 0x77, 0x61, 0x4d, 0xac, 0x81, 0x20, 0xaf, 0xc2,
-	0x4a, 0x20, 0xe2, 0xb1, 0x1d, 0x24, 0x09, 0xa4, 0x79, 0xb8, 0x72, 0x27, 0x44, 0xe1, 0x0b, 0xf7,
-	0x28, 0x93, 0x0b, 0x50, 0x12, 0xaa, 0xbc, 0x13, 0xc2, 0x5c, 0x50, 0x19, 0x77, 0x65, 0xf6, 0x89,
-	0x0e, 0x20, 0x1f, 0x7d, 0x0b, 0xc3, 0xd3, 0xed, 0xdf, 0x19, 0xcf, 0xc6, 0x9c, 0x63, 0xb1, 0xdc,
-	0x6b, 0xfa, 0x5c, 0x56, 0x32, 0xe6, 0x1c, 0x8b, 0xac, 0x03, 0xe0, 0x55, 0x35, 0x1c, 0x8b, 0x7d,
-	0x59, 0xe6, 0x8d, 0xeb, 0xb8, 0xb2, 0x6f, 0x29, 0x16, 0x4e, 0x9c, 0x48, 0x14, 0xe1, 0xf6, 0xe0,
-	0xc5, 0x40, 0x21, 0xe9, 0x37, 0x64, 0xc5, 0x8c, 0xc9, 0x29, 0x77, 0xe1, 0xa5, 0x68, 0x95, 0x7d,
-	0xb7, 0x45, 0x9f, 0xe3, 0xcb, 0xa4, 0xd4, 0x21, 0x7b, 0x59, 0x0e, 0x69, 0x6b, 0x30, 0xef, 0xb8,
-	0x2d, 0x8a, 0x43, 0x5e, 0x14, 0x7e, 0x12, 0x74, 0xd3, 0x0b, 0x26, 0xd9, 0x60, 0xd1, 0x95, 0x7f,
-	0xd3, 0xf0, 0x02, 0x93, 0x24, 0xdf, 0x48, 0xb0, 0x14, 0xbc, 0xf1, 0x64, 0x5b, 0x98, 0x2e, 0xf2,
-	0x1d, 0x79, 0x27, 0x49, 0x28, 0x67, 0x54, 0x76, 0x4e, 0xfe, 0x7e, 0xbc, 0x23, 0x7d, 0xf5, 0xdb,
-	0x5f, 0xdf, 0xcd, 0x15, 0xc8, 0xba, 0x26, 0x74, 0xc8, 0x00, 0xe1, 0x7b, 0x09, 0x16, 0x51, 0x80,
-	0x94, 0x66, 0xd6, 0x08, 0x68, 0xb6, 0x13, 0x44, 0x22, 0x4c, 0x6d, 0x0c, 0xb3, 0x4d, 0xb6, 0xa6,
-	0xc2, 0x68, 0xc7, 0x78, 0x03, 0x43, 0xf2, 0xb3, 0x04, 0xe4, 0xf2, 0xcc, 0x90, 0xea, 0xcc, 0xba,
-	0x97, 0xc7, 0x56, 0xae, 0x5d, 0x2d, 0xe9, 0x0a, 0xdc, 0xe1, 0x3b, 0xd5, 0x70, 0x2c, 0xed, 0xd8,
-	0xb1, 0x86, 0xe4, 0x6b, 0x09, 0x16, 0xb8, 0x23, 0x90, 0xad, 0xc9, 0x65, 0x63, 0xf6, 0x23, 0x97,
-	0x66, 0x07, 0x22, 0x53, 0x69, 0xcc, 0xb4, 0x4e, 0x72, 0x42, 0x26, 0x6e, 0x40, 0xe4, 0x47, 0x09,
-	0x56, 0xe2, 0xf6, 0x42, 0xb4, 0xc9, 0x65, 0x84, 0x36, 0x25, 0xbf, 0x9e, 0x3c, 0x01, 0xf9, 0x76,
-	0xc7, 0x7c, 0x9b, 0xe4, 0x15, 0x21, 0x5f, 0x87, 0x65, 0x36, 0xc2, 0xf9, 0xfb, 0x45, 0x82, 0x55,
-	0x81, 0xaf, 0x90, 0x5a, 0xc2, 0xe2, 0x31, 0xf7, 0x92, 0x6f, 0x5f, 0x31, 0x0b, 0xb9, 0xdf, 0x18,
-	0x73, 0x97, 0xc9, 0x6b, 0x49, 0xb8, 0xb5, 0xe3, 0x91, 0x33, 0x0e, 0xc9, 0x89, 0x04, 0xe9, 0xa8,
-	0x11, 0x4d, 0x78, 0x87, 0x04, 0x16, 0x36, 0xe1, 0x1d, 0x12, 0xb9, 0x9a, 0xb2, 0x31, 0xf5, 0xca,
-	0xb9, 0xb7, 0x91, 0xc7, 0x12, 0x64, 0x44, 0x96, 0x44, 0xc4, 0xf7, 0x38, 0xc5, 0x01, 0xe5, 0xdd,
-	0x2b, 0x64, 0x20, 0x62, 0x75, 0x6a, 0xf7, 0x38, 0x62, 0xf8, 0x7e, 0x73, 0x17, 0x1a, 0x92, 0x9f,
-	0xc6, 0xc8, 0x31, 0xe3, 0x9a, 0x8e, 0x2c, 0x72, 0xca, 0xe9, 0xc8, 0x42, 0x57, 0x54, 0x6a, 0x0c,
-	0x59, 0x25, 0xb7, 0x12, 0x21, 0x73, 0xff, 0x1d, 0x92, 0x1f, 0x24, 0x58, 0x8e, 0x18, 0x03, 0xb9,
-	0x35, 0xf3, 0xeb, 0x12, 0xb1, 0x23, 0xb9, 0x9c, 0x30, 0x3a, 0xf9, 0x60, 0x86, 0xee, 0xeb, 0xb6,
-	0xe8, 0xf8, 0x03, 0xaa, 0x57, 0x9f, 0x9c, 0xe5, 0xa5, 0x67, 0x67, 0x79, 0xe9, 0xcf, 0xb3, 0xbc,
-	0xf4, 0xed, 0x79, 0x3e, 0xf5, 0xec, 0x3c, 0x9f, 0xfa, 0xfd, 0x3c, 0x9f, 0xfa, 0x04, 0xff, 0x23,
-	0xf2, 0xac, 0xcf, 0x54, 0x87, 0x6a, 0x0f, 0xb9, 0x9a, 0x3f, 0xe8, 0xda, 0x5e, 0x73, 0x81, 0xfd,
-	0xad, 0x56, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xde, 0xbd, 0x53, 0x06, 0x0e, 0x00, 0x00,
+	0xad, 0x93, 0x38, 0x70, 0x4b, 0x67, 0xde, 0xfb, 0xbe, 0xcf, 0xcc, 0x7b, 0x9e, 0xef, 0x2e, 0x14,
+	0x0e, 0x99, 0xdb, 0x62, 0xae, 0x6a, 0x74, 0xbd, 0x23, 0xf5, 0xc1, 0x4e, 0xdd, 0xf2, 0x8c, 0x1d,
+	0xf5, 0x7e, 0xd7, 0xea, 0xf4, 0x94, 0x76, 0x87, 0x79, 0x8c, 0x2e, 0x89, 0x00, 0x65, 0x10, 0xa0,
+	0x60, 0x80, 0xb4, 0x85, 0x59, 0x75, 0xc3, 0xb5, 0x44, 0x74, 0x90, 0xdb, 0x36, 0x9a, 0xb6, 0x63,
+	0x78, 0x36, 0x73, 0x84, 0x80, 0x94, 0x6d, 0xb2, 0x26, 0xe3, 0x3f, 0xd5, 0xc1, 0x2f, 0x5c, 0x7d,
+	0xb1, 0xc9, 0x58, 0xf3, 0xd8, 0x52, 0xf9, 0x5f, 0xf5, 0x6e, 0x43, 0x35, 0x1c, 0xac, 0x28, 0xad,
+	0xe0, 0x96, 0xd1, 0xb6, 0x55, 0xc3, 0x71, 0x98, 0xc7, 0xd5, 0x5c, 0xdc, 0xcd, 0xc7, 0x01, 0x73,
+	0x38, 0x14, 0x16, 0xfb, 0x35, 0x51, 0x11, 0xe1, 0xc5, 0xd6, 0x32, 0xa6, 0xfa, 0xc0, 0xe1, 0x73,
+	0xca, 0x5f, 0x10, 0xc8, 0x7e, 0x38, 0xf8, 0xbb, 0x72, 0x78, 0xc8, 0xba, 0x8e, 0xe7, 0xea, 0xd6,
+	0xfd, 0xae, 0xe5, 0x7a, 0xf4, 0x5d, 0x80, 0xe1, 0x99, 0x72, 0xa4, 0x48, 0x4a, 0x0b, 0xbb, 0xeb,
+	0x0a, 0x0a, 0x0f, 0x2e, 0x40, 0x11, 0x32, 0xc8, 0xa2, 0x54, 0x8d, 0xa6, 0x85, 0xb9, 0x7a, 0x28,
+	0x93, 0xbe, 0x04, 0x99, 0x07, 0x96, 0xeb, 0xd9, 0x4e, 0xb3, 0xc6, 0x9c, 0xe3, 0x5e, 0x2e, 0x5d,
+	0x24, 0xa5, 0x79, 0x7d, 0x01, 0xd7, 0x3e, 0x70, 0x8e, 0x7b, 0xf2, 0x19, 0x81, 0x9b, 0x97, 0x18,
+	0xdc, 0x36, 0x73, 0x5c, 0x8b, 0xea, 0x30, 0x6f, 0xe0, 0x5a, 0x8e, 0x14, 0xaf, 0x95, 0x16, 0x76,
+	0xb3, 0x8a, 0xb8, 0x26, 0xc5, 0xbf, 0x41, 0xa5, 0xe2, 0xf4, 0xb4, 0xe2, 0xd3, 0xb3, 0xed, 0x95,
+	0x98, 0x8e, 0x29, 0xa8, 0xb8, 0xaf, 0x07, 0x3a, 0xf4, 0xbd, 0xc8, 0xc1, 0xd2, 0xfc, 0x60, 0x1b,
+	0x13, 0x0f, 0x26, 0x80, 0xc2, 0x27, 0x93, 0x0f, 0x60, 0x29, 0x4c, 0xed, 0x5f, 0xdc, 0x2e, 0xcc,
+	0x19, 0xa6, 0xd9, 0xb1, 0x5c, 0x97, 0xdf, 0xda, 0x75, 0x2d, 0xf7, 0xcb, 0xd9, 0x76, 0x16, 0xf5,
+	0x2b, 0x62, 0xe7, 0xc0, 0xeb, 0xd8, 0x4e, 0x53, 0xf7, 0x03, 0xdf, 0x9c, 0x3f, 0x7d, 0x54, 0x48,
+	0xfd, 0xfd, 0xa8, 0x90, 0x92, 0x8f, 0xa2, 0xed, 0x08, 0x6e, 0xa2, 0x0a, 0x73, 0x78, 0x02, 0xec,
+	0xc5, 0x7f, 0xbd, 0x08, 0x5f, 0x46, 0xce, 0x02, 0xe5, 0x95, 0xaa, 0x46, 0xc7, 0x68, 0xf9, 0x6d,
+	0x97, 0xab, 0xb0, 0x14, 0x59, 0xc5, 0xf2, 0x6f, 0xc0, 0x6c, 0x9b, 0xaf, 0x60, 0xf5, 0x65, 0x25,
+	0xae, 0x88, 0x48, 0xd2, 0x66, 0x9e, 0xfc, 0x5e, 0x48, 0xe9, 0x98, 0x20, 0xaf, 0x80, 0xc4, 0x15,
+	0xef, 0x32, 0xb3, 0x7b, 0x6c, 0x5d, 0x1a, 0x33, 0xf9, 0x33, 0x58, 0x8e, 0xdd, 0xc5, 0xba, 0x1f,
+	0x27, 0x1c, 0x80, 0xf5, 0xa7, 0x67, 0xdb, 0x72, 0x1c, 0x52, 0x44, 0x37, 0x34, 0x06, 0xf2, 0x6d,
+	0x28, 0x5c, 0x2d, 0xac, 0xf5, 0xde, 0x37, 0x5a, 0xfe, 0x18, 0x53, 0x0a, 0x33, 0x8e, 0xd1, 0xb2,
+	0x44, 0x1b, 0x75, 0xfe, 0x5b, 0xfe, 0x1c, 0x8a, 0xa3, 0xd3, 0x10, 0xfa, 0xa3, 0x64, 0xbd, 0x4a,
+	0xca, 0x1c, 0x74, 0xec, 0x26, 0x2c, 0x69, 0xd6, 0xe1, 0x51, 0x79, 0xb7, 0xda, 0xb1, 0x1a, 0xf6,
+	0x43, 0xff, 0x0a, 0xdf, 0x82, 0x6c, 0x74, 0x19, 0x31, 0xd6, 0xe0, 0x46, 0x9d, 0xaf, 0xd7, 0xda,
+	0x7c, 0x03, 0xcf, 0x91, 0xa9, 0x87, 0x82, 0x65, 0x0d, 0x96, 0x71, 0x26, 0xb5, 0x9e, 0x67, 0xb9,
+	0xf7, 0x18, 0x8e, 0x26, 0x5e, 0xc1, 0x1a, 0xdc, 0xc0, 0x19, 0xad, 0xd5, 0x07, 0xfb, 0x5c, 0x23,
+	0xa3, 0x67, 0x8c, 0x50, 0x8e, 0xfc, 0x0e, 0xac, 0xc4, 0x6b, 0x20, 0xc8, 0x2b, 0xb0, 0xe8, 0x8b,
+	0xb8, 0x7c, 0x07, 0x49, 0x7c, 0x69, 0x11, 0x2e, 0xdf, 0x09, 0x50, 0xc4, 0xc2, 0x3d, 0xc6, 0xe5,
+	0x7c, 0x94, 0x84, 0x2a, 0x6f, 0x07, 0x30, 0x97, 0x54, 0x86, 0xb7, 0x32, 0xf9, 0x44, 0x07, 0x90,
+	0x0f, 0x7f, 0x85, 0xc1, 0xe9, 0xf6, 0xef, 0x0c, 0x67, 0x23, 0x6d, 0x9b, 0x3c, 0xf7, 0x9a, 0x96,
+	0xce, 0x11, 0x3d, 0x6d, 0x9b, 0x74, 0x15, 0x00, 0x5b, 0x55, 0xb3, 0x4d, 0xfe, 0xb2, 0xcc, 0xe8,
+	0xd7, 0x71, 0x65, 0xdf, 0x94, 0x4d, 0x28, 0x8c, 0x14, 0x45, 0xb8, 0x0a, 0x3c, 0xef, 0x2b, 0x24,
+	0x7d, 0x43, 0x16, 0x8d, 0x88, 0x9c, 0x7c, 0x17, 0x5e, 0x08, 0x57, 0xd9, 0x77, 0x1a, 0xec, 0x7f,
+	0xbc, 0x4c, 0x72, 0x15, 0x72, 0x57, 0xe5, 0x90, 0x76, 0x0f, 0x66, 0x6c, 0xa7, 0xc1, 0x70, 0xc8,
+	0x8b, 0xb1, 0x4f, 0x82, 0x66, 0xb8, 0xfe, 0x24, 0xeb, 0x3c, 0x7a, 0xf7, 0x9f, 0x0c, 0x3c, 0xc7,
+	0x25, 0xe9, 0xd7, 0x04, 0xe6, 0x2b, 0xfe, 0xb3, 0xbc, 0x19, 0x9b, 0x1e, 0x67, 0x4d, 0xd2, 0x56,
+	0x92, 0x50, 0xc1, 0x28, 0x6f, 0x9d, 0xfe, 0xf5, 0x78, 0x8b, 0x7c, 0xf9, 0xeb, 0x9f, 0xdf, 0xa6,
+	0x0b, 0x74, 0x55, 0x8d, 0x75, 0x51, 0x1f, 0xe1, 0x3b, 0x02, 0x73, 0x28, 0x40, 0x4b, 0x13, 0x6b,
+	0xf8, 0x34, 0x9b, 0x09, 0x22, 0x11, 0x66, 0x6f, 0x08, 0xb3, 0x49, 0x37, 0xc6, 0xc2, 0xa8, 0x27,
+	0xd8, 0x81, 0x3e, 0xfd, 0x89, 0x00, 0xbd, 0x3a, 0x33, 0xb4, 0x3c, 0xb1, 0xee, 0xd5, 0xb1, 0x95,
+	0xf6, 0xa6, 0x4b, 0x9a, 0x82, 0x3b, 0xf8, 0xa6, 0x6a, 0xb6, 0xa9, 0x9e, 0xd8, 0x66, 0x9f, 0x7e,
+	0x45, 0x60, 0x56, 0x38, 0x02, 0xdd, 0x18, 0x5d, 0x36, 0x62, 0x3f, 0x52, 0x69, 0x72, 0x20, 0x32,
+	0x95, 0x86, 0x4c, 0xab, 0x74, 0x39, 0x96, 0x49, 0x18, 0x10, 0xfd, 0x81, 0xc0, 0x62, 0xd4, 0x5e,
+	0xa8, 0x3a, 0xba, 0x4c, 0xac, 0x4d, 0x49, 0xaf, 0x25, 0x4f, 0x40, 0xbe, 0x9d, 0x21, 0xdf, 0x3a,
+	0x7d, 0x39, 0x96, 0xaf, 0xc5, 0x33, 0x6b, 0xc1, 0xfc, 0xfd, 0x4c, 0x60, 0x29, 0xc6, 0x57, 0xe8,
+	0x5e, 0xc2, 0xe2, 0x11, 0xf7, 0x92, 0x6e, 0x4f, 0x99, 0x85, 0xdc, 0xaf, 0x0f, 0xb9, 0xb7, 0xe9,
+	0xab, 0x49, 0xb8, 0xd5, 0x93, 0x81, 0x33, 0xf6, 0xe9, 0x29, 0x81, 0x4c, 0xd8, 0x88, 0x46, 0x7c,
+	0x43, 0x31, 0x16, 0x26, 0x6d, 0x26, 0x88, 0x44, 0xbe, 0xb5, 0xb1, 0x2d, 0x17, 0xde, 0x46, 0x1f,
+	0x13, 0xc8, 0xc6, 0x59, 0x12, 0x8d, 0xef, 0xe3, 0x18, 0x07, 0x94, 0x76, 0xa6, 0xc8, 0x40, 0xc4,
+	0xf2, 0xd8, 0xdb, 0x13, 0x88, 0xea, 0x49, 0xc4, 0x85, 0xfa, 0xf4, 0xc7, 0x21, 0x72, 0xc4, 0xb8,
+	0xc6, 0x23, 0xc7, 0x39, 0xa5, 0xb4, 0x33, 0x45, 0x86, 0xff, 0x85, 0x73, 0x64, 0x85, 0xde, 0x4a,
+	0x84, 0x2c, 0xfc, 0xb7, 0x4f, 0xbf, 0x27, 0xb0, 0x10, 0x32, 0x06, 0x7a, 0x6b, 0xe2, 0xeb, 0x12,
+	0xb2, 0x23, 0x69, 0x3b, 0x61, 0x74, 0xf2, 0xc1, 0x0c, 0xdc, 0xd7, 0x69, 0xb0, 0xe1, 0x03, 0xaa,
+	0x95, 0x9f, 0x9c, 0xe7, 0xc9, 0xb3, 0xf3, 0x3c, 0xf9, 0xe3, 0x3c, 0x4f, 0xbe, 0xb9, 0xc8, 0xa7,
+	0x9e, 0x5d, 0xe4, 0x53, 0xbf, 0x5d, 0xe4, 0x53, 0x9f, 0xe0, 0xff, 0x9a, 0x5c, 0xf3, 0x53, 0xc5,
+	0x66, 0xea, 0x43, 0xa1, 0xe6, 0xf5, 0xda, 0x96, 0x5b, 0x9f, 0xe5, 0xff, 0x56, 0x2b, 0xff, 0x3b,
+	0x00, 0x19, 0x2c, 0x14, 0xb3, 0x2a, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1535,6 +1546,16 @@ func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VestingOnly {
+		i--
+		if m.VestingOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2167,6 +2188,9 @@ func (m *QueryAccountsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.VestingOnly {
+		n += 2
+	}
 	return n
 }
 
@@ -2484,6 +2508,26 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VestingOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -130,36 +131,126 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRotatePubKey is the Msg/RotatePubKey request type.
+type MsgRotatePubKey struct {
+	// address is the address of the account whose public key is rotated.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// new_pub_key is the public key replacing the current public key of the account.
+	NewPubKey *types.Any `protobuf:"bytes,2,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+}
+
+func (m *MsgRotatePubKey) Reset()         { *m = MsgRotatePubKey{} }
+func (m *MsgRotatePubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePubKey) ProtoMessage()    {}
+func (*MsgRotatePubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{2}
+}
+func (m *MsgRotatePubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePubKey.Merge(m, src)
+}
+func (m *MsgRotatePubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePubKey proto.InternalMessageInfo
+
+// MsgRotatePubKeyResponse defines the response structure for executing a
+// MsgRotatePubKey message.
+type MsgRotatePubKeyResponse struct {
+}
+
+func (m *MsgRotatePubKeyResponse) Reset()         { *m = MsgRotatePubKeyResponse{} }
+func (m *MsgRotatePubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePubKeyResponse) ProtoMessage()    {}
+func (*MsgRotatePubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{3}
+}
+func (m *MsgRotatePubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotatePubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePubKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRotatePubKey)(nil), "cosmos.auth.v1beta1.MsgRotatePubKey")
+	proto.RegisterType((*MsgRotatePubKeyResponse)(nil), "cosmos.auth.v1beta1.MsgRotatePubKeyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0x4a,
-	0x12, 0xa2, 0x34, 0x1e, 0x22, 0x01, 0xd5, 0x07, 0x91, 0x12, 0x87, 0xda, 0x91, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a,
-	0x24, 0x87, 0xcd, 0x3d, 0x60, 0xeb, 0xc1, 0xf2, 0x4a, 0xfb, 0x19, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x85, 0xcc, 0xb8, 0x38,
-	0x41, 0x2a, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28,
-	0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x8e, 0x8b, 0xad, 0x00, 0x6c, 0x82, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0x16, 0xef, 0xea, 0x41, 0x2c, 0x71, 0xe2, 0x3c, 0x71, 0x4f,
-	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x56, 0x26, 0x4d, 0xcf, 0x37, 0x68,
-	0x21, 0xcc, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x11, 0x62, 0x82, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0xc4, 0x13, 0x68, 0xae, 0x55, 0x92, 0xe4, 0x12, 0x47, 0x13, 0x0a, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf,
-	0x2b, 0x4e, 0x35, 0x2a, 0xe0, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x4a, 0xe2, 0xe2, 0x41, 0xf1, 0x9f,
-	0x0a, 0x56, 0x77, 0xa1, 0x19, 0x22, 0xa5, 0x43, 0x8c, 0x2a, 0x98, 0x55, 0x52, 0xac, 0x0d, 0x20,
-	0xaf, 0x38, 0x19, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x3e,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0x61, 0x7e, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x47, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x09, 0xbc, 0x34, 0x3c, 0x02, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0xd4, 0x40,
+	0x18, 0xcd, 0x28, 0x56, 0x76, 0x2a, 0x88, 0x71, 0xa1, 0x69, 0x94, 0xb4, 0x2e, 0x1e, 0xca, 0xd2,
+	0xce, 0xb0, 0x5b, 0xe9, 0xa1, 0x07, 0xa1, 0xb9, 0x4a, 0x45, 0x22, 0x5e, 0xbc, 0x2c, 0x93, 0x66,
+	0x1c, 0xc3, 0x9a, 0x4c, 0xc8, 0x4c, 0xda, 0xe6, 0x26, 0x9e, 0xc4, 0x93, 0x3f, 0xc1, 0xa3, 0xc7,
+	0x3d, 0x78, 0xf7, 0x5a, 0x04, 0xa1, 0x88, 0x07, 0x4f, 0x22, 0xbb, 0x87, 0xfd, 0x1b, 0x32, 0x99,
+	0x19, 0x76, 0xbb, 0x6c, 0x69, 0x2f, 0xbb, 0x93, 0xef, 0xbd, 0x79, 0xef, 0x7b, 0xdf, 0x97, 0xc0,
+	0x87, 0x47, 0x5c, 0x64, 0x5c, 0x60, 0x52, 0xc9, 0xb7, 0xf8, 0xb8, 0x17, 0x53, 0x49, 0x7a, 0x58,
+	0x9e, 0xa2, 0xa2, 0xe4, 0x92, 0xbb, 0xf7, 0x35, 0x8a, 0x14, 0x8a, 0x0c, 0xea, 0xb7, 0x19, 0x67,
+	0xbc, 0xc1, 0xb1, 0x3a, 0x69, 0xaa, 0xbf, 0xce, 0x38, 0x67, 0xef, 0x28, 0x6e, 0x9e, 0xe2, 0xea,
+	0x0d, 0x26, 0x79, 0x6d, 0x21, 0xad, 0x32, 0xd0, 0x77, 0x8c, 0xa4, 0x86, 0xd6, 0x8c, 0x7d, 0x26,
+	0x18, 0x3e, 0xee, 0xa9, 0x3f, 0x03, 0xdc, 0x23, 0x59, 0x9a, 0x73, 0xdc, 0xfc, 0x9a, 0x52, 0xb0,
+	0xac, 0xd5, 0xa6, 0xb3, 0x06, 0xef, 0x7c, 0x07, 0xf0, 0xee, 0xa1, 0x60, 0xaf, 0x8a, 0x84, 0x48,
+	0xfa, 0x82, 0x94, 0x24, 0x13, 0xee, 0x1e, 0x6c, 0x29, 0x06, 0x2f, 0x53, 0x59, 0x7b, 0x60, 0x13,
+	0x6c, 0xb5, 0x42, 0xef, 0xd7, 0xb7, 0x9d, 0xb6, 0x69, 0xe2, 0x20, 0x49, 0x4a, 0x2a, 0xc4, 0x4b,
+	0x59, 0xa6, 0x39, 0x8b, 0x66, 0x54, 0xf7, 0x29, 0x5c, 0x29, 0x1a, 0x05, 0xef, 0xc6, 0x26, 0xd8,
+	0x5a, 0xed, 0x3f, 0x40, 0x4b, 0x26, 0x81, 0xb4, 0x49, 0xd8, 0x3a, 0xfb, 0xbb, 0xe1, 0x7c, 0x9d,
+	0x8e, 0xba, 0x20, 0x32, 0xb7, 0xf6, 0x9f, 0x7c, 0x98, 0x8e, 0xba, 0x33, 0xbd, 0x4f, 0xd3, 0x51,
+	0xf7, 0x91, 0x56, 0xd8, 0x11, 0xc9, 0x10, 0x9f, 0xea, 0x10, 0x0b, 0xdd, 0x76, 0xd6, 0xe1, 0xda,
+	0x42, 0x29, 0xa2, 0xa2, 0xe0, 0xb9, 0xa0, 0x9d, 0x9f, 0x3a, 0x5c, 0xc4, 0xa5, 0xc2, 0xaa, 0xf8,
+	0x19, 0xad, 0xdd, 0x3e, 0xbc, 0x4d, 0x74, 0x80, 0x2b, 0xa3, 0x59, 0xa2, 0xfb, 0x1c, 0xae, 0xe6,
+	0xf4, 0x64, 0x50, 0x54, 0xf1, 0x60, 0x48, 0x6b, 0x93, 0xae, 0x8d, 0xf4, 0xf2, 0x90, 0x5d, 0x1e,
+	0x3a, 0xc8, 0xeb, 0xd0, 0xfb, 0x31, 0x53, 0x3b, 0x2a, 0xeb, 0x42, 0x72, 0xa4, 0x8d, 0xa3, 0x56,
+	0x4e, 0x4f, 0xf4, 0x71, 0x7f, 0xef, 0xe3, 0x97, 0x0d, 0x47, 0x85, 0xb5, 0x0e, 0x97, 0x47, 0x9d,
+	0xef, 0xdd, 0x44, 0x9d, 0x2f, 0xd9, 0xa8, 0xfd, 0xdf, 0x00, 0xde, 0x3c, 0x14, 0xcc, 0x8d, 0xe1,
+	0x9d, 0x0b, 0xbb, 0x7c, 0xbc, 0x74, 0x07, 0x0b, 0x03, 0xf3, 0xb7, 0xaf, 0xc3, 0xb2, 0x5e, 0xca,
+	0xe3, 0xc2, 0x48, 0x2f, 0xf5, 0x98, 0x67, 0xf9, 0xdb, 0xd7, 0x61, 0x59, 0x0f, 0xff, 0xd6, 0x7b,
+	0xf5, 0x6a, 0x84, 0xbb, 0x67, 0xe3, 0x00, 0x9c, 0x8f, 0x03, 0xf0, 0x6f, 0x1c, 0x80, 0xcf, 0x93,
+	0xc0, 0x39, 0x9f, 0x04, 0xce, 0x9f, 0x49, 0xe0, 0xbc, 0x36, 0xdf, 0x87, 0x48, 0x86, 0x28, 0xe5,
+	0x76, 0x60, 0xb2, 0x2e, 0xa8, 0x88, 0x57, 0x9a, 0x8d, 0xec, 0xfe, 0x1f, 0x00, 0x3a, 0x61, 0xbc,
+	0x62, 0xa7, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -179,6 +270,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, signed with its current public key.
+	RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -198,6 +292,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error) {
+	out := new(MsgRotatePubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/RotatePubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
@@ -205,6 +308,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, signed with its current public key.
+	RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -214,6 +320,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RotatePubKey(ctx context.Context, req *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePubKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -237,6 +346,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotatePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/RotatePubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePubKey(ctx, req.(*MsgRotatePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -245,6 +372,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RotatePubKey",
+			Handler:    _Msg_RotatePubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -313,6 +444,71 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotatePubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -348,6 +544,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotatePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotatePubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -519,6 +741,174 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotatePubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &types.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotatePubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
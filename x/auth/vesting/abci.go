@@ -0,0 +1,71 @@
+package vesting
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// releasePendingClawbacks returns the pending clawback of the clawback vesting
+// accounts which is no longer delegated, to their funder or to the community
+// pool, and stops tracking the accounts left without a pending clawback.
+func (am AppModule) releasePendingClawbacks(ctx context.Context) error {
+	var pending []collections.KeyValue[sdk.AccAddress, bool]
+	err := am.keeper.PendingClawbacks.Walk(ctx, nil, func(addr sdk.AccAddress, toCommunityPool bool) (bool, error) {
+		pending = append(pending, collections.KeyValue[sdk.AccAddress, bool]{Key: addr, Value: toCommunityPool})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range pending {
+		addr, toCommunityPool := kv.Key, kv.Value
+		acc, ok := am.accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		if !ok || acc.PendingClawback.IsZero() {
+			if err := am.keeper.PendingClawbacks.Remove(ctx, addr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		released := acc.ReleasePendingClawback(am.bankKeeper.GetAllBalances(ctx, addr))
+		if released.IsZero() {
+			continue
+		}
+
+		funder, err := am.accountKeeper.AddressCodec().StringToBytes(acc.FunderAddress)
+		if err != nil {
+			return err
+		}
+
+		// the released coins are unlocked once the account is updated
+		am.accountKeeper.SetAccount(ctx, acc)
+		if acc.PendingClawback.IsZero() {
+			if err := am.keeper.PendingClawbacks.Remove(ctx, addr); err != nil {
+				return err
+			}
+		}
+
+		if err := returnClawedBack(ctx, am.bankKeeper, am.poolKeeper, addr, funder, toCommunityPool, released); err != nil {
+			return err
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleasePendingClawback,
+				sdk.NewAttribute(types.AttributeKeyFunder, acc.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, acc.Address),
+				sdk.NewAttribute(types.AttributeKeyToCommunityPool, strconv.FormatBool(toCommunityPool)),
+				sdk.NewAttribute(types.AttributeKeyAmount, released.String()),
+				sdk.NewAttribute(types.AttributeKeyPending, acc.PendingClawback.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package vesting
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	vestingv1beta1 "cosmossdk.io/api/cosmos/vesting/v1beta1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              vestingv1beta1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "VestingBalances",
+					Use:            "balances [address]",
+					Short:          "Query the original, vested, vesting, locked and delegated balances of a vesting account",
+					Long:           "Query the original, vested, vesting, locked and delegated balances of a vesting account at the latest block time. The response is empty for accounts which are not vesting accounts.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              vestingv1beta1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateVestingAccount",
+					Skip:      true, // set by the custom create-vesting-account command
+				},
+				{
+					RpcMethod:      "CreatePermanentLockedAccount",
+					Use:            "create-permanent-locked-account [to_address] [amount]",
+					Short:          "Create a new permanently locked account funded with an allocation of tokens.",
+					Long:           "Create a new permanently locked account funded with an allocation of tokens. The tokens can be delegated but never transferred. The account must not exist yet.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "to_address"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod: "CreatePeriodicVestingAccount",
+					Skip:      true, // set by the custom create-periodic-vesting-account command
+				},
+				{
+					RpcMethod: "AddVestingGrant",
+					Skip:      true, // set by the custom add-vesting-grant command
+				},
+				{
+					RpcMethod:      "Clawback",
+					Use:            "clawback [address]",
+					Short:          "Claw back the coins still vesting of a clawback vesting account",
+					Long:           "Claw back the coins still vesting of a clawback vesting account, to its funder or to the community pool with --to-community-pool. The clawed back coins which are delegated are returned in the same way once undelegated.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// Query command flags
+const (
+	FlagFrom  = "from"
+	FlagLimit = "limit"
+)
+
+// secondsPerDay is the number of seconds over which the daily rate of a
+// continuous vesting account is computed.
+const secondsPerDay = 24 * 60 * 60
+
+// GetQueryCmd returns the vesting module's custom query commands. The commands
+// generated by autocli are added to it.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetVestingScheduleCmd(),
+	)
+
+	return queryCmd
+}
+
+// VestingSchedule is the upcoming vesting schedule of a vesting account, as
+// printed by the vesting-schedule query command.
+type VestingSchedule struct {
+	// Address is the address of the vesting account.
+	Address string `json:"address"`
+	// From is the unix time from which the schedule is computed.
+	From int64 `json:"from"`
+	// Unlocks are the next unlocks of a periodic or delayed vesting account,
+	// ordered by unlock time.
+	Unlocks []types.VestingUnlock `json:"unlocks,omitempty"`
+	// DailyRate is the amount of coins vesting per day of a continuous vesting
+	// account, until its end time.
+	DailyRate sdk.DecCoins `json:"daily_rate,omitempty"`
+	// Remaining are the coins still vesting after From.
+	Remaining sdk.Coins `json:"remaining"`
+}
+
+// NewVestingSchedule returns the schedule of a vesting account from the given
+// time: at most limit upcoming unlocks for periodic and delayed vesting accounts,
+// or the daily rate for continuous vesting accounts. Only the coins still vesting
+// are returned for other vesting accounts. A limit of zero returns all
+// the upcoming unlocks.
+func NewVestingSchedule(acc sdk.AccountI, from time.Time, limit int) (VestingSchedule, error) {
+	schedule := VestingSchedule{
+		Address: acc.GetAddress().String(),
+		From:    from.Unix(),
+	}
+
+	switch acc := acc.(type) {
+	case *types.PeriodicVestingAccount:
+		schedule.Unlocks = acc.GetRemainingUnlocks(from)
+		schedule.Remaining = acc.GetVestingCoins(from)
+
+	case *types.DelayedVestingAccount:
+		if from.Unix() < acc.EndTime {
+			schedule.Unlocks = []types.VestingUnlock{{UnlockTime: acc.EndTime, Amount: acc.GetVestingCoins(from)}}
+		}
+		schedule.Remaining = acc.GetVestingCoins(from)
+
+	case *types.ContinuousVestingAccount:
+		if from.Unix() < acc.EndTime {
+			duration := acc.EndTime - acc.StartTime
+			for _, coin := range acc.OriginalVesting {
+				rate := sdkmath.LegacyNewDecFromInt(coin.Amount).MulInt64(secondsPerDay).QuoInt64(duration)
+				schedule.DailyRate = schedule.DailyRate.Add(sdk.NewDecCoinFromDec(coin.Denom, rate))
+			}
+		}
+		schedule.Remaining = acc.GetVestingCoins(from)
+
+	case exported.VestingAccount:
+		// the unlocks of other vesting accounts, such as the ones defined by
+		// applications, are unknown
+		schedule.Remaining = acc.GetVestingCoins(from)
+
+	default:
+		return VestingSchedule{}, fmt.Errorf("account %s is not a vesting account", acc.GetAddress())
+	}
+
+	if limit > 0 && len(schedule.Unlocks) > limit {
+		schedule.Unlocks = schedule.Unlocks[:limit]
+	}
+
+	return schedule, nil
+}
+
+// GetVestingScheduleCmd returns a CLI command querying the upcoming vesting
+// schedule of a vesting account. The schedule is computed from the account
+// alone.
+func GetVestingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vesting-schedule [address]",
+		Aliases: []string{"schedule"},
+		Short:   "Query the upcoming unlocks of a vesting account",
+		Long: `Query the upcoming unlocks of a vesting account from the time given by '--from',
+as a UNIX epoch timestamp, or from now if it is not set. Up to '--limit' unlocks are
+printed for periodic and delayed vesting accounts, and the amount vesting per day
+for continuous vesting accounts, along with the coins still vesting.`,
+		Example: fmt.Sprintf("%s query vesting vesting-schedule cosmos1... --from 1735689600 --limit 4", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := time.Now()
+			if fromStr, _ := cmd.Flags().GetString(FlagFrom); fromStr != "" {
+				fromUnix, err := strconv.ParseInt(fromStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid from time %s: %w", fromStr, err)
+				}
+				from = time.Unix(fromUnix, 0)
+			}
+
+			limit, err := cmd.Flags().GetInt(FlagLimit)
+			if err != nil {
+				return err
+			}
+			if limit < 0 {
+				return fmt.Errorf("limit cannot be negative: %d", limit)
+			}
+
+			res, err := authtypes.NewQueryClient(clientCtx).Account(cmd.Context(), &authtypes.QueryAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			var acc sdk.AccountI
+			if err := clientCtx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+				return err
+			}
+
+			schedule, err := NewVestingSchedule(acc, from, limit)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(schedule)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(FlagFrom, "", "UNIX epoch timestamp from which the schedule is computed, now if not set")
+	cmd.Flags().Int(FlagLimit, 10, "Maximum number of unlocks to print, all if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,153 @@
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/client/cli"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewVestingSchedule(t *testing.T) {
+	const day = 24 * 60 * 60
+	_, _, addr := testdata.KeyTestPubAddr()
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	periodic, err := types.NewPeriodicVestingAccount(baseAcc, stake(400), 1000, types.Periods{
+		{Length: day, Amount: stake(100)},
+		{Length: day, Amount: stake(100)},
+		{Length: day, Amount: stake(100)},
+		{Length: day, Amount: stake(100)},
+	})
+	require.NoError(t, err)
+	continuous, err := types.NewContinuousVestingAccount(baseAcc, stake(1000), 1000, 1000+4*day)
+	require.NoError(t, err)
+	delayed, err := types.NewDelayedVestingAccount(baseAcc, stake(100), 1000+day)
+	require.NoError(t, err)
+	permanent, err := types.NewPermanentLockedAccount(baseAcc, stake(100))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		acc         sdk.AccountI
+		from        int64
+		limit       int
+		expSchedule cli.VestingSchedule
+		expErr      string
+	}{
+		{
+			name:  "periodic account not yet started",
+			acc:   periodic,
+			from:  0,
+			limit: 0,
+			expSchedule: cli.VestingSchedule{
+				Unlocks: []types.VestingUnlock{
+					{UnlockTime: 1000 + day, Amount: stake(100)},
+					{UnlockTime: 1000 + 2*day, Amount: stake(100)},
+					{UnlockTime: 1000 + 3*day, Amount: stake(100)},
+					{UnlockTime: 1000 + 4*day, Amount: stake(100)},
+				},
+				Remaining: stake(400),
+			},
+		},
+		{
+			name:  "periodic account mid-schedule with a limit",
+			acc:   periodic,
+			from:  1000 + day + 1,
+			limit: 2,
+			expSchedule: cli.VestingSchedule{
+				Unlocks: []types.VestingUnlock{
+					{UnlockTime: 1000 + 2*day, Amount: stake(100)},
+					{UnlockTime: 1000 + 3*day, Amount: stake(100)},
+				},
+				Remaining: stake(300),
+			},
+		},
+		{
+			name:        "periodic account fully vested",
+			acc:         periodic,
+			from:        1000 + 4*day,
+			limit:       10,
+			expSchedule: cli.VestingSchedule{},
+		},
+		{
+			name:  "continuous account not yet started",
+			acc:   continuous,
+			from:  0,
+			limit: 10,
+			expSchedule: cli.VestingSchedule{
+				DailyRate: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDec(250))),
+				Remaining: stake(1000),
+			},
+		},
+		{
+			name:  "continuous account mid-schedule",
+			acc:   continuous,
+			from:  1000 + day,
+			limit: 10,
+			expSchedule: cli.VestingSchedule{
+				DailyRate: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDec(250))),
+				Remaining: stake(750),
+			},
+		},
+		{
+			name:        "continuous account fully vested",
+			acc:         continuous,
+			from:        1000 + 4*day,
+			limit:       10,
+			expSchedule: cli.VestingSchedule{},
+		},
+		{
+			name:  "delayed account",
+			acc:   delayed,
+			from:  1000,
+			limit: 10,
+			expSchedule: cli.VestingSchedule{
+				Unlocks:   []types.VestingUnlock{{UnlockTime: 1000 + day, Amount: stake(100)}},
+				Remaining: stake(100),
+			},
+		},
+		{
+			name:  "permanent locked account",
+			acc:   permanent,
+			from:  1000,
+			limit: 10,
+			expSchedule: cli.VestingSchedule{
+				Remaining: stake(100),
+			},
+		},
+		{
+			name:   "base account",
+			acc:    baseAcc,
+			expErr: "is not a vesting account",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := cli.NewVestingSchedule(tc.acc, time.Unix(tc.from, 0), tc.limit)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, addr.String(), schedule.Address)
+			require.Equal(t, tc.from, schedule.From)
+			require.Equal(t, tc.expSchedule.Unlocks, schedule.Unlocks)
+			require.True(t, tc.expSchedule.DailyRate.Equal(schedule.DailyRate), "expected daily rate %s, got %s", tc.expSchedule.DailyRate, schedule.DailyRate)
+			require.True(t, tc.expSchedule.Remaining.Equal(schedule.Remaining), "expected remaining %s, got %s", tc.expSchedule.Remaining, schedule.Remaining)
+		})
+	}
+}
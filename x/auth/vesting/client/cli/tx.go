@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// Transaction command flags
+const (
+	FlagDelayed                  = "delayed"
+	FlagStartTime                = "start-time"
+	FlagClawback                 = "clawback"
+	FlagProportionalUndelegation = "proportional-undelegation"
+)
+
+// GetTxCmd returns vesting module's transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgAddVestingGrantCmd(),
+	)
+
+	return txCmd
+}
+
+// NewMsgCreateVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateVestingAccount transaction.
+func NewMsgCreateVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [end_time] [amount]",
+		Short: "Create a new vesting account funded with an allocation of tokens.",
+		Long: `Create a new vesting account funded with an allocation of tokens. The
+account can either be a delayed or continuous vesting account, which is determined
+by the '--delayed' flag. With the '--clawback' flag, a continuous vesting account
+is created whose vesting coins can be clawed back by the sender. With the
+'--proportional-undelegation' flag, undelegations are split between the delegated
+free and delegated vesting coins in proportion to their amounts. All vesting accounts created will have their start time
+set by the '--start-time' flag, or by the committed block's time if it is not set.
+The end_time must be provided as a UNIX epoch timestamp, or as a duration after
+the start time such as '8760h', counted from the current time if the start time
+is not set. The account must not exist yet. Coins of the amount are space separated.`,
+		Example: fmt.Sprintf("%s tx vesting create-vesting-account cosmos1... 1735689600 1000stake --delayed --from mykey", version.AppName),
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := clientCtx.AddressCodec.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := parseEndTime(args[1], startTime)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(strings.Join(args[2:], ","))
+			if err != nil {
+				return err
+			}
+
+			delayed, err := cmd.Flags().GetBool(FlagDelayed)
+			if err != nil {
+				return err
+			}
+
+			clawback, err := cmd.Flags().GetBool(FlagClawback)
+			if err != nil {
+				return err
+			}
+
+			proportional, err := cmd.Flags().GetBool(FlagProportionalUndelegation)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, startTime, endTime, delayed)
+			msg.Clawback = clawback
+			msg.ProportionalUndelegation = proportional
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+	cmd.Flags().Bool(FlagClawback, false, "Create a clawback vesting account, whose vesting coins can be clawed back by the sender, if true")
+	cmd.Flags().Int64(FlagStartTime, 0, "Optional start time (as a UNIX epoch timestamp) for continuous vesting accounts")
+	cmd.Flags().Bool(FlagProportionalUndelegation, false, "Split undelegations proportionally between the delegated free and delegated vesting coins if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: `Create a new periodic vesting account funded with an allocation of tokens.
+The periods of the vesting schedule are read from a JSON file, in the format of
+the add-vesting-grant command, and the account is funded with their total
+amount. The schedule starts at the time set by the '--start-time' flag, or by
+the committed block's time if it is not set. With the '--proportional-undelegation'
+flag, undelegations are split between the delegated free and delegated vesting
+coins in proportion to their amounts. The account must not exist yet.`,
+		Example: fmt.Sprintf("%s tx vesting create-periodic-vesting-account cosmos1... periods.json --start-time 1735689600 --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := clientCtx.AddressCodec.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			periods, err := ReadVestingPeriodsFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			proportional, err := cmd.Flags().GetBool(FlagProportionalUndelegation)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			msg.ProportionalUndelegation = proportional
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "Optional start time (as a UNIX epoch timestamp) of the vesting schedule")
+	cmd.Flags().Bool(FlagProportionalUndelegation, false, "Split undelegations proportionally between the delegated free and delegated vesting coins if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgAddVestingGrantCmd returns a CLI command handler for creating a
+// MsgAddVestingGrant transaction.
+func NewMsgAddVestingGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-vesting-grant [to_address] [periods_json_file]",
+		Short: "Add a grant of tokens to an existing periodic vesting account.",
+		Long: `Add a grant of tokens, funded by the sender, to an existing periodic vesting
+account. The periods of the grant are read from a JSON file and merged into the
+vesting schedule of the account. The grant starts at the time set by the
+'--start-time' flag, or by the committed block's time if it is not set.
+
+The periods file contains an array of periods, each with a length in seconds
+or as a duration string, and the comma separated coins released at its end:
+
+[
+  {"length": 2592000, "coins": "1000stake"},
+  {"length": "720h", "coins": "1000stake"}
+]`,
+		Example: fmt.Sprintf("%s tx vesting add-vesting-grant cosmos1... periods.json --start-time 1735689600 --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := clientCtx.AddressCodec.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			periods, err := ReadVestingPeriodsFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddVestingGrant(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "Optional start time (as a UNIX epoch timestamp) of the grant")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,310 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/client/cli"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type CLITestSuite struct {
+	suite.Suite
+
+	kr      keyring.Keyring
+	encCfg  testutilmod.TestEncodingConfig
+	baseCtx client.Context
+}
+
+func TestCLITestSuite(t *testing.T) {
+	suite.Run(t, new(CLITestSuite))
+}
+
+func (s *CLITestSuite) SetupSuite() {
+	s.encCfg = testutilmod.MakeTestEncodingConfig(codectestutil.CodecOptions{}, vesting.AppModule{})
+	s.kr = keyring.NewInMemory(s.encCfg.Codec)
+	s.baseCtx = client.Context{}.
+		WithKeyring(s.kr).
+		WithTxConfig(s.encCfg.TxConfig).
+		WithCodec(s.encCfg.Codec).
+		WithClient(clitestutil.MockCometRPC{Client: rpcclientmock.Client{}}).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutput(io.Discard).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+}
+
+func (s *CLITestSuite) TestNewMsgCreateVestingAccountCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	from, to := accounts[0].Address, accounts[1].Address
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectMsg    *types.MsgCreateVestingAccount
+	}{
+		{
+			"continuous vesting account",
+			[]string{to.String(), "4000", "100stake", "20photon", "--start-time=1000"},
+			"",
+			types.NewMsgCreateVestingAccount(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("photon", 20)), 1000, 4000, false),
+		},
+		{
+			"end time as a duration after the start time",
+			[]string{to.String(), "1h30m", "100stake", "--start-time=1000"},
+			"",
+			types.NewMsgCreateVestingAccount(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1000, 6400, false),
+		},
+		{
+			"delayed vesting account",
+			[]string{to.String(), "4000", "100stake", "--delayed"},
+			"",
+			types.NewMsgCreateVestingAccount(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 0, 4000, true),
+		},
+		{
+			"clawback vesting account",
+			[]string{to.String(), "4000", "100stake", "--clawback"},
+			"",
+			&types.MsgCreateVestingAccount{FromAddress: from.String(), ToAddress: to.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), EndTime: 4000, Clawback: true},
+		},
+		{
+			"delayed clawback vesting account",
+			[]string{to.String(), "4000", "100stake", "--clawback", "--delayed"},
+			"a clawback vesting account cannot be delayed",
+			nil,
+		},
+		{
+			"invalid to address",
+			[]string{"foo", "4000", "100stake"},
+			"decoding bech32 failed",
+			nil,
+		},
+		{
+			"invalid end time",
+			[]string{to.String(), "tomorrow", "100stake"},
+			"invalid end time",
+			nil,
+		},
+		{
+			"end time before start time",
+			[]string{to.String(), "4000", "100stake", "--start-time=5000"},
+			"end time must be after start time",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+			args := append(tc.args, extraArgs...)
+
+			cmd := cli.NewMsgCreateVestingAccountCmd()
+			cmd.SetOutput(io.Discard)
+			cmd.SetContext(ctx)
+			cmd.SetArgs(args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cmd, args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := s.encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err, out.String())
+			s.Require().Len(tx.GetMsgs(), 1)
+			s.Require().Equal(tc.expectMsg, tx.GetMsgs()[0])
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewMsgAddVestingGrantCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	from, to := accounts[0].Address, accounts[1].Address
+
+	periodsFile := testutil.WriteToNewTempFile(s.T(), `[{"length": 100, "coins": "10stake"}, {"length": "1h30m", "coins": "20stake,5photon"}]`).Name()
+	negativePeriodsFile := testutil.WriteToNewTempFile(s.T(), `[{"length": "-1h", "coins": "10stake"}]`).Name()
+	emptyPeriodsFile := testutil.WriteToNewTempFile(s.T(), `[]`).Name()
+	invalidPeriodsFile := testutil.WriteToNewTempFile(s.T(), `[{"length": 0, "coins": "10stake"}]`).Name()
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectMsg    *types.MsgAddVestingGrant
+	}{
+		{
+			"valid grant",
+			[]string{to.String(), periodsFile, "--start-time=1000"},
+			"",
+			types.NewMsgAddVestingGrant(from, to, 1000, []types.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+				{Length: 5400, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("photon", 5))},
+			}),
+		},
+		{
+			"invalid to address",
+			[]string{"foo", periodsFile},
+			"decoding bech32 failed",
+			nil,
+		},
+		{
+			"missing periods file",
+			[]string{to.String(), "does-not-exist.json"},
+			"failed to read vesting periods file",
+			nil,
+		},
+		{
+			"empty periods",
+			[]string{to.String(), emptyPeriodsFile},
+			"vesting periods file contains no period",
+			nil,
+		},
+		{
+			"non-positive period length",
+			[]string{to.String(), invalidPeriodsFile},
+			"non-positive length",
+			nil,
+		},
+		{
+			"negative period duration",
+			[]string{to.String(), negativePeriodsFile},
+			"cannot be negative",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+			args := append(tc.args, extraArgs...)
+
+			cmd := cli.NewMsgAddVestingGrantCmd()
+			cmd.SetOutput(io.Discard)
+			cmd.SetContext(ctx)
+			cmd.SetArgs(args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cmd, args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := s.encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err, out.String())
+			s.Require().Len(tx.GetMsgs(), 1)
+			s.Require().Equal(tc.expectMsg, tx.GetMsgs()[0])
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	from, to := accounts[0].Address, accounts[1].Address
+
+	periodsFile := testutil.WriteToNewTempFile(s.T(), `[{"length": 100, "coins": "10stake"}, {"length": "1h", "coins": "20stake"}]`).Name()
+	invalidPeriodsFile := testutil.WriteToNewTempFile(s.T(), `[{"length": 0, "coins": "10stake"}]`).Name()
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectMsg    *types.MsgCreatePeriodicVestingAccount
+	}{
+		{
+			"valid account",
+			[]string{to.String(), periodsFile, "--start-time=1000"},
+			"",
+			types.NewMsgCreatePeriodicVestingAccount(from, to, 1000, []types.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+				{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+			}),
+		},
+		{
+			"invalid to address",
+			[]string{"foo", periodsFile},
+			"decoding bech32 failed",
+			nil,
+		},
+		{
+			"non-positive period length",
+			[]string{to.String(), invalidPeriodsFile},
+			"non-positive length",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+			args := append(tc.args, extraArgs...)
+
+			cmd := cli.NewMsgCreatePeriodicVestingAccountCmd()
+			cmd.SetOutput(io.Discard)
+			cmd.SetContext(ctx)
+			cmd.SetArgs(args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cmd, args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := s.encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err, out.String())
+			s.Require().Len(tx.GetMsgs(), 1)
+			s.Require().Equal(tc.expectMsg, tx.GetMsgs()[0])
+		})
+	}
+}
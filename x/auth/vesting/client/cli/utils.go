@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingPeriodJSON is a vesting period as read from a vesting periods file,
+// where the coins are a comma separated list of coins. The length is given in
+// seconds, or as a duration string such as "720h".
+type VestingPeriodJSON struct {
+	Length int64  `json:"length"`
+	Coins  string `json:"coins"`
+}
+
+// UnmarshalJSON unmarshals a vesting period whose length is either a number of
+// seconds or a duration string, normalized to seconds.
+func (p *VestingPeriodJSON) UnmarshalJSON(bz []byte) error {
+	var raw struct {
+		Length json.RawMessage `json:"length"`
+		Coins  string          `json:"coins"`
+	}
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+
+	// numbers are checked along with the other fields of the periods file
+	var length int64
+	if len(raw.Length) > 0 && raw.Length[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw.Length, &s); err != nil {
+			return err
+		}
+		var err error
+		if length, err = types.ParsePeriodLength(s); err != nil {
+			return err
+		}
+	} else if len(raw.Length) > 0 {
+		if err := json.Unmarshal(raw.Length, &length); err != nil {
+			return err
+		}
+	}
+
+	*p = VestingPeriodJSON{Length: length, Coins: raw.Coins}
+	return nil
+}
+
+// ReadVestingPeriodsFile reads the vesting periods of a periodic vesting account
+// from a JSON file containing an array of VestingPeriodJSON. Periods must have a
+// positive length and valid coins, without duplicate denominations.
+func ReadVestingPeriodsFile(path string) (types.Periods, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vesting periods file: %w", err)
+	}
+
+	var input []VestingPeriodJSON
+	if err := json.Unmarshal(bz, &input); err != nil {
+		return nil, fmt.Errorf("failed to parse vesting periods file: %w", err)
+	}
+	if len(input) == 0 {
+		return nil, errors.New("vesting periods file contains no period")
+	}
+
+	periods := make(types.Periods, 0, len(input))
+	for i, p := range input {
+		if p.Length <= 0 {
+			return nil, fmt.Errorf("vesting period #%d has a non-positive length: %d", i, p.Length)
+		}
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("vesting period #%d has invalid coins %q: %w", i, p.Coins, err)
+		}
+		if !amount.IsAllPositive() {
+			return nil, fmt.Errorf("vesting period #%d has invalid coins: %q", i, p.Coins)
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return periods, nil
+}
+
+// parseEndTime parses the end time of a vesting account, given either as a UNIX
+// epoch timestamp or as a duration after the start time, such as "8760h". The
+// duration is counted from the current time when no start time is given.
+func parseEndTime(s string, startTime int64) (int64, error) {
+	if endTime, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return endTime, nil
+	}
+
+	length, err := types.ParsePeriodLength(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid end time %s: %w", s, err)
+	}
+
+	if startTime == 0 {
+		startTime = time.Now().Unix()
+	}
+
+	return startTime + length, nil
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/x/auth/keeper"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
 	"cosmossdk.io/x/auth/vesting/types"
 )
 
@@ -23,18 +24,23 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Environment   appmodule.Environment
 	AccountKeeper keeper.AccountKeeper
 	BankKeeper    types.BankKeeper
+	PoolKeeper    types.PoolKeeper    `optional:"true"`
+	StakingKeeper types.StakingKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	Module appmodule.AppModule
+	VestingKeeper vestingkeeper.Keeper
+	Module        appmodule.AppModule
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	m := NewAppModule(in.AccountKeeper, in.BankKeeper)
+	k := vestingkeeper.NewKeeper(in.Environment, in.AccountKeeper)
+	m := NewAppModule(k, in.AccountKeeper, in.BankKeeper, in.PoolKeeper, in.StakingKeeper)
 
-	return ModuleOutputs{Module: m}
+	return ModuleOutputs{VestingKeeper: k, Module: m}
 }
@@ -0,0 +1,97 @@
+package vesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// TestPeriodicVestingAccountGenesisRoundTrip checks that the schedule of a
+// periodic vesting account is preserved through export and import.
+func TestPeriodicVestingAccountGenesisRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{}).Codec
+
+	// 36 monthly periods of varying amounts and lengths
+	periods := make(vestingtypes.Periods, 36)
+	for i := range periods {
+		periods[i] = vestingtypes.Period{
+			Length: int64(28+i%4) * 24 * 60 * 60,
+			Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", int64(100+i)), sdk.NewInt64Coin("stake", int64(36-i))),
+		}
+	}
+	baseAcc := authtypes.NewBaseAccountWithAddress(to1Addr)
+	require.NoError(t, baseAcc.SetAccountNumber(1))
+	pva, err := vestingtypes.NewPeriodicVestingAccount(baseAcc, periods.TotalAmount(), 1000, periods)
+	require.NoError(t, err)
+
+	genState := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{
+		pva, authtypes.NewBaseAccount(to2Addr, nil, 0, 0),
+	})
+	require.NoError(t, authtypes.ValidateGenesis(*genState))
+
+	exportImport := func(genState *authtypes.GenesisState) []byte {
+		ctx, ak := newGenesisAccountKeeper(t, cdc)
+		require.NoError(t, ak.InitGenesis(ctx, *genState))
+		exported, err := ak.ExportGenesis(ctx)
+		require.NoError(t, err)
+
+		bz, err := cdc.MarshalJSON(exported)
+		require.NoError(t, err)
+		return bz
+	}
+
+	exported := exportImport(genState)
+
+	var imported authtypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(exported, &imported))
+	require.NoError(t, authtypes.ValidateGenesis(imported))
+	accs, err := authtypes.UnpackAccounts(imported.Accounts)
+	require.NoError(t, err)
+
+	var importedPva *vestingtypes.PeriodicVestingAccount
+	for _, acc := range accs {
+		if acc.GetAddress().Equals(to1Addr) {
+			importedPva = acc.(*vestingtypes.PeriodicVestingAccount)
+		}
+	}
+	require.NotNil(t, importedPva)
+	require.Equal(t, periods, vestingtypes.Periods(importedPva.VestingPeriods))
+	require.Equal(t, pva.StartTime, importedPva.StartTime)
+	require.Equal(t, pva.EndTime, importedPva.EndTime)
+
+	require.Equal(t, string(exported), string(exportImport(&imported)))
+}
+
+func newGenesisAccountKeeper(t *testing.T, cdc codec.Codec) (sdk.Context, authkeeper.AccountKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	ak := authkeeper.NewAccountKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger()),
+		cdc,
+		authtypes.ProtoBaseAccount,
+		map[string][]string{authtypes.FeeCollectorName: nil},
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	return ctx, ak
+}
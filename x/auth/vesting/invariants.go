@@ -0,0 +1,85 @@
+package vesting
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/auth/keeper"
+	vestexported "cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the vesting module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, ak keeper.AccountKeeper, sk types.StakingKeeper) {
+	ir.RegisterRoute(types.ModuleName, "delegated-vesting", DelegatedVestingInvariant(ak, sk))
+}
+
+// DelegatedVestingInvariant checks that the delegated vesting coins of every
+// vesting account do not exceed its original vesting coins, and that the
+// delegations it tracks cover its bonded and unbonding tokens. The tracked
+// delegations can exceed the tokens of the account, since slashing is not
+// tracked. The delegations are only checked if a staking keeper is provided.
+func DelegatedVestingInvariant(ak keeper.AccountKeeper, sk types.StakingKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		bondDenom := ""
+		if sk != nil {
+			var err error
+			if bondDenom, err = sk.BondDenom(ctx); err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "delegated-vesting",
+					fmt.Sprintf("error getting the bond denom %v", err)), false
+			}
+		}
+
+		err := ak.Accounts.Walk(ctx, nil, func(addr sdk.AccAddress, acc sdk.AccountI) (bool, error) {
+			vacc, ok := acc.(vestexported.VestingAccount)
+			if !ok {
+				return false, nil
+			}
+
+			originalVesting, delegatedFree, delegatedVesting := vacc.GetOriginalVesting(), vacc.GetDelegatedFree(), vacc.GetDelegatedVesting()
+			if !delegatedVesting.IsAllLTE(originalVesting) {
+				count++
+				msg += fmt.Sprintf("\t%s has delegated vesting %s greater than its original vesting %s\n", addr, delegatedVesting, originalVesting)
+			}
+
+			if sk == nil {
+				return false, nil
+			}
+
+			bonded, err := sk.GetDelegatorBonded(ctx, addr)
+			if err != nil {
+				return true, err
+			}
+			unbonding, err := sk.GetDelegatorUnbonding(ctx, addr)
+			if err != nil {
+				return true, err
+			}
+
+			tracked := delegatedFree.AmountOf(bondDenom).Add(delegatedVesting.AmountOf(bondDenom))
+			if tracked.LT(bonded.Add(unbonding)) {
+				count++
+				msg += fmt.Sprintf("\t%s has delegated free %s and delegated vesting %s smaller than its bonded %s and unbonding %s tokens\n",
+					addr, delegatedFree, delegatedVesting, sdk.NewCoin(bondDenom, bonded), sdk.NewCoin(bondDenom, unbonding))
+			}
+
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "delegated-vesting",
+				fmt.Sprintf("error iterating the accounts %v", err)), false
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "delegated-vesting",
+			fmt.Sprintf("amount of vesting accounts with inconsistent delegations found %d\n%s", count, msg),
+		), broken
+	}
+}
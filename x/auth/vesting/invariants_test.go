@@ -0,0 +1,60 @@
+package vesting_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtestutil "cosmossdk.io/x/auth/vesting/testutil"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *VestingTestSuite) TestDelegatedVestingInvariant() {
+	ctrl := gomock.NewController(s.T())
+	stakingKeeper := vestingtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo", nil).AnyTimes()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+	acc, err := vestingtypes.NewContinuousVestingAccount(s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount), coins, 1000, 2000)
+	s.Require().NoError(err)
+	s.Require().NoError(acc.TrackDelegation(time.Unix(1500, 0), coins, sdk.NewCoins(sdk.NewInt64Coin("foo", 80))))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// accounts which are not vesting accounts are ignored
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+
+	expectDelegations := func(bonded, unbonding int64) {
+		stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), addr).Return(math.NewInt(bonded), nil)
+		stakingKeeper.EXPECT().GetDelegatorUnbonding(gomock.Any(), addr).Return(math.NewInt(unbonding), nil)
+	}
+
+	// the delegations are tracked
+	expectDelegations(60, 20)
+	msg, broken := vesting.DelegatedVestingInvariant(s.accountKeeper, stakingKeeper)(s.ctx)
+	s.Require().False(broken, msg)
+
+	// slashed delegations are covered by the tracked delegations
+	expectDelegations(50, 10)
+	msg, broken = vesting.DelegatedVestingInvariant(s.accountKeeper, stakingKeeper)(s.ctx)
+	s.Require().False(broken, msg)
+
+	// the delegations exceed the tracked delegations
+	expectDelegations(60, 30)
+	msg, broken = vesting.DelegatedVestingInvariant(s.accountKeeper, stakingKeeper)(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, addr.String())
+	s.Require().Contains(msg, "delegated free 30foo and delegated vesting 50foo smaller than its bonded 60foo and unbonding 30foo tokens")
+
+	// the delegated vesting coins exceed the original vesting coins
+	acc.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin("foo", 150))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+	msg, broken = vesting.DelegatedVestingInvariant(s.accountKeeper, nil)(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, addr.String()+" has delegated vesting 150foo greater than its original vesting 100foo")
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the pending unlocks and clawbacks from the genesis
+// state, then tracks the unlocks of the periodic vesting accounts missing from
+// it.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, pendingUnlock := range data.PendingUnlocks {
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(pendingUnlock.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setPendingUnlock(ctx, addr, pendingUnlock.UnlockTime); err != nil {
+			return err
+		}
+	}
+
+	for _, pendingClawback := range data.PendingClawbacks {
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(pendingClawback.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.PendingClawbacks.Set(ctx, addr, pendingClawback.ToCommunityPool); err != nil {
+			return err
+		}
+	}
+
+	return k.TrackAllUnlocks(ctx)
+}
+
+// ExportGenesis exports the pending unlocks of the periodic vesting accounts,
+// and the pending clawbacks of the clawback vesting accounts.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var pendingUnlocks []types.PendingUnlock
+	err := k.PendingUnlocks.Walk(ctx, nil, func(addr sdk.AccAddress, unlockTime int64) (bool, error) {
+		addrStr, err := k.accountKeeper.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+
+		pendingUnlocks = append(pendingUnlocks, types.PendingUnlock{Address: addrStr, UnlockTime: unlockTime})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingClawbacks []types.PendingClawback
+	err = k.PendingClawbacks.Walk(ctx, nil, func(addr sdk.AccAddress, toCommunityPool bool) (bool, error) {
+		addrStr, err := k.accountKeeper.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+
+		pendingClawbacks = append(pendingClawbacks, types.PendingClawback{Address: addrStr, ToCommunityPool: toCommunityPool})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(pendingUnlocks, pendingClawbacks), nil
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper tracks the periods of the periodic vesting accounts, in order to emit
+// an event in the first block after each of them unlocks, and the clawback
+// vesting accounts whose pending clawback is returned once undelegated.
+type Keeper struct {
+	environment   appmodule.Environment
+	accountKeeper authkeeper.AccountKeeper
+
+	Schema collections.Schema
+	// UnlockQueue key: unlock time+address | value: none
+	UnlockQueue collections.KeySet[collections.Pair[int64, sdk.AccAddress]]
+	// PendingUnlocks key: address | value: unlock time of the next pending unlock
+	PendingUnlocks collections.Map[sdk.AccAddress, int64]
+	// PendingClawbacks key: address | value: whether the pending clawback is
+	// returned to the community pool
+	PendingClawbacks collections.Map[sdk.AccAddress, bool]
+}
+
+// NewKeeper creates a vesting Keeper
+func NewKeeper(env appmodule.Environment, ak authkeeper.AccountKeeper) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+
+	k := Keeper{
+		environment:   env,
+		accountKeeper: ak,
+		UnlockQueue: collections.NewKeySet(
+			sb,
+			types.UnlockQueuePrefix,
+			"unlock_queue",
+			collections.PairKeyCodec(collections.Int64Key, sdk.AccAddressKey),
+		),
+		PendingUnlocks: collections.NewMap(
+			sb,
+			types.PendingUnlocksPrefix,
+			"pending_unlocks",
+			sdk.AccAddressKey,
+			collections.Int64Value,
+		),
+		PendingClawbacks: collections.NewMap(
+			sb,
+			types.PendingClawbacksPrefix,
+			"pending_clawbacks",
+			sdk.AccAddressKey,
+			collections.BoolValue,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// TrackUnlocks queues the first period of the periodic vesting account
+// unlocking after the current block time, unless an earlier unlock of the
+// account is already pending.
+func (k Keeper) TrackUnlocks(ctx context.Context, pva *types.PeriodicVestingAccount) error {
+	unlocks := pva.GetRemainingUnlocks(k.environment.HeaderService.GetHeaderInfo(ctx).Time)
+	if len(unlocks) == 0 {
+		return nil
+	}
+
+	addr := pva.GetAddress()
+	next := unlocks[0].UnlockTime
+	pending, err := k.PendingUnlocks.Get(ctx, addr)
+	switch {
+	case err == nil:
+		if pending <= next {
+			return nil
+		}
+		if err := k.UnlockQueue.Remove(ctx, collections.Join(pending, addr)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	return k.setPendingUnlock(ctx, addr, next)
+}
+
+// TrackAllUnlocks tracks the unlocks of all the periodic vesting accounts.
+func (k Keeper) TrackAllUnlocks(ctx context.Context) error {
+	return k.accountKeeper.Accounts.Walk(ctx, nil, func(_ sdk.AccAddress, acc sdk.AccountI) (bool, error) {
+		pva, ok := acc.(*types.PeriodicVestingAccount)
+		if !ok {
+			return false, nil
+		}
+		return false, k.TrackUnlocks(ctx, pva)
+	})
+}
+
+// EmitUnlocks emits a vesting_unlock event for each period unlocked since the
+// pending unlock of the queued periodic vesting accounts, up to the current
+// block time, and queues their next unlock. At most limit accounts are
+// processed, the remaining ones being processed in the next blocks.
+func (k Keeper) EmitUnlocks(ctx context.Context, limit int) error {
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time.Unix()
+
+	var due []collections.Pair[int64, sdk.AccAddress]
+	rng := collections.NewPrefixUntilPairRange[int64, sdk.AccAddress](blockTime)
+	err := k.UnlockQueue.Walk(ctx, rng, func(key collections.Pair[int64, sdk.AccAddress]) (bool, error) {
+		due = append(due, key)
+		return len(due) == limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		pendingTime, addr := key.K1(), key.K2()
+		if err := k.UnlockQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.PendingUnlocks.Remove(ctx, addr); err != nil {
+			return err
+		}
+
+		// the account may no longer be a periodic vesting account
+		pva, ok := k.accountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+		if !ok {
+			continue
+		}
+
+		end := pva.StartTime
+		for i, period := range pva.VestingPeriods {
+			end += period.Length
+			if end < pendingTime {
+				continue
+			}
+
+			if end > blockTime {
+				if err := k.setPendingUnlock(ctx, addr, end); err != nil {
+					return err
+				}
+				break
+			}
+
+			if err := k.environment.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeVestingUnlock,
+				event.NewAttribute(types.AttributeKeyAccount, pva.Address),
+				event.NewAttribute(types.AttributeKeyPeriodIndex, strconv.Itoa(i)),
+				event.NewAttribute(types.AttributeKeyAmount, period.Amount.String()),
+				event.NewAttribute(types.AttributeKeyUnlockTime, strconv.FormatInt(end, 10)),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setPendingUnlock(ctx context.Context, addr sdk.AccAddress, unlockTime int64) error {
+	if err := k.PendingUnlocks.Set(ctx, addr, unlockTime); err != nil {
+		return err
+	}
+	return k.UnlockQueue.Set(ctx, collections.Join(unlockTime, addr))
+}
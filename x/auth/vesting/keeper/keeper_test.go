@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/keeper"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1________________"))
+	addr2 = sdk.AccAddress([]byte("addr2________________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	vestingKeeper keeper.Keeper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, types.StoreKey)
+	s.ctx = testutil.DefaultContextWithKeys(keys, nil, nil).WithHeaderInfo(header.Info{Time: time.Unix(1000, 0)})
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+
+	s.accountKeeper = authkeeper.NewAccountKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		nil,
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	s.vestingKeeper = keeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[types.StoreKey]), log.NewNopLogger()), s.accountKeeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// setPeriodicAccount stores a periodic vesting account starting at 1000, whose
+// periods unlock at 1100, 1200, 1200 and 1400.
+func (s *KeeperTestSuite) setPeriodicAccount(addr sdk.AccAddress) *types.PeriodicVestingAccount {
+	baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount)
+	pva, err := types.NewPeriodicVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), 1000, []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 10))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 20))},
+		{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 30))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 40))},
+	})
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, pva)
+	return pva
+}
+
+// emitUnlocks runs EmitUnlocks at the given block time, returning the period
+// indexes of the emitted vesting_unlock events of each account.
+func (s *KeeperTestSuite) emitUnlocks(blockTime int64, limit int) map[string][]string {
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(blockTime, 0)}).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.vestingKeeper.EmitUnlocks(s.ctx, limit))
+
+	unlocks := make(map[string][]string)
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeVestingUnlock {
+			continue
+		}
+		account, ok := event.GetAttribute(types.AttributeKeyAccount)
+		s.Require().True(ok)
+		index, ok := event.GetAttribute(types.AttributeKeyPeriodIndex)
+		s.Require().True(ok)
+		unlocks[account.Value] = append(unlocks[account.Value], index.Value)
+	}
+	return unlocks
+}
+
+func (s *KeeperTestSuite) TestEmitUnlocks() {
+	pva := s.setPeriodicAccount(addr1)
+	s.Require().NoError(s.vestingKeeper.TrackUnlocks(s.ctx, pva))
+
+	unlockTime, err := s.vestingKeeper.PendingUnlocks.Get(s.ctx, addr1)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1100), unlockTime)
+
+	s.Require().Empty(s.emitUnlocks(1050, 200))
+	s.Require().Equal(map[string][]string{addr1.String(): {"0"}}, s.emitUnlocks(1100, 200))
+	s.Require().Empty(s.emitUnlocks(1150, 200))
+	// the periods unlocked since the previous block are all emitted once
+	s.Require().Equal(map[string][]string{addr1.String(): {"1", "2"}}, s.emitUnlocks(1300, 200))
+	s.Require().Empty(s.emitUnlocks(1300, 200))
+	s.Require().Equal(map[string][]string{addr1.String(): {"3"}}, s.emitUnlocks(5000, 200))
+
+	// the account is fully unlocked
+	has, err := s.vestingKeeper.PendingUnlocks.Has(s.ctx, addr1)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	s.Require().Empty(s.emitUnlocks(6000, 200))
+}
+
+func (s *KeeperTestSuite) TestEmitUnlocksLimit() {
+	s.Require().NoError(s.vestingKeeper.TrackUnlocks(s.ctx, s.setPeriodicAccount(addr1)))
+	s.Require().NoError(s.vestingKeeper.TrackUnlocks(s.ctx, s.setPeriodicAccount(addr2)))
+
+	// the accounts beyond the limit are processed in the next block
+	s.Require().Len(s.emitUnlocks(1100, 1), 1)
+	s.Require().Len(s.emitUnlocks(1100, 1), 1)
+	s.Require().Empty(s.emitUnlocks(1100, 1))
+}
+
+func (s *KeeperTestSuite) TestTrackUnlocks() {
+	pva := s.setPeriodicAccount(addr1)
+
+	// the unlocks already passed are not tracked
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1200, 0)})
+	s.Require().NoError(s.vestingKeeper.TrackUnlocks(s.ctx, pva))
+	unlockTime, err := s.vestingKeeper.PendingUnlocks.Get(s.ctx, addr1)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1400), unlockTime)
+
+	// an earlier unlock replaces the pending one
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1000, 0)})
+	s.Require().NoError(s.vestingKeeper.TrackUnlocks(s.ctx, pva))
+	unlockTime, err = s.vestingKeeper.PendingUnlocks.Get(s.ctx, addr1)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1100), unlockTime)
+
+	has, err := s.vestingKeeper.UnlockQueue.Has(s.ctx, collections.Join(int64(1400), addr1))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// an account which is no longer a periodic vesting account is dropped
+	s.accountKeeper.SetAccount(s.ctx, pva.BaseAccount)
+	s.Require().Empty(s.emitUnlocks(1500, 200))
+	has, err = s.vestingKeeper.PendingUnlocks.Has(s.ctx, addr1)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	pva := s.setPeriodicAccount(addr1)
+	s.Require().NoError(s.vestingKeeper.TrackUnlocks(s.ctx, pva))
+	s.Require().Equal(map[string][]string{addr1.String(): {"0"}}, s.emitUnlocks(1100, 200))
+	s.Require().NoError(s.vestingKeeper.PendingClawbacks.Set(s.ctx, addr2, true))
+
+	genesis, err := s.vestingKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PendingUnlock{{Address: addr1.String(), UnlockTime: 1200}}, genesis.PendingUnlocks)
+	s.Require().Equal([]types.PendingClawback{{Address: addr2.String(), ToCommunityPool: true}}, genesis.PendingClawbacks)
+
+	// a periodic vesting account missing from the genesis state is tracked
+	s.SetupTest()
+	s.setPeriodicAccount(addr1)
+	s.setPeriodicAccount(addr2)
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1150, 0)})
+	s.Require().NoError(s.vestingKeeper.InitGenesis(s.ctx, genesis))
+
+	genesis, err = s.vestingKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]types.PendingUnlock{
+		{Address: addr1.String(), UnlockTime: 1200},
+		{Address: addr2.String(), UnlockTime: 1200},
+	}, genesis.PendingUnlocks)
+	s.Require().Equal([]types.PendingClawback{{Address: addr2.String(), ToCommunityPool: true}}, genesis.PendingClawbacks)
+
+	// the periods unlocked before the exported pending unlock are not emitted again
+	s.Require().Equal(map[string][]string{addr1.String(): {"1", "2"}, addr2.String(): {"1", "2"}}, s.emitUnlocks(1200, 200))
+}
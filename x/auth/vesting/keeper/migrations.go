@@ -0,0 +1,19 @@
+package keeper
+
+import "context"
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, tracking the unlocks of the
+// existing periodic vesting accounts.
+func (m Migrator) Migrate1to2(ctx context.Context) error {
+	return m.keeper.TrackAllUnlocks(ctx)
+}
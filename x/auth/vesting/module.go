@@ -1,12 +1,25 @@
 package vesting
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
+	"cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/client/cli"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
 	"cosmossdk.io/x/auth/vesting/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -14,19 +27,32 @@ var (
 	_ module.AppModule = AppModule{}
 	_ module.HasName   = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ module.HasGRPCGateway = AppModule{}
+	_ module.HasInvariants  = AppModule{}
+	_ module.HasGenesis     = AppModule{}
+
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasServices     = AppModule{}
+	_ appmodule.HasMigrations   = AppModule{}
 )
 
 // AppModule implementing the AppModule interface.
 type AppModule struct {
+	keeper        vestingkeeper.Keeper
 	accountKeeper keeper.AccountKeeper
 	bankKeeper    types.BankKeeper
+	poolKeeper    types.PoolKeeper
+	stakingKeeper types.StakingKeeper
 }
 
-func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper) AppModule {
+func NewAppModule(k vestingkeeper.Keeper, ak keeper.AccountKeeper, bk types.BankKeeper, pk types.PoolKeeper, sk types.StakingKeeper) AppModule {
 	return AppModule{
+		keeper:        k,
 		accountKeeper: ak,
 		bankKeeper:    bk,
+		poolKeeper:    pk,
+		stakingKeeper: sk,
 	}
 }
 
@@ -49,5 +75,93 @@ func (AppModule) RegisterInterfaces(registry registry.LegacyRegistry) {
 	types.RegisterInterfaces(registry)
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the vesting module.
+func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterInvariants registers the vesting module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.accountKeeper, am.stakingKeeper)
+}
+
+// GetQueryCmd returns the root query command for the vesting module.
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// GetTxCmd returns the root tx command for the vesting module.
+func (AppModule) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	types.RegisterMsgServer(registrar, NewMsgServerImpl(am.accountKeeper, am.bankKeeper, am.poolKeeper, am.keeper))
+	types.RegisterQueryServer(registrar, NewQueryServerImpl(am.accountKeeper))
+
+	return nil
+}
+
+// RegisterMigrations registers module migrations.
+func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
+	m := vestingkeeper.NewMigrator(am.keeper)
+
+	if err := mr.Register(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err)
+	}
+
+	return nil
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the vesting module.
+func (AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the vesting module.
+func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal %s genesis state", types.ModuleName)
+	}
+
+	return types.ValidateGenesis(data, am.accountKeeper.AddressCodec())
+}
+
+// InitGenesis performs genesis initialization for the vesting module.
+func (am AppModule) InitGenesis(ctx context.Context, cdc codec.JSONCodec, bz json.RawMessage) {
+	var gs types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &gs)
+
+	if err := am.keeper.InitGenesis(ctx, &gs); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the vesting module.
+func (am AppModule) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return cdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock emits the events of the periods of the periodic vesting accounts
+// unlocked since the previous block, and returns the pending clawbacks
+// undelegated since the previous block.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	// 200 is an arbitrary value, the remaining accounts are processed in the next blocks
+	if err := am.keeper.EmitUnlocks(ctx, 200); err != nil {
+		return err
+	}
+
+	return am.releasePendingClawbacks(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
@@ -0,0 +1,382 @@
+package vesting
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	types.UnimplementedMsgServer
+
+	keeper.AccountKeeper
+	types.BankKeeper
+	types.PoolKeeper
+
+	vestingKeeper vestingkeeper.Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
+// wrapping the corresponding AccountKeeper, BankKeeper, PoolKeeper and vesting
+// Keeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, pk types.PoolKeeper, vk vestingkeeper.Keeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, PoolKeeper: pk, vestingKeeper: vk}
+}
+
+var _ types.MsgServer = &msgServer{}
+
+// CreateVestingAccount creates a new continuous, delayed or clawback vesting
+// account, funded with the amount of the funder.
+func (s msgServer) CreateVestingAccount(ctx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
+	from, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if s.AccountKeeper.HasAccount(ctx, to) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s already exists", msg.ToAddress)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = sdkCtx.HeaderInfo().Time.Unix()
+	}
+	if msg.EndTime <= startTime {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("end time must be after start time")
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	baseVestingAccount, err := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	baseVestingAccount.ProportionalUndelegation = msg.ProportionalUndelegation
+
+	var vestingAccount sdk.AccountI
+	switch {
+	case msg.Clawback:
+		vestingAccount = types.NewClawbackVestingAccountRaw(baseVestingAccount, startTime, msg.FromAddress)
+	case msg.Delayed:
+		vestingAccount = types.NewDelayedVestingAccountRaw(baseVestingAccount)
+	default:
+		vestingAccount = types.NewContinuousVestingAccountRaw(baseVestingAccount, startTime)
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(startTime, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(msg.EndTime, 10)),
+			sdk.NewAttribute(types.AttributeKeyDelayed, strconv.FormatBool(msg.Delayed)),
+			sdk.NewAttribute(types.AttributeKeyClawback, strconv.FormatBool(msg.Clawback)),
+		),
+	)
+
+	return &types.MsgCreateVestingAccountResponse{}, nil
+}
+
+// CreatePermanentLockedAccount creates a new permanent locked account, funded
+// with the amount of the funder.
+func (s msgServer) CreatePermanentLockedAccount(ctx context.Context, msg *types.MsgCreatePermanentLockedAccount) (*types.MsgCreatePermanentLockedAccountResponse, error) {
+	from, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if s.AccountKeeper.HasAccount(ctx, to) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount, err := types.NewPermanentLockedAccount(baseAccount, msg.Amount.Sort())
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	vestingAccount.ProportionalUndelegation = msg.ProportionalUndelegation
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatePermanentLockedAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgCreatePermanentLockedAccountResponse{}, nil
+}
+
+// CreatePeriodicVestingAccount creates a new periodic vesting account, funded
+// with the total amount of its vesting periods by the funder.
+func (s msgServer) CreatePeriodicVestingAccount(ctx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	from, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	amount := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if s.AccountKeeper.HasAccount(ctx, to) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s already exists", msg.ToAddress)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = sdkCtx.HeaderInfo().Time.Unix()
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	periodicAccount, err := types.NewPeriodicVestingAccount(baseAccount, amount, startTime, msg.VestingPeriods)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	periodicAccount.ProportionalUndelegation = msg.ProportionalUndelegation
+
+	s.AccountKeeper.SetAccount(ctx, periodicAccount)
+	if err := s.vestingKeeper.TrackUnlocks(ctx, periodicAccount); err != nil {
+		return nil, err
+	}
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatePeriodicVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(startTime, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(periodicAccount.EndTime, 10)),
+		),
+	)
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+// Clawback claws back the coins still vesting of a clawback vesting account, and
+// returns them to its funder or to the community pool. The clawed back coins
+// which are delegated are returned in the same way once undelegated.
+func (s msgServer) Clawback(ctx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("account %s does not exist", msg.Address)
+	}
+	clawbackAccount, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s is not a clawback vesting account", msg.Address)
+	}
+
+	accountFunder, err := s.AccountKeeper.AddressCodec().StringToBytes(clawbackAccount.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(funder, accountFunder) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	if msg.ToCommunityPool && s.PoolKeeper == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("community pool is not available")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	toReturn, pending := clawbackAccount.Clawback(sdkCtx.HeaderInfo().Time)
+
+	// the clawed back coins are unlocked once the account is updated
+	s.AccountKeeper.SetAccount(ctx, clawbackAccount)
+
+	// the pending clawback is returned once undelegated, where this clawback
+	// returns its coins
+	if pending.IsZero() {
+		err = s.vestingKeeper.PendingClawbacks.Remove(ctx, addr)
+	} else {
+		err = s.vestingKeeper.PendingClawbacks.Set(ctx, addr, msg.ToCommunityPool)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := returnClawedBack(ctx, s.BankKeeper, s.PoolKeeper, addr, funder, msg.ToCommunityPool, toReturn); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyToCommunityPool, strconv.FormatBool(msg.ToCommunityPool)),
+			sdk.NewAttribute(types.AttributeKeyAmount, toReturn.String()),
+			sdk.NewAttribute(types.AttributeKeyPending, pending.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{ClawedBack: toReturn, Pending: pending}, nil
+}
+
+// returnClawedBack returns the coins clawed back from the account to its funder,
+// or to the community pool.
+func returnClawedBack(ctx context.Context, bk types.BankKeeper, pk types.PoolKeeper, addr, funder sdk.AccAddress, toCommunityPool bool, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	if toCommunityPool {
+		return pk.FundCommunityPool(ctx, amount, addr)
+	}
+
+	return bk.SendCoins(ctx, addr, funder, amount)
+}
+
+// AddVestingGrant adds a grant, funded with the coins of the sender, to an
+// existing periodic vesting account. The periods of the grant are merged into
+// the vesting schedule of the account.
+func (s msgServer) AddVestingGrant(ctx context.Context, msg *types.MsgAddVestingGrant) (*types.MsgAddVestingGrantResponse, error) {
+	from, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	amount := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	acc := s.AccountKeeper.GetAccount(ctx, to)
+	if acc == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("account %s does not exist", msg.ToAddress)
+	}
+	periodicAccount, ok := acc.(*types.PeriodicVestingAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s is not a periodic vesting account", msg.ToAddress)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = sdkCtx.HeaderInfo().Time.Unix()
+	}
+
+	if err := periodicAccount.AddGrant(startTime, msg.VestingPeriods, amount); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	s.AccountKeeper.SetAccount(ctx, periodicAccount)
+	if err := s.vestingKeeper.TrackUnlocks(ctx, periodicAccount); err != nil {
+		return nil, err
+	}
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddVestingGrant,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(startTime, 10)),
+		),
+	)
+
+	return &types.MsgAddVestingGrantResponse{}, nil
+}
@@ -0,0 +1,725 @@
+package vesting_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
+	vestingtestutil "cosmossdk.io/x/auth/vesting/testutil"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	fromAddr   = sdk.AccAddress([]byte("from1________________"))
+	to1Addr    = sdk.AccAddress([]byte("to1__________________"))
+	to2Addr    = sdk.AccAddress([]byte("to2__________________"))
+	fooCoin    = sdk.NewInt64Coin("foo", 100)
+	periodCoin = sdk.NewInt64Coin("foo", 20)
+)
+
+type VestingTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    *vestingtestutil.MockBankKeeper
+	poolKeeper    *vestingtestutil.MockPoolKeeper
+	vestingKeeper vestingkeeper.Keeper
+	msgServer     vestingtypes.MsgServer
+}
+
+func (s *VestingTestSuite) SetupTest() {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, vestingtypes.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger())
+	s.ctx = testutil.DefaultContextWithKeys(keys, nil, nil).WithHeaderInfo(header.Info{Time: time.Unix(1000, 0)})
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+
+	ctrl := gomock.NewController(s.T())
+	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.poolKeeper = vestingtestutil.NewMockPoolKeeper(ctrl)
+	s.accountKeeper = authkeeper.NewAccountKeeper(
+		env,
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		nil,
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	s.vestingKeeper = vestingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[vestingtypes.StoreKey]), log.NewNopLogger()), s.accountKeeper)
+
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.poolKeeper, s.vestingKeeper)
+}
+
+func TestVestingTestSuite(t *testing.T) {
+	suite.Run(t, new(VestingTestSuite))
+}
+
+func (s *VestingTestSuite) TestCreateVestingAccount() {
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateVestingAccount
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid to address",
+			input: &vestingtypes.MsgCreateVestingAccount{
+				FromAddress: fromAddr.String(),
+				ToAddress:   "invalid",
+				Amount:      sdk.Coins{fooCoin},
+				EndTime:     2000,
+			},
+			expErr:    true,
+			expErrMsg: "invalid 'to' address",
+		},
+		{
+			name:      "zero amount",
+			input:     vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, sdk.Coins{sdk.NewInt64Coin("foo", 0)}, 0, 2000, false),
+			expErr:    true,
+			expErrMsg: "invalid amount",
+		},
+		{
+			name:      "end time before start time",
+			input:     vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, 2000, 1500, false),
+			expErr:    true,
+			expErrMsg: "end time must be after start time",
+		},
+		{
+			name: "end time before block time",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, 0, 500, false),
+			expErr:    true,
+			expErrMsg: "end time must be after start time",
+		},
+		{
+			name:      "delayed clawback vesting account",
+			input:     &vestingtypes.MsgCreateVestingAccount{FromAddress: fromAddr.String(), ToAddress: to1Addr.String(), Amount: sdk.Coins{fooCoin}, EndTime: 2000, Delayed: true, Clawback: true},
+			expErr:    true,
+			expErrMsg: "a clawback vesting account cannot be delayed",
+		},
+		{
+			name: "blocked address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, 0, 2000, false),
+			expErr:    true,
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "account already exists",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateVestingAccount(fromAddr, to2Addr, sdk.Coins{fooCoin}, 0, 2000, false),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+		{
+			name: "create continuous vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.Coins{fooCoin}).Return(nil)
+			},
+			input:  vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, 0, 2000, false),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreateVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr)
+	s.Require().IsType(&vestingtypes.ContinuousVestingAccount{}, acc)
+	vestingAcc := acc.(*vestingtypes.ContinuousVestingAccount)
+	s.Require().Equal(sdk.Coins{fooCoin}, vestingAcc.OriginalVesting)
+	s.Require().Equal(int64(1000), vestingAcc.StartTime)
+	s.Require().Equal(int64(2000), vestingAcc.EndTime)
+
+	var found bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == vestingtypes.EventTypeCreateVestingAccount {
+			found = true
+		}
+	}
+	s.Require().True(found)
+}
+
+func (s *VestingTestSuite) TestCreateDelayedVestingAccount() {
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin).Return(nil)
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.Coins{periodCoin}).Return(nil)
+
+	_, err := s.msgServer.CreateVestingAccount(s.ctx, vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, sdk.Coins{periodCoin}, 0, 2000, true))
+	s.Require().NoError(err)
+
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr)
+	s.Require().IsType(&vestingtypes.DelayedVestingAccount{}, acc)
+	s.Require().Equal(sdk.Coins{periodCoin}, acc.(*vestingtypes.DelayedVestingAccount).OriginalVesting)
+}
+
+func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreatePermanentLockedAccount
+		expErrMsg string
+	}{
+		{
+			name:      "invalid to address",
+			input:     &vestingtypes.MsgCreatePermanentLockedAccount{FromAddress: fromAddr.String(), ToAddress: "invalid", Amount: sdk.Coins{fooCoin}},
+			expErrMsg: "invalid 'to' address",
+		},
+		{
+			name:      "zero amount",
+			input:     vestingtypes.NewMsgCreatePermanentLockedAccount(fromAddr, to1Addr, sdk.Coins{sdk.NewInt64Coin("foo", 0)}),
+			expErrMsg: "invalid amount",
+		},
+		{
+			name: "blocked address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreatePermanentLockedAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}),
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "account already exists",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreatePermanentLockedAccount(fromAddr, to2Addr, sdk.Coins{fooCoin}),
+			expErrMsg: "already exists",
+		},
+		{
+			name: "create permanent locked account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.Coins{fooCoin}).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreatePermanentLockedAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreatePermanentLockedAccount(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr)
+	s.Require().IsType(&vestingtypes.PermanentLockedAccount{}, acc)
+	lockedAcc := acc.(*vestingtypes.PermanentLockedAccount)
+	s.Require().Equal(sdk.Coins{fooCoin}, lockedAcc.OriginalVesting)
+	s.Require().Equal(int64(0), lockedAcc.EndTime)
+
+	var found bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == vestingtypes.EventTypeCreatePermanentLockedAccount {
+			found = true
+		}
+	}
+	s.Require().True(found)
+}
+
+func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+
+	periods := []vestingtypes.Period{
+		{Length: 500, Amount: sdk.Coins{periodCoin}},
+		{Length: 500, Amount: sdk.Coins{periodCoin}},
+	}
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 40))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreatePeriodicVestingAccount
+		expErrMsg string
+	}{
+		{
+			name:      "invalid to address",
+			input:     &vestingtypes.MsgCreatePeriodicVestingAccount{FromAddress: fromAddr.String(), ToAddress: "invalid", VestingPeriods: periods},
+			expErrMsg: "invalid 'to' address",
+		},
+		{
+			name:      "no periods",
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 0, nil),
+			expErrMsg: "vesting periods cannot be empty",
+		},
+		{
+			name:      "non-positive period length",
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 0, []vestingtypes.Period{{Length: 0, Amount: sdk.Coins{periodCoin}}}),
+			expErrMsg: "non-positive length",
+		},
+		{
+			name:      "overflowing period lengths",
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 0, []vestingtypes.Period{{Length: math.MaxInt64, Amount: sdk.Coins{periodCoin}}, {Length: math.MaxInt64, Amount: sdk.Coins{periodCoin}}}),
+			expErrMsg: "total length of vesting periods exceeds the maximum",
+		},
+		{
+			name: "blocked address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), amount[0]).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 0, periods),
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "account already exists",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), amount[0]).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to2Addr, 0, periods),
+			expErrMsg: "already exists",
+		},
+		{
+			name: "create periodic vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), amount[0]).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, amount).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 0, periods),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreatePeriodicVestingAccount(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	// the schedule starts at the block time
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr)
+	s.Require().IsType(&vestingtypes.PeriodicVestingAccount{}, acc)
+	periodicAcc := acc.(*vestingtypes.PeriodicVestingAccount)
+	s.Require().Equal(amount, periodicAcc.OriginalVesting)
+	s.Require().Equal(int64(1000), periodicAcc.StartTime)
+	s.Require().Equal(int64(2000), periodicAcc.EndTime)
+	s.Require().Equal(periods, periodicAcc.VestingPeriods)
+
+	var found bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == vestingtypes.EventTypeCreatePeriodicVestingAccount {
+			found = true
+		}
+	}
+	s.Require().True(found)
+
+	// the first unlock of the account is tracked
+	unlockTime, err := s.vestingKeeper.PendingUnlocks.Get(s.ctx, to1Addr)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1500), unlockTime)
+}
+
+func (s *VestingTestSuite) createClawbackVestingAccount(addr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64) {
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().BlockedAddr(addr).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, addr, amount).Return(nil)
+
+	msg := vestingtypes.NewMsgCreateVestingAccount(fromAddr, addr, amount, startTime, endTime, false)
+	msg.Clawback = true
+	_, err := s.msgServer.CreateVestingAccount(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	s.createClawbackVestingAccount(to1Addr, sdk.Coins{fooCoin}, 0, 2000)
+
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr)
+	s.Require().IsType(&vestingtypes.ClawbackVestingAccount{}, acc)
+	clawbackAcc := acc.(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(fromAddr.String(), clawbackAcc.FunderAddress)
+	s.Require().Equal(sdk.Coins{fooCoin}, clawbackAcc.OriginalVesting)
+	s.Require().Equal(int64(1000), clawbackAcc.StartTime)
+	s.Require().Equal(int64(2000), clawbackAcc.EndTime)
+}
+
+func (s *VestingTestSuite) TestCreateProportionalUndelegationAccounts() {
+	to3Addr := sdk.AccAddress([]byte("to3__________________"))
+	amount := sdk.Coins{fooCoin}
+	periods := []vestingtypes.Period{
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 50))},
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 50))},
+	}
+	for _, addr := range []sdk.AccAddress{to1Addr, to2Addr, to3Addr} {
+		s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+		s.bankKeeper.EXPECT().BlockedAddr(addr).Return(false)
+		s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, addr, amount).Return(nil)
+	}
+
+	continuous := vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, amount, 0, 2000, false)
+	continuous.ProportionalUndelegation = true
+	_, err := s.msgServer.CreateVestingAccount(s.ctx, continuous)
+	s.Require().NoError(err)
+
+	periodic := vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to2Addr, 0, periods)
+	periodic.ProportionalUndelegation = true
+	_, err = s.msgServer.CreatePeriodicVestingAccount(s.ctx, periodic)
+	s.Require().NoError(err)
+
+	permanent := vestingtypes.NewMsgCreatePermanentLockedAccount(fromAddr, to3Addr, amount)
+	permanent.ProportionalUndelegation = true
+	_, err = s.msgServer.CreatePermanentLockedAccount(s.ctx, permanent)
+	s.Require().NoError(err)
+
+	s.Require().True(s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ContinuousVestingAccount).ProportionalUndelegation)
+	s.Require().True(s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.PeriodicVestingAccount).ProportionalUndelegation)
+	s.Require().True(s.accountKeeper.GetAccount(s.ctx, to3Addr).(*vestingtypes.PermanentLockedAccount).ProportionalUndelegation)
+
+	// half of the continuous vesting account is vested halfway through its
+	// schedule, so the undelegation is split evenly between the delegated free
+	// and delegated vesting coins
+	halfway := s.ctx.HeaderInfo().Time.Add(500 * time.Second)
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().NoError(acc.TrackDelegation(halfway, amount, amount))
+	s.Require().NoError(acc.TrackUndelegation(sdk.NewCoins(sdk.NewInt64Coin("foo", 40))))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 30)), acc.DelegatedFree)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 30)), acc.DelegatedVesting)
+
+	// without the flag, the delegated free coins are undelegated first
+	to4Addr := sdk.AccAddress([]byte("to4__________________"))
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().BlockedAddr(to4Addr).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to4Addr, amount).Return(nil)
+	_, err = s.msgServer.CreateVestingAccount(s.ctx, vestingtypes.NewMsgCreateVestingAccount(fromAddr, to4Addr, amount, 0, 2000, false))
+	s.Require().NoError(err)
+	acc = s.accountKeeper.GetAccount(s.ctx, to4Addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().False(acc.ProportionalUndelegation)
+	s.Require().NoError(acc.TrackDelegation(halfway, amount, amount))
+	s.Require().NoError(acc.TrackUndelegation(sdk.NewCoins(sdk.NewInt64Coin("foo", 40))))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), acc.DelegatedFree)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 50)), acc.DelegatedVesting)
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+	s.createClawbackVestingAccount(to1Addr, sdk.Coins{fooCoin}, 0, 3000)
+
+	testCases := []struct {
+		name      string
+		input     *vestingtypes.MsgClawback
+		expErrMsg string
+	}{
+		{
+			name:      "invalid account address",
+			input:     &vestingtypes.MsgClawback{FunderAddress: fromAddr.String(), Address: "invalid"},
+			expErrMsg: "invalid account address",
+		},
+		{
+			name:      "account does not exist",
+			input:     vestingtypes.NewMsgClawback(fromAddr, sdk.AccAddress([]byte("to3__________________")), false),
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "not a clawback vesting account",
+			input:     vestingtypes.NewMsgClawback(fromAddr, to2Addr, false),
+			expErrMsg: "is not a clawback vesting account",
+		},
+		{
+			name:      "not the funder",
+			input:     vestingtypes.NewMsgClawback(to2Addr, to1Addr, false),
+			expErrMsg: "is not the funder",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Clawback(s.ctx, tc.input)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), tc.expErrMsg)
+		})
+	}
+
+	// half of the vesting period has passed
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(2000, 0)})
+	halfCoin := sdk.NewInt64Coin("foo", 50)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.Coins{halfCoin}).Return(nil)
+
+	res, err := s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, false))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{halfCoin}, res.ClawedBack)
+	s.Require().Empty(res.Pending)
+
+	acc := s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(sdk.Coins{halfCoin}, acc.OriginalVesting)
+	s.Require().Equal(int64(2000), acc.EndTime)
+	s.Require().Empty(acc.LockedCoins(ctx.HeaderInfo().Time))
+
+	// nothing is left to claw back
+	res, err = s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, false))
+	s.Require().NoError(err)
+	s.Require().Empty(res.ClawedBack)
+	s.Require().Empty(res.Pending)
+}
+
+func (s *VestingTestSuite) TestClawbackDelegated() {
+	s.createClawbackVestingAccount(to1Addr, sdk.Coins{fooCoin}, 0, 3000)
+
+	// delegate all the vesting coins
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().NoError(acc.TrackDelegation(s.ctx.HeaderInfo().Time, sdk.Coins{fooCoin}, sdk.Coins{fooCoin}))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// the delegated vesting coins are pending until undelegated
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(2000, 0)})
+	halfCoin := sdk.NewInt64Coin("foo", 50)
+	res, err := s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, false))
+	s.Require().NoError(err)
+	s.Require().Empty(res.ClawedBack)
+	s.Require().Equal(sdk.Coins{halfCoin}, res.Pending)
+
+	acc = s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(sdk.Coins{halfCoin}, acc.PendingClawback)
+	s.Require().Equal(sdk.Coins{halfCoin}, acc.DelegatedVesting)
+	s.Require().Equal(sdk.Coins{halfCoin}, acc.DelegatedFree)
+
+	has, err := s.vestingKeeper.PendingClawbacks.Has(ctx, to1Addr)
+	s.Require().NoError(err)
+	s.Require().True(has)
+
+	// the pending clawback is not released while delegated
+	appModule := vesting.NewAppModule(s.vestingKeeper, s.accountKeeper, s.bankKeeper, s.poolKeeper, nil)
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), to1Addr).Return(sdk.Coins{halfCoin})
+	s.Require().NoError(appModule.BeginBlock(ctx))
+	acc = s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(sdk.Coins{halfCoin}, acc.PendingClawback)
+
+	// once undelegated, the pending clawback is released to the funder
+	s.Require().NoError(acc.TrackUndelegation(sdk.Coins{fooCoin}))
+	s.Require().Equal(sdk.Coins{halfCoin}, acc.LockedCoins(ctx.HeaderInfo().Time))
+	s.accountKeeper.SetAccount(ctx, acc)
+
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), to1Addr).Return(sdk.Coins{fooCoin, halfCoin})
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.Coins{halfCoin}).Return(nil)
+	s.Require().NoError(appModule.BeginBlock(ctx))
+
+	acc = s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Empty(acc.PendingClawback)
+	s.Require().Empty(acc.LockedCoins(ctx.HeaderInfo().Time))
+	has, err = s.vestingKeeper.PendingClawbacks.Has(ctx, to1Addr)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *VestingTestSuite) TestClawbackDelegatedToCommunityPool() {
+	s.createClawbackVestingAccount(to1Addr, sdk.Coins{fooCoin}, 0, 3000)
+
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().NoError(acc.TrackDelegation(s.ctx.HeaderInfo().Time, sdk.Coins{fooCoin}, sdk.Coins{fooCoin}))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(2000, 0)})
+	halfCoin := sdk.NewInt64Coin("foo", 50)
+	res, err := s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, true))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{halfCoin}, res.Pending)
+
+	// half of the pending clawback is undelegated, once the delegated free
+	// coins are, and released to the community pool
+	quarterCoin := sdk.NewInt64Coin("foo", 25)
+	acc = s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().NoError(acc.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin("foo", 75)}))
+	s.accountKeeper.SetAccount(ctx, acc)
+
+	appModule := vesting.NewAppModule(s.vestingKeeper, s.accountKeeper, s.bankKeeper, s.poolKeeper, nil)
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), to1Addr).Return(sdk.Coins{sdk.NewInt64Coin("foo", 75)})
+	s.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.Coins{quarterCoin}, to1Addr).Return(nil)
+	s.Require().NoError(appModule.BeginBlock(ctx))
+
+	acc = s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(sdk.Coins{quarterCoin}, acc.PendingClawback)
+	toCommunityPool, err := s.vestingKeeper.PendingClawbacks.Get(ctx, to1Addr)
+	s.Require().NoError(err)
+	s.Require().True(toCommunityPool)
+}
+
+func (s *VestingTestSuite) TestAddVestingGrant() {
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+
+	baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr).(*authtypes.BaseAccount)
+	periodicAcc, err := vestingtypes.NewPeriodicVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("foo", 40)), 1000, []vestingtypes.Period{
+		{Length: 500, Amount: sdk.Coins{periodCoin}},
+		{Length: 500, Amount: sdk.Coins{periodCoin}},
+	})
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, periodicAcc)
+
+	grantPeriods := []vestingtypes.Period{
+		{Length: 500, Amount: sdk.Coins{periodCoin}},
+		{Length: 250, Amount: sdk.Coins{periodCoin}},
+	}
+	grantAmount := sdk.NewCoins(sdk.NewInt64Coin("foo", 40))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgAddVestingGrant
+		expErrMsg string
+	}{
+		{
+			name:      "invalid to address",
+			input:     &vestingtypes.MsgAddVestingGrant{FromAddress: fromAddr.String(), ToAddress: "invalid", VestingPeriods: grantPeriods},
+			expErrMsg: "invalid 'to' address",
+		},
+		{
+			name:      "no periods",
+			input:     vestingtypes.NewMsgAddVestingGrant(fromAddr, to1Addr, 0, nil),
+			expErrMsg: "vesting periods cannot be empty",
+		},
+		{
+			name:      "non-positive period length",
+			input:     vestingtypes.NewMsgAddVestingGrant(fromAddr, to1Addr, 0, []vestingtypes.Period{{Length: 0, Amount: sdk.Coins{periodCoin}}}),
+			expErrMsg: "non-positive length",
+		},
+		{
+			name:      "overflowing period lengths",
+			input:     vestingtypes.NewMsgAddVestingGrant(fromAddr, to1Addr, 0, []vestingtypes.Period{{Length: math.MaxInt64, Amount: sdk.Coins{periodCoin}}, {Length: math.MaxInt64, Amount: sdk.Coins{periodCoin}}}),
+			expErrMsg: "total length of vesting periods exceeds the maximum",
+		},
+		{
+			name: "blocked address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), grantAmount[0]).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgAddVestingGrant(fromAddr, to1Addr, 0, grantPeriods),
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "account does not exist",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), grantAmount[0]).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+			},
+			input:     vestingtypes.NewMsgAddVestingGrant(fromAddr, fromAddr, 0, grantPeriods),
+			expErrMsg: "does not exist",
+		},
+		{
+			name: "not a periodic vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), grantAmount[0]).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgAddVestingGrant(fromAddr, to2Addr, 0, grantPeriods),
+			expErrMsg: "is not a periodic vesting account",
+		},
+		{
+			name: "add grant",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), grantAmount[0]).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, grantAmount).Return(nil)
+			},
+			input: vestingtypes.NewMsgAddVestingGrant(fromAddr, to1Addr, 0, grantPeriods),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.AddVestingGrant(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	// the grant starts at the block time and its first period ends with the
+	// first period of the account
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().NoError(acc.Validate())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 80)), acc.OriginalVesting)
+	s.Require().Equal(int64(1000), acc.StartTime)
+	s.Require().Equal(int64(2000), acc.EndTime)
+	s.Require().Equal([]vestingtypes.Period{
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 40))},
+		{Length: 250, Amount: sdk.Coins{periodCoin}},
+		{Length: 250, Amount: sdk.Coins{periodCoin}},
+	}, acc.VestingPeriods)
+
+	var found bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == vestingtypes.EventTypeAddVestingGrant {
+			found = true
+		}
+	}
+	s.Require().True(found)
+
+	// the first unlock of the account is tracked
+	unlockTime, err := s.vestingKeeper.PendingUnlocks.Get(s.ctx, to1Addr)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1500), unlockTime)
+}
@@ -0,0 +1,74 @@
+package vesting
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/auth/keeper"
+	vestexported "cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type queryServer struct {
+	keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer
+// interface, wrapping the AccountKeeper.
+func NewQueryServerImpl(k keeper.AccountKeeper) types.QueryServer {
+	return &queryServer{AccountKeeper: k}
+}
+
+var _ types.QueryServer = &queryServer{}
+
+// VestingBalances returns the vesting balances of a vesting account at the
+// current block time, or an empty response if the account is not vesting.
+func (s queryServer) VestingBalances(ctx context.Context, req *types.QueryVestingBalancesRequest) (*types.QueryVestingBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	vacc, ok := acc.(vestexported.VestingAccount)
+	if !ok {
+		return &types.QueryVestingBalancesResponse{}, nil
+	}
+
+	// the header info of queries at the latest height has no time, in which
+	// case the time of the last committed block header is used
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.HeaderInfo().Time
+	if blockTime.IsZero() {
+		blockTime = sdkCtx.BlockHeader().Time
+	}
+
+	resp := &types.QueryVestingBalancesResponse{
+		OriginalVesting:  vacc.GetOriginalVesting(),
+		DelegatedFree:    vacc.GetDelegatedFree(),
+		DelegatedVesting: vacc.GetDelegatedVesting(),
+		Vested:           sdk.NewCoins(vacc.GetVestedCoins(blockTime)...),
+		Vesting:          sdk.NewCoins(vacc.GetVestingCoins(blockTime)...),
+		Locked:           sdk.NewCoins(vacc.LockedCoins(blockTime)...),
+		StartTime:        vacc.GetStartTime(),
+		EndTime:          vacc.GetEndTime(),
+	}
+
+	if pva, ok := vacc.(*types.PeriodicVestingAccount); ok {
+		resp.RemainingPeriods = pva.GetRemainingUnlocks(blockTime)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,155 @@
+package vesting_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *VestingTestSuite) TestVestingBalances() {
+	queryServer := vesting.NewQueryServerImpl(s.accountKeeper)
+
+	continuousAddr := sdk.AccAddress([]byte("continuous__________"))
+	delayedAddr := sdk.AccAddress([]byte("delayed_____________"))
+	periodicAddr := sdk.AccAddress([]byte("periodic____________"))
+	baseAddr := sdk.AccAddress([]byte("base________________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+
+	newBaseAccount := func(addr sdk.AccAddress) *authtypes.BaseAccount {
+		return s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount)
+	}
+
+	continuousAcc, err := vestingtypes.NewContinuousVestingAccount(newBaseAccount(continuousAddr), coins, 1000, 2000)
+	s.Require().NoError(err)
+	s.Require().NoError(continuousAcc.TrackDelegation(time.Unix(1000, 0), coins, sdk.NewCoins(sdk.NewInt64Coin("foo", 30))))
+	s.accountKeeper.SetAccount(s.ctx, continuousAcc)
+
+	delayedAcc, err := vestingtypes.NewDelayedVestingAccount(newBaseAccount(delayedAddr), coins, 2000)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, delayedAcc)
+
+	periodicAcc, err := vestingtypes.NewPeriodicVestingAccount(newBaseAccount(periodicAddr), coins, 1000, vestingtypes.Periods{
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 20))},
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 80))},
+	})
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, periodicAcc)
+
+	s.accountKeeper.SetAccount(s.ctx, newBaseAccount(baseAddr))
+
+	foo := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("foo", amount))
+	}
+
+	testCases := []struct {
+		name      string
+		address   string
+		blockTime int64
+		expResp   *vestingtypes.QueryVestingBalancesResponse
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			address:   "invalid",
+			blockTime: 1000,
+			expErrMsg: "invalid address",
+		},
+		{
+			name:      "account not found",
+			address:   sdk.AccAddress([]byte("unknown_____________")).String(),
+			blockTime: 1000,
+			expErrMsg: "not found",
+		},
+		{
+			name:      "base account",
+			address:   baseAddr.String(),
+			blockTime: 1000,
+			expResp:   &vestingtypes.QueryVestingBalancesResponse{},
+		},
+		{
+			name:      "continuous vesting account at start time",
+			address:   continuousAddr.String(),
+			blockTime: 1000,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, DelegatedVesting: foo(30), Vesting: coins, Locked: foo(70), StartTime: 1000, EndTime: 2000,
+			},
+		},
+		{
+			name:      "continuous vesting account half vested",
+			address:   continuousAddr.String(),
+			blockTime: 1500,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, DelegatedVesting: foo(30), Vested: foo(50), Vesting: foo(50), Locked: foo(20), StartTime: 1000, EndTime: 2000,
+			},
+		},
+		{
+			name:      "continuous vesting account fully vested",
+			address:   continuousAddr.String(),
+			blockTime: 2500,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, DelegatedVesting: foo(30), Vested: coins, StartTime: 1000, EndTime: 2000,
+			},
+		},
+		{
+			name:      "delayed vesting account before end time",
+			address:   delayedAddr.String(),
+			blockTime: 1999,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, Vesting: coins, Locked: coins, EndTime: 2000,
+			},
+		},
+		{
+			name:      "delayed vesting account at end time",
+			address:   delayedAddr.String(),
+			blockTime: 2000,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, Vested: coins, EndTime: 2000,
+			},
+		},
+		{
+			name:      "periodic vesting account before the first period",
+			address:   periodicAddr.String(),
+			blockTime: 1200,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, Vesting: coins, Locked: coins, StartTime: 1000, EndTime: 2000,
+				RemainingPeriods: []vestingtypes.VestingUnlock{{UnlockTime: 1500, Amount: foo(20)}, {UnlockTime: 2000, Amount: foo(80)}},
+			},
+		},
+		{
+			name:      "periodic vesting account after the first period",
+			address:   periodicAddr.String(),
+			blockTime: 1500,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, Vested: foo(20), Vesting: foo(80), Locked: foo(80), StartTime: 1000, EndTime: 2000,
+				RemainingPeriods: []vestingtypes.VestingUnlock{{UnlockTime: 2000, Amount: foo(80)}},
+			},
+		},
+		{
+			name:      "periodic vesting account fully vested",
+			address:   periodicAddr.String(),
+			blockTime: 2000,
+			expResp: &vestingtypes.QueryVestingBalancesResponse{
+				OriginalVesting: coins, Vested: coins, StartTime: 1000, EndTime: 2000,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(tc.blockTime, 0)})
+			resp, err := queryServer.VestingBalances(ctx, &vestingtypes.QueryVestingBalancesRequest{Address: tc.address})
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expResp.String(), resp.String())
+		})
+	}
+}
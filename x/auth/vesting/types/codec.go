@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/core/registry"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/auth/vesting/exported"
@@ -20,9 +22,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
 	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
 	cdc.RegisterConcrete(&PermanentLockedAccount{}, "cosmos-sdk/PermanentLockedAccount", nil)
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "cosmos-sdk/ClawbackVestingAccount", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgCreateVestingAccount{}, "cosmos-sdk/MsgCreateVestingAccount")
 	legacy.RegisterAminoMsg(cdc, &MsgCreatePermanentLockedAccount{}, "cosmos-sdk/MsgCreatePermLockedAccount")
 	legacy.RegisterAminoMsg(cdc, &MsgCreatePeriodicVestingAccount{}, "cosmos-sdk/MsgCreatePeriodVestAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgClawback{}, "cosmos-sdk/MsgClawback")
+	legacy.RegisterAminoMsg(cdc, &MsgAddVestingGrant{}, "cosmos-sdk/MsgAddVestingGrant")
 }
 
 // RegisterInterface associates protoName with AccountI and VestingAccount
@@ -35,6 +40,7 @@ func RegisterInterfaces(registry registry.LegacyRegistry) {
 		&DelayedVestingAccount{},
 		&PeriodicVestingAccount{},
 		&PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
@@ -44,6 +50,7 @@ func RegisterInterfaces(registry registry.LegacyRegistry) {
 		&ContinuousVestingAccount{},
 		&PeriodicVestingAccount{},
 		&PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
@@ -53,13 +60,34 @@ func RegisterInterfaces(registry registry.LegacyRegistry) {
 		&ContinuousVestingAccount{},
 		&PeriodicVestingAccount{},
 		&PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateVestingAccount{},
 		&MsgCreatePermanentLockedAccount{},
+		&MsgClawback{},
+		&MsgAddVestingGrant{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
+
+// RegisterVestingAccountImplementations registers vesting account types defined
+// by applications as implementations of the VestingAccount, sdk.AccountI and
+// GenesisAccount interfaces, so that they can be imported from the genesis state
+// and handled through the VestingAccount interface like the vesting accounts of
+// this module. Their Amino JSON name is registered with
+// authtypes.RegisterAccountTypeCodec.
+func RegisterVestingAccountImplementations(registry registry.LegacyRegistry, accounts ...exported.VestingAccount) {
+	for _, acc := range accounts {
+		genAcc, ok := acc.(authtypes.GenesisAccount)
+		if !ok {
+			panic(fmt.Errorf("vesting account %T must implement GenesisAccount", acc))
+		}
+
+		registry.RegisterImplementations((*exported.VestingAccount)(nil), acc)
+		authtypes.RegisterAccountImplementations(registry, genAcc)
+	}
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// milestoneVestingAccount is a vesting account type defined outside of the
+// vesting module, standing for the vesting accounts of applications.
+type milestoneVestingAccount struct {
+	types.DelayedVestingAccount
+}
+
+func (*milestoneVestingAccount) XXX_MessageName() string {
+	return "cosmos.vesting.v1beta1.testutil.MilestoneVestingAccount"
+}
+
+func (m *milestoneVestingAccount) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	s, err := jm.MarshalToString(&m.DelayedVestingAccount)
+	return []byte(s), err
+}
+
+func (m *milestoneVestingAccount) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return u.Unmarshal(bytes.NewReader(bz), &m.DelayedVestingAccount)
+}
+
+func TestRegisterVestingAccountImplementations(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+	types.RegisterVestingAccountImplementations(encCfg.InterfaceRegistry, &milestoneVestingAccount{})
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+	dva, err := types.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(addr), coins, 1000)
+	require.NoError(t, err)
+	acc := &milestoneVestingAccount{DelayedVestingAccount: *dva}
+
+	// round-trip the account through the auth genesis JSON
+	bz, err := encCfg.Codec.MarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{acc}))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "/cosmos.vesting.v1beta1.testutil.MilestoneVestingAccount")
+
+	var genState authtypes.GenesisState
+	require.NoError(t, encCfg.Codec.UnmarshalJSON(bz, &genState))
+	require.NoError(t, authtypes.ValidateGenesis(genState))
+
+	accs, err := authtypes.UnpackAccounts(genState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 1)
+	require.Equal(t, acc, accs[0])
+
+	// the account is handled through the VestingAccount interface
+	vacc, ok := accs[0].(exported.VestingAccount)
+	require.True(t, ok)
+	require.Equal(t, coins, vacc.GetOriginalVesting())
+
+	// an invalid account is rejected by the genesis validation
+	acc.EndTime = 0
+	bz, err = encCfg.Codec.MarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{acc}))
+	require.NoError(t, err)
+	require.NoError(t, encCfg.Codec.UnmarshalJSON(bz, &genState))
+	require.ErrorContains(t, authtypes.ValidateGenesis(genState), "delayed vesting end-time must be positive")
+}
+
+func TestRegisterVestingAccountImplementationsNotGenesisAccount(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	require.Panics(t, func() {
+		types.RegisterVestingAccountImplementations(encCfg.InterfaceRegistry, notGenesisVestingAccount{})
+	})
+}
+
+// notGenesisVestingAccount is a vesting account which cannot be validated as a
+// genesis account.
+type notGenesisVestingAccount struct {
+	exported.VestingAccount
+}
@@ -1,9 +1,28 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module's name.
 	ModuleName = "vesting"
 
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+var (
+	// UnlockQueuePrefix is the prefix of the queue of the periodic vesting
+	// accounts, ordered by the unlock time of their next pending unlock.
+	UnlockQueuePrefix = collections.NewPrefix(0)
+
+	// PendingUnlocksPrefix is the prefix of the unlock time of the next pending
+	// unlock of each periodic vesting account.
+	PendingUnlocksPrefix = collections.NewPrefix(1)
+
+	// PendingClawbacksPrefix is the prefix of the clawback vesting accounts
+	// whose pending clawback is returned once undelegated.
+	PendingClawbacksPrefix = collections.NewPrefix(2)
+)
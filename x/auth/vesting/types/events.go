@@ -0,0 +1,26 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeCreateVestingAccount         = "create_vesting_account"
+	EventTypeCreatePermanentLockedAccount = "create_permanent_locked_account"
+	EventTypeCreatePeriodicVestingAccount = "create_periodic_vesting_account"
+	EventTypeClawback                     = "clawback"
+	EventTypeAddVestingGrant              = "add_vesting_grant"
+	EventTypeVestingUnlock                = "vesting_unlock"
+	EventTypeGovClawback                  = "gov_clawback"
+	EventTypeReleasePendingClawback       = "release_pending_clawback"
+
+	AttributeKeyFunder          = "funder"
+	AttributeKeyAccount         = "account"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyStartTime       = "start_time"
+	AttributeKeyEndTime         = "end_time"
+	AttributeKeyDelayed         = "delayed"
+	AttributeKeyClawback        = "clawback"
+	AttributeKeyPending         = "pending"
+	AttributeKeyToCommunityPool = "to_community_pool"
+	AttributeKeyPeriodIndex     = "period_index"
+	AttributeKeyUnlockTime      = "unlock_time"
+	AttributeKeyExcluded        = "excluded"
+)
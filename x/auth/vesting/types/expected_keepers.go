@@ -3,13 +3,30 @@ package types
 import (
 	context "context"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // BankKeeper defines the expected interface contract the vesting module requires
-// for creating vesting accounts with funds.
+// for creating vesting accounts with funds, and for returning clawed back coins.
 type BankKeeper interface {
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// PoolKeeper defines the expected interface contract the vesting module requires
+// for sending clawed back coins to the community pool.
+type PoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// StakingKeeper defines the expected interface contract the vesting module
+// requires for checking the delegations tracked by vesting accounts.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
+	GetDelegatorUnbonding(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
 }
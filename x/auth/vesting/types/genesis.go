@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(pendingUnlocks []PendingUnlock, pendingClawbacks []PendingClawback) *GenesisState {
+	return &GenesisState{
+		PendingUnlocks:   pendingUnlocks,
+		PendingClawbacks: pendingClawbacks,
+	}
+}
+
+// DefaultGenesisState returns a default vesting module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]PendingUnlock{}, []PendingClawback{})
+}
+
+// ValidateGenesis performs basic validation of the vesting genesis state,
+// returning an error for any failed validation criteria.
+func ValidateGenesis(gs GenesisState, ac address.Codec) error {
+	seen := make(map[string]bool, len(gs.PendingUnlocks))
+	for _, pendingUnlock := range gs.PendingUnlocks {
+		if _, err := ac.StringToBytes(pendingUnlock.Address); err != nil {
+			return fmt.Errorf("invalid pending unlock address %s: %w", pendingUnlock.Address, err)
+		}
+
+		if seen[pendingUnlock.Address] {
+			return fmt.Errorf("duplicate pending unlock for address %s", pendingUnlock.Address)
+		}
+		seen[pendingUnlock.Address] = true
+
+		if pendingUnlock.UnlockTime < 0 {
+			return fmt.Errorf("pending unlock time of %s cannot be negative: %d", pendingUnlock.Address, pendingUnlock.UnlockTime)
+		}
+	}
+
+	seen = make(map[string]bool, len(gs.PendingClawbacks))
+	for _, pendingClawback := range gs.PendingClawbacks {
+		if _, err := ac.StringToBytes(pendingClawback.Address); err != nil {
+			return fmt.Errorf("invalid pending clawback address %s: %w", pendingClawback.Address, err)
+		}
+
+		if seen[pendingClawback.Address] {
+			return fmt.Errorf("duplicate pending clawback for address %s", pendingClawback.Address)
+		}
+		seen[pendingClawback.Address] = true
+	}
+
+	return nil
+}
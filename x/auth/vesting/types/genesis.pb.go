@@ -0,0 +1,836 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/vesting/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the vesting module's genesis state.
+type GenesisState struct {
+	// pending_unlocks are the periodic vesting accounts with periods whose unlock
+	// event has not been emitted yet.
+	PendingUnlocks []PendingUnlock `protobuf:"bytes,1,rep,name=pending_unlocks,json=pendingUnlocks,proto3" json:"pending_unlocks"`
+	// pending_clawbacks are the clawback vesting accounts whose pending clawback
+	// is returned once undelegated.
+	PendingClawbacks []PendingClawback `protobuf:"bytes,2,rep,name=pending_clawbacks,json=pendingClawbacks,proto3" json:"pending_clawbacks"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46498241afaff54d, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetPendingUnlocks() []PendingUnlock {
+	if m != nil {
+		return m.PendingUnlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingClawbacks() []PendingClawback {
+	if m != nil {
+		return m.PendingClawbacks
+	}
+	return nil
+}
+
+// PendingUnlock defines the time from which the unlock events of the periods
+// of a periodic vesting account have not been emitted yet.
+type PendingUnlock struct {
+	// address is the address of the periodic vesting account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// unlock_time is the unlock time of the first period whose unlock event has
+	// not been emitted yet, as unix timestamp (in seconds).
+	UnlockTime int64 `protobuf:"varint,2,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+}
+
+func (m *PendingUnlock) Reset()         { *m = PendingUnlock{} }
+func (m *PendingUnlock) String() string { return proto.CompactTextString(m) }
+func (*PendingUnlock) ProtoMessage()    {}
+func (*PendingUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46498241afaff54d, []int{1}
+}
+func (m *PendingUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUnlock.Merge(m, src)
+}
+func (m *PendingUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUnlock proto.InternalMessageInfo
+
+func (m *PendingUnlock) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingUnlock) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+// PendingClawback defines where the pending clawback of a clawback vesting
+// account is returned once undelegated.
+type PendingClawback struct {
+	// address is the address of the clawback vesting account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// to_community_pool indicates whether the pending clawback is returned to
+	// the community pool rather than to the funder of the account.
+	ToCommunityPool bool `protobuf:"varint,2,opt,name=to_community_pool,json=toCommunityPool,proto3" json:"to_community_pool,omitempty"`
+}
+
+func (m *PendingClawback) Reset()         { *m = PendingClawback{} }
+func (m *PendingClawback) String() string { return proto.CompactTextString(m) }
+func (*PendingClawback) ProtoMessage()    {}
+func (*PendingClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46498241afaff54d, []int{2}
+}
+func (m *PendingClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingClawback.Merge(m, src)
+}
+func (m *PendingClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingClawback proto.InternalMessageInfo
+
+func (m *PendingClawback) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingClawback) GetToCommunityPool() bool {
+	if m != nil {
+		return m.ToCommunityPool
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.vesting.v1beta1.GenesisState")
+	proto.RegisterType((*PendingUnlock)(nil), "cosmos.vesting.v1beta1.PendingUnlock")
+	proto.RegisterType((*PendingClawback)(nil), "cosmos.vesting.v1beta1.PendingClawback")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/vesting/v1beta1/genesis.proto", fileDescriptor_46498241afaff54d)
+}
+
+var fileDescriptor_46498241afaff54d = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4e, 0xea, 0x40,
+	0x18, 0x85, 0x3b, 0x90, 0xdc, 0x7b, 0x19, 0xae, 0x22, 0x0d, 0x31, 0xc8, 0xa2, 0x20, 0xd1, 0x48,
+	0x48, 0x6c, 0x03, 0x2e, 0x5d, 0x09, 0x0b, 0xb7, 0xa4, 0xe8, 0x42, 0x37, 0xcd, 0xd0, 0x4e, 0xea,
+	0x84, 0x76, 0xfe, 0xca, 0x0c, 0x28, 0x6f, 0xe1, 0x63, 0xb8, 0x74, 0xe1, 0x43, 0x10, 0x57, 0xc4,
+	0x95, 0x2b, 0x63, 0x60, 0xe1, 0x6b, 0x18, 0x3a, 0xc5, 0x80, 0x31, 0x31, 0x71, 0xd3, 0x34, 0xff,
+	0xf9, 0xe6, 0x9c, 0xf3, 0x67, 0x06, 0xef, 0xb9, 0x20, 0x42, 0x10, 0xd6, 0x88, 0x0a, 0xc9, 0xb8,
+	0x6f, 0x8d, 0x1a, 0x3d, 0x2a, 0x49, 0xc3, 0xf2, 0x29, 0xa7, 0x82, 0x09, 0x33, 0x1a, 0x80, 0x04,
+	0x7d, 0x5b, 0x51, 0x66, 0x42, 0x99, 0x09, 0x55, 0x2a, 0xf8, 0xe0, 0x43, 0x8c, 0x58, 0x8b, 0x3f,
+	0x45, 0x97, 0x76, 0x14, 0xed, 0x28, 0x21, 0x39, 0xaa, 0xa4, 0x3c, 0x09, 0x19, 0x07, 0x2b, 0xfe,
+	0xaa, 0x51, 0xf5, 0x09, 0xe1, 0xff, 0xa7, 0x2a, 0xad, 0x2b, 0x89, 0xa4, 0xfa, 0x05, 0xce, 0x45,
+	0x94, 0x7b, 0x8c, 0xfb, 0xce, 0x90, 0x07, 0xe0, 0xf6, 0x45, 0x11, 0x55, 0xd2, 0xb5, 0x6c, 0x73,
+	0xdf, 0xfc, 0xbe, 0x86, 0xd9, 0x51, 0xf8, 0x79, 0x4c, 0xb7, 0x32, 0x93, 0xd7, 0xb2, 0x76, 0xff,
+	0xfe, 0x50, 0x47, 0xf6, 0x66, 0xb4, 0xaa, 0x08, 0xdd, 0xc1, 0xf9, 0xa5, 0xb5, 0x1b, 0x90, 0x9b,
+	0x1e, 0x59, 0x98, 0xa7, 0x62, 0xf3, 0x83, 0x1f, 0xcc, 0xdb, 0x09, 0xbf, 0x6a, 0xbf, 0x15, 0xad,
+	0x6b, 0xa2, 0xea, 0xe1, 0x8d, 0xb5, 0x32, 0x7a, 0x13, 0xff, 0x25, 0x9e, 0x37, 0xa0, 0x62, 0xb1,
+	0x04, 0xaa, 0x65, 0x5a, 0xc5, 0xe7, 0xc7, 0xc3, 0x42, 0x12, 0x75, 0xa2, 0x94, 0xae, 0x1c, 0x30,
+	0xee, 0xdb, 0x4b, 0x50, 0x2f, 0xe3, 0xac, 0x5a, 0xdc, 0x91, 0x2c, 0xa4, 0xc5, 0x54, 0x05, 0xd5,
+	0xd2, 0x36, 0x56, 0xa3, 0x33, 0x16, 0xd2, 0xea, 0x35, 0xce, 0x7d, 0x69, 0xf5, 0xab, 0x9c, 0x3a,
+	0xce, 0x4b, 0x70, 0x5c, 0x08, 0xc3, 0x21, 0x67, 0x72, 0xec, 0x44, 0x00, 0x41, 0x9c, 0xf6, 0xcf,
+	0xce, 0x49, 0x68, 0x2f, 0xe7, 0x1d, 0x80, 0xa0, 0x75, 0x3c, 0x99, 0x19, 0x68, 0x3a, 0x33, 0xd0,
+	0xdb, 0xcc, 0x40, 0x77, 0x73, 0x43, 0x9b, 0xce, 0x0d, 0xed, 0x65, 0x6e, 0x68, 0x97, 0xbb, 0x2a,
+	0x44, 0x78, 0x7d, 0x93, 0x81, 0x75, 0x6b, 0x91, 0xa1, 0xbc, 0xfa, 0x7c, 0x4e, 0x72, 0x1c, 0x51,
+	0xd1, 0xfb, 0x13, 0xdf, 0xf4, 0xd1, 0xc7, 0x00, 0xc4, 0xdb, 0x39, 0xc6, 0x6d, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingClawbacks) > 0 {
+		for iNdEx := len(m.PendingClawbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingClawbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PendingUnlocks) > 0 {
+		for iNdEx := len(m.PendingUnlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToCommunityPool {
+		i--
+		if m.ToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingUnlocks) > 0 {
+		for _, e := range m.PendingUnlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingClawbacks) > 0 {
+		for _, e := range m.PendingClawbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *PendingClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ToCommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUnlocks = append(m.PendingUnlocks, PendingUnlock{})
+			if err := m.PendingUnlocks[len(m.PendingUnlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClawbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingClawbacks = append(m.PendingClawbacks, PendingClawback{})
+			if err := m.PendingClawbacks[len(m.PendingClawbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToCommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	authcodec "cosmossdk.io/x/auth/codec"
 	authtypes "cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -42,4 +43,52 @@ func TestValidateGenesisInvalidAccounts(t *testing.T) {
 	// invalid start time
 	genAccs[0] = NewContinuousVestingAccountRaw(baseVestingAcc, 1548888000)
 	require.Error(t, authtypes.ValidateGenAccounts(genAccs))
+	// invalid cliff time
+	cva := NewContinuousVestingAccountRaw(baseVestingAcc, 1548000000)
+	cva.CliffTime = 1548888000
+	genAccs[0] = cva
+	require.Error(t, authtypes.ValidateGenAccounts(genAccs))
+	cva.CliffTime = 1548500000
+	require.NoError(t, authtypes.ValidateGenAccounts(genAccs))
+	// start time equal to end time
+	genAccs[0] = NewContinuousVestingAccountRaw(baseVestingAcc, baseVestingAcc.EndTime)
+	require.Error(t, authtypes.ValidateGenAccounts(genAccs))
+	// negative start time
+	genAccs[0] = NewContinuousVestingAccountRaw(baseVestingAcc, -1)
+	require.Error(t, authtypes.ValidateGenAccounts(genAccs))
+	// permanent locked account
+	plva, err := NewPermanentLockedAccount(authtypes.NewBaseAccountWithAddress(sdk.AccAddress(addr1)), acc1Balance)
+	require.NoError(t, err)
+	genAccs[0] = plva
+	require.NoError(t, authtypes.ValidateGenAccounts(genAccs))
+	// permanent locked account with an end time
+	plva.EndTime = 1548775410
+	require.Error(t, authtypes.ValidateGenAccounts(genAccs))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	ac := authcodec.NewBech32Codec("cosmos")
+	addr1Str := sdk.AccAddress(addr1).String()
+
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState(), ac))
+	require.NoError(t, ValidateGenesis(*NewGenesisState([]PendingUnlock{{Address: addr1Str, UnlockTime: 1000}}, nil), ac))
+
+	// invalid address
+	require.Error(t, ValidateGenesis(*NewGenesisState([]PendingUnlock{{Address: "invalid", UnlockTime: 1000}}, nil), ac))
+	// duplicate address
+	require.Error(t, ValidateGenesis(*NewGenesisState([]PendingUnlock{
+		{Address: addr1Str, UnlockTime: 1000},
+		{Address: addr1Str, UnlockTime: 2000},
+	}, nil), ac))
+	// negative unlock time
+	require.Error(t, ValidateGenesis(*NewGenesisState([]PendingUnlock{{Address: addr1Str, UnlockTime: -1}}, nil), ac))
+
+	require.NoError(t, ValidateGenesis(*NewGenesisState(nil, []PendingClawback{{Address: addr1Str, ToCommunityPool: true}}), ac))
+	// invalid pending clawback address
+	require.Error(t, ValidateGenesis(*NewGenesisState(nil, []PendingClawback{{Address: "invalid"}}), ac))
+	// duplicate pending clawback address
+	require.Error(t, ValidateGenesis(*NewGenesisState(nil, []PendingClawback{
+		{Address: addr1Str},
+		{Address: addr1Str, ToCommunityPool: true},
+	}), ac))
 }
@@ -2,25 +2,53 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
 	_ sdk.Msg = &MsgCreateVestingAccount{}
 	_ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgAddVestingGrant{}
 )
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
-func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool) *MsgCreateVestingAccount {
+// A zero start time starts the vesting at the block time of the account creation.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, delayed bool) *MsgCreateVestingAccount {
 	return &MsgCreateVestingAccount{
 		FromAddress: fromAddr.String(),
 		ToAddress:   toAddr.String(),
 		Amount:      amount,
+		StartTime:   startTime,
 		EndTime:     endTime,
 		Delayed:     delayed,
 	}
 }
 
+// ValidateBasic performs the stateless checks of a MsgCreateVestingAccount.
+// The addresses are checked by the message handler, with the address codec of
+// the chain.
+func (msg MsgCreateVestingAccount) ValidateBasic() error {
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount)
+	}
+	if msg.StartTime < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("start time cannot be negative")
+	}
+	if msg.EndTime <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be positive")
+	}
+	if msg.EndTime <= msg.StartTime {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after start time")
+	}
+	if msg.Clawback && msg.Delayed {
+		return sdkerrors.ErrInvalidRequest.Wrap("a clawback vesting account cannot be delayed")
+	}
+
+	return nil
+}
+
 // NewMsgCreatePermanentLockedAccount returns a reference to a new MsgCreatePermanentLockedAccount.
 func NewMsgCreatePermanentLockedAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) *MsgCreatePermanentLockedAccount {
 	return &MsgCreatePermanentLockedAccount{
@@ -30,7 +58,19 @@ func NewMsgCreatePermanentLockedAccount(fromAddr, toAddr sdk.AccAddress, amount
 	}
 }
 
+// ValidateBasic performs the stateless checks of a
+// MsgCreatePermanentLockedAccount. The addresses are checked by the message
+// handler, with the address codec of the chain.
+func (msg MsgCreatePermanentLockedAccount) ValidateBasic() error {
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount)
+	}
+
+	return nil
+}
+
 // NewMsgCreatePeriodicVestingAccount returns a reference to a new MsgCreatePeriodicVestingAccount.
+// A zero start time starts the vesting at the block time of the account creation.
 func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreatePeriodicVestingAccount {
 	return &MsgCreatePeriodicVestingAccount{
 		FromAddress:    fromAddr.String(),
@@ -39,3 +79,61 @@ func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTi
 		VestingPeriods: periods,
 	}
 }
+
+// ValidateBasic performs the stateless checks of a
+// MsgCreatePeriodicVestingAccount. The addresses are checked by the message
+// handler, with the address codec of the chain.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	return validateVestingPeriods(msg.StartTime, msg.VestingPeriods)
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback.
+func NewMsgClawback(funderAddr, addr sdk.AccAddress, toCommunityPool bool) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress:   funderAddr.String(),
+		Address:         addr.String(),
+		ToCommunityPool: toCommunityPool,
+	}
+}
+
+// NewMsgAddVestingGrant returns a reference to a new MsgAddVestingGrant.
+// A zero start time starts the vesting at the block time of the grant.
+func NewMsgAddVestingGrant(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgAddVestingGrant {
+	return &MsgAddVestingGrant{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// ValidateBasic performs the stateless checks of a MsgAddVestingGrant. The
+// addresses are checked by the message handler, with the address codec of the
+// chain.
+func (msg MsgAddVestingGrant) ValidateBasic() error {
+	return validateVestingPeriods(msg.StartTime, msg.VestingPeriods)
+}
+
+// validateVestingPeriods checks a vesting schedule starting at startTime, or at
+// the block time if zero.
+func validateVestingPeriods(startTime int64, periods []Period) error {
+	if startTime < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("start time cannot be negative")
+	}
+	if len(periods) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("vesting periods cannot be empty")
+	}
+	for i, p := range periods {
+		if p.Length <= 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("period #%d has a non-positive length: %d", i, p.Length)
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("period #%d has invalid coins: %s", i, p.Amount)
+		}
+	}
+	if _, err := Periods(periods).EndTime(startTime); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
@@ -1,13 +1,27 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/cosmos/gogoproto/jsonpb"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxScheduleLength is the maximum total length of the periods of a vesting
+// schedule, in seconds: 200 years.
+const MaxScheduleLength int64 = 200 * 365 * 24 * 60 * 60
+
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
 type Periods []Period
 
@@ -16,6 +30,85 @@ func (p Period) Duration() time.Duration {
 	return time.Duration(p.Length) * time.Second
 }
 
+// ParsePeriodLength parses a period length given either as an integer number of
+// seconds or as a duration string such as "720h" or "1h30m", and returns it in
+// seconds. Negative lengths and durations which are not a whole number of
+// seconds are rejected.
+func ParsePeriodLength(s string) (int64, error) {
+	length, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		d, durationErr := time.ParseDuration(s)
+		if durationErr != nil {
+			return 0, fmt.Errorf("invalid period length %q: neither a number of seconds nor a duration", s)
+		}
+		if d%time.Second != 0 {
+			return 0, fmt.Errorf("period length %s is not a whole number of seconds", d)
+		}
+		length = int64(d / time.Second)
+	}
+
+	if length < 0 {
+		return 0, fmt.Errorf("period length cannot be negative: %s", s)
+	}
+
+	return length, nil
+}
+
+// periodJSON is the JSON representation of a Period. The length is read either
+// as a number or as a string, holding a number of seconds or a duration.
+type periodJSON struct {
+	Length json.RawMessage `json:"length,omitempty"`
+	Amount sdk.Coins       `json:"amount"`
+}
+
+// MarshalJSON marshals the period as proto JSON does, with its length as a
+// string of seconds, so that the genesis and legacy Amino JSON representations
+// of the periods are unchanged.
+func (p Period) MarshalJSON() ([]byte, error) {
+	length, err := json.Marshal(strconv.FormatInt(p.Length, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(periodJSON{Length: length, Amount: p.Amount})
+}
+
+// UnmarshalJSON unmarshals a period whose length is either an integer number of
+// seconds, as a number or a string, or a duration string such as "720h". The
+// length is normalized to seconds.
+func (p *Period) UnmarshalJSON(bz []byte) error {
+	var raw periodJSON
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	var length int64
+	if len(raw.Length) > 0 && !bytes.Equal(raw.Length, []byte("null")) {
+		s := string(raw.Length)
+		if raw.Length[0] == '"' {
+			if err := json.Unmarshal(raw.Length, &s); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if length, err = ParsePeriodLength(s); err != nil {
+			return err
+		}
+	}
+
+	*p = Period{Length: length, Amount: raw.Amount}
+	return nil
+}
+
+// UnmarshalJSONPB implements the jsonpb.JSONPBUnmarshaler interface, so that the
+// periods of the genesis accounts accept duration strings as well.
+func (p *Period) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, bz []byte) error {
+	return p.UnmarshalJSON(bz)
+}
+
 // TotalLength return the total length in seconds for a period
 func (p Periods) TotalLength() int64 {
 	var total int64
@@ -25,6 +118,30 @@ func (p Periods) TotalLength() int64 {
 	return total
 }
 
+// EndTime returns the end time of a schedule of the periods starting at
+// startTime. Unlike TotalLength, the sum of the lengths is checked: it returns
+// an error if a period has a negative length, if the total length exceeds
+// MaxScheduleLength or if the end time overflows.
+func (p Periods) EndTime(startTime int64) (int64, error) {
+	var total int64
+	for i, period := range p {
+		if period.Length < 0 {
+			return 0, fmt.Errorf("period #%d has a negative length: %d", i, period.Length)
+		}
+		if period.Length > MaxScheduleLength-total {
+			return 0, fmt.Errorf("total length of vesting periods exceeds the maximum of %d seconds", MaxScheduleLength)
+		}
+		total += period.Length
+	}
+
+	endTime := startTime + total
+	if endTime < startTime {
+		return 0, errors.New("vesting end time overflows")
+	}
+
+	return endTime, nil
+}
+
 // TotalDuration returns the total duration of the period
 func (p Periods) TotalDuration() time.Duration {
 	len := p.TotalLength()
@@ -33,11 +150,48 @@ func (p Periods) TotalDuration() time.Duration {
 
 // TotalDuration returns the sum of coins for the period
 func (p Periods) TotalAmount() sdk.Coins {
-	total := sdk.Coins{}
+	// accumulate in place to avoid allocating a new sum for every period
+	sums := make(map[string]*big.Int)
 	for _, period := range p {
-		total = total.Add(period.Amount...)
+		for _, coin := range period.Amount {
+			sum, ok := sums[coin.Denom]
+			if !ok {
+				sum = new(big.Int)
+				sums[coin.Denom] = sum
+			}
+			sum.Add(sum, coin.Amount.BigIntMut())
+		}
 	}
-	return total
+
+	total := make(sdk.Coins, 0, len(sums))
+	for denom, sum := range sums {
+		if sum.Sign() != 0 {
+			total = append(total, sdk.NewCoin(denom, math.NewIntFromBigIntMut(sum)))
+		}
+	}
+	return total.Sort()
+}
+
+// elapsedCount returns the number of leading periods whose full length has
+// elapsed after the given number of seconds. Since period lengths are
+// non-negative, the cumulative end times are sorted and are binary searched.
+func (p Periods) elapsedCount(elapsedSeconds int64) int {
+	ends := p.cumulativeEnds()
+	return sort.Search(len(ends), func(i int) bool {
+		return ends[i] > elapsedSeconds
+	})
+}
+
+// cumulativeEnds returns the end time of each period, relative to the start of
+// the schedule.
+func (p Periods) cumulativeEnds() []int64 {
+	ends := make([]int64, len(p))
+	var end int64
+	for i, period := range p {
+		end += period.Length
+		ends[i] = end
+	}
+	return ends
 }
 
 // String implements the fmt.Stringer interface
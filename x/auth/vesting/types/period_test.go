@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestParsePeriodLength(t *testing.T) {
+	testCases := []struct {
+		input     string
+		expLength int64
+		expErr    string
+	}{
+		{input: "2629746", expLength: 2629746},
+		{input: "1h30m", expLength: 5400},
+		{input: "720h", expLength: 2592000},
+		{input: "0", expLength: 0},
+		{input: "-1", expErr: "cannot be negative"},
+		{input: "-1h", expErr: "cannot be negative"},
+		{input: "1.5s", expErr: "not a whole number of seconds"},
+		{input: "one month", expErr: "neither a number of seconds nor a duration"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			length, err := types.ParsePeriodLength(tc.input)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expLength, length)
+		})
+	}
+}
+
+func TestPeriodUnmarshalJSON(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name      string
+		input     string
+		expLength int64
+		expErr    string
+	}{
+		{name: "number", input: `{"length":2629746,"amount":[{"denom":"stake","amount":"100"}]}`, expLength: 2629746},
+		{name: "string of seconds", input: `{"length":"2629746","amount":[{"denom":"stake","amount":"100"}]}`, expLength: 2629746},
+		{name: "duration", input: `{"length":"1h30m","amount":[{"denom":"stake","amount":"100"}]}`, expLength: 5400},
+		{name: "negative number", input: `{"length":-5,"amount":[{"denom":"stake","amount":"100"}]}`, expErr: "cannot be negative"},
+		{name: "negative duration", input: `{"length":"-1h30m","amount":[{"denom":"stake","amount":"100"}]}`, expErr: "cannot be negative"},
+		{name: "unknown field", input: `{"length":"1h","amount":[],"coins":"100stake"}`, expErr: "unknown field"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var period types.Period
+			err := json.Unmarshal([]byte(tc.input), &period)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.Period{Length: tc.expLength, Amount: amount}, period)
+		})
+	}
+}
+
+func TestPeriodicVestingAccountJSONDurations(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, vesting.AppModule{})
+	addr := sdk.AccAddress([]byte("addr_________________"))
+	acc, err := types.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 300)), 1000, types.Periods{
+		{Length: 2629746, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))},
+		{Length: 5400, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 200))},
+	})
+	require.NoError(t, err)
+
+	// the genesis and legacy Amino JSON representations are unchanged
+	periodsJSON := `"vesting_periods":[{"length":"2629746","amount":[{"denom":"stake","amount":"100"}]},{"length":"5400","amount":[{"denom":"stake","amount":"200"}]}]`
+	genesisJSON := `{"@type":"/cosmos.vesting.v1beta1.PeriodicVestingAccount","base_vesting_account":{"base_account":{"address":"cosmos1v9jxgujlta047h6lta047h6lta047h6ltu0zup00","pub_key":null,"account_number":"0","sequence":"0"},"original_vesting":[{"denom":"stake","amount":"300"}],"delegated_free":[],"delegated_vesting":[],"end_time":"2636146","proportional_undelegation":false},"start_time":"1000",` + periodsJSON + `}`
+	bz, err := encCfg.Codec.MarshalInterfaceJSON(acc)
+	require.NoError(t, err)
+	require.Equal(t, genesisJSON, string(bz))
+
+	aminoJSON := `{"type":"cosmos-sdk/PeriodicVestingAccount","value":{"base_vesting_account":{"base_account":{"address":"cosmos1v9jxgujlta047h6lta047h6lta047h6ltu0zup00"},"original_vesting":[{"denom":"stake","amount":"300"}],"delegated_free":[],"delegated_vesting":[],"end_time":"2636146"},"start_time":"1000",` + periodsJSON + `}}`
+	bz, err = encCfg.Amino.MarshalJSON(acc)
+	require.NoError(t, err)
+	require.Equal(t, aminoJSON, string(bz))
+
+	// the periods of a genesis account can be written as durations
+	withDurations := `"vesting_periods":[{"length":"2629746s","amount":[{"denom":"stake","amount":"100"}]},{"length":"1h30m","amount":[{"denom":"stake","amount":"200"}]}]`
+	var decoded sdk.AccountI
+	require.NoError(t, encCfg.Codec.UnmarshalInterfaceJSON([]byte(strings.Replace(genesisJSON, periodsJSON, withDurations, 1)), &decoded))
+	require.Equal(t, acc, decoded)
+
+	invalid := strings.Replace(genesisJSON, `"length":"5400"`, `"length":"-90m"`, 1)
+	require.ErrorContains(t, encCfg.Codec.UnmarshalInterfaceJSON([]byte(invalid), &decoded), "cannot be negative")
+}
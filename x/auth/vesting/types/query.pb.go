@@ -0,0 +1,1302 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/vesting/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryVestingBalancesRequest is the request type for the Query/VestingBalances RPC method.
+type QueryVestingBalancesRequest struct {
+	// address is the address of the account to query.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVestingBalancesRequest) Reset()         { *m = QueryVestingBalancesRequest{} }
+func (m *QueryVestingBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBalancesRequest) ProtoMessage()    {}
+func (*QueryVestingBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{0}
+}
+func (m *QueryVestingBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBalancesRequest.Merge(m, src)
+}
+func (m *QueryVestingBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryVestingBalancesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryVestingBalancesResponse is the response type for the Query/VestingBalances RPC method.
+// It is empty for accounts which are not vesting accounts.
+type QueryVestingBalancesResponse struct {
+	// original_vesting is the amount of coins initially vesting.
+	OriginalVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=original_vesting,json=originalVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"original_vesting"`
+	// delegated_free is the amount of delegated coins which were vested at the
+	// time of delegation.
+	DelegatedFree github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=delegated_free,json=delegatedFree,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_free"`
+	// delegated_vesting is the amount of delegated coins which were vesting at
+	// the time of delegation.
+	DelegatedVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegated_vesting,json=delegatedVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_vesting"`
+	// vested is the amount of coins vested at the current block time.
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// vesting is the amount of coins still vesting at the current block time.
+	Vesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=vesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting"`
+	// locked is the amount of coins not spendable at the current block time,
+	// being the vesting coins which are not delegated.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// start_time is the vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the vesting end time, as unix timestamp (in seconds).
+	EndTime int64 `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// remaining_periods are the periods of a periodic vesting account which are
+	// not unlocked yet at the current block time, in unlock order.
+	RemainingPeriods []VestingUnlock `protobuf:"bytes,9,rep,name=remaining_periods,json=remainingPeriods,proto3" json:"remaining_periods"`
+}
+
+func (m *QueryVestingBalancesResponse) Reset()         { *m = QueryVestingBalancesResponse{} }
+func (m *QueryVestingBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBalancesResponse) ProtoMessage()    {}
+func (*QueryVestingBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{1}
+}
+func (m *QueryVestingBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBalancesResponse.Merge(m, src)
+}
+func (m *QueryVestingBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryVestingBalancesResponse) GetOriginalVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OriginalVesting
+	}
+	return nil
+}
+
+func (m *QueryVestingBalancesResponse) GetDelegatedFree() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedFree
+	}
+	return nil
+}
+
+func (m *QueryVestingBalancesResponse) GetDelegatedVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedVesting
+	}
+	return nil
+}
+
+func (m *QueryVestingBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryVestingBalancesResponse) GetVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *QueryVestingBalancesResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryVestingBalancesResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryVestingBalancesResponse) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryVestingBalancesResponse) GetRemainingPeriods() []VestingUnlock {
+	if m != nil {
+		return m.RemainingPeriods
+	}
+	return nil
+}
+
+// VestingUnlock defines an amount of coins unlocking at a given time.
+type VestingUnlock struct {
+	// unlock_time is the time at which the coins unlock, as unix timestamp (in
+	// seconds).
+	UnlockTime int64 `protobuf:"varint,1,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// amount is the amount of coins unlocking.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *VestingUnlock) Reset()         { *m = VestingUnlock{} }
+func (m *VestingUnlock) String() string { return proto.CompactTextString(m) }
+func (*VestingUnlock) ProtoMessage()    {}
+func (*VestingUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{2}
+}
+func (m *VestingUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingUnlock.Merge(m, src)
+}
+func (m *VestingUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingUnlock proto.InternalMessageInfo
+
+func (m *VestingUnlock) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *VestingUnlock) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryVestingBalancesRequest)(nil), "cosmos.vesting.v1beta1.QueryVestingBalancesRequest")
+	proto.RegisterType((*QueryVestingBalancesResponse)(nil), "cosmos.vesting.v1beta1.QueryVestingBalancesResponse")
+	proto.RegisterType((*VestingUnlock)(nil), "cosmos.vesting.v1beta1.VestingUnlock")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/vesting/v1beta1/query.proto", fileDescriptor_94f6d251f3006c48)
+}
+
+var fileDescriptor_94f6d251f3006c48 = []byte{
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0xb4, 0x36, 0x69, 0xa6, 0xd6, 0xb6, 0x43, 0x91, 0x6d, 0x5a, 0xb7, 0x35, 0x20, 0x84,
+	0x42, 0x77, 0x68, 0xab, 0x27, 0x2f, 0x1a, 0xa1, 0x67, 0x1b, 0x7f, 0x1c, 0x04, 0x09, 0x93, 0xdd,
+	0xcf, 0xed, 0xd0, 0xec, 0x4c, 0xba, 0x33, 0x09, 0x86, 0xe2, 0xc5, 0x53, 0x0f, 0x22, 0x82, 0x37,
+	0xff, 0x02, 0xd1, 0x4b, 0x0f, 0x9e, 0x3d, 0xf7, 0x58, 0x14, 0xc1, 0x93, 0x4a, 0x2b, 0xf4, 0xdf,
+	0x90, 0x9d, 0x99, 0xa4, 0x54, 0x5a, 0xc1, 0xcb, 0x5e, 0x92, 0x65, 0xde, 0x9b, 0xf7, 0xde, 0xf7,
+	0x92, 0x7c, 0xc1, 0xd5, 0x50, 0xaa, 0x44, 0x2a, 0xda, 0x03, 0xa5, 0xb9, 0x88, 0x69, 0x6f, 0xb5,
+	0x05, 0x9a, 0xad, 0xd2, 0x9d, 0x2e, 0xa4, 0xfd, 0xa0, 0x93, 0x4a, 0x2d, 0xc9, 0x55, 0xcb, 0x09,
+	0x1c, 0x27, 0x70, 0x9c, 0xca, 0x6c, 0x2c, 0x63, 0x69, 0x28, 0x34, 0x7b, 0xb2, 0xec, 0xca, 0x42,
+	0x2c, 0x65, 0xdc, 0x06, 0xca, 0x3a, 0x9c, 0x32, 0x21, 0xa4, 0x66, 0x9a, 0x4b, 0xa1, 0x1c, 0xea,
+	0x3b, 0xbf, 0x16, 0x53, 0x30, 0x34, 0x0b, 0x25, 0x17, 0x0e, 0x9f, 0x77, 0xb8, 0xf1, 0xa7, 0xbd,
+	0x33, 0x41, 0x2a, 0x73, 0x16, 0x6c, 0x5a, 0x4f, 0x97, 0xca, 0x42, 0x33, 0x2c, 0xe1, 0x42, 0x52,
+	0xf3, 0x6a, 0x8f, 0xaa, 0x9b, 0x78, 0x7e, 0x33, 0xbb, 0xfc, 0xd8, 0xc6, 0xae, 0xb3, 0x36, 0x13,
+	0x21, 0xa8, 0x06, 0xec, 0x74, 0x41, 0x69, 0xb2, 0x86, 0x4b, 0x2c, 0x8a, 0x52, 0x50, 0xca, 0x43,
+	0x4b, 0xa8, 0x56, 0xae, 0x7b, 0x5f, 0x3e, 0xad, 0xcc, 0x3a, 0xd1, 0xbb, 0x16, 0x79, 0xa0, 0x53,
+	0x2e, 0xe2, 0xc6, 0x80, 0x58, 0xfd, 0x56, 0xc2, 0x0b, 0xe7, 0x6b, 0xaa, 0x8e, 0x14, 0x0a, 0xc8,
+	0x2b, 0x84, 0xa7, 0x65, 0xca, 0x63, 0x2e, 0x58, 0xbb, 0xe9, 0xfa, 0xf2, 0xd0, 0xd2, 0x68, 0x6d,
+	0x62, 0x6d, 0x2e, 0x70, 0xda, 0xd9, 0xe8, 0x83, 0x0e, 0x83, 0x7b, 0x92, 0x8b, 0xfa, 0xc6, 0xc1,
+	0x8f, 0xc5, 0xc2, 0x87, 0x9f, 0x8b, 0xb5, 0x98, 0xeb, 0xad, 0x6e, 0x2b, 0x08, 0x65, 0xe2, 0xa6,
+	0x73, 0x6f, 0x2b, 0x2a, 0xda, 0xa6, 0xba, 0xdf, 0x01, 0x65, 0x2e, 0xa8, 0x77, 0x27, 0xfb, 0xcb,
+	0x97, 0xdb, 0x10, 0xb3, 0xb0, 0xdf, 0xcc, 0xca, 0x53, 0xef, 0x4f, 0xf6, 0x97, 0x51, 0x63, 0x6a,
+	0x60, 0xed, 0xe2, 0x91, 0x3d, 0x84, 0xaf, 0x44, 0x90, 0x11, 0x35, 0x44, 0xcd, 0x67, 0x29, 0x80,
+	0x37, 0x92, 0x57, 0x98, 0xc9, 0xa1, 0xf1, 0x46, 0x0a, 0x40, 0x5e, 0x23, 0x3c, 0x73, 0x1a, 0x65,
+	0x50, 0xcd, 0x68, 0x5e, 0x69, 0xa6, 0x87, 0xde, 0x83, 0x6e, 0xfa, 0xb8, 0x98, 0xa5, 0x80, 0xc8,
+	0xbb, 0x94, 0x57, 0x08, 0x67, 0x48, 0x76, 0x71, 0x69, 0x50, 0xc0, 0x58, 0x5e, 0xde, 0xa5, 0xde,
+	0xe9, 0xdc, 0x6d, 0x19, 0x6e, 0x43, 0xe4, 0x15, 0x73, 0x9b, 0xdb, 0x1a, 0x92, 0x6b, 0x18, 0x2b,
+	0xcd, 0x52, 0xdd, 0xd4, 0x3c, 0x01, 0xaf, 0xb4, 0x84, 0x6a, 0xa3, 0x8d, 0xb2, 0x39, 0x79, 0xc8,
+	0x13, 0x20, 0x73, 0x78, 0x1c, 0x44, 0x64, 0xc1, 0x71, 0x03, 0x96, 0x40, 0x44, 0x06, 0x7a, 0x8a,
+	0x67, 0x52, 0x48, 0x18, 0x17, 0x5c, 0xc4, 0xcd, 0x0e, 0xa4, 0x5c, 0x46, 0xca, 0x2b, 0x9b, 0xfc,
+	0x37, 0x82, 0xf3, 0xd7, 0x53, 0xe0, 0x3e, 0xe8, 0x47, 0x22, 0x73, 0xaf, 0x97, 0xb3, 0x59, 0xdc,
+	0x77, 0x61, 0x28, 0x75, 0xdf, 0x2a, 0x55, 0x3f, 0x22, 0x3c, 0x79, 0x86, 0x4e, 0x16, 0xf1, 0x44,
+	0xd7, 0x3c, 0xd9, 0x38, 0xc8, 0xc4, 0xc1, 0xf6, 0xc8, 0x24, 0xea, 0xe3, 0x22, 0x4b, 0x64, 0x57,
+	0xe8, 0xfc, 0x7e, 0x51, 0xce, 0x70, 0xed, 0x00, 0xe1, 0x31, 0xb3, 0x85, 0xc8, 0x67, 0x84, 0xa7,
+	0xfe, 0x5a, 0x45, 0x64, 0xfd, 0xa2, 0x3e, 0xfe, 0xb1, 0x0c, 0x2b, 0x37, 0xff, 0xef, 0x92, 0xdd,
+	0x76, 0xd5, 0x3b, 0x7b, 0x59, 0xae, 0x97, 0x5f, 0x7f, 0xbf, 0x1d, 0xb9, 0x45, 0xd6, 0xe9, 0x05,
+	0x7f, 0x25, 0x2c, 0x0c, 0xb3, 0xd8, 0x8a, 0xee, 0xba, 0x35, 0xfa, 0x82, 0xb6, 0x9c, 0x52, 0xfd,
+	0xf6, 0xc1, 0x91, 0x8f, 0x0e, 0x8f, 0x7c, 0xf4, 0xeb, 0xc8, 0x47, 0x6f, 0x8e, 0xfd, 0xc2, 0xe1,
+	0xb1, 0x5f, 0xf8, 0x7e, 0xec, 0x17, 0x9e, 0x5c, 0xb7, 0x6a, 0x2a, 0xda, 0x0e, 0xb8, 0xa4, 0xcf,
+	0x29, 0xeb, 0xea, 0xad, 0xa1, 0xb4, 0xe9, 0xaa, 0x55, 0x34, 0x7b, 0x7e, 0xfd, 0xcf, 0x00, 0x68,
+	0x73, 0xc5, 0xed, 0xc4, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VestingBalances returns the vesting balances of a vesting account at the
+	// current block time.
+	VestingBalances(ctx context.Context, in *QueryVestingBalancesRequest, opts ...grpc.CallOption) (*QueryVestingBalancesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VestingBalances(ctx context.Context, in *QueryVestingBalancesRequest, opts ...grpc.CallOption) (*QueryVestingBalancesResponse, error) {
+	out := new(QueryVestingBalancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Query/VestingBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VestingBalances returns the vesting balances of a vesting account at the
+	// current block time.
+	VestingBalances(context.Context, *QueryVestingBalancesRequest) (*QueryVestingBalancesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) VestingBalances(ctx context.Context, req *QueryVestingBalancesRequest) (*QueryVestingBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingBalances not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_VestingBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Query/VestingBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingBalances(ctx, req.(*QueryVestingBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.vesting.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VestingBalances",
+			Handler:    _Query_VestingBalances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/vesting/v1beta1/query.proto",
+}
+
+func (m *QueryVestingBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingPeriods) > 0 {
+		for iNdEx := len(m.RemainingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for iNdEx := len(m.DelegatedVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OriginalVesting) > 0 {
+		for iNdEx := len(m.OriginalVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.UnlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVestingBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OriginalVesting) > 0 {
+		for _, e := range m.OriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for _, e := range m.DelegatedVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if len(m.RemainingPeriods) > 0 {
+		for _, e := range m.RemainingPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockTime))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVestingBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalVesting = append(m.OriginalVesting, types.Coin{})
+			if err := m.OriginalVesting[len(m.OriginalVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingPeriods = append(m.RemainingPeriods, VestingUnlock{})
+			if err := m.RemainingPeriods[len(m.RemainingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -46,6 +46,12 @@ type MsgCreateVestingAccount struct {
 	//
 	// Since 0.51.x
 	StartTime int64 `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// clawback creates a clawback vesting account, whose vesting coins can be
+	// clawed back by the from_address. It cannot be combined with delayed.
+	Clawback bool `protobuf:"varint,7,opt,name=clawback,proto3" json:"clawback,omitempty"`
+	// proportional_undelegation sets the proportional_undelegation flag of the
+	// created account.
+	ProportionalUndelegation bool `protobuf:"varint,8,opt,name=proportional_undelegation,json=proportionalUndelegation,proto3" json:"proportional_undelegation,omitempty"`
 }
 
 func (m *MsgCreateVestingAccount) Reset()         { *m = MsgCreateVestingAccount{} }
@@ -123,6 +129,20 @@ func (m *MsgCreateVestingAccount) GetStartTime() int64 {
 	return 0
 }
 
+func (m *MsgCreateVestingAccount) GetClawback() bool {
+	if m != nil {
+		return m.Clawback
+	}
+	return false
+}
+
+func (m *MsgCreateVestingAccount) GetProportionalUndelegation() bool {
+	if m != nil {
+		return m.ProportionalUndelegation
+	}
+	return false
+}
+
 // MsgCreateVestingAccountResponse defines the Msg/CreateVestingAccount response type.
 type MsgCreateVestingAccountResponse struct {
 }
@@ -168,6 +188,9 @@ type MsgCreatePermanentLockedAccount struct {
 	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
 	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
 	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// proportional_undelegation sets the proportional_undelegation flag of the
+	// created account.
+	ProportionalUndelegation bool `protobuf:"varint,4,opt,name=proportional_undelegation,json=proportionalUndelegation,proto3" json:"proportional_undelegation,omitempty"`
 }
 
 func (m *MsgCreatePermanentLockedAccount) Reset()         { *m = MsgCreatePermanentLockedAccount{} }
@@ -224,6 +247,13 @@ func (m *MsgCreatePermanentLockedAccount) GetAmount() github_com_cosmos_cosmos_s
 	return nil
 }
 
+func (m *MsgCreatePermanentLockedAccount) GetProportionalUndelegation() bool {
+	if m != nil {
+		return m.ProportionalUndelegation
+	}
+	return false
+}
+
 // MsgCreatePermanentLockedAccountResponse defines the Msg/CreatePermanentLockedAccount response type.
 //
 // Since: cosmos-sdk 0.46
@@ -275,6 +305,9 @@ type MsgCreatePeriodicVestingAccount struct {
 	// start of vesting as unix time (in seconds).
 	StartTime      int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	VestingPeriods []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+	// proportional_undelegation sets the proportional_undelegation flag of the
+	// created account.
+	ProportionalUndelegation bool `protobuf:"varint,5,opt,name=proportional_undelegation,json=proportionalUndelegation,proto3" json:"proportional_undelegation,omitempty"`
 }
 
 func (m *MsgCreatePeriodicVestingAccount) Reset()         { *m = MsgCreatePeriodicVestingAccount{} }
@@ -338,6 +371,13 @@ func (m *MsgCreatePeriodicVestingAccount) GetVestingPeriods() []Period {
 	return nil
 }
 
+func (m *MsgCreatePeriodicVestingAccount) GetProportionalUndelegation() bool {
+	if m != nil {
+		return m.ProportionalUndelegation
+	}
+	return false
+}
+
 // MsgCreateVestingAccountResponse defines the Msg/CreatePeriodicVestingAccount
 // response type.
 //
@@ -380,6 +420,236 @@ func (m *MsgCreatePeriodicVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse proto.InternalMessageInfo
 
+// MsgClawback defines a message that enables the funder of a clawback vesting
+// account to reclaim the coins that are still vesting.
+type MsgClawback struct {
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// to_community_pool sends the clawed back coins to the community pool
+	// instead of the funder.
+	ToCommunityPool bool `protobuf:"varint,3,opt,name=to_community_pool,json=toCommunityPool,proto3" json:"to_community_pool,omitempty"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{6}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetToCommunityPool() bool {
+	if m != nil {
+		return m.ToCommunityPool
+	}
+	return false
+}
+
+// MsgClawbackResponse defines the Msg/Clawback response type.
+type MsgClawbackResponse struct {
+	// clawed_back are the coins returned by the clawback.
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+	// pending are the clawed back coins still delegated, which will be returned
+	// in the same way once undelegated.
+	Pending github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending"`
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{7}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackResponse) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return nil
+}
+
+func (m *MsgClawbackResponse) GetPending() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+// MsgAddVestingGrant defines a message that enables adding a grant, funded by
+// the from_address, to an existing periodic vesting account. The periods of the
+// grant are merged into the vesting schedule of the account.
+type MsgAddVestingGrant struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// start of the grant vesting as unix time (in seconds). A zero start time
+	// starts the vesting at the block time.
+	StartTime      int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	VestingPeriods []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *MsgAddVestingGrant) Reset()         { *m = MsgAddVestingGrant{} }
+func (m *MsgAddVestingGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgAddVestingGrant) ProtoMessage()    {}
+func (*MsgAddVestingGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{8}
+}
+func (m *MsgAddVestingGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddVestingGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddVestingGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddVestingGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddVestingGrant.Merge(m, src)
+}
+func (m *MsgAddVestingGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddVestingGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddVestingGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddVestingGrant proto.InternalMessageInfo
+
+func (m *MsgAddVestingGrant) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgAddVestingGrant) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgAddVestingGrant) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgAddVestingGrant) GetVestingPeriods() []Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgAddVestingGrantResponse defines the Msg/AddVestingGrant response type.
+type MsgAddVestingGrantResponse struct {
+}
+
+func (m *MsgAddVestingGrantResponse) Reset()         { *m = MsgAddVestingGrantResponse{} }
+func (m *MsgAddVestingGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddVestingGrantResponse) ProtoMessage()    {}
+func (*MsgAddVestingGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{9}
+}
+func (m *MsgAddVestingGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddVestingGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddVestingGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddVestingGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddVestingGrantResponse.Merge(m, src)
+}
+func (m *MsgAddVestingGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddVestingGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddVestingGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddVestingGrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccount")
 	proto.RegisterType((*MsgCreateVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccountResponse")
@@ -387,55 +657,75 @@ func init() {
 	proto.RegisterType((*MsgCreatePermanentLockedAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePermanentLockedAccountResponse")
 	proto.RegisterType((*MsgCreatePeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccount")
 	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccountResponse")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.vesting.v1beta1.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "cosmos.vesting.v1beta1.MsgClawbackResponse")
+	proto.RegisterType((*MsgAddVestingGrant)(nil), "cosmos.vesting.v1beta1.MsgAddVestingGrant")
+	proto.RegisterType((*MsgAddVestingGrantResponse)(nil), "cosmos.vesting.v1beta1.MsgAddVestingGrantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/v1beta1/tx.proto", fileDescriptor_5338ca97811f9792) }
 
 var fileDescriptor_5338ca97811f9792 = []byte{
-	// 680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xc7, 0xb7, 0x94, 0x5f, 0x3b, 0x10, 0x0d, 0x15, 0xa5, 0x6c, 0xa4, 0x5d, 0x1a, 0x8d, 0x2b,
-	0x09, 0x6d, 0x40, 0x13, 0x92, 0xc5, 0x84, 0xb0, 0x24, 0x9e, 0x24, 0x31, 0xd5, 0x78, 0xf0, 0xb2,
-	0x99, 0x6d, 0xc7, 0x32, 0x81, 0x76, 0x36, 0x9d, 0x81, 0xb0, 0x37, 0xe2, 0xd1, 0x93, 0x37, 0x8d,
-	0xf1, 0xe0, 0xd1, 0x78, 0xe2, 0xe0, 0xbf, 0x60, 0xc2, 0x4d, 0xe2, 0xc9, 0x13, 0x1a, 0x38, 0xe0,
-	0x99, 0xbf, 0xc0, 0x4c, 0x67, 0xba, 0xee, 0xae, 0x2d, 0x0b, 0x9e, 0xbc, 0x50, 0x76, 0xde, 0xf7,
-	0xfb, 0xde, 0xeb, 0xe7, 0xcd, 0x4c, 0x81, 0xe9, 0x11, 0x1a, 0x12, 0xea, 0xec, 0x20, 0xca, 0x70,
-	0x14, 0x38, 0x3b, 0x0b, 0x0d, 0xc4, 0xe0, 0x82, 0xc3, 0x76, 0xed, 0x66, 0x4c, 0x18, 0xd1, 0x6e,
-	0x08, 0x81, 0x2d, 0x05, 0xb6, 0x14, 0x94, 0x26, 0x03, 0x12, 0x90, 0x44, 0xe2, 0xf0, 0xff, 0x84,
-	0xba, 0x64, 0xc8, 0x74, 0x0d, 0x48, 0x51, 0x3b, 0x97, 0x47, 0x70, 0x24, 0xe3, 0xd3, 0x22, 0x5e,
-	0x17, 0x46, 0x99, 0x5a, 0x84, 0x6e, 0xe5, 0x74, 0x92, 0x16, 0x16, 0xaa, 0x29, 0xa9, 0x0a, 0x29,
-	0x57, 0xf0, 0x87, 0x0c, 0x4c, 0xc0, 0x10, 0x47, 0xc4, 0x49, 0xfe, 0x8a, 0x25, 0xeb, 0xbd, 0x0a,
-	0xa6, 0xd6, 0x69, 0xb0, 0x16, 0x23, 0xc8, 0xd0, 0x33, 0x91, 0x66, 0xd5, 0xf3, 0xc8, 0x76, 0xc4,
-	0xb4, 0x65, 0x30, 0xfe, 0x22, 0x26, 0x61, 0x1d, 0xfa, 0x7e, 0x8c, 0x28, 0xd5, 0x95, 0xb2, 0x52,
-	0x29, 0xd6, 0xf4, 0x6f, 0x9f, 0xe7, 0x27, 0x65, 0x57, 0xab, 0x22, 0xf2, 0x84, 0xc5, 0x38, 0x0a,
-	0xdc, 0x31, 0xae, 0x96, 0x4b, 0xda, 0x12, 0x00, 0x8c, 0xb4, 0xad, 0x03, 0x7d, 0xac, 0x45, 0x46,
-	0x52, 0x63, 0x0b, 0x0c, 0xc3, 0x90, 0xd7, 0xd7, 0xd5, 0xb2, 0x5a, 0x19, 0x5b, 0x9c, 0xb6, 0xa5,
-	0x83, 0xf3, 0x4a, 0xd1, 0xda, 0x6b, 0x04, 0x47, 0xb5, 0x87, 0x07, 0x47, 0x66, 0xe1, 0xd3, 0x0f,
-	0xb3, 0x12, 0x60, 0xb6, 0xb1, 0xdd, 0xb0, 0x3d, 0x12, 0x4a, 0x5e, 0xf2, 0x31, 0x4f, 0xfd, 0x4d,
-	0x87, 0xb5, 0x9a, 0x88, 0x26, 0x06, 0xfa, 0xee, 0x74, 0x7f, 0x6e, 0x7c, 0x0b, 0x05, 0xd0, 0x6b,
-	0xd5, 0x39, 0x71, 0xfa, 0xf1, 0x74, 0x7f, 0x4e, 0x71, 0x65, 0x41, 0x6d, 0x1a, 0x8c, 0xa2, 0xc8,
-	0xaf, 0x33, 0x1c, 0x22, 0x7d, 0xb0, 0xac, 0x54, 0x54, 0x77, 0x04, 0x45, 0xfe, 0x53, 0x1c, 0x22,
-	0x4d, 0x07, 0x23, 0x3e, 0xda, 0x82, 0x2d, 0xe4, 0xeb, 0x43, 0x65, 0xa5, 0x32, 0xea, 0xa6, 0x3f,
-	0xb5, 0x19, 0x00, 0x28, 0x83, 0x31, 0x13, 0xb6, 0xe1, 0xc4, 0x56, 0x4c, 0x56, 0xb8, 0xb1, 0xfa,
-	0xe0, 0xd7, 0x07, 0x53, 0x79, 0xc9, 0xeb, 0x76, 0xb2, 0x7c, 0x75, 0xba, 0x3f, 0x67, 0x75, 0xf4,
-	0x98, 0x33, 0x02, 0x6b, 0x16, 0x98, 0x39, 0x21, 0x17, 0xd1, 0x26, 0x89, 0x28, 0xb2, 0xbe, 0x0e,
-	0x74, 0x68, 0x1e, 0xa3, 0x38, 0x84, 0x11, 0x8a, 0xd8, 0x23, 0xe2, 0x6d, 0x22, 0x3f, 0x9d, 0x64,
-	0x35, 0x73, 0x92, 0x53, 0x67, 0x47, 0xe6, 0xb5, 0x16, 0x0c, 0xb7, 0xaa, 0x56, 0x67, 0xd4, 0xea,
-	0x1e, 0xe4, 0xfd, 0x8c, 0x41, 0x5e, 0x3f, 0x3b, 0x32, 0x27, 0x84, 0xf3, 0x4f, 0xcc, 0xfa, 0x3f,
-	0xa6, 0x58, 0x5d, 0xc9, 0x25, 0x7e, 0x3b, 0x8b, 0x38, 0x47, 0xd6, 0x45, 0xcb, 0xba, 0x0b, 0xee,
-	0xf4, 0x01, 0xda, 0x86, 0xff, 0xa6, 0x07, 0x3e, 0x26, 0x3e, 0xf6, 0x7a, 0x8e, 0xd1, 0x6c, 0x16,
-	0xfc, 0x6e, 0xc6, 0x33, 0x7f, 0x33, 0xee, 0x84, 0xd9, 0xbd, 0xc5, 0xd4, 0x9e, 0x2d, 0xa6, 0xb9,
-	0xe0, 0xaa, 0xbc, 0x00, 0xea, 0xcd, 0xa4, 0x05, 0xaa, 0x0f, 0x26, 0xd0, 0x0d, 0x3b, 0xfb, 0x62,
-	0xb2, 0x45, 0xa7, 0xb5, 0x22, 0x27, 0x2f, 0xe0, 0x5d, 0x91, 0x12, 0x11, 0xa1, 0x09, 0xc4, 0xc2,
-	0xa5, 0x20, 0x62, 0xe2, 0xf3, 0x17, 0xcf, 0x81, 0x98, 0x01, 0x26, 0x85, 0xb8, 0xf8, 0x45, 0x05,
-	0xea, 0x3a, 0x0d, 0xb4, 0x3d, 0x05, 0x4c, 0x66, 0x5e, 0x44, 0x4e, 0xde, 0x7b, 0xe4, 0x9c, 0x8d,
-	0xd2, 0xd2, 0x25, 0x0d, 0x69, 0x2b, 0xda, 0x5b, 0x05, 0xdc, 0x3c, 0xf7, 0x24, 0xf5, 0xcf, 0x9c,
-	0x6d, 0x2c, 0xad, 0xfc, 0xa3, 0x31, 0xbb, 0xb5, 0xac, 0x7d, 0x76, 0xa1, 0xd6, 0x32, 0x8c, 0x17,
-	0x6b, 0xed, 0x9c, 0x01, 0x96, 0x86, 0xf6, 0xf8, 0x1e, 0xaa, 0x2d, 0x1f, 0x1c, 0x1b, 0xca, 0xe1,
-	0xb1, 0xa1, 0xfc, 0x3c, 0x36, 0x94, 0xd7, 0x27, 0x46, 0xe1, 0xf0, 0xc4, 0x28, 0x7c, 0x3f, 0x31,
-	0x0a, 0xcf, 0x67, 0x45, 0x01, 0xea, 0x6f, 0xda, 0x98, 0x38, 0xbb, 0x0e, 0xdc, 0x66, 0x1b, 0xed,
-	0x8f, 0x58, 0x72, 0xb2, 0x1b, 0xc3, 0xc9, 0xf7, 0xe8, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x59, 0xc3, 0x58, 0xdb, 0x6d, 0x07, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x49, 0xec, 0x4c, 0x4a, 0xa3, 0x6c, 0x43, 0xb3, 0x59, 0x35, 0x76, 0xb2, 0x80,
+	0x30, 0x46, 0xdd, 0x55, 0x53, 0xa4, 0x4a, 0x0e, 0x52, 0x14, 0x47, 0x82, 0x0b, 0x91, 0x2a, 0xf3,
+	0xe3, 0x80, 0x90, 0x56, 0xe3, 0x9d, 0xe9, 0x76, 0x15, 0xef, 0xcc, 0xb2, 0x33, 0x2e, 0xb5, 0xb8,
+	0x54, 0x3d, 0x22, 0x0e, 0x9c, 0x00, 0x71, 0xe2, 0x88, 0x38, 0x45, 0x88, 0x3f, 0xa2, 0xc7, 0x0a,
+	0x71, 0xe0, 0x14, 0x50, 0x72, 0x08, 0xe7, 0x4a, 0xdc, 0xd1, 0xec, 0xcc, 0x2e, 0x6b, 0x77, 0xed,
+	0xb8, 0x39, 0x84, 0x5e, 0xe2, 0xec, 0xbc, 0xef, 0x7b, 0xf3, 0xe6, 0xfb, 0xde, 0xbc, 0x5d, 0xd0,
+	0xf0, 0x28, 0x0b, 0x29, 0x73, 0x1e, 0x60, 0xc6, 0x03, 0xe2, 0x3b, 0x0f, 0x6e, 0xf5, 0x30, 0x87,
+	0xb7, 0x1c, 0xfe, 0xd0, 0x8e, 0x62, 0xca, 0xa9, 0x7e, 0x5d, 0x02, 0x6c, 0x05, 0xb0, 0x15, 0xc0,
+	0x5c, 0xf5, 0xa9, 0x4f, 0x13, 0x88, 0x23, 0xfe, 0x93, 0x68, 0xb3, 0xae, 0xd2, 0xf5, 0x20, 0xc3,
+	0x59, 0x2e, 0x8f, 0x06, 0x44, 0xc5, 0xd7, 0x65, 0xdc, 0x95, 0x44, 0x95, 0x5a, 0x86, 0x5e, 0x9f,
+	0x50, 0x49, 0xba, 0xb1, 0x44, 0xad, 0x29, 0x54, 0xc8, 0x04, 0x42, 0xfc, 0xa8, 0xc0, 0x0a, 0x0c,
+	0x03, 0x42, 0x9d, 0xe4, 0xaf, 0x5c, 0xb2, 0xfe, 0xa9, 0x80, 0xb5, 0x03, 0xe6, 0xef, 0xc7, 0x18,
+	0x72, 0xfc, 0x89, 0x4c, 0xb3, 0xe7, 0x79, 0x74, 0x40, 0xb8, 0xbe, 0x03, 0xae, 0xdc, 0x8b, 0x69,
+	0xe8, 0x42, 0x84, 0x62, 0xcc, 0x98, 0xa1, 0x6d, 0x6a, 0xcd, 0xc5, 0x8e, 0xf1, 0xdb, 0xaf, 0x37,
+	0x57, 0x55, 0x55, 0x7b, 0x32, 0xf2, 0x21, 0x8f, 0x03, 0xe2, 0x77, 0x97, 0x04, 0x5a, 0x2d, 0xe9,
+	0x77, 0x00, 0xe0, 0x34, 0xa3, 0x96, 0xcf, 0xa1, 0x2e, 0x72, 0x9a, 0x12, 0x87, 0x60, 0x01, 0x86,
+	0x62, 0x7f, 0xa3, 0xb2, 0x59, 0x69, 0x2e, 0x6d, 0xaf, 0xdb, 0x8a, 0x21, 0xf4, 0x4a, 0xa5, 0xb5,
+	0xf7, 0x69, 0x40, 0x3a, 0xef, 0x3d, 0x39, 0x6e, 0x94, 0x7e, 0xfe, 0xb3, 0xd1, 0xf4, 0x03, 0x7e,
+	0x7f, 0xd0, 0xb3, 0x3d, 0x1a, 0x2a, 0xbd, 0xd4, 0xcf, 0x4d, 0x86, 0x0e, 0x1d, 0x3e, 0x8c, 0x30,
+	0x4b, 0x08, 0xec, 0x87, 0xb3, 0xa3, 0xd6, 0x95, 0x3e, 0xf6, 0xa1, 0x37, 0x74, 0x85, 0xe2, 0xec,
+	0xa7, 0xb3, 0xa3, 0x96, 0xd6, 0x55, 0x1b, 0xea, 0xeb, 0xa0, 0x86, 0x09, 0x72, 0x79, 0x10, 0x62,
+	0x63, 0x6e, 0x53, 0x6b, 0x56, 0xba, 0x55, 0x4c, 0xd0, 0x47, 0x41, 0x88, 0x75, 0x03, 0x54, 0x11,
+	0xee, 0xc3, 0x21, 0x46, 0xc6, 0xfc, 0xa6, 0xd6, 0xac, 0x75, 0xd3, 0x47, 0x7d, 0x03, 0x00, 0xc6,
+	0x61, 0xcc, 0x25, 0x6d, 0x21, 0xa1, 0x2d, 0x26, 0x2b, 0x09, 0xd1, 0x04, 0x35, 0xaf, 0x0f, 0xbf,
+	0xe8, 0x41, 0xef, 0xd0, 0xa8, 0x26, 0xcc, 0xec, 0x59, 0xdf, 0x01, 0xeb, 0x51, 0x4c, 0x23, 0x1a,
+	0xf3, 0x80, 0x12, 0xd8, 0x77, 0x07, 0x04, 0x61, 0x51, 0x9d, 0x78, 0x34, 0x6a, 0x09, 0xd8, 0xc8,
+	0x03, 0x3e, 0xce, 0xc5, 0xdb, 0xef, 0xfe, 0xfd, 0x63, 0x43, 0x7b, 0x2c, 0x0e, 0x94, 0x37, 0xe9,
+	0xab, 0xb3, 0xa3, 0x96, 0x95, 0x3b, 0xfc, 0x04, 0x6f, 0xad, 0x2d, 0xd0, 0x98, 0x10, 0xea, 0x62,
+	0x16, 0x51, 0xc2, 0xb0, 0xf5, 0x75, 0x25, 0x87, 0xb9, 0x8b, 0xe3, 0x10, 0x12, 0x4c, 0xf8, 0x07,
+	0xd4, 0x3b, 0xc4, 0x28, 0x6d, 0x91, 0x76, 0x61, 0x8b, 0xac, 0x3d, 0x3b, 0x6e, 0x5c, 0x1b, 0xc2,
+	0xb0, 0xdf, 0xb6, 0xf2, 0x51, 0x6b, 0xb4, 0x43, 0xde, 0x29, 0xe8, 0x90, 0x57, 0x9f, 0x1d, 0x37,
+	0x56, 0x24, 0xf3, 0xbf, 0x98, 0xf5, 0x92, 0xb4, 0xc7, 0x54, 0xbb, 0xe6, 0xce, 0xb1, 0x6b, 0x77,
+	0xa2, 0x5d, 0x6f, 0x14, 0xd9, 0x25, 0xf4, 0x1e, 0x91, 0xda, 0x7a, 0x0b, 0xbc, 0x79, 0x8e, 0x1b,
+	0x99, 0x73, 0xbf, 0x97, 0x47, 0x9d, 0x0b, 0x28, 0x0a, 0xbc, 0xb1, 0xcb, 0xbd, 0x55, 0xe4, 0xdc,
+	0xa8, 0x41, 0x1b, 0xcf, 0x1b, 0x94, 0x77, 0x62, 0xb4, 0xf1, 0x2b, 0xe3, 0x8d, 0xdf, 0x05, 0xcb,
+	0x6a, 0x2c, 0xb9, 0x51, 0x52, 0x02, 0x33, 0xe6, 0x12, 0xc7, 0xea, 0x76, 0xf1, 0xb8, 0xb4, 0x65,
+	0xa5, 0x9d, 0x45, 0x61, 0x9b, 0x54, 0xfe, 0xaa, 0x82, 0xc8, 0x08, 0x9b, 0xee, 0xc0, 0xfc, 0x0c,
+	0x0e, 0x94, 0x5e, 0xc8, 0x81, 0x80, 0x22, 0xa1, 0xda, 0x04, 0x07, 0x0a, 0x54, 0xcd, 0x1c, 0x38,
+	0xd6, 0xc0, 0x92, 0xc0, 0xa6, 0x37, 0x7d, 0x17, 0x5c, 0xbd, 0x27, 0x8a, 0x8d, 0x67, 0x1e, 0xa6,
+	0xaf, 0x48, 0x7c, 0x2a, 0xf6, 0x36, 0xa8, 0xce, 0x3a, 0x4b, 0x53, 0xa0, 0xde, 0x02, 0x2b, 0x9c,
+	0xba, 0x1e, 0x0d, 0xc3, 0x01, 0x09, 0xf8, 0xd0, 0x8d, 0x28, 0xed, 0x27, 0x3e, 0xd5, 0xba, 0xcb,
+	0x9c, 0xee, 0xa7, 0xeb, 0x77, 0x29, 0xed, 0xb7, 0x6d, 0x21, 0xcc, 0x58, 0x8d, 0x42, 0x9a, 0xeb,
+	0x63, 0xd2, 0xa8, 0x03, 0x59, 0xdf, 0x95, 0xc1, 0xb5, 0xdc, 0x73, 0x7a, 0x70, 0xfd, 0xb1, 0x06,
+	0x96, 0xc4, 0x7c, 0xc3, 0xc8, 0x15, 0xeb, 0x86, 0x76, 0x59, 0x97, 0x14, 0xc8, 0x5d, 0x3b, 0x42,
+	0xed, 0x2f, 0x41, 0x35, 0xc2, 0x04, 0x05, 0xc4, 0x37, 0xca, 0x97, 0xb5, 0x7f, 0xba, 0xa3, 0xf5,
+	0x4b, 0x19, 0xe8, 0x07, 0xcc, 0xdf, 0x43, 0x48, 0xf5, 0xc6, 0xfb, 0x31, 0xfc, 0xdf, 0x5e, 0xa6,
+	0x97, 0x7f, 0x47, 0xdb, 0xb7, 0x0b, 0xaf, 0xd8, 0xc6, 0x68, 0x1f, 0x8d, 0xa9, 0x63, 0xdd, 0x00,
+	0xe6, 0xf3, 0xab, 0x69, 0x53, 0x6d, 0x7f, 0x3b, 0x0f, 0x2a, 0x07, 0xcc, 0xd7, 0x1f, 0x69, 0x60,
+	0xb5, 0xf0, 0x4b, 0xc5, 0x99, 0x54, 0xee, 0x84, 0x77, 0x9c, 0x79, 0xe7, 0x05, 0x09, 0x59, 0x7f,
+	0x7f, 0xaf, 0x81, 0x1b, 0x53, 0xdf, 0x88, 0xe7, 0x67, 0x2e, 0x26, 0x9a, 0xbb, 0x17, 0x24, 0x16,
+	0x97, 0x56, 0x34, 0xf2, 0x67, 0x2a, 0xad, 0x80, 0x68, 0xee, 0x5e, 0x90, 0x98, 0x95, 0xf6, 0x19,
+	0xa8, 0x65, 0xa3, 0xf0, 0xb5, 0x69, 0xc9, 0x14, 0xc8, 0x7c, 0x7b, 0x06, 0x50, 0x96, 0xfd, 0x73,
+	0xb0, 0x3c, 0x7e, 0xdb, 0x5a, 0x53, 0xf8, 0x63, 0x58, 0x73, 0x7b, 0x76, 0x6c, 0xba, 0xa5, 0x39,
+	0xff, 0x48, 0xf4, 0x7e, 0x67, 0xe7, 0xc9, 0x49, 0x5d, 0x7b, 0x7a, 0x52, 0xd7, 0xfe, 0x3a, 0xa9,
+	0x6b, 0xdf, 0x9c, 0xd6, 0x4b, 0x4f, 0x4f, 0xeb, 0xa5, 0x3f, 0x4e, 0xeb, 0xa5, 0x4f, 0xb7, 0x64,
+	0x4e, 0x86, 0x0e, 0xed, 0x80, 0x3a, 0x0f, 0x1d, 0x38, 0xe0, 0xf7, 0xb3, 0xcf, 0xf6, 0x64, 0x9a,
+	0xf4, 0x16, 0x92, 0x2f, 0xf0, 0xdb, 0xff, 0x0e, 0x00, 0x6b, 0x07, 0x8d, 0xb1, 0x5f, 0x0c, 0x00,
+	0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
@@ -480,6 +770,12 @@ func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
 	if this.StartTime != that1.StartTime {
 		return false
 	}
+	if this.Clawback != that1.Clawback {
+		return false
+	}
+	if this.ProportionalUndelegation != that1.ProportionalUndelegation {
+		return false
+	}
 	return true
 }
 func (this *MsgCreatePermanentLockedAccount) Equal(that interface{}) bool {
@@ -515,6 +811,9 @@ func (this *MsgCreatePermanentLockedAccount) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ProportionalUndelegation != that1.ProportionalUndelegation {
+		return false
+	}
 	return true
 }
 
@@ -543,6 +842,12 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// Clawback defines a method that enables the funder of a clawback vesting
+	// account to reclaim the coins that are still vesting.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// AddVestingGrant defines a method that enables adding a grant, funded by the
+	// sender, to an existing periodic vesting account.
+	AddVestingGrant(ctx context.Context, in *MsgAddVestingGrant, opts ...grpc.CallOption) (*MsgAddVestingGrantResponse, error)
 }
 
 type msgClient struct {
@@ -580,6 +885,24 @@ func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCre
 	return out, nil
 }
 
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddVestingGrant(ctx context.Context, in *MsgAddVestingGrant, opts ...grpc.CallOption) (*MsgAddVestingGrantResponse, error) {
+	out := new(MsgAddVestingGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/AddVestingGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateVestingAccount defines a method that enables creating a vesting
@@ -595,6 +918,12 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// Clawback defines a method that enables the funder of a clawback vesting
+	// account to reclaim the coins that are still vesting.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// AddVestingGrant defines a method that enables adding a grant, funded by the
+	// sender, to an existing periodic vesting account.
+	AddVestingGrant(context.Context, *MsgAddVestingGrant) (*MsgAddVestingGrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -610,6 +939,12 @@ func (*UnimplementedMsgServer) CreatePermanentLockedAccount(ctx context.Context,
 func (*UnimplementedMsgServer) CreatePeriodicVestingAccount(ctx context.Context, req *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (*UnimplementedMsgServer) AddVestingGrant(ctx context.Context, req *MsgAddVestingGrant) (*MsgAddVestingGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVestingGrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -669,6 +1004,42 @@ func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddVestingGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddVestingGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddVestingGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/AddVestingGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddVestingGrant(ctx, req.(*MsgAddVestingGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.vesting.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -685,6 +1056,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePeriodicVestingAccount",
 			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
 		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "AddVestingGrant",
+			Handler:    _Msg_AddVestingGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -710,6 +1089,26 @@ func (m *MsgCreateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ProportionalUndelegation {
+		i--
+		if m.ProportionalUndelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Clawback {
+		i--
+		if m.Clawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.StartTime != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
 		i--
@@ -804,6 +1203,16 @@ func (m *MsgCreatePermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.ProportionalUndelegation {
+		i--
+		if m.ProportionalUndelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -878,6 +1287,16 @@ func (m *MsgCreatePeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.ProportionalUndelegation {
+		i--
+		if m.ProportionalUndelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -937,21 +1356,198 @@ func (m *MsgCreatePeriodicVestingAccountResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToCommunityPool {
+		i--
+		if m.ToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddVestingGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddVestingGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddVestingGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddVestingGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddVestingGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddVestingGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.FromAddress)
@@ -977,6 +1573,12 @@ func (m *MsgCreateVestingAccount) Size() (n int) {
 	if m.StartTime != 0 {
 		n += 1 + sovTx(uint64(m.StartTime))
 	}
+	if m.Clawback {
+		n += 2
+	}
+	if m.ProportionalUndelegation {
+		n += 2
+	}
 	return n
 }
 
@@ -1009,6 +1611,9 @@ func (m *MsgCreatePermanentLockedAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.ProportionalUndelegation {
+		n += 2
+	}
 	return n
 }
 
@@ -1044,25 +1649,618 @@ func (m *MsgCreatePeriodicVestingAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	if m.ProportionalUndelegation {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToCommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddVestingGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddVestingGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delayed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Clawback = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProportionalUndelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProportionalUndelegation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProportionalUndelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProportionalUndelegation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCreatePeriodicVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1085,10 +2283,10 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1156,8 +2354,27 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1184,33 +2401,14 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			m.EndTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProportionalUndelegation", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1227,26 +2425,7 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Delayed = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.ProportionalUndelegation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1268,7 +2447,7 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,10 +2470,10 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1318,7 +2497,7 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,15 +2520,15 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1377,11 +2556,11 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1409,13 +2588,13 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCommunityPool", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1425,26 +2604,12 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ToCommunityPool = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1466,7 +2631,7 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,12 +2654,80 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, types.Coin{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1516,7 +2749,7 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgAddVestingGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1539,10 +2772,10 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddVestingGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddVestingGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1683,7 +2916,7 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddVestingGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1706,10 +2939,10 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddVestingGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddVestingGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6,6 +6,7 @@ package types
 import (
 	types "cosmossdk.io/x/auth/types"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -36,11 +37,14 @@ type BaseVestingAccount struct {
 	DelegatedVesting   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=delegated_vesting,json=delegatedVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_vesting"`
 	// Vesting end time, as unix timestamp (in seconds).
 	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// proportional_undelegation, when set, attributes undelegations to the
+	// delegated free and delegated vesting coins proportionally to their current
+	// amounts, instead of to the delegated free coins first.
+	ProportionalUndelegation bool `protobuf:"varint,6,opt,name=proportional_undelegation,json=proportionalUndelegation,proto3" json:"proportional_undelegation,omitempty"`
 }
 
-func (m *BaseVestingAccount) Reset()         { *m = BaseVestingAccount{} }
-func (m *BaseVestingAccount) String() string { return proto.CompactTextString(m) }
-func (*BaseVestingAccount) ProtoMessage()    {}
+func (m *BaseVestingAccount) Reset()      { *m = BaseVestingAccount{} }
+func (*BaseVestingAccount) ProtoMessage() {}
 func (*BaseVestingAccount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_89e80273ca606d6e, []int{0}
 }
@@ -77,11 +81,14 @@ type ContinuousVestingAccount struct {
 	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
 	// Vesting start time, as unix timestamp (in seconds).
 	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Vesting cliff time, as unix timestamp (in seconds). No coins vest before
+	// the cliff, and the coins vested linearly since the start time are all
+	// vested at the cliff. A zero cliff time means no cliff.
+	CliffTime int64 `protobuf:"varint,3,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
 }
 
-func (m *ContinuousVestingAccount) Reset()         { *m = ContinuousVestingAccount{} }
-func (m *ContinuousVestingAccount) String() string { return proto.CompactTextString(m) }
-func (*ContinuousVestingAccount) ProtoMessage()    {}
+func (m *ContinuousVestingAccount) Reset()      { *m = ContinuousVestingAccount{} }
+func (*ContinuousVestingAccount) ProtoMessage() {}
 func (*ContinuousVestingAccount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_89e80273ca606d6e, []int{1}
 }
@@ -117,11 +124,13 @@ var xxx_messageInfo_ContinuousVestingAccount proto.InternalMessageInfo
 // locked until a specified time.
 type DelayedVestingAccount struct {
 	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// free_coins are the part of the original vesting coins that are vested from
+	// the start, as an allowance spendable before the end time, e.g. for fees.
+	FreeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=free_coins,json=freeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"free_coins,omitempty"`
 }
 
-func (m *DelayedVestingAccount) Reset()         { *m = DelayedVestingAccount{} }
-func (m *DelayedVestingAccount) String() string { return proto.CompactTextString(m) }
-func (*DelayedVestingAccount) ProtoMessage()    {}
+func (m *DelayedVestingAccount) Reset()      { *m = DelayedVestingAccount{} }
+func (*DelayedVestingAccount) ProtoMessage() {}
 func (*DelayedVestingAccount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_89e80273ca606d6e, []int{2}
 }
@@ -214,9 +223,8 @@ type PeriodicVestingAccount struct {
 	VestingPeriods      []Period `protobuf:"bytes,3,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
 }
 
-func (m *PeriodicVestingAccount) Reset()         { *m = PeriodicVestingAccount{} }
-func (m *PeriodicVestingAccount) String() string { return proto.CompactTextString(m) }
-func (*PeriodicVestingAccount) ProtoMessage()    {}
+func (m *PeriodicVestingAccount) Reset()      { *m = PeriodicVestingAccount{} }
+func (*PeriodicVestingAccount) ProtoMessage() {}
 func (*PeriodicVestingAccount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_89e80273ca606d6e, []int{4}
 }
@@ -289,6 +297,55 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It vests
+// continuously like a ContinuousVestingAccount, and lets its funder claw back
+// the coins that are still vesting.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// Vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// funder_address is the address of the account allowed to claw back the
+	// vesting coins.
+	FunderAddress string `protobuf:"bytes,3,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// pending_clawback are the clawed back coins which were delegated at the time
+	// of the clawback. They stay locked once undelegated, until they are returned
+	// in the next block.
+	PendingClawback github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=pending_clawback,json=pendingClawback,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_clawback"`
+}
+
+func (m *ClawbackVestingAccount) Reset()         { *m = ClawbackVestingAccount{} }
+func (m *ClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ClawbackVestingAccount) ProtoMessage()    {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -296,6 +353,7 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -303,46 +361,57 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x6b, 0x13, 0x4f,
-	0x1c, 0xce, 0x34, 0xfd, 0xe7, 0x6f, 0x27, 0xb5, 0x2f, 0x4b, 0x0d, 0x69, 0xa1, 0x9b, 0xb8, 0x8a,
-	0xc4, 0xa0, 0xbb, 0xb4, 0xde, 0xe2, 0xc9, 0x54, 0x0a, 0x82, 0x07, 0x09, 0xe2, 0xc1, 0x4b, 0x98,
-	0xdd, 0x1d, 0x37, 0x43, 0xb2, 0x33, 0x61, 0x67, 0x52, 0xcc, 0x37, 0x28, 0x22, 0xe2, 0xd9, 0x5e,
-	0x3c, 0x49, 0xf1, 0x94, 0x8f, 0x51, 0x10, 0x21, 0x47, 0x4f, 0x55, 0x92, 0x43, 0xbe, 0x86, 0xec,
-	0xcc, 0xec, 0x36, 0xe4, 0xe5, 0x1a, 0xbc, 0x24, 0x3b, 0xbf, 0xb7, 0xe7, 0x79, 0x7e, 0x79, 0x36,
-	0x03, 0xef, 0x7b, 0x8c, 0x87, 0x8c, 0x3b, 0x67, 0x98, 0x0b, 0x42, 0x03, 0xe7, 0xec, 0xc8, 0xc5,
-	0x02, 0x1d, 0x25, 0x67, 0xbb, 0x1b, 0x31, 0xc1, 0x8c, 0x82, 0xaa, 0xb2, 0x93, 0xa8, 0xae, 0x3a,
-	0xd8, 0x45, 0x21, 0xa1, 0xcc, 0x91, 0x9f, 0xaa, 0xf4, 0x60, 0x2f, 0x60, 0x01, 0x93, 0x8f, 0x4e,
-	0xfc, 0xa4, 0xa3, 0xa6, 0x86, 0x71, 0x11, 0xc7, 0x29, 0x86, 0xc7, 0x08, 0x9d, 0xc9, 0xa3, 0x9e,
-	0x68, 0xa5, 0xf9, 0xf8, 0xa0, 0xf2, 0xd6, 0xcf, 0x75, 0x68, 0xd4, 0x11, 0xc7, 0x6f, 0x14, 0x81,
-	0x67, 0x9e, 0xc7, 0x7a, 0x54, 0x18, 0x2f, 0xe0, 0x66, 0x3c, 0xb1, 0x89, 0xd4, 0xb9, 0x08, 0xca,
-	0xa0, 0x92, 0x3f, 0x2e, 0xdb, 0x9a, 0xae, 0x1c, 0xa0, 0xa7, 0xd9, 0x71, 0xbb, 0xee, 0xab, 0xaf,
-	0x0f, 0xaf, 0x4b, 0xa0, 0x91, 0x77, 0x6f, 0x42, 0xc6, 0x47, 0x00, 0x77, 0x58, 0x44, 0x02, 0x42,
-	0x51, 0xa7, 0xa9, 0x75, 0x16, 0xd7, 0xca, 0xd9, 0x4a, 0xfe, 0x78, 0x3f, 0x99, 0x17, 0xd7, 0xa7,
-	0xf3, 0x4e, 0x18, 0xa1, 0xf5, 0xd3, 0xab, 0xeb, 0x52, 0xe6, 0xfb, 0xef, 0x52, 0x25, 0x20, 0xa2,
-	0xd5, 0x73, 0x6d, 0x8f, 0x85, 0x8e, 0x96, 0xa2, 0xbe, 0x1e, 0x73, 0xbf, 0xed, 0x88, 0x7e, 0x17,
-	0x73, 0xd9, 0xc0, 0xbf, 0x4c, 0x06, 0xd5, 0xcd, 0x0e, 0x0e, 0x90, 0xd7, 0x6f, 0xc6, 0xfa, 0xf9,
-	0xe5, 0x64, 0x50, 0x05, 0x8d, 0xed, 0x04, 0x5a, 0x0b, 0x34, 0xce, 0x01, 0xdc, 0xf2, 0x71, 0x5c,
-	0x28, 0xb0, 0xdf, 0x7c, 0x17, 0x61, 0x5c, 0xcc, 0xae, 0x8a, 0xcc, 0xed, 0x14, 0xf8, 0x34, 0xc2,
-	0xd8, 0xf8, 0x04, 0xe0, 0xee, 0x0d, 0x95, 0x64, 0x35, 0xeb, 0xab, 0x62, 0xb3, 0x93, 0x62, 0x27,
-	0xbb, 0xd9, 0x87, 0xb7, 0x30, 0xf5, 0x9b, 0x82, 0x84, 0xb8, 0xf8, 0x5f, 0x19, 0x54, 0xb2, 0x8d,
-	0xff, 0x31, 0xf5, 0x5f, 0x93, 0x10, 0xd7, 0x1e, 0x9c, 0x7f, 0x2d, 0x65, 0x3e, 0x4c, 0x06, 0xd5,
-	0xc3, 0x29, 0x8c, 0x79, 0xe3, 0x58, 0x3f, 0x00, 0x2c, 0x9e, 0x30, 0x2a, 0x08, 0xed, 0xb1, 0x1e,
-	0x9f, 0x71, 0x95, 0x0b, 0xf7, 0xa4, 0xab, 0xb4, 0xd4, 0x19, 0x77, 0x55, 0xed, 0xc5, 0x2f, 0x83,
-	0x3d, 0x0f, 0xa3, 0x7d, 0x66, 0xb8, 0xf3, 0xce, 0x3d, 0x84, 0x90, 0x0b, 0x14, 0x09, 0xa5, 0x62,
-	0x4d, 0xaa, 0xd8, 0x90, 0x11, 0xa9, 0xe3, 0x51, 0xa2, 0xe3, 0xde, 0x94, 0x8e, 0x65, 0x84, 0xad,
-	0x6f, 0x00, 0xde, 0x79, 0x8e, 0x3b, 0xa8, 0x9f, 0xee, 0x68, 0x85, 0x52, 0x6a, 0x0f, 0x13, 0xae,
-	0xe5, 0x29, 0xae, 0x0b, 0xe9, 0x58, 0x17, 0x00, 0xe6, 0x5e, 0xe1, 0x88, 0x30, 0xdf, 0x28, 0xc0,
-	0x5c, 0x07, 0xd3, 0x40, 0xb4, 0x24, 0x97, 0x6c, 0x43, 0x9f, 0x8c, 0x3e, 0xcc, 0xa1, 0x50, 0x72,
-	0x5c, 0xd9, 0xcb, 0xa7, 0x01, 0xad, 0x8b, 0x35, 0x58, 0x50, 0xec, 0x88, 0xf7, 0xcf, 0x59, 0xc2,
-	0x68, 0xc0, 0xed, 0x04, 0xbd, 0x2b, 0x49, 0x72, 0xfd, 0x8f, 0x60, 0x2e, 0x43, 0x57, 0x5a, 0xea,
-	0x1b, 0xf1, 0x9a, 0x94, 0xd2, 0x2d, 0x5d, 0xa2, 0x32, 0xbc, 0x56, 0x4d, 0x7e, 0xba, 0xbb, 0x53,
-	0x0b, 0x5b, 0xbc, 0x02, 0xeb, 0x12, 0xc8, 0xed, 0x84, 0x88, 0x62, 0x2a, 0x5e, 0x32, 0xaf, 0x8d,
-	0xfd, 0x55, 0xba, 0x6c, 0x19, 0xd5, 0x05, 0x7c, 0xea, 0x4f, 0xaf, 0x46, 0x26, 0x18, 0x8e, 0x4c,
-	0xf0, 0x67, 0x64, 0x82, 0xcf, 0x63, 0x33, 0x33, 0x1c, 0x9b, 0x99, 0x5f, 0x63, 0x33, 0xf3, 0x56,
-	0x37, 0x73, 0xbf, 0x6d, 0x13, 0xe6, 0xbc, 0xd7, 0xf7, 0x8d, 0xbe, 0xfb, 0xa4, 0x53, 0xdc, 0x9c,
-	0xbc, 0x71, 0x9e, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x25, 0x0e, 0xad, 0x1a, 0x07, 0x00,
-	0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3d, 0x6b, 0x1b, 0x49,
+	0x18, 0xd6, 0x48, 0xb6, 0xce, 0x1a, 0x7f, 0x2f, 0x3a, 0xb1, 0x32, 0x78, 0xa5, 0xd3, 0x5d, 0x21,
+	0xc4, 0x79, 0x75, 0xf6, 0x75, 0x72, 0x71, 0x58, 0x3e, 0x0c, 0x81, 0x14, 0x61, 0xf3, 0x01, 0x49,
+	0x23, 0xf6, 0x63, 0xb4, 0x1e, 0xa4, 0x9d, 0x11, 0x3b, 0x23, 0x27, 0xfa, 0x07, 0x26, 0x04, 0x13,
+	0x48, 0x91, 0x90, 0x14, 0x71, 0x69, 0x52, 0xb9, 0xc8, 0x0f, 0x48, 0xe9, 0xd2, 0xa4, 0x4a, 0xe5,
+	0x04, 0xbb, 0x30, 0xa4, 0xc8, 0x6f, 0x08, 0x3b, 0x33, 0x2b, 0x6f, 0x6c, 0x99, 0x14, 0x01, 0xe1,
+	0x46, 0xda, 0x99, 0xe7, 0x7d, 0xe7, 0x79, 0xde, 0x67, 0xde, 0x99, 0x5d, 0xf8, 0x97, 0x4b, 0x59,
+	0x40, 0x59, 0x7d, 0x07, 0x31, 0x8e, 0x89, 0x5f, 0xdf, 0x59, 0x75, 0x10, 0xb7, 0x57, 0xe3, 0xb1,
+	0xd9, 0x0b, 0x29, 0xa7, 0x5a, 0x41, 0x46, 0x99, 0xf1, 0xac, 0x8a, 0x5a, 0x5a, 0xb4, 0x03, 0x4c,
+	0x68, 0x5d, 0xfc, 0xca, 0xd0, 0xa5, 0xbc, 0x4f, 0x7d, 0x2a, 0x1e, 0xeb, 0xd1, 0x93, 0x9a, 0x35,
+	0x14, 0x8d, 0x63, 0x33, 0x34, 0xe4, 0x70, 0x29, 0x26, 0x97, 0x70, 0xbb, 0xcf, 0xb7, 0x87, 0x78,
+	0x34, 0x50, 0x78, 0x51, 0xe2, 0x2d, 0xb9, 0xb0, 0x52, 0x23, 0x06, 0x95, 0xbd, 0x49, 0xa8, 0x35,
+	0x6d, 0x86, 0x1e, 0x48, 0x6d, 0x1b, 0xae, 0x4b, 0xfb, 0x84, 0x6b, 0xb7, 0xe0, 0x4c, 0x44, 0xd6,
+	0xb2, 0xe5, 0x58, 0x07, 0x65, 0x50, 0x9d, 0x5e, 0x2b, 0x9b, 0x2a, 0x57, 0xac, 0xad, 0x88, 0xcc,
+	0x28, 0x5d, 0xe5, 0x35, 0x27, 0x8e, 0x4f, 0x4a, 0xc0, 0x9a, 0x76, 0x2e, 0xa6, 0xb4, 0x67, 0x00,
+	0x2e, 0xd0, 0x10, 0xfb, 0x98, 0xd8, 0xdd, 0x96, 0xb2, 0x40, 0x4f, 0x97, 0x33, 0xd5, 0xe9, 0xb5,
+	0x62, 0xbc, 0x5e, 0x14, 0x3f, 0x5c, 0x6f, 0x93, 0x62, 0xd2, 0xdc, 0x3a, 0x3a, 0x29, 0xa5, 0xde,
+	0x7d, 0x2e, 0x55, 0x7d, 0xcc, 0xb7, 0xfb, 0x8e, 0xe9, 0xd2, 0x40, 0x09, 0x57, 0x7f, 0x2b, 0xcc,
+	0xeb, 0xd4, 0xf9, 0xa0, 0x87, 0x98, 0x48, 0x60, 0xaf, 0xcf, 0x0f, 0x6b, 0x33, 0x5d, 0xe4, 0xdb,
+	0xee, 0xa0, 0x15, 0x59, 0xc3, 0x0e, 0xce, 0x0f, 0x6b, 0xc0, 0x9a, 0x8f, 0xa9, 0x55, 0x81, 0xda,
+	0x2e, 0x80, 0x73, 0x1e, 0x8a, 0x02, 0x39, 0xf2, 0x5a, 0xed, 0x10, 0x21, 0x3d, 0x33, 0x2e, 0x31,
+	0xb3, 0x43, 0xe2, 0xad, 0x10, 0x21, 0x6d, 0x0f, 0xc0, 0xc5, 0x0b, 0x29, 0xb1, 0x35, 0x13, 0xe3,
+	0x52, 0xb3, 0x30, 0xe4, 0x8e, 0xbd, 0x29, 0xc2, 0x29, 0x44, 0xbc, 0x16, 0xc7, 0x01, 0xd2, 0x27,
+	0xcb, 0xa0, 0x9a, 0xb1, 0x7e, 0x43, 0xc4, 0xbb, 0x87, 0x03, 0xa4, 0xad, 0xc3, 0x62, 0x2f, 0xa4,
+	0x3d, 0x1a, 0x72, 0x4c, 0xa3, 0x8d, 0xec, 0x13, 0x95, 0x8d, 0x29, 0xd1, 0xb3, 0x65, 0x50, 0x9d,
+	0xb2, 0xf4, 0x64, 0xc0, 0xfd, 0x04, 0xde, 0xa8, 0xed, 0xee, 0x97, 0x52, 0xaf, 0xf6, 0x4b, 0xa9,
+	0xa7, 0xe7, 0x87, 0xb5, 0xe5, 0x84, 0xc8, 0xab, 0x9d, 0x57, 0xf9, 0x06, 0xa0, 0xbe, 0x49, 0x09,
+	0xc7, 0xa4, 0x4f, 0xfb, 0xec, 0x52, 0x5b, 0x3a, 0x30, 0x2f, 0xda, 0x52, 0x79, 0x75, 0xa9, 0x3d,
+	0x6b, 0xe6, 0xe8, 0x83, 0x66, 0x5e, 0xa5, 0x51, 0x8d, 0xaa, 0x39, 0x57, 0x5b, 0x7f, 0x19, 0x42,
+	0xc6, 0xed, 0x90, 0x4b, 0x1b, 0xd2, 0xc2, 0x86, 0x9c, 0x98, 0x11, 0x46, 0x2c, 0x43, 0xe8, 0x76,
+	0x71, 0xbb, 0x2d, 0xe1, 0x8c, 0x84, 0xc5, 0x4c, 0x04, 0x37, 0xfe, 0x49, 0x96, 0xfa, 0x67, 0xa2,
+	0xd4, 0xeb, 0x6a, 0xaa, 0x7c, 0x48, 0xc3, 0xdf, 0xff, 0x47, 0x5d, 0x7b, 0x80, 0xbc, 0x1f, 0x91,
+	0xb1, 0x54, 0xfb, 0x02, 0x40, 0x18, 0x1d, 0x02, 0xd9, 0x18, 0x3f, 0x3f, 0x97, 0x0f, 0xa3, 0xe6,
+	0xfb, 0x7a, 0x52, 0xca, 0x5f, 0x24, 0xfd, 0x4d, 0x03, 0xcc, 0x51, 0xd0, 0xe3, 0x83, 0x5f, 0x6a,
+	0x4a, 0x2b, 0x17, 0x2d, 0x29, 0xb0, 0xc6, 0x4a, 0xd2, 0xc5, 0x72, 0x62, 0x81, 0x91, 0x46, 0x55,
+	0xde, 0x00, 0x98, 0xbd, 0x83, 0x42, 0x4c, 0x3d, 0xad, 0x00, 0xb3, 0x5d, 0x44, 0x7c, 0xbe, 0x2d,
+	0x5c, 0xca, 0x58, 0x6a, 0xa4, 0x0d, 0x60, 0xd6, 0x0e, 0x84, 0x7b, 0x63, 0xbb, 0x7a, 0x14, 0x61,
+	0xe5, 0x6d, 0x1a, 0x16, 0xa4, 0x3a, 0xec, 0xde, 0xbc, 0x7e, 0xb6, 0xe0, 0x7c, 0xcc, 0xde, 0x13,
+	0x22, 0x99, 0xba, 0x0f, 0x8d, 0xeb, 0xd8, 0x65, 0x2d, 0xcd, 0x5c, 0x64, 0x93, 0xac, 0x74, 0x4e,
+	0x85, 0x48, 0x84, 0x35, 0xcc, 0xe4, 0xf6, 0xfd, 0x91, 0x30, 0x6d, 0xb4, 0x0d, 0x95, 0x03, 0x20,
+	0x1c, 0x0a, 0x6c, 0x82, 0x08, 0xbf, 0x4d, 0xdd, 0x0e, 0xf2, 0xc6, 0xe8, 0x90, 0xbc, 0x9e, 0x46,
+	0x48, 0x1d, 0xa1, 0xa7, 0xf2, 0x32, 0x03, 0x0b, 0x9b, 0x5d, 0xfb, 0xb1, 0x63, 0xbb, 0x9d, 0x9b,
+	0xb7, 0x99, 0xff, 0xc1, 0xb9, 0x76, 0x74, 0x33, 0x87, 0x2d, 0xdb, 0xf3, 0x42, 0xc4, 0x98, 0xb8,
+	0xa0, 0x72, 0x4d, 0xfd, 0xe3, 0xfb, 0x95, 0xbc, 0xe2, 0xdf, 0x90, 0xc8, 0x5d, 0x1e, 0x62, 0xe2,
+	0x5b, 0xb3, 0x32, 0x5e, 0x4d, 0x8a, 0x97, 0x75, 0x0f, 0x11, 0x2f, 0xd2, 0xef, 0xaa, 0x32, 0xc7,
+	0xf7, 0x46, 0x9a, 0x57, 0xd4, 0xb1, 0xc1, 0xa3, 0x77, 0x66, 0xb4, 0xfd, 0xcd, 0xf5, 0xa3, 0x53,
+	0x03, 0x1c, 0x9f, 0x1a, 0xe0, 0xcb, 0xa9, 0x01, 0x9e, 0x9f, 0x19, 0xa9, 0xe3, 0x33, 0x23, 0xf5,
+	0xe9, 0xcc, 0x48, 0x3d, 0x52, 0xc9, 0xcc, 0xeb, 0x98, 0x98, 0xd6, 0x9f, 0xa8, 0xcf, 0x24, 0x99,
+	0x2d, 0x55, 0x39, 0x59, 0xf1, 0x35, 0xf4, 0xef, 0xf7, 0x01, 0x00, 0xe9, 0xbf, 0x4a, 0xab, 0xd1,
+	0x09, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -365,6 +434,16 @@ func (m *BaseVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProportionalUndelegation {
+		i--
+		if m.ProportionalUndelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EndTime != 0 {
 		i = encodeVarintVesting(dAtA, i, uint64(m.EndTime))
 		i--
@@ -447,6 +526,11 @@ func (m *ContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.CliffTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.StartTime != 0 {
 		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
 		i--
@@ -487,6 +571,20 @@ func (m *DelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FreeCoins) > 0 {
+		for iNdEx := len(m.FreeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.BaseVestingAccount != nil {
 		{
 			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
@@ -633,6 +731,67 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingClawback) > 0 {
+		for iNdEx := len(m.PendingClawback) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingClawback[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -675,6 +834,9 @@ func (m *BaseVestingAccount) Size() (n int) {
 	if m.EndTime != 0 {
 		n += 1 + sovVesting(uint64(m.EndTime))
 	}
+	if m.ProportionalUndelegation {
+		n += 2
+	}
 	return n
 }
 
@@ -691,6 +853,9 @@ func (m *ContinuousVestingAccount) Size() (n int) {
 	if m.StartTime != 0 {
 		n += 1 + sovVesting(uint64(m.StartTime))
 	}
+	if m.CliffTime != 0 {
+		n += 1 + sovVesting(uint64(m.CliffTime))
+	}
 	return n
 }
 
@@ -704,6 +869,12 @@ func (m *DelayedVestingAccount) Size() (n int) {
 		l = m.BaseVestingAccount.Size()
 		n += 1 + l + sovVesting(uint64(l))
 	}
+	if len(m.FreeCoins) > 0 {
+		for _, e := range m.FreeCoins {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -760,6 +931,32 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.PendingClawback) > 0 {
+		for _, e := range m.PendingClawback {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -952,6 +1149,26 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProportionalUndelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProportionalUndelegation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -1057,6 +1274,25 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -1143,6 +1379,40 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeCoins = append(m.FreeCoins, types1.Coin{})
+			if err := m.FreeCoins[len(m.FreeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -1492,6 +1762,177 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClawback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingClawback = append(m.PendingClawback, types1.Coin{})
+			if err := m.PendingClawback[len(m.PendingClawback)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
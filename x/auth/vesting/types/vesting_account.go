@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	"sigs.k8s.io/yaml"
+
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	vestexported "cosmossdk.io/x/auth/vesting/exported"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Compile-time type assertions
@@ -52,22 +55,25 @@ func (bva BaseVestingAccount) LockedCoinsFromVesting(vestingCoins sdk.Coins) sdk
 
 // TrackDelegation tracks a delegation amount for any given vesting account type
 // given the amount of coins currently vesting and the current account balance
-// of the delegation denominations.
+// of the delegation denominations. It returns an error, leaving the account
+// untouched, if any delegated coin is zero or exceeds the balance.
 //
 // CONTRACT: The account's coins, delegation coins, vesting coins, and delegated
 // vesting coins must be sorted.
-func (bva *BaseVestingAccount) TrackDelegation(balance, vestingCoins, amount sdk.Coins) {
+func (bva *BaseVestingAccount) TrackDelegation(balance, vestingCoins, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if coin.Amount.IsZero() {
+			return sdkerrors.ErrInvalidCoins.Wrap("delegation attempt with zero coins")
+		}
+		if baseAmt := balance.AmountOf(coin.Denom); baseAmt.LT(coin.Amount) {
+			return sdkerrors.ErrInsufficientFunds.Wrapf("delegation attempt with insufficient funds: %s%s < %s", baseAmt, coin.Denom, coin)
+		}
+	}
+
 	for _, coin := range amount {
-		baseAmt := balance.AmountOf(coin.Denom)
 		vestingAmt := vestingCoins.AmountOf(coin.Denom)
 		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
 
-		// Panic if the delegation amount is zero or if the base coins does not
-		// exceed the desired delegation amount.
-		if coin.Amount.IsZero() || baseAmt.LT(coin.Amount) {
-			panic("delegation attempt with zero coins or insufficient funds")
-		}
-
 		// compute x and y per the specification, where:
 		// X := min(max(V - DV, 0), D)
 		// Y := D - X
@@ -84,6 +90,8 @@ func (bva *BaseVestingAccount) TrackDelegation(balance, vestingCoins, amount sdk
 			bva.DelegatedFree = bva.DelegatedFree.Add(yCoin)
 		}
 	}
+
+	return nil
 }
 
 // TrackUndelegation tracks an undelegation amount by setting the necessary
@@ -95,21 +103,36 @@ func (bva *BaseVestingAccount) TrackDelegation(balance, vestingCoins, amount sdk
 // which can increase the validator's exchange rate (tokens/shares) slightly if
 // the undelegated tokens are non-integral.
 //
+// If the account has ProportionalUndelegation set, the undelegation is
+// attributed to the delegated free and delegated vesting coins proportionally
+// to their current amounts, the rounding remainder going to the delegated
+// vesting coins. Otherwise, it is attributed to the delegated free coins first.
+//
+// It returns an error, leaving the account untouched, if any undelegated coin
+// is zero.
+//
 // CONTRACT: The account's coins and undelegation coins must be sorted.
-func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) error {
 	for _, coin := range amount {
-		// panic if the undelegation amount is zero
 		if coin.Amount.IsZero() {
-			panic("undelegation attempt with zero coins")
+			return sdkerrors.ErrInvalidCoins.Wrap("undelegation attempt with zero coins")
 		}
+	}
+
+	for _, coin := range amount {
 		delegatedFree := bva.DelegatedFree.AmountOf(coin.Denom)
 		delegatedVesting := bva.DelegatedVesting.AmountOf(coin.Denom)
 
-		// compute x and y per the specification, where:
-		// X := min(DF, D)
-		// Y := min(DV, D - X)
-		x := math.MinInt(delegatedFree, coin.Amount)
-		y := math.MinInt(delegatedVesting, coin.Amount.Sub(x))
+		var x, y math.Int
+		if bva.ProportionalUndelegation {
+			x, y = proportionalUndelegation(delegatedFree, delegatedVesting, coin.Amount)
+		} else {
+			// compute x and y per the specification, where:
+			// X := min(DF, D)
+			// Y := min(DV, D - X)
+			x = math.MinInt(delegatedFree, coin.Amount)
+			y = math.MinInt(delegatedVesting, coin.Amount.Sub(x))
+		}
 
 		if !x.IsZero() {
 			xCoin := sdk.NewCoin(coin.Denom, x)
@@ -121,6 +144,53 @@ func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 			bva.DelegatedVesting = bva.DelegatedVesting.Sub(yCoin)
 		}
 	}
+
+	return nil
+}
+
+// ReconcileDelegations clamps the tracked delegations of the given denom to
+// the amount the account actually has bonded or unbonding, which is lower than
+// the tracked delegations once a validator it delegated to has been slashed,
+// since slashing is not tracked by the account. The excess is attributed to the
+// delegated vesting coins first, then to the delegated free coins. It returns
+// whether the tracked delegations changed.
+func (bva *BaseVestingAccount) ReconcileDelegations(delegated sdk.Coin) bool {
+	delegatedFree := bva.DelegatedFree.AmountOf(delegated.Denom)
+	delegatedVesting := bva.DelegatedVesting.AmountOf(delegated.Denom)
+
+	excess := delegatedFree.Add(delegatedVesting).Sub(delegated.Amount)
+	if !excess.IsPositive() {
+		return false
+	}
+
+	y := math.MinInt(delegatedVesting, excess)
+	x := excess.Sub(y)
+
+	if !y.IsZero() {
+		bva.DelegatedVesting = bva.DelegatedVesting.Sub(sdk.NewCoin(delegated.Denom, y))
+	}
+
+	if !x.IsZero() {
+		bva.DelegatedFree = bva.DelegatedFree.Sub(sdk.NewCoin(delegated.Denom, x))
+	}
+
+	return true
+}
+
+// proportionalUndelegation splits an undelegated amount D between the delegated
+// free coins DF and the delegated vesting coins DV, where X := DF and Y := DV
+// if D >= DF + DV, and otherwise:
+// X := floor(D * DF / (DF + DV))
+// Y := D - X
+// Since D * DV / (DF + DV) < DV when D < DF + DV, Y never exceeds DV.
+func proportionalUndelegation(delegatedFree, delegatedVesting, amount math.Int) (x, y math.Int) {
+	total := delegatedFree.Add(delegatedVesting)
+	if amount.GTE(total) {
+		return delegatedFree, delegatedVesting
+	}
+
+	x = amount.Mul(delegatedFree).Quo(total)
+	return x, amount.Sub(x)
 }
 
 // GetOriginalVesting returns a vesting account's original vesting amount
@@ -155,6 +225,14 @@ func (bva BaseVestingAccount) Validate() error {
 		return fmt.Errorf("invalid coins: %s", bva.OriginalVesting.String())
 	}
 
+	if err := bva.DelegatedVesting.Validate(); err != nil {
+		return fmt.Errorf("invalid delegated vesting coins: %w", err)
+	}
+
+	if err := bva.DelegatedFree.Validate(); err != nil {
+		return fmt.Errorf("invalid delegated free coins: %w", err)
+	}
+
 	if !(bva.DelegatedVesting.IsAllLTE(bva.OriginalVesting)) {
 		return errors.New("delegated vesting amount cannot be greater than original vesting amount")
 	}
@@ -162,6 +240,77 @@ func (bva BaseVestingAccount) Validate() error {
 	return bva.BaseAccount.Validate()
 }
 
+// vestingAccountYAML is the human-readable representation of a vesting account.
+// Times are rendered as RFC3339 along with their unix value.
+type vestingAccountYAML struct {
+	Address          string `json:"address" yaml:"address"`
+	PubKey           string `json:"public_key,omitempty" yaml:"public_key,omitempty"`
+	AccountNumber    uint64 `json:"account_number" yaml:"account_number"`
+	Sequence         uint64 `json:"sequence" yaml:"sequence"`
+	OriginalVesting  string `json:"original_vesting" yaml:"original_vesting"`
+	DelegatedFree    string `json:"delegated_free" yaml:"delegated_free"`
+	DelegatedVesting string `json:"delegated_vesting" yaml:"delegated_vesting"`
+	EndTime          string `json:"end_time" yaml:"end_time"`
+
+	ProportionalUndelegation bool `json:"proportional_undelegation,omitempty" yaml:"proportional_undelegation,omitempty"`
+
+	// custom fields based on concrete vesting type which can be omitted
+	StartTime      string   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	CliffTime      string   `json:"cliff_time,omitempty" yaml:"cliff_time,omitempty"`
+	VestingPeriods []string `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FreeCoins      string   `json:"free_coins,omitempty" yaml:"free_coins,omitempty"`
+}
+
+func newVestingAccountYAML(bva *BaseVestingAccount) vestingAccountYAML {
+	var out vestingAccountYAML
+	if bva == nil {
+		return out
+	}
+
+	if bva.BaseAccount != nil {
+		out.Address = bva.Address
+		out.AccountNumber = bva.AccountNumber
+		out.Sequence = bva.Sequence
+		if pk := bva.GetPubKey(); pk != nil {
+			out.PubKey = pk.String()
+		}
+	}
+
+	out.OriginalVesting = bva.OriginalVesting.String()
+	out.DelegatedFree = bva.DelegatedFree.String()
+	out.DelegatedVesting = bva.DelegatedVesting.String()
+	out.EndTime = formatVestingTime(bva.EndTime)
+	out.ProportionalUndelegation = bva.ProportionalUndelegation
+	return out
+}
+
+// marshal returns the YAML encoding of the vesting account.
+func (out vestingAccountYAML) marshal() (string, error) {
+	bz, err := yaml.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// formatVestingTime renders a unix timestamp as RFC3339, followed by the unix
+// timestamp itself.
+func formatVestingTime(unix int64) string {
+	return fmt.Sprintf("%s (%d)", time.Unix(unix, 0).UTC().Format(time.RFC3339), unix)
+}
+
+// MarshalYAML returns the YAML representation of a BaseVestingAccount.
+func (bva BaseVestingAccount) MarshalYAML() (interface{}, error) {
+	return newVestingAccountYAML(&bva).marshal()
+}
+
+// String implements the fmt.Stringer interface.
+func (bva BaseVestingAccount) String() string {
+	out, _ := bva.MarshalYAML()
+	return out.(string)
+}
+
 // Continuous Vesting Account
 
 var (
@@ -177,6 +326,24 @@ func NewContinuousVestingAccountRaw(bva *BaseVestingAccount, startTime int64) *C
 	}
 }
 
+// NewContinuousVestingAccountWithCliff returns a new ContinuousVestingAccount
+// with a cliff, before which no coins vest.
+func NewContinuousVestingAccountWithCliff(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime, cliffTime, endTime int64) (*ContinuousVestingAccount, error) {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	continuousVestingAccount := &ContinuousVestingAccount{
+		StartTime:          startTime,
+		CliffTime:          cliffTime,
+		BaseVestingAccount: baseVestingAcc,
+	}
+
+	return continuousVestingAccount, continuousVestingAccount.Validate()
+}
+
 // NewContinuousVestingAccount returns a new ContinuousVestingAccount
 func NewContinuousVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime, endTime int64) (*ContinuousVestingAccount, error) {
 	baseVestingAcc := &BaseVestingAccount{
@@ -201,7 +368,12 @@ func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coin
 	// We must handle the case where the start time for a vesting account has
 	// been set into the future or when the start of the chain is not exactly
 	// known.
-	if blockTime.Unix() <= cva.StartTime {
+	if blockTime.Unix() < cva.StartTime {
+		return nil
+	}
+
+	// No coins vest at the start time nor before the cliff.
+	if blockTime.Unix() == cva.StartTime || blockTime.Unix() < cva.CliffTime {
 		return vestedCoins
 	} else if blockTime.Unix() >= cva.EndTime {
 		return cva.OriginalVesting
@@ -235,8 +407,8 @@ func (cva ContinuousVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
 // TrackDelegation tracks a desired delegation amount by setting the appropriate
 // values for the amount of delegated vesting, delegated free, and reducing the
 // overall amount of base coins.
-func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
-	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) error {
+	return cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
 }
 
 // GetStartTime returns the time when vesting starts for a continuous vesting
@@ -245,15 +417,50 @@ func (cva ContinuousVestingAccount) GetStartTime() int64 {
 	return cva.StartTime
 }
 
+// GetCliffTime returns the time before which no coins vest for a continuous
+// vesting account, zero meaning no cliff.
+func (cva ContinuousVestingAccount) GetCliffTime() int64 {
+	return cva.CliffTime
+}
+
 // Validate checks for errors on the account fields
 func (cva ContinuousVestingAccount) Validate() error {
-	if cva.GetStartTime() >= cva.GetEndTime() {
+	if cva.GetStartTime() < 0 {
+		return errors.New("vesting start-time cannot be negative")
+	}
+
+	if cva.GetStartTime() == cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be equal to end-time")
+	}
+
+	if cva.GetStartTime() > cva.GetEndTime() {
 		return errors.New("vesting start-time cannot be before end-time")
 	}
 
+	if cva.CliffTime != 0 && (cva.CliffTime < cva.StartTime || cva.CliffTime > cva.EndTime) {
+		return fmt.Errorf("vesting cliff-time %d must be between start-time %d and end-time %d", cva.CliffTime, cva.StartTime, cva.EndTime)
+	}
+
 	return cva.BaseVestingAccount.Validate()
 }
 
+// MarshalYAML returns the YAML representation of a ContinuousVestingAccount.
+func (cva ContinuousVestingAccount) MarshalYAML() (interface{}, error) {
+	out := newVestingAccountYAML(cva.BaseVestingAccount)
+	out.StartTime = formatVestingTime(cva.StartTime)
+	if cva.CliffTime != 0 {
+		out.CliffTime = formatVestingTime(cva.CliffTime)
+	}
+
+	return out.marshal()
+}
+
+// String implements the fmt.Stringer interface.
+func (cva ContinuousVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
 // Periodic Vesting Account
 
 var (
@@ -272,9 +479,9 @@ func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount, startTime int64, peri
 
 // NewPeriodicVestingAccount returns a new PeriodicVestingAccount
 func NewPeriodicVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime int64, periods Periods) (*PeriodicVestingAccount, error) {
-	endTime := startTime
-	for _, p := range periods {
-		endTime += p.Length
+	endTime, err := periods.EndTime(startTime)
+	if err != nil {
+		return nil, err
 	}
 
 	baseVestingAcc := &BaseVestingAccount{
@@ -292,6 +499,64 @@ func NewPeriodicVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting s
 	return periodicVestingAccount, periodicVestingAccount.Validate()
 }
 
+// NewPeriodicVestingAccountFromContinuous converts a ContinuousVestingAccount
+// into a PeriodicVestingAccount with periods of the given length, in seconds,
+// the last one ending at the end time, and a period ending at the cliff time.
+// Each period vests the coins vested linearly until its end, rounded down, and
+// the rounding dust vests in the last period. Periods vesting no coins, e.g.
+// before the cliff, are merged into the next one. The delegated free and
+// delegated vesting coins are preserved.
+func NewPeriodicVestingAccountFromContinuous(cva *ContinuousVestingAccount, periodLength int64) (*PeriodicVestingAccount, error) {
+	if periodLength <= 0 {
+		return nil, fmt.Errorf("vesting period length must be positive: %d", periodLength)
+	}
+	if cva.StartTime >= cva.EndTime {
+		return nil, errors.New("vesting start-time cannot be before end-time")
+	}
+
+	var periods Periods
+	vested := sdk.NewCoins()
+	duration := cva.EndTime - cva.StartTime
+	periodStart, periodEnd, gridEnd := cva.StartTime, cva.StartTime, cva.StartTime
+	for periodEnd < cva.EndTime {
+		if periodEnd == gridEnd {
+			if periodLength >= cva.EndTime-gridEnd {
+				gridEnd = cva.EndTime
+			} else {
+				gridEnd += periodLength
+			}
+		}
+
+		if periodEnd < cva.CliffTime && cva.CliffTime < gridEnd {
+			periodEnd = cva.CliffTime
+		} else {
+			periodEnd = gridEnd
+		}
+
+		cumulative := sdk.NewCoins()
+		switch {
+		case periodEnd == cva.EndTime:
+			cumulative = cva.OriginalVesting
+		case periodEnd >= cva.CliffTime:
+			for _, ovc := range cva.OriginalVesting {
+				cumulative = cumulative.Add(sdk.NewCoin(ovc.Denom, ovc.Amount.MulRaw(periodEnd-cva.StartTime).QuoRaw(duration)))
+			}
+		}
+
+		amount := cumulative.Sub(vested...)
+		if amount.IsZero() {
+			continue
+		}
+
+		periods = append(periods, Period{Length: periodEnd - periodStart, Amount: amount})
+		vested = cumulative
+		periodStart = periodEnd
+	}
+
+	pva := NewPeriodicVestingAccountRaw(cva.BaseVestingAccount, cva.StartTime, periods)
+	return pva, pva.Validate()
+}
+
 // GetVestedCoins returns the total number of vested coins. If no coins are vested,
 // nil is returned.
 func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
@@ -306,23 +571,21 @@ func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins
 		return pva.OriginalVesting
 	}
 
-	// track the start time of the next period
-	currentPeriodStartTime := pva.StartTime
+	// a period's coins vest only once its full length has elapsed
+	periods := Periods(pva.VestingPeriods)
+	elapsed := periods.elapsedCount(blockTime.Unix() - pva.StartTime)
+	if elapsed == 0 {
+		return vestedCoins
+	}
 
-	// for each period, if the period is over, add those coins as vested and check the next period.
-	for _, period := range pva.VestingPeriods {
-		x := blockTime.Unix() - currentPeriodStartTime
-		if x < period.Length {
-			break
+	// sum whichever side of the schedule is shorter, once
+	if remaining := periods[elapsed:]; len(remaining) < elapsed {
+		if vested, hasNeg := pva.OriginalVesting.SafeSub(remaining.TotalAmount()...); !hasNeg {
+			return vested
 		}
-
-		vestedCoins = vestedCoins.Add(period.Amount...)
-
-		// update the start time of the next period
-		currentPeriodStartTime += period.Length
 	}
 
-	return vestedCoins
+	return periods[:elapsed].TotalAmount()
 }
 
 // GetVestingCoins returns the total number of vesting coins. If no coins are
@@ -340,8 +603,8 @@ func (pva PeriodicVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
 // TrackDelegation tracks a desired delegation amount by setting the appropriate
 // values for the amount of delegated vesting, delegated free, and reducing the
 // overall amount of base coins.
-func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
-	pva.BaseVestingAccount.TrackDelegation(balance, pva.GetVestingCoins(blockTime), amount)
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) error {
+	return pva.BaseVestingAccount.TrackDelegation(balance, pva.GetVestingCoins(blockTime), amount)
 }
 
 // GetStartTime returns the time when vesting starts for a periodic vesting
@@ -355,20 +618,112 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// GetRemainingUnlocks returns the periods of the account which are not unlocked
+// yet at the given time, along with their absolute unlock time.
+func (pva PeriodicVestingAccount) GetRemainingUnlocks(blockTime time.Time) []VestingUnlock {
+	var unlocks []VestingUnlock
+
+	unlockTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		unlockTime += period.Length
+		if unlockTime > blockTime.Unix() {
+			unlocks = append(unlocks, VestingUnlock{UnlockTime: unlockTime, Amount: period.Amount})
+		}
+	}
+
+	return unlocks
+}
+
+// AddGrant merges a grant of coins, vesting according to the given periods from
+// startTime, into the vesting schedule of the account. The start time of the
+// account becomes the earliest of both start times and its end time the latest
+// of both end times. Periods of both schedules ending at the same time are
+// merged into a single period, so that the coins of the account vest as they
+// would have in two separate accounts.
+func (pva *PeriodicVestingAccount) AddGrant(startTime int64, periods Periods, coins sdk.Coins) error {
+	if !coins.Equal(periods.TotalAmount()) {
+		return fmt.Errorf("grant coins (%s) do not match the sum of all coins in the grant periods (%s)", coins, periods.TotalAmount())
+	}
+	if _, err := periods.EndTime(startTime); err != nil {
+		return fmt.Errorf("invalid grant periods: %w", err)
+	}
+
+	mergedStart, merged := mergePeriods(pva.StartTime, pva.VestingPeriods, startTime, periods)
+	endTime, err := Periods(merged).EndTime(mergedStart)
+	if err != nil {
+		return fmt.Errorf("invalid merged vesting schedule: %w", err)
+	}
+
+	pva.StartTime, pva.VestingPeriods, pva.EndTime = mergedStart, merged, endTime
+	pva.OriginalVesting = pva.OriginalVesting.Add(coins...)
+
+	return nil
+}
+
+// mergePeriods merges two vesting schedules, each starting at its own start
+// time, into a single schedule starting at the earliest start time. Periods are
+// ordered by end time, and periods of both schedules ending at the same time
+// are merged into one.
+func mergePeriods(startA int64, a []Period, startB int64, b []Period) (int64, []Period) {
+	start := min(startA, startB)
+	merged := make([]Period, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	endA, endB := startA, startB
+	if len(a) > 0 {
+		endA += a[0].Length
+	}
+	if len(b) > 0 {
+		endB += b[0].Length
+	}
+
+	last := start
+	for i < len(a) || j < len(b) {
+		var end int64
+		var amount sdk.Coins
+
+		switch {
+		case j == len(b) || (i < len(a) && endA < endB):
+			end, amount = endA, a[i].Amount
+		case i == len(a) || endB < endA:
+			end, amount = endB, b[j].Amount
+		default:
+			end, amount = endA, a[i].Amount.Add(b[j].Amount...)
+		}
+
+		if i < len(a) && endA == end {
+			if i++; i < len(a) {
+				endA += a[i].Length
+			}
+		}
+		if j < len(b) && endB == end {
+			if j++; j < len(b) {
+				endB += b[j].Length
+			}
+		}
+
+		merged = append(merged, Period{Length: end - last, Amount: amount})
+		last = end
+	}
+
+	return start, merged
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
 		return errors.New("vesting start-time cannot be before end-time")
 	}
-	endTime := pva.StartTime
+	endTime, err := Periods(pva.VestingPeriods).EndTime(pva.StartTime)
+	if err != nil {
+		return err
+	}
 	originalVesting := sdk.NewCoins()
 	for i, p := range pva.VestingPeriods {
-		if p.Length < 0 {
-			return fmt.Errorf("period #%d has a negative length: %d", i, p.Length)
+		if err := p.Amount.Validate(); err != nil {
+			return fmt.Errorf("period #%d has invalid coins: %w", i, err)
 		}
-		endTime += p.Length
-
-		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+		if !p.Amount.IsAllPositive() {
 			return fmt.Errorf("period #%d has invalid coins: %s", i, p.Amount.String())
 		}
 
@@ -377,9 +732,6 @@ func (pva PeriodicVestingAccount) Validate() error {
 	if endTime != pva.EndTime {
 		return errors.New("vesting end time does not match length of all vesting periods")
 	}
-	if endTime < pva.GetStartTime() {
-		return errors.New("cumulative endTime overflowed, and/or is less than startTime")
-	}
 	if !originalVesting.Equal(pva.OriginalVesting) {
 		return fmt.Errorf("original vesting coins (%v) does not match the sum of all coins in vesting periods (%v)", pva.OriginalVesting, originalVesting)
 	}
@@ -387,6 +739,27 @@ func (pva PeriodicVestingAccount) Validate() error {
 	return pva.BaseVestingAccount.Validate()
 }
 
+// MarshalYAML returns the YAML representation of a PeriodicVestingAccount. Each
+// period is listed on its own line, along with the time at which it unlocks.
+func (pva PeriodicVestingAccount) MarshalYAML() (interface{}, error) {
+	out := newVestingAccountYAML(pva.BaseVestingAccount)
+	out.StartTime = formatVestingTime(pva.StartTime)
+
+	unlockTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		unlockTime += period.Length
+		out.VestingPeriods = append(out.VestingPeriods, fmt.Sprintf("%s unlocks at %s after %ds", period.Amount, formatVestingTime(unlockTime), period.Length))
+	}
+
+	return out.marshal()
+}
+
+// String implements the fmt.Stringer interface.
+func (pva PeriodicVestingAccount) String() string {
+	out, _ := pva.MarshalYAML()
+	return out.(string)
+}
+
 // Delayed Vesting Account
 
 var (
@@ -409,19 +782,41 @@ func NewDelayedVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sd
 		EndTime:         endTime,
 	}
 
-	delayedVestingAccount := &DelayedVestingAccount{baseVestingAcc}
+	delayedVestingAccount := &DelayedVestingAccount{BaseVestingAccount: baseVestingAcc}
+
+	return delayedVestingAccount, delayedVestingAccount.Validate()
+}
+
+// NewDelayedVestingAccountWithFreeCoins returns a DelayedVestingAccount whose
+// free coins, part of the original vesting coins, are vested from the start.
+func NewDelayedVestingAccountWithFreeCoins(baseAcc *authtypes.BaseAccount, originalVesting, freeCoins sdk.Coins, endTime int64) (*DelayedVestingAccount, error) {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	delayedVestingAccount := &DelayedVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FreeCoins:          freeCoins,
+	}
 
 	return delayedVestingAccount, delayedVestingAccount.Validate()
 }
 
 // GetVestedCoins returns the total amount of vested coins for a delayed vesting
-// account. All coins are only vested once the schedule has elapsed.
+// account. Only the free coins are vested before the schedule has elapsed, and
+// all coins are vested once it has.
 func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
 	if blockTime.Unix() >= dva.EndTime {
 		return dva.OriginalVesting
 	}
 
-	return nil
+	if dva.FreeCoins.IsZero() {
+		return nil
+	}
+
+	return dva.FreeCoins
 }
 
 // GetVestingCoins returns the total number of vesting coins for a delayed
@@ -439,8 +834,8 @@ func (dva DelayedVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
 // TrackDelegation tracks a desired delegation amount by setting the appropriate
 // values for the amount of delegated vesting, delegated free, and reducing the
 // overall amount of base coins.
-func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
-	dva.BaseVestingAccount.TrackDelegation(balance, dva.GetVestingCoins(blockTime), amount)
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) error {
+	return dva.BaseVestingAccount.TrackDelegation(balance, dva.GetVestingCoins(blockTime), amount)
 }
 
 // GetStartTime returns zero since a delayed vesting account has no start time.
@@ -450,9 +845,43 @@ func (dva DelayedVestingAccount) GetStartTime() int64 {
 
 // Validate checks for errors on the account fields
 func (dva DelayedVestingAccount) Validate() error {
+	if dva.GetEndTime() <= 0 {
+		return errors.New("delayed vesting end-time must be positive")
+	}
+
+	if err := dva.FreeCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid free coins: %w", err)
+	}
+
+	for _, coin := range dva.FreeCoins {
+		if !dva.OriginalVesting.AmountOf(coin.Denom).IsPositive() {
+			return fmt.Errorf("free coins denom %s is not in the original vesting coins", coin.Denom)
+		}
+	}
+
+	if !dva.FreeCoins.IsAllLTE(dva.OriginalVesting) {
+		return fmt.Errorf("free coins %s cannot be greater than the original vesting coins %s", dva.FreeCoins, dva.OriginalVesting)
+	}
+
 	return dva.BaseVestingAccount.Validate()
 }
 
+// MarshalYAML returns the YAML representation of a DelayedVestingAccount.
+func (dva DelayedVestingAccount) MarshalYAML() (interface{}, error) {
+	out := newVestingAccountYAML(dva.BaseVestingAccount)
+	if !dva.FreeCoins.IsZero() {
+		out.FreeCoins = dva.FreeCoins.String()
+	}
+
+	return out.marshal()
+}
+
+// String implements the fmt.Stringer interface.
+func (dva DelayedVestingAccount) String() string {
+	out, _ := dva.MarshalYAML()
+	return out.(string)
+}
+
 //-----------------------------------------------------------------------------
 // Permanent Locked Vesting Account
 
@@ -495,8 +924,8 @@ func (plva PermanentLockedAccount) LockedCoins(_ time.Time) sdk.Coins {
 // TrackDelegation tracks a desired delegation amount by setting the appropriate
 // values for the amount of delegated vesting, delegated free, and reducing the
 // overall amount of base coins.
-func (plva *PermanentLockedAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
-	plva.BaseVestingAccount.TrackDelegation(balance, plva.OriginalVesting, amount)
+func (plva *PermanentLockedAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) error {
+	return plva.BaseVestingAccount.TrackDelegation(balance, plva.OriginalVesting, amount)
 }
 
 // GetStartTime returns zero since a permanent locked vesting account has no start time.
@@ -518,3 +947,302 @@ func (plva PermanentLockedAccount) Validate() error {
 
 	return plva.BaseVestingAccount.Validate()
 }
+
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccountRaw creates a new ClawbackVestingAccount object from BaseVestingAccount
+func NewClawbackVestingAccountRaw(bva *BaseVestingAccount, startTime int64, funderAddress string) *ClawbackVestingAccount {
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		FunderAddress:      funderAddress,
+	}
+}
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime, endTime int64, funderAddress string) (*ClawbackVestingAccount, error) {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	clawbackVestingAccount := &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          startTime,
+		FunderAddress:      funderAddress,
+	}
+
+	return clawbackVestingAccount, clawbackVestingAccount.Validate()
+}
+
+// GetVestedCoins returns the total number of vested coins, vesting continuously
+// like a ContinuousVestingAccount. If no coins are vested, nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= cva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - cva.StartTime
+	y := cva.EndTime - cva.StartTime
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	for _, ovc := range cva.OriginalVesting {
+		vestedAmt := math.LegacyNewDecFromInt(ovc.Amount).Mul(s).RoundInt()
+		vestedCoins = append(vestedCoins, sdk.NewCoin(ovc.Denom, vestedAmt))
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins, including the
+// pending clawback which is never released to the account. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...).Add(cva.PendingClawback...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) error {
+	return cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// Clawback stops the vesting schedule at the given block time and claws back
+// the coins that are still vesting, along with the pending clawback. The ones
+// held by the account are returned right away, while the delegated ones are
+// kept as the pending clawback, which stays locked in the account once
+// undelegated, until released by ReleasePendingClawback.
+//
+// CONTRACT: The account must be updated in state before the returned coins are
+// sent out of the account, as they are locked until then.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time) (toReturn, pending sdk.Coins) {
+	vested := cva.GetVestedCoins(blockTime)
+	vesting := cva.GetVestingCoins(blockTime)
+
+	// the delegated vesting coins are considered vesting first, as they are
+	// when tracking delegations, and the rest of them become delegated free
+	pending = vesting.Min(cva.DelegatedVesting)
+	toReturn = vesting.Sub(pending...)
+
+	cva.DelegatedFree = cva.DelegatedFree.Add(cva.DelegatedVesting.Sub(pending...)...)
+	cva.DelegatedVesting = pending
+	cva.PendingClawback = pending
+
+	// the account keeps its vested coins and stops vesting
+	cva.OriginalVesting = sdk.NewCoins(vested...)
+	if blockTime.Unix() < cva.StartTime {
+		cva.StartTime = blockTime.Unix()
+	}
+	cva.EndTime = blockTime.Unix()
+
+	return toReturn, pending
+}
+
+// ReleasePendingClawback releases the pending clawback which is no longer
+// delegated, up to the given balance of the account, and returns it. The
+// delegated vesting coins are considered part of the pending clawback, as they
+// are when clawing back.
+//
+// CONTRACT: The account must be updated in state before the returned coins are
+// sent out of the account, as they are locked until then.
+func (cva *ClawbackVestingAccount) ReleasePendingClawback(balance sdk.Coins) sdk.Coins {
+	undelegated := cva.PendingClawback.Sub(cva.PendingClawback.Min(cva.DelegatedVesting)...)
+	released := undelegated.Min(balance)
+	cva.PendingClawback = cva.PendingClawback.Sub(released...)
+	return released
+}
+
+// ReconcileDelegations clamps the tracked delegations of the given denom to
+// the coins actually delegated, like BaseVestingAccount.ReconcileDelegations.
+// The delegated vesting coins lost are removed from the pending clawback, as
+// they are never undelegated.
+func (cva *ClawbackVestingAccount) ReconcileDelegations(delegated sdk.Coin) bool {
+	delegatedVesting := cva.DelegatedVesting.AmountOf(delegated.Denom)
+	if !cva.BaseVestingAccount.ReconcileDelegations(delegated) {
+		return false
+	}
+
+	lost := delegatedVesting.Sub(cva.DelegatedVesting.AmountOf(delegated.Denom))
+	lost = math.MinInt(lost, cva.PendingClawback.AmountOf(delegated.Denom))
+	if lost.IsPositive() {
+		cva.PendingClawback = cva.PendingClawback.Sub(sdk.NewCoin(delegated.Denom, lost))
+	}
+
+	return true
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.FunderAddress == "" {
+		return errors.New("funder address cannot be empty")
+	}
+
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	if cva.EndTime < 0 {
+		return errors.New("end time cannot be negative")
+	}
+
+	// the original vesting is emptied when clawed back before vesting started
+	if !cva.OriginalVesting.IsValid() {
+		return fmt.Errorf("invalid coins: %s", cva.OriginalVesting.String())
+	}
+
+	if !cva.PendingClawback.IsValid() {
+		return fmt.Errorf("invalid pending clawback coins: %s", cva.PendingClawback.String())
+	}
+
+	if err := cva.DelegatedVesting.Validate(); err != nil {
+		return fmt.Errorf("invalid delegated vesting coins: %w", err)
+	}
+
+	if err := cva.DelegatedFree.Validate(); err != nil {
+		return fmt.Errorf("invalid delegated free coins: %w", err)
+	}
+
+	if !(cva.DelegatedVesting.IsAllLTE(cva.OriginalVesting.Add(cva.PendingClawback...))) {
+		return errors.New("delegated vesting amount cannot be greater than original vesting amount")
+	}
+
+	return cva.BaseAccount.Validate()
+}
+
+// ClawbackVesting claws back the coins still vesting at the given block time of
+// a continuous, delayed, periodic or clawback vesting account. It returns the
+// updated account, the clawed back coins, and the delegated vesting coins which
+// are excluded from the clawback.
+//
+// A clawback vesting account is clawed back by its Clawback method, the
+// excluded coins being its pending clawback. The other accounts keep their
+// type, and the excluded coins keep vesting on the remaining schedule of the
+// account, scaled down to them:
+//   - a continuous vesting account vests them from the given block time, or
+//     from its start or cliff time if later, until its end time;
+//   - a delayed vesting account keeps its free coins, and vests them at its end
+//     time;
+//   - a periodic vesting account keeps its elapsed periods, and vests them in
+//     its remaining periods, proportionally to their amounts.
+//
+// An account left without any original vesting coins is turned into a base
+// account.
+//
+// CONTRACT: The account must be updated in state before the returned coins are
+// sent out of the account, as they are locked until then.
+func ClawbackVesting(acc vestexported.VestingAccount, blockTime time.Time) (updated sdk.AccountI, clawedBack, excluded sdk.Coins, err error) {
+	var bva *BaseVestingAccount
+	switch acc := acc.(type) {
+	case *ClawbackVestingAccount:
+		clawedBack, excluded = acc.Clawback(blockTime)
+		return acc, clawedBack, excluded, nil
+	case *ContinuousVestingAccount:
+		bva = acc.BaseVestingAccount
+	case *DelayedVestingAccount:
+		bva = acc.BaseVestingAccount
+	case *PeriodicVestingAccount:
+		bva = acc.BaseVestingAccount
+	default:
+		return nil, nil, nil, fmt.Errorf("cannot claw back account of type %T", acc)
+	}
+
+	vesting := acc.GetVestingCoins(blockTime)
+	if vesting.IsZero() {
+		return acc, nil, nil, nil
+	}
+
+	// the delegated vesting coins are considered vesting first, as they are
+	// when tracking delegations, and the rest of them become delegated free
+	excluded = vesting.Min(bva.DelegatedVesting)
+	clawedBack = vesting.Sub(excluded...)
+
+	bva.DelegatedFree = bva.DelegatedFree.Add(bva.DelegatedVesting.Sub(excluded...)...)
+	bva.DelegatedVesting = excluded
+
+	switch acc := acc.(type) {
+	case *ContinuousVestingAccount:
+		acc.OriginalVesting = excluded
+		if t := blockTime.Unix(); t > acc.StartTime && t >= acc.CliffTime {
+			acc.StartTime = t
+			acc.CliffTime = 0
+		}
+	case *DelayedVestingAccount:
+		acc.OriginalVesting = acc.FreeCoins.Add(excluded...)
+	case *PeriodicVestingAccount:
+		acc.scaleRemainingPeriods(blockTime, vesting, excluded)
+	}
+
+	if bva.OriginalVesting.IsZero() {
+		return bva.BaseAccount, clawedBack, excluded, nil
+	}
+
+	return acc, clawedBack, excluded, nil
+}
+
+// scaleRemainingPeriods scales the amounts of the periods not elapsed at the
+// given block time, vesting the given coins, down to the given excluded coins.
+// Each amount is rounded down, the remainder going to the last period of its
+// denom. A period left empty is merged into the next one, and the trailing
+// empty periods are dropped.
+func (pva *PeriodicVestingAccount) scaleRemainingPeriods(blockTime time.Time, vesting, excluded sdk.Coins) {
+	periods := Periods(pva.VestingPeriods)
+	elapsed := 0
+	if blockTime.Unix() > pva.StartTime {
+		elapsed = periods.elapsedCount(blockTime.Unix() - pva.StartTime)
+	}
+
+	remaining := make([]sdk.Coins, len(periods)-elapsed)
+	last := make(map[string]int)
+	scaled := sdk.NewCoins()
+	for i, period := range periods[elapsed:] {
+		for _, coin := range period.Amount {
+			amount := coin.Amount.Mul(excluded.AmountOf(coin.Denom)).Quo(vesting.AmountOf(coin.Denom))
+			remaining[i] = remaining[i].Add(sdk.NewCoin(coin.Denom, amount))
+			scaled = scaled.Add(sdk.NewCoin(coin.Denom, amount))
+			last[coin.Denom] = i
+		}
+	}
+	for _, coin := range excluded {
+		i := last[coin.Denom]
+		remaining[i] = remaining[i].Add(coin.SubAmount(scaled.AmountOf(coin.Denom)))
+	}
+
+	newPeriods := append([]Period{}, periods[:elapsed]...)
+	var length int64
+	for i, amount := range remaining {
+		length += periods[elapsed+i].Length
+		if amount.IsZero() {
+			continue
+		}
+		newPeriods = append(newPeriods, Period{Length: length, Amount: amount})
+		length = 0
+	}
+
+	pva.VestingPeriods = newPeriods
+	pva.OriginalVesting = Periods(newPeriods).TotalAmount()
+	pva.EndTime = pva.StartTime + Periods(newPeriods).TotalLength()
+}
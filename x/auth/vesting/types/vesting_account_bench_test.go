@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// linearPeriodicVestedCoins is the original implementation of
+// PeriodicVestingAccount.GetVestedCoins, walking every period, kept as a
+// reference for the binary search.
+func linearPeriodicVestedCoins(pva *types.PeriodicVestingAccount, blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	currentPeriodStartTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount...)
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// newRandomPeriodicVestingAccount creates a periodic vesting account with n
+// periods of random length (possibly zero) and random amounts of up to two
+// denominations.
+func newRandomPeriodicVestingAccount(t testing.TB, r *rand.Rand, n int) *types.PeriodicVestingAccount {
+	t.Helper()
+
+	periods := make(types.Periods, n)
+	for i := range periods {
+		amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 1+r.Int63n(1000)))
+		if r.Intn(2) == 0 {
+			amount = amount.Add(sdk.NewInt64Coin(feeDenom, 1+r.Int63n(1000)))
+		}
+		periods[i] = types.Period{Length: r.Int63n(3), Amount: amount}
+	}
+	// the account must end after it starts
+	periods[n-1].Length++
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	pva, err := types.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), periods.TotalAmount(), 1000, periods)
+	require.NoError(t, err)
+	require.NoError(t, pva.Validate())
+	return pva
+}
+
+// newDailyPeriodicVestingAccount creates a periodic vesting account releasing
+// the same amount every day over n days.
+func newDailyPeriodicVestingAccount(b *testing.B, n int) *types.PeriodicVestingAccount {
+	b.Helper()
+
+	periods := make(types.Periods, n)
+	for i := range periods {
+		periods[i] = types.Period{Length: 24 * 60 * 60, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 1000))}
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	pva, err := types.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), periods.TotalAmount(), 0, periods)
+	require.NoError(b, err)
+	return pva
+}
+
+func FuzzPeriodicVestingAccountGetVestedCoins(f *testing.F) {
+	f.Add(int64(0), uint16(1))
+	f.Add(int64(1), uint16(2))
+	f.Add(int64(2), uint16(7))
+	f.Add(int64(3), uint16(64))
+	f.Add(int64(4), uint16(500))
+
+	f.Fuzz(func(t *testing.T, seed int64, n uint16) {
+		if n == 0 || n > 2000 {
+			t.Skip()
+		}
+
+		pva := newRandomPeriodicVestingAccount(t, rand.New(rand.NewSource(seed)), int(n))
+
+		// compare both implementations at, and around, every period boundary
+		for unix := pva.StartTime - 1; unix <= pva.EndTime+1; unix++ {
+			blockTime := time.Unix(unix, 0)
+			expected := linearPeriodicVestedCoins(pva, blockTime)
+			actual := pva.GetVestedCoins(blockTime)
+			require.True(t, expected.Equal(actual), "time %d: expected %s, got %s", unix, expected, actual)
+			require.Equal(t, expected == nil, actual == nil, "time %d", unix)
+		}
+	})
+}
+
+func BenchmarkPeriodicVestingAccountGetVestedCoins(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		pva := newDailyPeriodicVestingAccount(b, n)
+		// just before the end of the schedule is the worst case of the old linear walk
+		blockTime := time.Unix(pva.EndTime-1, 0)
+
+		b.Run(fmt.Sprintf("old/periods=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				linearPeriodicVestedCoins(pva, blockTime)
+			}
+		})
+
+		b.Run(fmt.Sprintf("new/periods=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pva.GetVestedCoins(blockTime)
+			}
+		})
+
+		halfway := time.Unix(pva.EndTime/2, 0)
+		b.Run(fmt.Sprintf("old-halfway/periods=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				linearPeriodicVestedCoins(pva, halfway)
+			}
+		})
+
+		b.Run(fmt.Sprintf("new-halfway/periods=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pva.GetVestedCoins(halfway)
+			}
+		})
+	}
+}
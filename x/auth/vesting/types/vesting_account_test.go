@@ -1,6 +1,9 @@
 package types_test
 
 import (
+	"fmt"
+	"math"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -22,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -29,6 +33,7 @@ var (
 	stakeDenom = "stake"
 	feeDenom   = "fee"
 	emptyCoins = sdk.Coins{}
+	funderAddr = sdk.AccAddress([]byte("funder______________"))
 )
 
 type VestingAccountTestSuite struct {
@@ -102,6 +107,50 @@ func TestGetVestedCoinsContVestingAcc(t *testing.T) {
 	require.Equal(t, origCoins, vestedCoins)
 }
 
+func TestContVestingAccFutureStart(t *testing.T) {
+	now := time.Now()
+	startTime := now.Add(12 * time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewContinuousVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix())
+	require.NoError(t, err)
+
+	// require all coins locked while the schedule has not started yet
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+	require.Equal(t, origCoins, cva.LockedCoins(now))
+
+	// require no coins vested at the start time
+	require.Nil(t, cva.GetVestedCoins(startTime))
+	require.Equal(t, origCoins, cva.GetVestingCoins(startTime))
+
+	// require 50% of coins vested at the midpoint
+	midTime := startTime.Add(12 * time.Hour)
+	halfCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}
+	require.Equal(t, halfCoins, cva.GetVestedCoins(midTime))
+	require.Equal(t, halfCoins, cva.GetVestingCoins(midTime))
+
+	// require all coins vested at the end time
+	require.Equal(t, origCoins, cva.GetVestedCoins(endTime))
+	require.True(t, cva.GetVestingCoins(endTime).IsZero())
+	require.True(t, cva.LockedCoins(endTime).IsZero())
+}
+
+func TestValidateContVestingAcc(t *testing.T) {
+	now := time.Now()
+
+	bacc, origCoins := initBaseAccount()
+	_, err := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), now.Unix())
+	require.ErrorContains(t, err, "vesting start-time cannot be equal to end-time")
+
+	_, err = types.NewContinuousVestingAccount(bacc, origCoins, -1, now.Unix())
+	require.ErrorContains(t, err, "vesting start-time cannot be negative")
+
+	_, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix()+1, now.Unix())
+	require.ErrorContains(t, err, "vesting start-time cannot be before end-time")
+}
+
 func TestGetVestingCoinsContVestingAcc(t *testing.T) {
 	now := time.Now()
 	startTime := now.Add(24 * time.Hour)
@@ -164,6 +213,15 @@ func TestSpendableCoinsContVestingAcc(t *testing.T) {
 	// require 25% of coins vesting after 3/4 of the time between start and end time has passed
 	lockedCoins = cva.LockedCoins(startTime.Add(18 * time.Hour))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, lockedCoins)
+
+	// delegate all the stake before the beginning of the vesting schedule
+	// require that the locked stake floors at zero once the delegated vesting
+	// coins exceed the stake still vesting, without affecting the fee coins
+	require.NoError(t, cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, cva.LockedCoins(startTime))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, cva.LockedCoins(startTime.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250)}, cva.LockedCoins(startTime.Add(18*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), cva.LockedCoins(endTime))
 }
 
 func TestTrackDelegationContVestingAcc(t *testing.T) {
@@ -175,38 +233,65 @@ func TestTrackDelegationContVestingAcc(t *testing.T) {
 	// require the ability to delegate all vesting coins
 	cva, err := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	cva.TrackDelegation(now, origCoins, origCoins)
+	require.NoError(t, cva.TrackDelegation(now, origCoins, origCoins))
 	require.Equal(t, origCoins, cva.DelegatedVesting)
 	require.Nil(t, cva.DelegatedFree)
 
 	// require the ability to delegate all vested coins
 	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	cva.TrackDelegation(endTime, origCoins, origCoins)
+	require.NoError(t, cva.TrackDelegation(endTime, origCoins, origCoins))
 	require.Nil(t, cva.DelegatedVesting)
 	require.Equal(t, origCoins, cva.DelegatedFree)
 
 	// require the ability to delegate all vesting coins (50%) and all vested coins (50%)
 	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
 	require.Nil(t, cva.DelegatedFree)
 
-	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
 
 	// require no modifications when delegation amount is zero or not enough funds
 	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		cva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
-	})
+	require.Error(t, cva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)}))
 	require.Nil(t, cva.DelegatedVesting)
 	require.Nil(t, cva.DelegatedFree)
 }
 
+func TestTrackDelegationFailureLeavesAccountUntouched(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+	require.NoError(t, err)
+
+	// delegate half of the stake at the halfway point
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	delegatedVesting, delegatedFree := cva.DelegatedVesting, cva.DelegatedFree
+
+	// the valid fee coin must not be tracked when the stake coin fails
+	err = cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 1000)})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Equal(t, delegatedVesting, cva.DelegatedVesting)
+	require.Equal(t, delegatedFree, cva.DelegatedFree)
+
+	err = cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 0)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+	require.Equal(t, delegatedVesting, cva.DelegatedVesting)
+	require.Equal(t, delegatedFree, cva.DelegatedFree)
+
+	err = cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 0)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+	require.Equal(t, delegatedVesting, cva.DelegatedVesting)
+	require.Equal(t, delegatedFree, cva.DelegatedFree)
+}
+
 func TestTrackUndelegationContVestingAcc(t *testing.T) {
 	now := time.Now()
 	endTime := now.Add(24 * time.Hour)
@@ -216,43 +301,248 @@ func TestTrackUndelegationContVestingAcc(t *testing.T) {
 	// require the ability to undelegate all vesting coins
 	cva, err := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	cva.TrackDelegation(now, origCoins, origCoins)
-	cva.TrackUndelegation(origCoins)
+	require.NoError(t, cva.TrackDelegation(now, origCoins, origCoins))
+	require.NoError(t, cva.TrackUndelegation(origCoins))
 	require.Nil(t, cva.DelegatedFree)
 	require.Equal(t, emptyCoins, cva.DelegatedVesting)
 
 	// require the ability to undelegate all vested coins
 	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	cva.TrackDelegation(endTime, origCoins, origCoins)
-	cva.TrackUndelegation(origCoins)
+	require.NoError(t, cva.TrackDelegation(endTime, origCoins, origCoins))
+	require.NoError(t, cva.TrackUndelegation(origCoins))
 	require.Equal(t, emptyCoins, cva.DelegatedFree)
 	require.Nil(t, cva.DelegatedVesting)
 
 	// require no modifications when the undelegation amount is zero
 	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
-	})
+	require.Error(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}))
 	require.Nil(t, cva.DelegatedFree)
 	require.Nil(t, cva.DelegatedVesting)
 
 	// vest 50% and delegate to two validators
 	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
 	require.NoError(t, err)
-	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 
 	// undelegate from one validator that got slashed 50%
-	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
 
 	// undelegate from the other validator that did not get slashed
-	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Equal(t, emptyCoins, cva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+
+	// vest 50% and delegate to two validators, with proportional undelegations
+	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+	require.NoError(t, err)
+	cva.ProportionalUndelegation = true
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+
+	// undelegate from one validator that got slashed 50%, the remainder going
+	// to the delegated vesting coins
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 38)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 37)}, cva.DelegatedVesting)
+
+	// undelegate from the other validator that did not get slashed
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 13)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 12)}, cva.DelegatedVesting)
+}
+
+func TestReconcileDelegations(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+
+	// vest 50% and delegate to two validators
+	cva, err := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+
+	// require no modifications while the delegations are intact
+	require.False(t, cva.ReconcileDelegations(sdk.NewInt64Coin(stakeDenom, 100)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+
+	// undelegate from one validator that got slashed 50%, the slashed coins
+	// being attributed to the delegated vesting coins
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
+	require.True(t, cva.ReconcileDelegations(sdk.NewInt64Coin(stakeDenom, 50)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+
+	// undelegate from the other validator that did not get slashed
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.False(t, cva.ReconcileDelegations(sdk.NewInt64Coin(stakeDenom, 0)))
+	require.Equal(t, emptyCoins, cva.DelegatedFree)
+	require.Equal(t, emptyCoins, cva.DelegatedVesting)
+
+	// the delegated free coins absorb the excess once the delegated vesting
+	// coins are exhausted
+	cva, err = types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}))
+	require.True(t, cva.ReconcileDelegations(sdk.NewInt64Coin(stakeDenom, 20)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, cva.DelegatedFree)
+	require.Equal(t, emptyCoins, cva.DelegatedVesting)
+
+	// other denoms are left untouched
+	require.False(t, cva.ReconcileDelegations(sdk.NewInt64Coin(feeDenom, 0)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, cva.DelegatedFree)
+}
+
+func TestTrackUndelegationProportionalInvariants(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(100 * time.Hour)
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000)}
+
+	for seed := int64(0); seed < 100; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		bacc, _ := initBaseAccount()
+		cva, err := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+		require.NoError(t, err)
+		cva.ProportionalUndelegation = true
+
+		balance, delegated := int64(1000), int64(0)
+		for step := 0; step < 50; step++ {
+			blockTime := now.Add(time.Duration(step*2) * time.Hour)
+
+			// delegate
+			if balance > 0 {
+				amount := 1 + r.Int63n(balance)
+				require.NoError(t, cva.TrackDelegation(blockTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, balance)}, sdk.Coins{sdk.NewInt64Coin(stakeDenom, amount)}))
+				balance -= amount
+				delegated += amount
+			}
+
+			// slash
+			delegated -= delegated * r.Int63n(30) / 100
+
+			// undelegate
+			if delegated > 0 {
+				amount := 1 + r.Int63n(delegated)
+				require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, amount)}))
+				balance += amount
+				delegated -= amount
+			}
+
+			require.True(t, cva.DelegatedFree.IsValid(), "seed %d step %d: delegated free %s", seed, step, cva.DelegatedFree)
+			require.True(t, cva.DelegatedVesting.IsValid(), "seed %d step %d: delegated vesting %s", seed, step, cva.DelegatedVesting)
+			require.True(t, cva.DelegatedVesting.IsAllLTE(cva.OriginalVesting), "seed %d step %d: delegated vesting %s", seed, step, cva.DelegatedVesting)
+		}
+	}
+}
+
+func TestGetVestedCoinsContVestingAccWithCliff(t *testing.T) {
+	now := time.Now()
+	startTime := now.Add(24 * time.Hour)
+	cliffTime := startTime.Add(12 * time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewContinuousVestingAccountWithCliff(bacc, origCoins, startTime.Unix(), cliffTime.Unix(), endTime.Unix())
+	require.NoError(t, err)
+
+	// require no coins vested before the cliff
+	require.Nil(t, cva.GetVestedCoins(startTime.Add(6*time.Hour)))
+	require.Nil(t, cva.GetVestedCoins(cliffTime.Add(-time.Second)))
+
+	// require the coins vested since the start time (50%) to be vested at the cliff
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(cliffTime))
+
+	// require 75% of coins vested after the cliff
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, cva.GetVestedCoins(startTime.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, cva.GetVestedCoins(endTime))
+
+	// require the same vesting as without cliff after the cliff, and with a zero cliff
+	noCliff, err := types.NewContinuousVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix())
+	require.NoError(t, err)
+	zeroCliff, err := types.NewContinuousVestingAccountWithCliff(bacc, origCoins, startTime.Unix(), 0, endTime.Unix())
+	require.NoError(t, err)
+	for _, blockTime := range []time.Time{now, startTime.Add(6 * time.Hour), cliffTime, startTime.Add(18 * time.Hour), endTime} {
+		require.Equal(t, noCliff.GetVestedCoins(blockTime), zeroCliff.GetVestedCoins(blockTime))
+		if !blockTime.Before(cliffTime) {
+			require.Equal(t, noCliff.GetVestedCoins(blockTime), cva.GetVestedCoins(blockTime))
+		}
+	}
+}
+
+func TestSpendableCoinsContVestingAccWithCliff(t *testing.T) {
+	now := time.Now()
+	startTime := now.Add(24 * time.Hour)
+	cliffTime := startTime.Add(12 * time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewContinuousVestingAccountWithCliff(bacc, origCoins, startTime.Unix(), cliffTime.Unix(), endTime.Unix())
+	require.NoError(t, err)
+
+	// require that all original coins are locked before the cliff
+	require.Equal(t, origCoins, cva.LockedCoins(startTime.Add(6*time.Hour)))
+
+	// require that the coins vested at the cliff (50%) are spendable
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.LockedCoins(cliffTime))
+
+	// require that there exist no locked coins in the end of the vesting schedule
+	require.Equal(t, sdk.NewCoins(), cva.LockedCoins(endTime))
+}
+
+func TestTrackDelegationContVestingAccWithCliff(t *testing.T) {
+	now := time.Now()
+	cliffTime := now.Add(12 * time.Hour)
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+
+	// require delegations before the cliff to be all vesting
+	cva, err := types.NewContinuousVestingAccountWithCliff(bacc, origCoins, now.Unix(), cliffTime.Unix(), endTime.Unix())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now.Add(6*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+
+	// require delegations at the cliff to be vesting (50%) and free (50%)
+	cva, err = types.NewContinuousVestingAccountWithCliff(bacc, origCoins, now.Unix(), cliffTime.Unix(), endTime.Unix())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(cliffTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+}
+
+func TestContinuousVestingAccountJSONWithoutCliff(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, vesting.AppModule{}).Codec
+
+	// a continuous vesting account exported before the cliff was introduced
+	bz := []byte(fmt.Sprintf(`{
+		"@type": "/cosmos.vesting.v1beta1.ContinuousVestingAccount",
+		"base_vesting_account": {
+			"base_account": {"address": %q, "account_number": "1", "sequence": "0"},
+			"original_vesting": [{"denom": "stake", "amount": "100"}],
+			"delegated_free": [],
+			"delegated_vesting": [],
+			"end_time": "200"
+		},
+		"start_time": "100"
+	}`, funderAddr.String()))
+
+	var acc sdk.AccountI
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &acc))
+	cva, ok := acc.(*types.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Zero(t, cva.CliffTime)
+	require.NoError(t, cva.Validate())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(time.Unix(150, 0)))
 }
 
 func TestGetVestedCoinsDelVestingAcc(t *testing.T) {
@@ -314,9 +604,115 @@ func TestSpendableCoinsDelVestingAcc(t *testing.T) {
 	// delegate some locked coins
 	// require that locked is reduced
 	delegatedAmount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))
-	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, delegatedAmount)
+	require.NoError(t, dva.TrackDelegation(now.Add(12*time.Hour), origCoins, delegatedAmount))
 	lockedCoins = dva.LockedCoins(now.Add(12 * time.Hour))
 	require.True(t, lockedCoins.Equal(origCoins.Sub(delegatedAmount...)))
+
+	// delegate more stake than is still locked
+	// require that the locked stake floors at zero, without affecting the fee coins
+	require.NoError(t, dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, dva.LockedCoins(now))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, dva.LockedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), dva.LockedCoins(endTime))
+}
+
+func TestSpendableCoinsDelVestingAccWithFreeCoins(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	freeCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 50), sdk.NewInt64Coin(stakeDenom, 5)}
+	lockedAtStart := sdk.Coins{sdk.NewInt64Coin(feeDenom, 950), sdk.NewInt64Coin(stakeDenom, 95)}
+
+	// require that all coins but the 5% allowance are locked in the beginning
+	// of the vesting schedule
+	dva, err := types.NewDelayedVestingAccountWithFreeCoins(bacc, origCoins, freeCoins, endTime.Unix())
+	require.NoError(t, err)
+	require.Equal(t, freeCoins, dva.GetVestedCoins(now))
+	require.Equal(t, lockedAtStart, dva.GetVestingCoins(now))
+	require.Equal(t, lockedAtStart, dva.LockedCoins(now))
+
+	// require that all coins are spendable after the maturation of the vesting
+	// schedule
+	require.Equal(t, origCoins, dva.GetVestedCoins(endTime))
+	require.Equal(t, sdk.NewCoins(), dva.LockedCoins(endTime))
+
+	// require that the allowance is still the only spendable part after some time
+	require.Equal(t, lockedAtStart, dva.LockedCoins(now.Add(12*time.Hour)))
+
+	// delegate some locked coins
+	// require that locked is reduced
+	delegatedAmount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))
+	require.NoError(t, dva.TrackDelegation(now.Add(12*time.Hour), origCoins, delegatedAmount))
+	require.Equal(t, lockedAtStart.Sub(delegatedAmount...), dva.LockedCoins(now.Add(12*time.Hour)))
+
+	// delegate more stake than is still locked
+	// require that the delegations exceeding the locked stake are free, and
+	// that the locked stake floors at zero without affecting the fee coins
+	require.NoError(t, dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 95)}, dva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 5)}, dva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 950)}, dva.LockedCoins(now))
+	require.Equal(t, sdk.NewCoins(), dva.LockedCoins(endTime))
+}
+
+func TestDelayedVestingAccountFreeCoinsValidate(t *testing.T) {
+	endTime := time.Now().Add(24 * time.Hour).Unix()
+	bacc, origCoins := initBaseAccount()
+
+	testCases := []struct {
+		name      string
+		freeCoins sdk.Coins
+		expErr    string
+	}{
+		{"no free coins", nil, ""},
+		{"5% allowance", sdk.Coins{sdk.NewInt64Coin(feeDenom, 50), sdk.NewInt64Coin(stakeDenom, 5)}, ""},
+		{"all coins free", origCoins, ""},
+		{"free coins greater than the original vesting", sdk.Coins{sdk.NewInt64Coin(stakeDenom, 101)}, "cannot be greater than the original vesting coins"},
+		{"free coins of another denom", sdk.Coins{sdk.NewInt64Coin("other", 1)}, "is not in the original vesting coins"},
+		{"zero free coins", sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}, "invalid free coins"},
+		{"unsorted free coins", sdk.Coins{sdk.NewInt64Coin(stakeDenom, 5), sdk.NewInt64Coin(feeDenom, 50)}, "invalid free coins"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.NewDelayedVestingAccountWithFreeCoins(bacc, origCoins, tc.freeCoins, endTime)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestDelayedVestingAccountJSONWithoutFreeCoins(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, vesting.AppModule{})
+
+	// a delayed vesting account exported before the free coins were introduced
+	bz := []byte(fmt.Sprintf(`{
+		"@type": "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+		"base_vesting_account": {
+			"base_account": {"address": %q, "account_number": "1", "sequence": "0"},
+			"original_vesting": [{"denom": "stake", "amount": "100"}],
+			"delegated_free": [],
+			"delegated_vesting": [],
+			"end_time": "200"
+		}
+	}`, funderAddr.String()))
+
+	var acc sdk.AccountI
+	require.NoError(t, encCfg.Codec.UnmarshalInterfaceJSON(bz, &acc))
+	dva, ok := acc.(*types.DelayedVestingAccount)
+	require.True(t, ok)
+	require.Empty(t, dva.FreeCoins)
+	require.NoError(t, dva.Validate())
+	require.Nil(t, dva.GetVestedCoins(time.Unix(150, 0)))
+
+	// the legacy amino JSON of an account without free coins is unchanged
+	legacyBz, err := encCfg.Amino.MarshalJSON(dva)
+	require.NoError(t, err)
+	require.NotContains(t, string(legacyBz), "free_coins")
 }
 
 func TestTrackDelegationDelVestingAcc(t *testing.T) {
@@ -328,14 +724,14 @@ func TestTrackDelegationDelVestingAcc(t *testing.T) {
 	// require the ability to delegate all vesting coins
 	dva, err := types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	dva.TrackDelegation(now, origCoins, origCoins)
+	require.NoError(t, dva.TrackDelegation(now, origCoins, origCoins))
 	require.Equal(t, origCoins, dva.DelegatedVesting)
 	require.Nil(t, dva.DelegatedFree)
 
 	// require the ability to delegate all vested coins
 	dva, err = types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	dva.TrackDelegation(endTime, origCoins, origCoins)
+	require.NoError(t, dva.TrackDelegation(endTime, origCoins, origCoins))
 	require.Nil(t, dva.DelegatedVesting)
 	require.Equal(t, origCoins, dva.DelegatedFree)
 
@@ -343,16 +739,14 @@ func TestTrackDelegationDelVestingAcc(t *testing.T) {
 	// schedule
 	dva, err = types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, origCoins)
+	require.NoError(t, dva.TrackDelegation(now.Add(12*time.Hour), origCoins, origCoins))
 	require.Equal(t, origCoins, dva.DelegatedVesting)
 	require.Nil(t, dva.DelegatedFree)
 
 	// require no modifications when delegation amount is zero or not enough funds
 	dva, err = types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		dva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
-	})
+	require.Error(t, dva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)}))
 	require.Nil(t, dva.DelegatedVesting)
 	require.Nil(t, dva.DelegatedFree)
 }
@@ -366,44 +760,60 @@ func TestTrackUndelegationDelVestingAcc(t *testing.T) {
 	// require the ability to undelegate all vesting coins
 	dva, err := types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	dva.TrackDelegation(now, origCoins, origCoins)
-	dva.TrackUndelegation(origCoins)
+	require.NoError(t, dva.TrackDelegation(now, origCoins, origCoins))
+	require.NoError(t, dva.TrackUndelegation(origCoins))
 	require.Nil(t, dva.DelegatedFree)
 	require.Equal(t, emptyCoins, dva.DelegatedVesting)
 
 	// require the ability to undelegate all vested coins
 	dva, err = types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	dva.TrackDelegation(endTime, origCoins, origCoins)
-	dva.TrackUndelegation(origCoins)
+	require.NoError(t, dva.TrackDelegation(endTime, origCoins, origCoins))
+	require.NoError(t, dva.TrackUndelegation(origCoins))
 	require.Equal(t, emptyCoins, dva.DelegatedFree)
 	require.Nil(t, dva.DelegatedVesting)
 
 	// require no modifications when the undelegation amount is zero
 	dva, err = types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
-	})
+	require.Error(t, dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}))
 	require.Nil(t, dva.DelegatedFree)
 	require.Nil(t, dva.DelegatedVesting)
 
 	// vest 50% and delegate to two validators
 	dva, err = types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
 	require.NoError(t, err)
-	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 
 	// undelegate from one validator that got slashed 50%
-	dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.NoError(t, dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
 
 	require.Nil(t, dva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, dva.DelegatedVesting)
 
 	// undelegate from the other validator that did not get slashed
-	dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Nil(t, dva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, dva.DelegatedVesting)
+
+	// delegate before and after the end time, with proportional undelegations
+	dva, err = types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
+	require.NoError(t, err)
+	dva.ProportionalUndelegation = true
+	require.NoError(t, dva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, dva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+
+	// undelegate from one validator that got slashed 50%, the remainder going
+	// to the delegated vesting coins
+	require.NoError(t, dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 38)}, dva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 37)}, dva.DelegatedVesting)
+
+	// undelegate more than what is tracked
+	require.NoError(t, dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}))
+	require.Equal(t, emptyCoins, dva.DelegatedFree)
+	require.Equal(t, emptyCoins, dva.DelegatedVesting)
 }
 
 func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
@@ -472,7 +882,7 @@ func TestOverflowAndNegativeVestedCoinsPeriods(t *testing.T) {
 				types.Period{Length: 9223372036854775108, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
 				types.Period{Length: 6 * 60 * 60, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
 			},
-			"vesting start-time cannot be before end-time", // it overflow to a negative number, making start-time > end-time
+			"total length of vesting periods exceeds the maximum of 6307200000 seconds",
 		},
 		{
 			"good periods that are not negative nor overflow",
@@ -502,6 +912,164 @@ func TestOverflowAndNegativeVestedCoinsPeriods(t *testing.T) {
 	}
 }
 
+func TestPeriodsEndTime(t *testing.T) {
+	coins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	tests := []struct {
+		name       string
+		startTime  int64
+		periods    types.Periods
+		expEndTime int64
+		expErr     string
+	}{
+		{
+			"no periods",
+			100,
+			types.Periods{},
+			100,
+			"",
+		},
+		{
+			"maximum schedule length",
+			100,
+			types.Periods{{Length: types.MaxScheduleLength - 1, Amount: coins}, {Length: 1, Amount: coins}},
+			100 + types.MaxScheduleLength,
+			"",
+		},
+		{
+			"schedule length above the maximum",
+			100,
+			types.Periods{{Length: types.MaxScheduleLength, Amount: coins}, {Length: 1, Amount: coins}},
+			0,
+			"total length of vesting periods exceeds the maximum of 6307200000 seconds",
+		},
+		{
+			"period lengths summing past MaxInt64",
+			0,
+			types.Periods{{Length: math.MaxInt64, Amount: coins}, {Length: math.MaxInt64, Amount: coins}},
+			0,
+			"total length of vesting periods exceeds the maximum of 6307200000 seconds",
+		},
+		{
+			"end time at MaxInt64",
+			math.MaxInt64 - 60,
+			types.Periods{{Length: 60, Amount: coins}},
+			math.MaxInt64,
+			"",
+		},
+		{
+			"end time overflowing MaxInt64",
+			math.MaxInt64 - 59,
+			types.Periods{{Length: 60, Amount: coins}},
+			0,
+			"vesting end time overflows",
+		},
+		{
+			"negative length",
+			100,
+			types.Periods{{Length: 60, Amount: coins}, {Length: -1, Amount: coins}},
+			0,
+			"period #1 has a negative length: -1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endTime, err := tt.periods.EndTime(tt.startTime)
+			if tt.expErr != "" {
+				require.EqualError(t, err, tt.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expEndTime, endTime)
+		})
+	}
+}
+
+func TestPeriodicVestingAccountOverflowingSchedule(t *testing.T) {
+	bacc, origCoins := initBaseAccount()
+	periods := types.Periods{{Length: 60, Amount: origCoins}}
+
+	_, err := types.NewPeriodicVestingAccount(bacc, origCoins, math.MaxInt64-59, periods)
+	require.EqualError(t, err, "vesting end time overflows")
+
+	// a wrapped end time set directly on the account is rejected by Validate
+	bva := &types.BaseVestingAccount{BaseAccount: bacc, OriginalVesting: origCoins, EndTime: math.MinInt64}
+	pva := types.NewPeriodicVestingAccountRaw(bva, math.MaxInt64-59, periods)
+	require.Error(t, pva.Validate())
+	pva.StartTime = 0
+	pva.EndTime = 60
+	require.NoError(t, pva.Validate())
+
+	// grants extending the schedule past the maximum length are rejected
+	pva.VestingPeriods = types.Periods{{Length: 60, Amount: origCoins}}
+	grant := types.Periods{{Length: 60, Amount: origCoins}}
+	err = pva.AddGrant(types.MaxScheduleLength, grant, origCoins)
+	require.EqualError(t, err, "invalid merged vesting schedule: total length of vesting periods exceeds the maximum of 6307200000 seconds")
+	require.Equal(t, int64(60), pva.EndTime)
+	require.Equal(t, origCoins, pva.OriginalVesting)
+}
+
+func TestNewPeriodicVestingAccountFromContinuous(t *testing.T) {
+	const day = 24 * 60 * 60
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 7)}
+
+	testCases := []struct {
+		name         string
+		startTime    int64
+		cliffTime    int64
+		endTime      int64
+		periodLength int64
+		expPeriods   int
+		expErr       string
+	}{
+		{"monthly periods", 0, 0, 365 * day, 30 * day, 13, ""},
+		{"periods dividing the schedule", 1000, 0, 1000 + 90*day, 30 * day, 3, ""},
+		{"period longer than the schedule", 1000, 0, 1000 + day, 30 * day, 1, ""},
+		{"periods before the cliff are merged", 0, 100 * day, 365 * day, 30 * day, 11, ""},
+		{"periods vesting no coins are merged", 0, 0, 10 * day, 60, 0, ""},
+		{"non positive period length", 0, 0, 365 * day, 0, 0, "vesting period length must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bacc, _ := initBaseAccount()
+			cva, err := types.NewContinuousVestingAccountWithCliff(bacc, origCoins, tc.startTime, tc.cliffTime, tc.endTime)
+			require.NoError(t, err)
+			cva.DelegatedFree = sdk.Coins{sdk.NewInt64Coin(stakeDenom, 2)}
+			cva.DelegatedVesting = sdk.Coins{sdk.NewInt64Coin(stakeDenom, 3)}
+
+			pva, err := types.NewPeriodicVestingAccountFromContinuous(cva, tc.periodLength)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expPeriods != 0 {
+				require.Len(t, pva.VestingPeriods, tc.expPeriods)
+			}
+			require.Equal(t, cva.StartTime, pva.StartTime)
+			require.Equal(t, cva.EndTime, pva.EndTime)
+			require.Equal(t, cva.OriginalVesting, types.Periods(pva.VestingPeriods).TotalAmount())
+			require.Equal(t, cva.DelegatedFree, pva.DelegatedFree)
+			require.Equal(t, cva.DelegatedVesting, pva.DelegatedVesting)
+
+			// the vested coins differ by at most the coins vesting during a
+			// period, plus one for the rounding
+			r := rand.New(rand.NewSource(tc.endTime))
+			for i := 0; i < 1000; i++ {
+				blockTime := time.Unix(tc.startTime-day+r.Int63n(tc.endTime-tc.startTime+2*day), 0)
+				continuous, periodic := cva.GetVestedCoins(blockTime), pva.GetVestedCoins(blockTime)
+				for _, ovc := range origCoins {
+					bound := ovc.Amount.MulRaw(tc.periodLength).QuoRaw(tc.endTime - tc.startTime).AddRaw(2)
+					diff := continuous.AmountOf(ovc.Denom).Sub(periodic.AmountOf(ovc.Denom)).Abs()
+					require.True(t, diff.LTE(bound), "time %d: continuous %s, periodic %s", blockTime.Unix(), continuous, periodic)
+				}
+			}
+		})
+	}
+}
+
 func TestGetVestingCoinsPeriodicVestingAcc(t *testing.T) {
 	now := time.Now()
 	endTime := now.Add(24 * time.Hour)
@@ -566,6 +1134,15 @@ func TestSpendableCoinsPeriodicVestingAcc(t *testing.T) {
 	// require that all still vesting coins (50%) are locked
 	lockedCoins = pva.LockedCoins(now.Add(12 * time.Hour))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lockedCoins)
+
+	// delegate 75 stake at the beginning of the vesting schedule
+	// require that the locked stake floors at zero once the delegated vesting
+	// coins exceed the stake still vesting, without affecting the fee coins
+	require.NoError(t, pva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 25)}, pva.LockedCoins(now))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, pva.LockedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250)}, pva.LockedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), pva.LockedCoins(endTime))
 }
 
 func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
@@ -582,21 +1159,21 @@ func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
 	// require the ability to delegate all vesting coins
 	pva, err := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(now, origCoins, origCoins)
+	require.NoError(t, pva.TrackDelegation(now, origCoins, origCoins))
 	require.Equal(t, origCoins, pva.DelegatedVesting)
 	require.Nil(t, pva.DelegatedFree)
 
 	// require the ability to delegate all vested coins
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(endTime, origCoins, origCoins)
+	require.NoError(t, pva.TrackDelegation(endTime, origCoins, origCoins))
 	require.Nil(t, pva.DelegatedVesting)
 	require.Equal(t, origCoins, pva.DelegatedFree)
 
 	// delegate half of vesting coins
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(now, origCoins, periods[0].Amount)
+	require.NoError(t, pva.TrackDelegation(now, origCoins, periods[0].Amount))
 	// require that all delegated coins are delegated vesting
 	require.Equal(t, pva.DelegatedVesting, periods[0].Amount)
 	require.Nil(t, pva.DelegatedFree)
@@ -604,7 +1181,7 @@ func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
 	// delegate 75% of coins, split between vested and vesting
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, periods[0].Amount.Add(periods[1].Amount...))
+	require.NoError(t, pva.TrackDelegation(now.Add(12*time.Hour), origCoins, periods[0].Amount.Add(periods[1].Amount...)))
 	// require that the maximum possible amount of vesting coins are chosen for delegation.
 	require.Equal(t, pva.DelegatedFree, periods[1].Amount)
 	require.Equal(t, pva.DelegatedVesting, periods[0].Amount)
@@ -612,24 +1189,75 @@ func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
 	// require the ability to delegate all vesting coins (50%) and all vested coins (50%)
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
 	require.Nil(t, pva.DelegatedFree)
 
-	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedFree)
 
 	// require no modifications when delegation amount is zero or not enough funds
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		pva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
-	})
+	require.Error(t, pva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)}))
 	require.Nil(t, pva.DelegatedVesting)
 	require.Nil(t, pva.DelegatedFree)
 }
 
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	bacc, _ := initBaseAccount()
+	stake := func(amount int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amount)} }
+
+	existingPeriods := types.Periods{
+		{Length: 500, Amount: stake(20)},
+		{Length: 500, Amount: stake(20)},
+	}
+	pva, err := types.NewPeriodicVestingAccount(bacc, stake(40), 1000, existingPeriods)
+	require.NoError(t, err)
+
+	// the grant coins must match its periods
+	require.Error(t, pva.AddGrant(1000, types.Periods{{Length: 500, Amount: stake(20)}}, stake(30)))
+	require.Equal(t, stake(40), pva.OriginalVesting)
+
+	// a grant starting earlier, with a period ending with an existing period
+	grantPeriods := types.Periods{
+		{Length: 200, Amount: stake(10)},
+		{Length: 800, Amount: stake(10)},
+		{Length: 300, Amount: stake(10)},
+	}
+	require.NoError(t, pva.AddGrant(500, grantPeriods, stake(30)))
+	require.NoError(t, pva.Validate())
+	require.Equal(t, int64(500), pva.StartTime)
+	require.Equal(t, int64(2000), pva.EndTime)
+	require.Equal(t, stake(70), pva.OriginalVesting)
+	require.Equal(t, []types.Period{
+		{Length: 200, Amount: stake(10)}, // 700: grant
+		{Length: 800, Amount: stake(30)}, // 1500: existing and grant
+		{Length: 300, Amount: stake(10)}, // 1800: grant
+		{Length: 200, Amount: stake(20)}, // 2000: existing
+	}, pva.VestingPeriods)
+
+	// the coins vest as they would have in two separate accounts
+	existing, err := types.NewPeriodicVestingAccount(bacc, stake(40), 1000, existingPeriods)
+	require.NoError(t, err)
+	grant, err := types.NewPeriodicVestingAccount(bacc, stake(30), 500, grantPeriods)
+	require.NoError(t, err)
+	for unix := int64(400); unix <= 2100; unix += 50 {
+		blockTime := time.Unix(unix, 0)
+		expected := existing.GetVestedCoins(blockTime).Add(grant.GetVestedCoins(blockTime)...)
+		require.True(t, expected.Equal(pva.GetVestedCoins(blockTime)), "time %d", unix)
+	}
+
+	// a grant ending after the account extends its end time
+	require.NoError(t, pva.AddGrant(1900, types.Periods{{Length: 300, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}}}, sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}))
+	require.NoError(t, pva.Validate())
+	require.Equal(t, int64(500), pva.StartTime)
+	require.Equal(t, int64(2200), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 5), sdk.NewInt64Coin(stakeDenom, 70)}, pva.OriginalVesting)
+	require.Equal(t, types.Period{Length: 200, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}}, pva.VestingPeriods[len(pva.VestingPeriods)-1])
+}
+
 func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	now := time.Now()
 	endTime := now.Add(24 * time.Hour)
@@ -644,49 +1272,47 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	// require the ability to undelegate all vesting coins at the beginning of vesting
 	pva, err := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(now, origCoins, origCoins)
-	pva.TrackUndelegation(origCoins)
+	require.NoError(t, pva.TrackDelegation(now, origCoins, origCoins))
+	require.NoError(t, pva.TrackUndelegation(origCoins))
 	require.Nil(t, pva.DelegatedFree)
 	require.Equal(t, emptyCoins, pva.DelegatedVesting)
 
 	// require the ability to undelegate all vested coins at the end of vesting
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(endTime, origCoins, origCoins)
-	pva.TrackUndelegation(origCoins)
+	require.NoError(t, pva.TrackDelegation(endTime, origCoins, origCoins))
+	require.NoError(t, pva.TrackUndelegation(origCoins))
 	require.Equal(t, emptyCoins, pva.DelegatedFree)
 	require.Nil(t, pva.DelegatedVesting)
 
 	// require the ability to undelegate half of coins
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(endTime, origCoins, periods[0].Amount)
-	pva.TrackUndelegation(periods[0].Amount)
+	require.NoError(t, pva.TrackDelegation(endTime, origCoins, periods[0].Amount))
+	require.NoError(t, pva.TrackUndelegation(periods[0].Amount))
 	require.Equal(t, emptyCoins, pva.DelegatedFree)
 	require.Nil(t, pva.DelegatedVesting)
 
 	// require no modifications when the undelegation amount is zero
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
-	})
+	require.Error(t, pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}))
 	require.Nil(t, pva.DelegatedFree)
 	require.Nil(t, pva.DelegatedVesting)
 
 	// vest 50% and delegate to two validators
 	pva, err = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
 	require.NoError(t, err)
-	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 
 	// undelegate from one validator that got slashed 50%
-	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.NoError(t, pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
 
 	// undelegate from the other validator that did not get slashed
-	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Equal(t, emptyCoins, pva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
@@ -745,7 +1371,7 @@ func TestSpendableCoinsPermLockedVestingAcc(t *testing.T) {
 	// delegate some locked coins
 	// require that locked is reduced
 	delegatedAmount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))
-	plva.TrackDelegation(now.Add(12*time.Hour), origCoins, delegatedAmount)
+	require.NoError(t, plva.TrackDelegation(now.Add(12*time.Hour), origCoins, delegatedAmount))
 	lockedCoins = plva.LockedCoins(now.Add(12 * time.Hour))
 	require.True(t, lockedCoins.Equal(origCoins.Sub(delegatedAmount...)))
 }
@@ -759,23 +1385,21 @@ func TestTrackDelegationPermLockedVestingAcc(t *testing.T) {
 	// require the ability to delegate all vesting coins
 	plva, err := types.NewPermanentLockedAccount(bacc, origCoins)
 	require.NoError(t, err)
-	plva.TrackDelegation(now, origCoins, origCoins)
+	require.NoError(t, plva.TrackDelegation(now, origCoins, origCoins))
 	require.Equal(t, origCoins, plva.DelegatedVesting)
 	require.Nil(t, plva.DelegatedFree)
 
 	// require the ability to delegate all vested coins at endTime
 	plva, err = types.NewPermanentLockedAccount(bacc, origCoins)
 	require.NoError(t, err)
-	plva.TrackDelegation(endTime, origCoins, origCoins)
+	require.NoError(t, plva.TrackDelegation(endTime, origCoins, origCoins))
 	require.Equal(t, origCoins, plva.DelegatedVesting)
 	require.Nil(t, plva.DelegatedFree)
 
 	// require no modifications when delegation amount is zero or not enough funds
 	plva, err = types.NewPermanentLockedAccount(bacc, origCoins)
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		plva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
-	})
+	require.Error(t, plva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)}))
 	require.Nil(t, plva.DelegatedVesting)
 	require.Nil(t, plva.DelegatedFree)
 }
@@ -789,46 +1413,314 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	// require the ability to undelegate all vesting coins
 	plva, err := types.NewPermanentLockedAccount(bacc, origCoins)
 	require.NoError(t, err)
-	plva.TrackDelegation(now, origCoins, origCoins)
-	plva.TrackUndelegation(origCoins)
+	require.NoError(t, plva.TrackDelegation(now, origCoins, origCoins))
+	require.NoError(t, plva.TrackUndelegation(origCoins))
 	require.Nil(t, plva.DelegatedFree)
 	require.Equal(t, emptyCoins, plva.DelegatedVesting)
 
 	// require the ability to undelegate all vesting coins at endTime
 	plva, err = types.NewPermanentLockedAccount(bacc, origCoins)
 	require.NoError(t, err)
-	plva.TrackDelegation(endTime, origCoins, origCoins)
-	plva.TrackUndelegation(origCoins)
+	require.NoError(t, plva.TrackDelegation(endTime, origCoins, origCoins))
+	require.NoError(t, plva.TrackUndelegation(origCoins))
 	require.Nil(t, plva.DelegatedFree)
 	require.Equal(t, emptyCoins, plva.DelegatedVesting)
 
 	// require no modifications when the undelegation amount is zero
 	plva, err = types.NewPermanentLockedAccount(bacc, origCoins)
 	require.NoError(t, err)
-	require.Panics(t, func() {
-		plva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
-	})
+	require.Error(t, plva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}))
 	require.Nil(t, plva.DelegatedFree)
 	require.Nil(t, plva.DelegatedVesting)
 
 	// delegate to two validators
 	plva, err = types.NewPermanentLockedAccount(bacc, origCoins)
 	require.NoError(t, err)
-	plva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	plva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, plva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, plva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 
 	// undelegate from one validator that got slashed 50%
-	plva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.NoError(t, plva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
 
 	require.Nil(t, plva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, plva.DelegatedVesting)
 
 	// undelegate from the other validator that did not get slashed
-	plva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NoError(t, plva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
 	require.Nil(t, plva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	startTime := now.Add(24 * time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewClawbackVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+
+	// require no coins vested _before_ the start time of the vesting schedule
+	vestedCoins := cva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = cva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+
+	// require 50% of coins vested
+	vestedCoins = cva.GetVestedCoins(startTime.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require 75% of coins vested
+	vestedCoins = cva.GetVestedCoins(startTime.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, vestedCoins)
+}
+
+func TestGetVestingCoinsClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	startTime := now.Add(24 * time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewClawbackVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+
+	// require all coins vesting before the start time of the vesting schedule
+	vestingCoins := cva.GetVestingCoins(now)
+	require.Equal(t, origCoins, vestingCoins)
+
+	// require no coins vesting at the end of the vesting schedule
+	vestingCoins = cva.GetVestingCoins(endTime)
+	require.Equal(t, emptyCoins, vestingCoins)
+
+	// require 50% of coins vesting in the middle between start and end time
+	vestingCoins = cva.GetVestingCoins(startTime.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestingCoins)
+
+	// require the pending clawback to be vesting
+	cva.PendingClawback = sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}
+	vestingCoins = cva.GetVestingCoins(endTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, vestingCoins)
+}
+
+func TestSpendableCoinsClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	startTime := now.Add(24 * time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewClawbackVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+
+	// require that all original coins are locked before the beginning of the vesting
+	// schedule
+	lockedCoins := cva.LockedCoins(now)
+	require.Equal(t, origCoins, lockedCoins)
+
+	// require that there exist no locked coins in the end of the vesting schedule
+	lockedCoins = cva.LockedCoins(endTime)
+	require.Equal(t, sdk.NewCoins(), lockedCoins)
+
+	// require that all vested coins (50%) are spendable
+	lockedCoins = cva.LockedCoins(startTime.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lockedCoins)
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+
+	// require the ability to delegate all vesting coins
+	cva, err := types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now, origCoins, origCoins))
+	require.Equal(t, origCoins, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+
+	// require the ability to delegate all vested coins
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(endTime, origCoins, origCoins))
+	require.Nil(t, cva.DelegatedVesting)
+	require.Equal(t, origCoins, cva.DelegatedFree)
+
+	// require the ability to delegate all vesting coins (50%) and all vested coins (50%)
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+
+	// require no modifications when delegation amount is zero or not enough funds
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.Error(t, cva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)}))
+	require.Nil(t, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+}
+
+func TestTrackUndelegationClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+
+	// require the ability to undelegate all vesting coins
+	cva, err := types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now, origCoins, origCoins))
+	require.NoError(t, cva.TrackUndelegation(origCoins))
+	require.Nil(t, cva.DelegatedFree)
+	require.Equal(t, emptyCoins, cva.DelegatedVesting)
+
+	// require the ability to undelegate all vested coins
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(endTime, origCoins, origCoins))
+	require.NoError(t, cva.TrackUndelegation(origCoins))
+	require.Equal(t, emptyCoins, cva.DelegatedFree)
+	require.Nil(t, cva.DelegatedVesting)
+
+	// require no modifications when the undelegation amount is zero
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.Error(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}))
+	require.Nil(t, cva.DelegatedFree)
+	require.Nil(t, cva.DelegatedVesting)
+
+	// vest 50% and delegate to two validators
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.NoError(t, cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+
+	// undelegate from one validator that got slashed 50%
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+
+	// undelegate from the other validator that did not get slashed
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, emptyCoins, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	startTime := now.Add(24 * time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+
+	// require all coins to be returned before the start time of the vesting schedule
+	cva, err := types.NewClawbackVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	toReturn, pending := cva.Clawback(now)
+	require.Equal(t, origCoins, toReturn)
+	require.Empty(t, pending)
+	require.Empty(t, cva.OriginalVesting)
+	require.Equal(t, now.Unix(), cva.StartTime)
+	require.Equal(t, now.Unix(), cva.EndTime)
+	require.Empty(t, cva.LockedCoins(endTime))
+	require.NoError(t, cva.Validate())
+
+	// require no coins to be returned at the end of the vesting schedule
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	toReturn, pending = cva.Clawback(endTime)
+	require.Empty(t, toReturn)
+	require.Empty(t, pending)
+	require.Equal(t, origCoins, cva.OriginalVesting)
+
+	// require the vesting coins (50%) to be returned, and the vested coins kept
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	halfTime := startTime.Add(12 * time.Hour)
+	toReturn, pending = cva.Clawback(halfTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, toReturn)
+	require.Empty(t, pending)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.OriginalVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(endTime))
+	require.Empty(t, cva.LockedCoins(halfTime))
+	require.NoError(t, cva.Validate())
+
+	// require the delegated vesting coins to be pending until undelegated
+	cva, err = types.NewClawbackVestingAccount(bacc, origCoins, startTime.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(startTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}))
+	toReturn, pending = cva.Clawback(halfTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, toReturn)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pending)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.PendingClawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, cva.DelegatedFree)
+	require.Empty(t, cva.LockedCoins(halfTime))
+	require.NoError(t, cva.Validate())
+
+	// undelegate the delegated free coins first, which stay spendable
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}))
+	require.Empty(t, cva.LockedCoins(halfTime))
+
+	// undelegate the pending clawback, slashed by 50%, which is locked
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.LockedCoins(halfTime))
+
+	// require the undelegated pending clawback to be returned
+	toReturn, pending = cva.Clawback(endTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, toReturn)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pending)
+	require.Empty(t, cva.LockedCoins(endTime))
+}
+
+func TestReleasePendingClawbackClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+	halfTime := now.Add(12 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+
+	// claw back half of the coins, 50 of them being delegated
+	cva, err := types.NewClawbackVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix(), funderAddr.String())
+	require.NoError(t, err)
+	require.NoError(t, cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}))
+	_, pending := cva.Clawback(halfTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pending)
+
+	// require nothing to be released while delegated
+	require.Empty(t, cva.ReleasePendingClawback(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.PendingClawback)
+
+	// undelegate the delegated free coins and 20 of the pending clawback
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, cva.DelegatedVesting)
+
+	// require the release to be capped by the balance
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, cva.ReleasePendingClawback(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}, cva.PendingClawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, cva.ReleasePendingClawback(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 70)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, cva.PendingClawback)
+	require.Empty(t, cva.LockedCoins(endTime))
+	require.NoError(t, cva.Validate())
+
+	// require the pending clawback lost to slashing to be dropped
+	require.True(t, cva.ReconcileDelegations(sdk.NewInt64Coin(stakeDenom, 15)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 15)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 15)}, cva.PendingClawback)
+
+	// require the rest to be released once undelegated
+	require.NoError(t, cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 15)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 15)}, cva.ReleasePendingClawback(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 70)}))
+	require.Empty(t, cva.PendingClawback)
+	require.Empty(t, cva.LockedCoins(endTime))
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -864,6 +1756,32 @@ func TestGenesisAccountValidate(t *testing.T) {
 			}(),
 			false,
 		},
+		{
+			"valid continuous vesting account with cliff",
+			func() authtypes.GenesisAccount {
+				acc, _ := types.NewContinuousVestingAccountWithCliff(baseAcc, initialVesting, 100, 150, 200)
+				return acc
+			}(),
+			false,
+		},
+		{
+			"valid continuous vesting account with cliff at end time",
+			func() authtypes.GenesisAccount {
+				acc, _ := types.NewContinuousVestingAccountWithCliff(baseAcc, initialVesting, 100, 200, 200)
+				return acc
+			}(),
+			false,
+		},
+		{
+			"invalid cliff before start time",
+			&types.ContinuousVestingAccount{BaseVestingAccount: baseVestingWithCoins, StartTime: 50, CliffTime: 20},
+			true,
+		},
+		{
+			"invalid cliff after end time",
+			&types.ContinuousVestingAccount{BaseVestingAccount: baseVestingWithCoins, StartTime: 50, CliffTime: 101},
+			true,
+		},
 		{
 			"invalid vesting times",
 			func() authtypes.GenesisAccount {
@@ -907,6 +1825,24 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			func() authtypes.GenesisAccount {
+				acc, _ := types.NewClawbackVestingAccount(baseAcc, initialVesting, 0, 100, funderAddr.String())
+				return acc
+			}(),
+			false,
+		},
+		{
+			"invalid clawback vesting account with an empty funder",
+			types.NewClawbackVestingAccountRaw(baseVestingWithCoins, 0, ""),
+			true,
+		},
+		{
+			"invalid clawback vesting times",
+			types.NewClawbackVestingAccountRaw(baseVestingWithCoins, 200, funderAddr.String()),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -918,6 +1854,181 @@ func TestGenesisAccountValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisAccountValidateVestingMath(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 0, 0)
+	initialVesting := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	baseVestingWithCoins, err := types.NewBaseVestingAccount(baseAcc, initialVesting, 100)
+	require.NoError(t, err)
+
+	withDelegations := func(delegatedVesting, delegatedFree sdk.Coins) *types.BaseVestingAccount {
+		bva := *baseVestingWithCoins
+		bva.DelegatedVesting = delegatedVesting
+		bva.DelegatedFree = delegatedFree
+		return &bva
+	}
+
+	tests := []struct {
+		name   string
+		acc    authtypes.GenesisAccount
+		expErr string
+	}{
+		{
+			"duplicate denoms in a vesting period",
+			types.NewPeriodicVestingAccountRaw(
+				baseVestingWithCoins,
+				0, types.Periods{types.Period{Length: 100, Amount: sdk.Coins{
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 25),
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 25),
+				}}}),
+			"period #0 has invalid coins: duplicate denomination stake",
+		},
+		{
+			"zero amount in a vesting period",
+			types.NewPeriodicVestingAccountRaw(
+				baseVestingWithCoins,
+				0, types.Periods{
+					types.Period{Length: 50, Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}},
+					types.Period{Length: 50, Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}},
+				}),
+			"period #1 has invalid coins: coin 0stake amount is not positive",
+		},
+		{
+			"empty vesting period amount",
+			types.NewPeriodicVestingAccountRaw(
+				baseVestingWithCoins,
+				0, types.Periods{
+					types.Period{Length: 50, Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}},
+					types.Period{Length: 50, Amount: sdk.Coins{}},
+				}),
+			"period #1 has invalid coins: ",
+		},
+		{
+			"delayed vesting account with a zero end time",
+			func() authtypes.GenesisAccount {
+				bva := withDelegations(nil, nil)
+				bva.EndTime = 0
+				return types.NewDelayedVestingAccountRaw(bva)
+			}(),
+			"delayed vesting end-time must be positive",
+		},
+		{
+			"delegated vesting with a zero amount",
+			types.NewDelayedVestingAccountRaw(withDelegations(sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}, nil)),
+			"invalid delegated vesting coins: coin 0stake amount is not positive",
+		},
+		{
+			"delegated free with duplicate denoms",
+			types.NewDelayedVestingAccountRaw(withDelegations(nil, sdk.Coins{
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+			})),
+			"invalid delegated free coins: duplicate denomination stake",
+		},
+		{
+			"clawback delegated vesting with a zero amount",
+			types.NewClawbackVestingAccountRaw(withDelegations(sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}, nil), 0, funderAddr.String()),
+			"invalid delegated vesting coins: coin 0stake amount is not positive",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.acc.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.expErr)
+		})
+	}
+}
+
+func TestVestingAccountMarshalYAML(t *testing.T) {
+	pubKey := secp256k1.GenPrivKeyFromSecret([]byte("vesting")).PubKey()
+	bacc := authtypes.NewBaseAccount(sdk.AccAddress(pubKey.Address()), pubKey, 5, 3)
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	endTime := startTime + 3*24*60*60
+
+	bva, err := types.NewBaseVestingAccount(bacc, origCoins, endTime)
+	require.NoError(t, err)
+	bva.DelegatedFree = sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20))
+	bva.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 30))
+
+	cva, err := types.NewContinuousVestingAccountWithCliff(bacc, origCoins, startTime, startTime+24*60*60, endTime)
+	require.NoError(t, err)
+
+	dva, err := types.NewDelayedVestingAccount(bacc, origCoins, endTime)
+	require.NoError(t, err)
+
+	periods := types.Periods{
+		{Length: 24 * 60 * 60, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: 2 * 24 * 60 * 60, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	pva, err := types.NewPeriodicVestingAccount(bacc, origCoins, startTime, periods)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		acc      fmt.Stringer
+		expected string
+	}{
+		{"base vesting account", bva, `account_number: 5
+address: cosmos1lthak3nx0luwahymtgu35dsd6wt8s22yvewhzy
+delegated_free: 20stake
+delegated_vesting: 30stake
+end_time: 2024-01-04T00:00:00Z (1704326400)
+original_vesting: 1000fee,100stake
+public_key: PubKeySecp256k1{03DC8B4EC5387C684DEA372783009C4249584684F26EC4081023E9D0EC7D1ED852}
+sequence: 3
+`},
+		{"continuous vesting account", cva, `account_number: 5
+address: cosmos1lthak3nx0luwahymtgu35dsd6wt8s22yvewhzy
+cliff_time: 2024-01-02T00:00:00Z (1704153600)
+delegated_free: ""
+delegated_vesting: ""
+end_time: 2024-01-04T00:00:00Z (1704326400)
+original_vesting: 1000fee,100stake
+public_key: PubKeySecp256k1{03DC8B4EC5387C684DEA372783009C4249584684F26EC4081023E9D0EC7D1ED852}
+sequence: 3
+start_time: 2024-01-01T00:00:00Z (1704067200)
+`},
+		{"delayed vesting account", dva, `account_number: 5
+address: cosmos1lthak3nx0luwahymtgu35dsd6wt8s22yvewhzy
+delegated_free: ""
+delegated_vesting: ""
+end_time: 2024-01-04T00:00:00Z (1704326400)
+original_vesting: 1000fee,100stake
+public_key: PubKeySecp256k1{03DC8B4EC5387C684DEA372783009C4249584684F26EC4081023E9D0EC7D1ED852}
+sequence: 3
+`},
+		{"periodic vesting account", pva, `account_number: 5
+address: cosmos1lthak3nx0luwahymtgu35dsd6wt8s22yvewhzy
+delegated_free: ""
+delegated_vesting: ""
+end_time: 2024-01-04T00:00:00Z (1704326400)
+original_vesting: 1000fee,100stake
+public_key: PubKeySecp256k1{03DC8B4EC5387C684DEA372783009C4249584684F26EC4081023E9D0EC7D1ED852}
+sequence: 3
+start_time: 2024-01-01T00:00:00Z (1704067200)
+vesting_periods:
+- 500fee,50stake unlocks at 2024-01-02T00:00:00Z (1704153600) after 86400s
+- 500fee,50stake unlocks at 2024-01-04T00:00:00Z (1704326400) after 172800s
+`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.acc.String())
+
+			out, err := tc.acc.(interface{ MarshalYAML() (interface{}, error) }).MarshalYAML()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, out)
+		})
+	}
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -929,3 +2040,32 @@ func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 func TestVestingAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingAccountTestSuite))
 }
+
+func TestClawbackVestingPeriodicRounding(t *testing.T) {
+	bacc, _ := initBaseAccount()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	pva, err := types.NewPeriodicVestingAccount(bacc, origCoins, 1000, types.Periods{
+		{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1)}},
+		{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1)}},
+		{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 49)}},
+		{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 49)}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, pva.TrackDelegation(time.Unix(1000, 0), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}))
+
+	// require the remaining periods to be scaled down to the 10 excluded coins,
+	// the periods left empty being merged into the next one, and the remainder
+	// going to the last period
+	updated, clawedBack, excluded, err := types.ClawbackVesting(pva, time.Unix(1000, 0))
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 90)}, clawedBack)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, excluded)
+	require.Same(t, pva, updated)
+	require.NoError(t, pva.Validate())
+	require.Equal(t, types.Periods{
+		{Length: 300, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 4)}},
+		{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 6)}},
+	}, types.Periods(pva.VestingPeriods))
+	require.Equal(t, int64(1400), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, pva.OriginalVesting)
+}
@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// SupplyDiscrepancy is a denom whose tracked total supply does not match the
+// sum of the balances held by all accounts.
+type SupplyDiscrepancy struct {
+	Denom    string
+	Supply   math.Int
+	Balances math.Int
+}
+
+// Difference returns the tracked supply minus the sum of the balances.
+func (d SupplyDiscrepancy) Difference() math.Int {
+	return d.Supply.Sub(d.Balances)
+}
+
+// AuditSupplyCmd returns a command which reconciles, offline, the balances of
+// all accounts and module accounts at a committed height against the total
+// supply tracked by the bank module.
+func AuditSupplyCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-supply",
+		Short: "Reconcile account balances against the tracked total supply at a committed height",
+		Long: `Reconcile account balances against the tracked total supply at a committed height.
+The balances of all accounts, including module accounts, are summed per denom and
+compared to the total supply tracked by the bank module. Every denom for which they
+differ is printed, and the command fails if any discrepancy is found.
+
+The node must be stopped, as the command reads the application database directly.`,
+		Example: fmt.Sprintf("%s audit-supply --height 100", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), filepath.Join(ctx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+			if height <= 0 {
+				height = rootmulti.GetLatestVersion(db)
+			}
+			if height <= 0 {
+				return fmt.Errorf("the database has no committed heights")
+			}
+
+			app := appCreator(log.NewNopLogger(), db, nil, ctx.Viper)
+			rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the auditing of rootmulti.Store type")
+			}
+
+			storeKey, ok := rootMultiStore.StoreKeysByName()[types.StoreKey]
+			if !ok {
+				return fmt.Errorf("the application has no %s store", types.StoreKey)
+			}
+
+			ms, err := rootMultiStore.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return err
+			}
+
+			discrepancies, err := AuditSupply(ms.GetKVStore(storeKey))
+			if err != nil {
+				return err
+			}
+
+			if len(discrepancies) == 0 {
+				cmd.Printf("total supply matches account balances at height %d\n", height)
+				return nil
+			}
+
+			for _, d := range discrepancies {
+				cmd.Printf("%s: supply %s, balances %s, difference %s\n", d.Denom, d.Supply, d.Balances, d.Difference())
+			}
+
+			return fmt.Errorf("found %d supply discrepancies at height %d", len(discrepancies), height)
+		},
+	}
+
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height to audit (defaults to the latest committed height)")
+
+	return cmd
+}
+
+// AuditSupply sums the balances of all accounts stored in the given bank store
+// per denom and returns, sorted by denom, every denom for which the sum differs
+// from the tracked total supply.
+func AuditSupply(store storetypes.KVStore) ([]SupplyDiscrepancy, error) {
+	supply := make(map[string]math.Int)
+	balances := make(map[string]math.Int)
+
+	supplyIter := storetypes.KVStorePrefixIterator(store, types.SupplyKey)
+	defer supplyIter.Close()
+	for ; supplyIter.Valid(); supplyIter.Next() {
+		_, denom, err := collections.StringKey.Decode(supplyIter.Key()[len(types.SupplyKey):])
+		if err != nil {
+			return nil, fmt.Errorf("invalid supply key %X: %w", supplyIter.Key(), err)
+		}
+
+		amount, err := sdk.IntValue.Decode(supplyIter.Value())
+		if err != nil {
+			return nil, fmt.Errorf("invalid supply of %s: %w", denom, err)
+		}
+
+		supply[denom] = amount
+	}
+
+	balanceKeyCodec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	balancesIter := storetypes.KVStorePrefixIterator(store, types.BalancesPrefix)
+	defer balancesIter.Close()
+	for ; balancesIter.Valid(); balancesIter.Next() {
+		_, key, err := balanceKeyCodec.Decode(balancesIter.Key()[len(types.BalancesPrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance key %X: %w", balancesIter.Key(), err)
+		}
+
+		amount, err := types.BalanceValueCodec.Decode(balancesIter.Value())
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance of %s for %s: %w", key.K2(), key.K1(), err)
+		}
+
+		denom := key.K2()
+		if total, ok := balances[denom]; ok {
+			amount = total.Add(amount)
+		}
+		balances[denom] = amount
+	}
+
+	var discrepancies []SupplyDiscrepancy
+	for denom := range mergeKeys(supply, balances) {
+		d := SupplyDiscrepancy{Denom: denom, Supply: math.ZeroInt(), Balances: math.ZeroInt()}
+		if amount, ok := supply[denom]; ok {
+			d.Supply = amount
+		}
+		if amount, ok := balances[denom]; ok {
+			d.Balances = amount
+		}
+
+		if !d.Supply.Equal(d.Balances) {
+			discrepancies = append(discrepancies, d)
+		}
+	}
+
+	sort.Slice(discrepancies, func(i, j int) bool { return discrepancies[i].Denom < discrepancies[j].Denom })
+	return discrepancies, nil
+}
+
+func mergeKeys(a, b map[string]math.Int) map[string]struct{} {
+	keys := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		keys[k] = struct{}{}
+	}
+	for k := range b {
+		keys[k] = struct{}{}
+	}
+
+	return keys
+}
@@ -0,0 +1,50 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/client/cli"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAuditSupply(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	supply := collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue)
+	balances := collections.NewMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	addr1, addr2 := sdk.AccAddress("addr1_______________"), sdk.AccAddress("addr2_______________")
+	require.NoError(t, supply.Set(ctx, "stake", math.NewInt(300)))
+	require.NoError(t, balances.Set(ctx, collections.Join(addr1, "stake"), math.NewInt(100)))
+	require.NoError(t, balances.Set(ctx, collections.Join(addr2, "stake"), math.NewInt(200)))
+
+	discrepancies, err := cli.AuditSupply(ctx.KVStore(key))
+	require.NoError(t, err)
+	require.Empty(t, discrepancies)
+
+	// a minted denom without tracked supply and an over-reported supply
+	require.NoError(t, balances.Set(ctx, collections.Join(addr1, "atom"), math.NewInt(5)))
+	require.NoError(t, supply.Set(ctx, "stake", math.NewInt(350)))
+
+	discrepancies, err = cli.AuditSupply(ctx.KVStore(key))
+	require.NoError(t, err)
+	require.Len(t, discrepancies, 2)
+	require.Equal(t, "atom", discrepancies[0].Denom)
+	require.Equal(t, math.NewInt(-5), discrepancies[0].Difference())
+	require.Equal(t, "stake", discrepancies[1].Denom)
+	require.Equal(t, math.NewInt(300), discrepancies[1].Balances)
+	require.Equal(t, math.NewInt(50), discrepancies[1].Difference())
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func init() {
+	tx.RegisterMsgSummaryFormatter(&types.MsgSend{}, formatMsgSend)
+	tx.RegisterMsgSummaryFormatter(&types.MsgMultiSend{}, formatMsgMultiSend)
+}
+
+func formatMsgSend(msg sdk.Msg) (string, error) {
+	m, ok := msg.(*types.MsgSend)
+	if !ok {
+		return "", fmt.Errorf("expected %T, got %T", &types.MsgSend{}, msg)
+	}
+
+	return fmt.Sprintf("send %s from %s to %s", m.Amount, m.FromAddress, m.ToAddress), nil
+}
+
+func formatMsgMultiSend(msg sdk.Msg) (string, error) {
+	m, ok := msg.(*types.MsgMultiSend)
+	if !ok {
+		return "", fmt.Errorf("expected %T, got %T", &types.MsgMultiSend{}, msg)
+	}
+
+	var sb strings.Builder
+	for _, in := range m.Inputs {
+		fmt.Fprintf(&sb, "send %s from %s to", sdk.Coins(in.Coins), in.Address)
+	}
+	for i, out := range m.Outputs {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, " %s (%s)", out.Address, sdk.Coins(out.Coins))
+	}
+
+	return sb.String(), nil
+}
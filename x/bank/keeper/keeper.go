@@ -444,8 +444,9 @@ func (k BaseKeeper) trackDelegation(ctx context.Context, addr sdk.AccAddress, ba
 
 	vacc, ok := acc.(types.VestingAccount)
 	if ok {
-		// TODO: return error on account.TrackDelegation
-		vacc.TrackDelegation(k.environment.HeaderService.GetHeaderInfo(ctx).Time, balance, amt)
+		if err := vacc.TrackDelegation(k.environment.HeaderService.GetHeaderInfo(ctx).Time, balance, amt); err != nil {
+			return err
+		}
 		k.ak.SetAccount(ctx, acc)
 	}
 
@@ -461,8 +462,9 @@ func (k BaseKeeper) trackUndelegation(ctx context.Context, addr sdk.AccAddress,
 
 	vacc, ok := acc.(types.VestingAccount)
 	if ok {
-		// TODO: return error on account.TrackUndelegation
-		vacc.TrackUndelegation(amt)
+		if err := vacc.TrackUndelegation(amt); err != nil {
+			return err
+		}
 		k.ak.SetAccount(ctx, acc)
 	}
 
@@ -1473,6 +1473,34 @@ func (suite *KeeperTestSuite) TestSpendableCoins() {
 	require.Equal(origCoins.Sub(lockedCoins...)[0], suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], "stake"))
 }
 
+func (suite *KeeperTestSuite) TestSpendableCoinsLockedExceedsBalance() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	// the account holds less stake than it has locked, e.g. after being slashed
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	vacc, err := vesting.NewDelayedVestingAccount(acc0, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), endTime.Unix())
+	require.NoError(err)
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin("foo", 30), sdk.NewInt64Coin("stake", 50))
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balance))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now})
+
+	// the locked stake floors at zero, without affecting the other denoms
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 30)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(sdk.NewInt64Coin("stake", 0), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], "stake"))
+
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(sdk.NewInt64Coin("foo", 30), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], "foo"))
+}
+
 func (suite *KeeperTestSuite) TestVestingAccountSend() {
 	ctx := sdk.UnwrapSDKContext(suite.ctx)
 	require := suite.Require()
@@ -1538,6 +1566,48 @@ func (suite *KeeperTestSuite) TestPeriodicVestingAccountSend() {
 	require.Equal(origCoins, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
 }
 
+func (suite *KeeperTestSuite) TestPermanentLockedAccountSend() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	now := time.Now()
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	vacc, err := vesting.NewPermanentLockedAccount(acc0, origCoins)
+	suite.Require().NoError(err)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], origCoins))
+
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], origCoins))
+
+	// require that no coins be sendable, no matter how much time has passed
+	suite.mockSendCoins(ctx, vacc, accAddrs[1])
+	require.Error(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendCoins))
+	require.Equal(origCoins, vacc.LockedCoins(now.Add(1000*24*time.Hour)))
+
+	// send some coins to the permanent locked account, e.g. staking rewards
+	suite.mockSendCoins(ctx, acc1, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sendCoins))
+
+	// require that the received coins are spendable, but not the locked ones
+	suite.mockSendCoins(ctx, vacc, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendCoins))
+	require.Equal(origCoins, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.mockSendCoins(ctx, vacc, accAddrs[1])
+	require.Error(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+
+	// require that delegating the locked coins releases nothing
+	require.NoError(vacc.TrackDelegation(now, origCoins, origCoins))
+	require.Equal(origCoins, vacc.GetDelegatedVesting())
+	require.True(vacc.LockedCoins(now).IsZero())
+}
+
 func (suite *KeeperTestSuite) TestVestingAccountReceive() {
 	ctx := sdk.UnwrapSDKContext(suite.ctx)
 	require := suite.Require()
@@ -1571,6 +1641,59 @@ func (suite *KeeperTestSuite) TestVestingAccountReceive() {
 	require.Equal(balances.Sub(vacc.LockedCoins(now.Add(12*time.Hour))...), origCoins)
 }
 
+func (suite *KeeperTestSuite) TestVestingAccountReceivePerDenom() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin("usd", 50))
+	extraCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	vacc, err := vesting.NewContinuousVestingAccount(acc0, origCoins, now.Unix(), endTime.Unix())
+	suite.Require().NoError(err)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], origCoins))
+
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], otherCoins.Add(extraCoins...)))
+
+	// receive a denom which is not vesting at the beginning of the schedule
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now})
+	suite.mockSendCoins(ctx, acc1, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], otherCoins))
+
+	// require that it is fully spendable right away, while all the stake is locked
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(otherCoins, suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(origCoins, suite.bankKeeper.LockedCoins(ctx, accAddrs[0]))
+
+	suite.mockSendCoins(ctx, vacc, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], otherCoins))
+
+	// receive more stake halfway through the schedule
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(12 * time.Hour)})
+	suite.mockSendCoins(ctx, acc1, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], extraCoins))
+
+	// require that only the scheduled stake remains locked
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.bankKeeper.LockedCoins(ctx, accAddrs[0]))
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	suite.mockSendCoins(ctx, vacc, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 90))))
+
+	suite.mockSendCoins(ctx, vacc, accAddrs[1])
+	require.Error(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+}
+
 func (suite *KeeperTestSuite) TestPeriodicVestingAccountReceive() {
 	ctx := sdk.UnwrapSDKContext(suite.ctx)
 	require := suite.Require()
@@ -1678,7 +1801,7 @@ func (suite *KeeperTestSuite) TestUndelegateCoins() {
 
 	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
 	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
-	vacc, err := vesting.NewContinuousVestingAccount(acc0, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	vacc, err := vesting.NewContinuousVestingAccount(acc0, origCoins, ctx.HeaderInfo().Time.Unix(), endTime.Unix())
 	suite.Require().NoError(err)
 
 	suite.mockFundAccount(accAddrs[0])
@@ -2340,11 +2463,13 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		{Denom: "paramscointrue", Enabled: true},
 		{Denom: "paramscoinfalse", Enabled: false},
 	}
+	params.MaxMultiSendOutputs = 7
 	require.NoError(bankKeeper.SetParams(ctx, params))
 
 	suite.Run("stored params are as expected", func() {
 		actual := bankKeeper.GetParams(ctx)
 		require.True(actual.DefaultSendEnabled, "DefaultSendEnabled")
+		require.Equal(uint64(7), actual.MaxMultiSendOutputs, "MaxMultiSendOutputs")
 		require.Len(actual.SendEnabled, 0, "SendEnabled") //nolint:staticcheck // we're testing the old way here
 	})
 
@@ -1,6 +1,10 @@
 package keeper
 
-import "context"
+import (
+	"context"
+
+	"cosmossdk.io/x/bank/types"
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
@@ -26,3 +30,15 @@ func (m Migrator) Migrate2to3(ctx context.Context) error {
 func (m Migrator) Migrate3to4(ctx context.Context) error {
 	return nil
 }
+
+// Migrate4to5 migrates x/bank storage from version 4 to 5, setting the
+// MaxMultiSendOutputs param to its default.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	params, err := m.keeper.BaseSendKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.MaxMultiSendOutputs = types.DefaultMaxMultiSendOutputs
+	return m.keeper.BaseSendKeeper.Params.Set(ctx, params)
+}
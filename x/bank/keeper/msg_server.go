@@ -13,6 +13,13 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// Flat amounts of gas consumed by MultiSend for each of its inputs and outputs,
+// on top of the gas of its store operations.
+const (
+	MultiSendInputGasCost  uint64 = 1000
+	MultiSendOutputGasCost uint64 = 1000
+)
+
 type msgServer struct {
 	Keeper
 }
@@ -93,6 +100,27 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 		return nil, types.ErrNoOutputs
 	}
 
+	// a zero MaxMultiSendOutputs, as in params set before it was added, uses the default
+	maxOutputs := k.GetParams(ctx).MaxMultiSendOutputs
+	if maxOutputs == 0 {
+		maxOutputs = types.DefaultMaxMultiSendOutputs
+	}
+	if uint64(len(msg.Outputs)) > maxOutputs {
+		return nil, types.ErrTooManyOutputs.Wrapf("got %d outputs, max %d", len(msg.Outputs), maxOutputs)
+	}
+
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	// charge a flat amount of gas for each input and output, so that the cost of
+	// the message grows linearly with them and not only with its store writes
+	base.environment.GasService.GetGasMeter(ctx).Consume(
+		MultiSendInputGasCost*uint64(len(msg.Inputs))+MultiSendOutputGasCost*uint64(len(msg.Outputs)),
+		"multi send",
+	)
+
 	if err := types.ValidateInputOutputs(msg.Inputs[0], msg.Outputs); err != nil {
 		return nil, err
 	}
@@ -105,17 +133,13 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 	}
 
 	for _, out := range msg.Outputs {
-		if base, ok := k.Keeper.(BaseKeeper); ok {
-			accAddr, err := base.ak.AddressCodec().StringToBytes(out.Address)
-			if err != nil {
-				return nil, err
-			}
+		accAddr, err := base.ak.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return nil, err
+		}
 
-			if k.BlockedAddr(accAddr) {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
-			}
-		} else {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+		if k.BlockedAddr(accAddr) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
 		}
 	}
 
@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/bank/keeper"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -257,6 +261,77 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 	}
 }
 
+// newMultiSend returns a MsgMultiSend of one sendableCoin from the minter account to
+// each of numOutputs distinct addresses.
+func newMultiSend(numOutputs int) *banktypes.MsgMultiSend {
+	outputs := make([]banktypes.Output, numOutputs)
+	for i := range outputs {
+		addr := sdk.AccAddress(fmt.Sprintf("output%014d", i))
+		outputs[i] = banktypes.NewOutput(addr, sdk.NewCoins(sdk.NewInt64Coin("sendableCoin", 1)))
+	}
+	input := banktypes.NewInput(minterAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("sendableCoin", int64(numOutputs))))
+	return banktypes.NewMsgMultiSend(input, outputs)
+}
+
+func (suite *KeeperTestSuite) TestMsgMultiSendMaxOutputs() {
+	suite.bankKeeper.SetSendEnabled(suite.ctx, "sendableCoin", true)
+	params := banktypes.DefaultParams()
+
+	testCases := []struct {
+		name       string
+		maxOutputs uint64
+		numOutputs int
+		expErr     error
+	}{
+		{"outputs at the max", 3, 3, nil},
+		{"outputs over the max", 3, 4, banktypes.ErrTooManyOutputs},
+		{"zero max uses the default", 0, 4, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params.MaxMultiSendOutputs = tc.maxOutputs
+			suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+			msg := newMultiSend(tc.numOutputs)
+			suite.mockMintCoins(minterAcc)
+			suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, msg.Inputs[0].Coins))
+			if tc.expErr == nil {
+				suite.mockInputOutputCoins([]sdk.AccountI{minterAcc}, nil)
+			}
+
+			_, err := suite.msgServer.MultiSend(suite.ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMultiSendGas() {
+	suite.bankKeeper.SetSendEnabled(suite.ctx, "sendableCoin", true)
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, banktypes.DefaultParams()))
+
+	// gasConsumed returns the gas consumed by a MsgMultiSend with numOutputs outputs
+	gasConsumed := func(numOutputs int) uint64 {
+		msg := newMultiSend(numOutputs)
+		suite.mockMintCoins(minterAcc)
+		suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, msg.Inputs[0].Coins))
+
+		ctx := sdk.UnwrapSDKContext(suite.ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		suite.ctx = ctx
+		suite.mockInputOutputCoins([]sdk.AccountI{minterAcc}, nil)
+		_, err := suite.msgServer.MultiSend(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	gas2, gas200 := gasConsumed(2), gasConsumed(200)
+	// on top of its store operations, each output consumes a flat amount of gas
+	suite.Require().GreaterOrEqual(gas200-gas2, 198*keeper.MultiSendOutputGasCost)
+}
+
 func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 	testCases := []struct {
 		name     string
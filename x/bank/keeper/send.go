@@ -128,7 +128,7 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
 	return k.Params.Set(ctx, params)
 }
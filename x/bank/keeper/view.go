@@ -178,7 +178,8 @@ func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccA
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// type, and only ever contains denoms of the original vesting amount, so any
+// other denom held by a vesting account is fully spendable.
 func (k BaseViewKeeper) LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
@@ -204,8 +205,11 @@ func (k BaseViewKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress)
 // is returned.
 func (k BaseViewKeeper) SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	balance := k.GetBalance(ctx, addr, denom)
-	locked := k.LockedCoins(ctx, addr)
-	return balance.SubAmount(locked.AmountOf(denom))
+	locked := k.LockedCoins(ctx, addr).AmountOf(denom)
+	if balance.Amount.LT(locked) {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+	return balance.SubAmount(locked)
 }
 
 // spendableCoins returns the coins the given address can spend alongside the total amount of coins it holds.
@@ -214,13 +218,16 @@ func (k BaseViewKeeper) spendableCoins(ctx context.Context, addr sdk.AccAddress)
 	total = k.GetAllBalances(ctx, addr)
 	locked := k.LockedCoins(ctx, addr)
 
-	spendable, hasNeg := total.SafeSub(locked...)
-	if hasNeg {
-		spendable = sdk.NewCoins()
-		return
+	// the spendable amount of each denom floors at zero, so that a denom whose
+	// locked amount exceeds its balance does not affect the other denoms
+	spendable = sdk.NewCoins()
+	for _, coin := range total {
+		if amount := coin.Amount.Sub(locked.AmountOf(coin.Denom)); amount.IsPositive() {
+			spendable = append(spendable, sdk.NewCoin(coin.Denom, amount))
+		}
 	}
 
-	return
+	return spendable, total
 }
 
 // ValidateBalance validates all balances for a given account address returning
@@ -26,7 +26,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/bank module consensus version.
-const ConsensusVersion = 4
+const ConsensusVersion = 5
 
 var (
 	_ module.HasName                  = AppModule{}
@@ -110,6 +110,10 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/bank from version 3 to 4: %w", err)
 	}
 
+	if err := mr.Register(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		return fmt.Errorf("failed to migrate x/bank from version 4 to 5: %w", err)
+	}
+
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
 	"cosmossdk.io/x/bank/keeper"
@@ -163,6 +164,7 @@ func sendMsgSend(
 
 	account := ak.GetAccount(ctx, from)
 	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+	locked := lockedBalancesOf(ctx, bk, from)
 
 	coins, hasNeg := spendable.SafeSub(msg.Amount...)
 	if !hasNeg {
@@ -191,7 +193,7 @@ func sendMsgSend(
 		return err
 	}
 
-	return nil
+	return checkLockedCoinsKept(ctx, bk, locked)
 }
 
 // SimulateMsgMultiSend tests and runs a single msg multisend, with randomized, capped number of inputs/outputs.
@@ -359,6 +361,7 @@ func sendMsgMultiSend(
 ) error {
 	accountNumbers := make([]uint64, len(msg.Inputs))
 	sequenceNumbers := make([]uint64, len(msg.Inputs))
+	inputAddrs := make([]sdk.AccAddress, len(msg.Inputs))
 	for i := 0; i < len(msg.Inputs); i++ {
 		addr, err := ak.AddressCodec().StringToBytes(msg.Inputs[i].Address)
 		if err != nil {
@@ -368,7 +371,9 @@ func sendMsgMultiSend(
 		acc := ak.GetAccount(ctx, addr)
 		accountNumbers[i] = acc.GetAccountNumber()
 		sequenceNumbers[i] = acc.GetSequence()
+		inputAddrs[i] = addr
 	}
+	locked := lockedBalancesOf(ctx, bk, inputAddrs...)
 	var (
 		fees sdk.Coins
 		err  error
@@ -406,7 +411,7 @@ func sendMsgMultiSend(
 	if err != nil {
 		return err
 	}
-	return nil
+	return checkLockedCoinsKept(ctx, bk, locked)
 }
 
 // randomSendFields returns the sender and recipient simulation accounts as well
@@ -437,6 +442,46 @@ func randomSendFields(
 	return from, to, sendCoins, false
 }
 
+// lockedBalance is the balance of an account holding locked coins, such as a
+// vesting account, along with its locked coins.
+type lockedBalance struct {
+	addr    sdk.AccAddress
+	balance sdk.Coins
+	locked  sdk.Coins
+}
+
+// lockedBalancesOf returns the balances of the given accounts which hold locked
+// coins, to be checked by checkLockedCoinsKept once a transaction is delivered.
+func lockedBalancesOf(ctx sdk.Context, bk keeper.Keeper, addrs ...sdk.AccAddress) []lockedBalance {
+	var balances []lockedBalance
+	for _, addr := range addrs {
+		locked := bk.LockedCoins(ctx, addr)
+		if locked.IsZero() {
+			continue
+		}
+
+		balances = append(balances, lockedBalance{addr: addr, balance: bk.GetAllBalances(ctx, addr), locked: locked})
+	}
+
+	return balances
+}
+
+// checkLockedCoinsKept checks the invariant that no transaction transfers the
+// locked coins of an account out, i.e. that the balance of each account still
+// holds the locked coins it held before the transaction. Locked coins are not
+// checked against the balance alone, since delegations lost to slashing can
+// leave an account holding less than its locked coins.
+func checkLockedCoinsKept(ctx sdk.Context, bk keeper.Keeper, balances []lockedBalance) error {
+	for _, b := range balances {
+		held := b.balance.Min(b.locked)
+		if balance := bk.GetAllBalances(ctx, b.addr); !balance.IsAllGTE(held) {
+			return fmt.Errorf("locked coins of %s transferred out: balance %s, locked coins %s before the transaction, balance %s after", b.addr, b.balance, b.locked, balance)
+		}
+	}
+
+	return nil
+}
+
 func getModuleAccounts(ak types.AccountKeeper, ctx sdk.Context, moduleAccount int) []simtypes.Account {
 	moduleAccounts := make([]simtypes.Account, moduleAccount)
 
@@ -37,6 +37,8 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// max_multi_send_outputs is the maximum number of outputs of a MsgMultiSend.
+	MaxMultiSendOutputs uint64 `protobuf:"varint,3,opt,name=max_multi_send_outputs,json=maxMultiSendOutputs,proto3" json:"max_multi_send_outputs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +89,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxMultiSendOutputs() uint64 {
+	if m != nil {
+		return m.MaxMultiSendOutputs
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -464,50 +473,52 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x4b,
-	0x10, 0xf6, 0xda, 0xf1, 0x8f, 0xac, 0xf3, 0x8a, 0xb7, 0xcf, 0x7a, 0x6f, 0x93, 0x27, 0xce, 0x96,
-	0x0b, 0x64, 0x2c, 0xc5, 0x47, 0x92, 0xce, 0x0d, 0xc2, 0xe1, 0x97, 0x0b, 0x04, 0xba, 0x28, 0x42,
-	0xa2, 0xb1, 0xd6, 0xbe, 0xc5, 0x5e, 0xe5, 0x6e, 0xf7, 0x74, 0xbb, 0x17, 0xe2, 0x96, 0x0a, 0xa5,
-	0xa2, 0xa6, 0x8a, 0xa8, 0x10, 0xa2, 0x70, 0x91, 0x9e, 0x36, 0x4a, 0x15, 0x51, 0x51, 0x05, 0xe4,
-	0x14, 0xce, 0x9f, 0x81, 0x6e, 0xf7, 0xce, 0x71, 0xa4, 0xd0, 0x22, 0xd1, 0xd8, 0x33, 0xf3, 0x7d,
-	0x3b, 0xf3, 0xcd, 0xec, 0xdc, 0x42, 0x6b, 0x20, 0xa4, 0x2f, 0xa4, 0xdd, 0x27, 0x7c, 0xcf, 0xde,
-	0xdf, 0xe8, 0x53, 0x45, 0x36, 0xb4, 0xd3, 0x0a, 0x42, 0xa1, 0x04, 0xfa, 0xc7, 0xe0, 0x2d, 0x1d,
-	0x4a, 0xf0, 0xb5, 0xca, 0x50, 0x0c, 0x85, 0xc6, 0xed, 0xd8, 0x32, 0xd4, 0xb5, 0x55, 0x43, 0xed,
-	0x19, 0x20, 0x39, 0x67, 0xa0, 0xab, 0x2a, 0x92, 0xce, 0xab, 0x0c, 0x04, 0xe3, 0x09, 0xfe, 0x5f,
-	0x82, 0xfb, 0x72, 0x68, 0xef, 0x6f, 0xc4, 0x7f, 0x09, 0xf0, 0x37, 0xf1, 0x19, 0x17, 0xb6, 0xfe,
-	0x35, 0xa1, 0xfa, 0x07, 0x00, 0x0b, 0xcf, 0x49, 0x48, 0x7c, 0x89, 0x1e, 0xc3, 0x15, 0x49, 0xb9,
-	0xdb, 0xa3, 0x9c, 0xf4, 0x3d, 0xea, 0x62, 0x50, 0xcb, 0x35, 0xca, 0x9b, 0xb5, 0xd6, 0x0d, 0x9a,
-	0x5b, 0x3b, 0x94, 0xbb, 0x0f, 0x0d, 0xaf, 0x93, 0xc5, 0xc0, 0x29, 0xcb, 0xab, 0x00, 0xba, 0x0b,
-	0x2b, 0x2e, 0x7d, 0x45, 0x22, 0x4f, 0xf5, 0xae, 0x25, 0xcc, 0xd6, 0x40, 0xa3, 0xe4, 0xa0, 0x04,
-	0x5b, 0x48, 0xd1, 0xbe, 0x75, 0x38, 0x9b, 0x34, 0xb1, 0x29, 0xb4, 0x2e, 0xdd, 0x3d, 0xfb, 0xc0,
-	0x8c, 0xd0, 0x28, 0xab, 0x6f, 0xc3, 0xf2, 0x02, 0x1b, 0x55, 0x60, 0xde, 0xa5, 0x5c, 0xf8, 0x18,
-	0xd4, 0x40, 0x63, 0xd9, 0x31, 0x0e, 0xc2, 0xb0, 0x78, 0xbd, 0x50, 0xea, 0xb6, 0x97, 0x2e, 0x8f,
-	0xaa, 0xa0, 0x7e, 0x0a, 0x60, 0xbe, 0xcb, 0x83, 0x48, 0xa1, 0x4d, 0x58, 0x24, 0xae, 0x1b, 0x52,
-	0x29, 0x4d, 0x86, 0x0e, 0xfe, 0x7a, 0xbc, 0x5e, 0x49, 0xda, 0xbc, 0x6f, 0x90, 0x1d, 0x15, 0x32,
-	0x3e, 0x74, 0x52, 0x22, 0x7a, 0x0d, 0xf3, 0xf1, 0x84, 0x25, 0xce, 0xea, 0xa9, 0xac, 0x5e, 0x4d,
-	0x45, 0xd2, 0xf9, 0x54, 0xb6, 0x05, 0xe3, 0x9d, 0x47, 0x27, 0xe7, 0xd5, 0xcc, 0xa7, 0xef, 0xd5,
-	0xc6, 0x90, 0xa9, 0x51, 0xd4, 0x6f, 0x0d, 0x84, 0x9f, 0x5c, 0x9f, 0xbd, 0xd0, 0xa0, 0x1a, 0x07,
-	0x54, 0xea, 0x03, 0xf2, 0xfd, 0x6c, 0xd2, 0x5c, 0xf1, 0xe8, 0x90, 0x0c, 0xc6, 0x3d, 0x5d, 0xe3,
-	0xe3, 0x6c, 0xd2, 0x04, 0x8e, 0xa9, 0xd7, 0xae, 0xbc, 0x3d, 0xaa, 0x66, 0x2e, 0x8f, 0xaa, 0x99,
-	0x37, 0xb3, 0x49, 0x33, 0x95, 0x53, 0xff, 0x02, 0x60, 0xe1, 0x59, 0xa4, 0xfe, 0xb8, 0x6e, 0x4a,
-	0x69, 0x37, 0xf5, 0xcf, 0x00, 0x16, 0x76, 0xa2, 0x20, 0xf0, 0xc6, 0xb1, 0x1a, 0x25, 0x14, 0xf1,
-	0x92, 0x8d, 0xfb, 0x1d, 0x6a, 0x74, 0xbd, 0xf6, 0x9d, 0x44, 0x0d, 0x38, 0x3d, 0x5e, 0xff, 0xff,
-	0xc6, 0x35, 0xd7, 0x02, 0xbb, 0x18, 0xd4, 0x5f, 0xc0, 0xe5, 0x07, 0xf1, 0x9a, 0xed, 0x72, 0xa6,
-	0x7e, 0xb1, 0x80, 0x6b, 0xb0, 0x44, 0x0f, 0x02, 0xc1, 0x29, 0x57, 0x7a, 0x03, 0xff, 0x72, 0xe6,
-	0x7e, 0xbc, 0x9c, 0xc4, 0x63, 0x44, 0x52, 0x89, 0x73, 0xb5, 0x5c, 0x63, 0xd9, 0x49, 0xdd, 0xfa,
-	0x61, 0x16, 0x96, 0x9e, 0x52, 0x45, 0x5c, 0xa2, 0x08, 0xaa, 0xc1, 0xb2, 0x4b, 0xe5, 0x20, 0x64,
-	0x81, 0x62, 0x82, 0x27, 0xe9, 0x17, 0x43, 0xe8, 0x5e, 0xcc, 0xe0, 0xc2, 0xef, 0x45, 0x9c, 0xa9,
-	0xf4, 0xfe, 0xac, 0x1b, 0xbf, 0xd1, 0xb9, 0x5e, 0x07, 0xba, 0xa9, 0x29, 0x11, 0x82, 0x4b, 0xf1,
-	0x5c, 0x71, 0x4e, 0xe7, 0xd6, 0x76, 0xac, 0xce, 0x65, 0x32, 0xf0, 0xc8, 0x18, 0x2f, 0xe9, 0x70,
-	0xea, 0xc6, 0x6c, 0x4e, 0x7c, 0x8a, 0xf3, 0x86, 0x1d, 0xdb, 0xe8, 0x5f, 0x58, 0x90, 0x63, 0xbf,
-	0x2f, 0x3c, 0x5c, 0xd0, 0xd1, 0xc4, 0x43, 0xab, 0x30, 0x17, 0x85, 0x0c, 0x17, 0xf5, 0x12, 0x16,
-	0xa7, 0xe7, 0xd5, 0xdc, 0xae, 0xd3, 0x75, 0xe2, 0x18, 0xba, 0x0d, 0x4b, 0x51, 0xc8, 0x7a, 0x23,
-	0x22, 0x47, 0xb8, 0xa4, 0xf1, 0xf2, 0xf4, 0xbc, 0x5a, 0xdc, 0x75, 0xba, 0x4f, 0x88, 0x1c, 0x39,
-	0xc5, 0x28, 0x64, 0xb1, 0xd1, 0xd9, 0x3a, 0x99, 0x5a, 0xe0, 0x6c, 0x6a, 0x81, 0x1f, 0x53, 0x0b,
-	0xbc, 0xbb, 0xb0, 0x32, 0x67, 0x17, 0x56, 0xe6, 0xdb, 0x85, 0x95, 0x79, 0x99, 0x3c, 0x87, 0xd2,
-	0xdd, 0x6b, 0x31, 0x91, 0x3e, 0x0f, 0xfa, 0xa2, 0xfb, 0x05, 0xfd, 0x92, 0x6d, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xee, 0xbd, 0x69, 0x7d, 0x05, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x31,
+	0x14, 0x8f, 0xf3, 0x1d, 0xa7, 0x0c, 0x5c, 0xa3, 0x72, 0x2d, 0xe2, 0x12, 0x65, 0x40, 0x21, 0x52,
+	0x13, 0xda, 0x6e, 0x59, 0x10, 0x29, 0x5f, 0x19, 0x2a, 0xd0, 0x55, 0x15, 0x12, 0x4b, 0xe4, 0xe4,
+	0x4c, 0x62, 0xf5, 0xce, 0x3e, 0x9d, 0x7d, 0x25, 0x59, 0x99, 0x50, 0x27, 0x66, 0xa6, 0x8e, 0x08,
+	0x31, 0x64, 0xe8, 0xce, 0x5a, 0x75, 0xaa, 0x58, 0x60, 0x2a, 0x28, 0x1d, 0xd2, 0x3f, 0x03, 0xd9,
+	0xbe, 0x4b, 0x53, 0xa9, 0xac, 0x48, 0x2c, 0x77, 0xef, 0xbd, 0xdf, 0xb3, 0xdf, 0xef, 0x7d, 0x19,
+	0x5a, 0x7d, 0xc6, 0x3d, 0xc6, 0x9b, 0x3d, 0x44, 0xf7, 0x9b, 0x07, 0x1b, 0x3d, 0x2c, 0xd0, 0x86,
+	0x52, 0x1a, 0x7e, 0xc0, 0x04, 0x33, 0x96, 0x35, 0xde, 0x50, 0xa6, 0x08, 0x5f, 0x2b, 0x0d, 0xd8,
+	0x80, 0x29, 0xbc, 0x29, 0x25, 0xed, 0xba, 0xb6, 0xaa, 0x5d, 0xbb, 0x1a, 0x88, 0xce, 0x69, 0xe8,
+	0x2a, 0x0a, 0xc7, 0xf3, 0x28, 0x7d, 0x46, 0x68, 0x84, 0xdf, 0x89, 0x70, 0x8f, 0x0f, 0x9a, 0x07,
+	0x1b, 0xf2, 0x17, 0x01, 0xb7, 0x91, 0x47, 0x28, 0x6b, 0xaa, 0xaf, 0x36, 0x55, 0x7f, 0x00, 0x98,
+	0x7d, 0x85, 0x02, 0xe4, 0x71, 0xe3, 0x39, 0x5c, 0xe2, 0x98, 0x3a, 0x5d, 0x4c, 0x51, 0xcf, 0xc5,
+	0x8e, 0x09, 0x2a, 0xa9, 0x5a, 0x71, 0xb3, 0xd2, 0xb8, 0x81, 0x73, 0x63, 0x17, 0x53, 0xe7, 0xa9,
+	0xf6, 0x6b, 0x27, 0x4d, 0x60, 0x17, 0xf9, 0x95, 0xc1, 0x78, 0x08, 0x4b, 0x0e, 0x7e, 0x8b, 0x42,
+	0x57, 0x74, 0xaf, 0x5d, 0x98, 0xac, 0x80, 0x5a, 0xde, 0x36, 0x22, 0x6c, 0xe1, 0x0a, 0x63, 0x0b,
+	0xae, 0x78, 0x68, 0xd4, 0xf5, 0x42, 0x57, 0x10, 0x7d, 0x86, 0x85, 0xc2, 0x0f, 0x05, 0x37, 0x53,
+	0x15, 0x50, 0x4b, 0xdb, 0xcb, 0x1e, 0x1a, 0xed, 0x48, 0x50, 0x1e, 0x7a, 0xa9, 0xa1, 0xd6, 0xbd,
+	0xc3, 0xd9, 0xa4, 0x6e, 0x6a, 0x76, 0xeb, 0xdc, 0xd9, 0x6f, 0x8e, 0x74, 0xdd, 0x75, 0x3a, 0xd5,
+	0x6d, 0x58, 0x5c, 0x0c, 0x51, 0x82, 0x19, 0x07, 0x53, 0xe6, 0x99, 0xa0, 0x02, 0x6a, 0x05, 0x5b,
+	0x2b, 0x86, 0x09, 0x73, 0xd7, 0xd9, 0xc5, 0x6a, 0x2b, 0x7d, 0x79, 0x54, 0x06, 0xd5, 0x53, 0x00,
+	0x33, 0x1d, 0xea, 0x87, 0xc2, 0xd8, 0x84, 0x39, 0xe4, 0x38, 0x01, 0xe6, 0x5c, 0xdf, 0xd0, 0x36,
+	0xbf, 0x1f, 0xaf, 0x97, 0xa2, 0xda, 0x3c, 0xd6, 0xc8, 0xae, 0x08, 0x08, 0x1d, 0xd8, 0xb1, 0xa3,
+	0xf1, 0x0e, 0x66, 0x64, 0x5b, 0xb8, 0x99, 0x54, 0xa5, 0x5c, 0xbd, 0x2a, 0x25, 0xc7, 0xf3, 0x52,
+	0x6e, 0x33, 0x42, 0xdb, 0xcf, 0x4e, 0xce, 0xcb, 0x89, 0x2f, 0xbf, 0xca, 0xb5, 0x01, 0x11, 0xc3,
+	0xb0, 0xd7, 0xe8, 0x33, 0x2f, 0xea, 0x79, 0x73, 0x21, 0x41, 0x31, 0xf6, 0x31, 0x57, 0x07, 0xf8,
+	0xa7, 0xd9, 0xa4, 0xbe, 0xe4, 0xe2, 0x01, 0xea, 0x8f, 0xbb, 0x2a, 0xc6, 0xe7, 0xd9, 0xa4, 0x0e,
+	0x6c, 0x1d, 0xaf, 0x55, 0xfa, 0x70, 0x54, 0x4e, 0x5c, 0x1e, 0x95, 0x13, 0xef, 0x67, 0x93, 0x7a,
+	0x4c, 0xa7, 0xfa, 0x0d, 0xc0, 0xac, 0x2e, 0xde, 0xff, 0x95, 0x4d, 0x3e, 0xce, 0xa6, 0xfa, 0x15,
+	0xc0, 0xec, 0x6e, 0xe8, 0xfb, 0xee, 0x58, 0xb2, 0x11, 0x4c, 0x20, 0xd7, 0x04, 0xff, 0x8c, 0x8d,
+	0x8a, 0xd7, 0x7a, 0x10, 0xb1, 0x01, 0xa7, 0xc7, 0xeb, 0x77, 0x6f, 0xdc, 0x0d, 0x45, 0xb0, 0x63,
+	0x82, 0xea, 0x6b, 0x58, 0x78, 0x22, 0xc7, 0x6c, 0x8f, 0x12, 0xf1, 0x97, 0x01, 0x5c, 0x83, 0x79,
+	0x3c, 0xf2, 0x19, 0xc5, 0x54, 0xa8, 0x09, 0xbc, 0x65, 0xcf, 0x75, 0x39, 0x9c, 0xc8, 0x25, 0x88,
+	0x63, 0xb9, 0x06, 0xa9, 0x5a, 0xc1, 0x8e, 0xd5, 0xea, 0x61, 0x12, 0xe6, 0x77, 0xb0, 0x40, 0x0e,
+	0x12, 0xc8, 0xa8, 0xc0, 0xa2, 0x83, 0x79, 0x3f, 0x20, 0xbe, 0x20, 0x8c, 0x46, 0xd7, 0x2f, 0x9a,
+	0x8c, 0x47, 0xd2, 0x83, 0x32, 0xaf, 0x1b, 0x52, 0x22, 0xe2, 0xfe, 0x59, 0x37, 0x2e, 0xf6, 0x9c,
+	0xaf, 0x0d, 0x9d, 0x58, 0xe4, 0x86, 0x01, 0xd3, 0xb2, 0xae, 0x6a, 0x1b, 0x0b, 0xb6, 0x92, 0x25,
+	0x3b, 0x87, 0x70, 0xdf, 0x45, 0x63, 0x33, 0xad, 0xcc, 0xb1, 0x2a, 0xbd, 0x29, 0xf2, 0xb0, 0x99,
+	0xd1, 0xde, 0x52, 0x36, 0x56, 0x60, 0x96, 0x8f, 0xbd, 0x1e, 0x73, 0xcd, 0xac, 0xb2, 0x46, 0x9a,
+	0xb1, 0x0a, 0x53, 0x61, 0x40, 0xcc, 0x9c, 0x1a, 0xc2, 0xdc, 0xf4, 0xbc, 0x9c, 0xda, 0xb3, 0x3b,
+	0xb6, 0xb4, 0x19, 0xf7, 0x61, 0x3e, 0x0c, 0x48, 0x77, 0x88, 0xf8, 0xd0, 0xcc, 0x2b, 0xbc, 0x38,
+	0x3d, 0x2f, 0xe7, 0xf6, 0xec, 0xce, 0x0b, 0xc4, 0x87, 0x76, 0x2e, 0x0c, 0x88, 0x14, 0xda, 0x5b,
+	0x27, 0x53, 0x0b, 0x9c, 0x4d, 0x2d, 0xf0, 0x7b, 0x6a, 0x81, 0x8f, 0x17, 0x56, 0xe2, 0xec, 0xc2,
+	0x4a, 0xfc, 0xbc, 0xb0, 0x12, 0x6f, 0xa2, 0x37, 0x94, 0x3b, 0xfb, 0x0d, 0xc2, 0xe2, 0xe7, 0x41,
+	0x35, 0xba, 0x97, 0x55, 0xcf, 0xdf, 0xd6, 0x9f, 0x01, 0x00, 0x52, 0x62, 0x3f, 0x37, 0xb2, 0x05,
+	0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -586,6 +597,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMultiSendOutputs != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxMultiSendOutputs))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -934,6 +950,9 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.MaxMultiSendOutputs != 0 {
+		n += 1 + sovBank(uint64(m.MaxMultiSendOutputs))
+	}
 	return n
 }
 
@@ -1160,6 +1179,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiSendOutputs", wireType)
+			}
+			m.MaxMultiSendOutputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMultiSendOutputs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
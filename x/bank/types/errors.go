@@ -13,4 +13,5 @@ var (
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
 	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrTooManyOutputs        = errors.Register(ModuleName, 11, "too many outputs")
 )
@@ -10,19 +10,25 @@ import (
 // DefaultDefaultSendEnabled is the value that DefaultSendEnabled will have from DefaultParams().
 var DefaultDefaultSendEnabled = true
 
+// DefaultMaxMultiSendOutputs is the value that MaxMultiSendOutputs will have from DefaultParams().
+// It is also the max used when MaxMultiSendOutputs is 0.
+var DefaultMaxMultiSendOutputs uint64 = 1000
+
 // NewParams creates a new parameter configuration for the bank module
 func NewParams(defaultSendEnabled bool) Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: defaultSendEnabled,
+		SendEnabled:         nil,
+		DefaultSendEnabled:  defaultSendEnabled,
+		MaxMultiSendOutputs: DefaultMaxMultiSendOutputs,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		SendEnabled:         nil,
+		DefaultSendEnabled:  DefaultDefaultSendEnabled,
+		MaxMultiSendOutputs: DefaultMaxMultiSendOutputs,
 	}
 }
 
@@ -31,7 +37,10 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	return validateMaxMultiSendOutputs(p.MaxMultiSendOutputs)
 }
 
 // Validate gets any errors with this SendEnabled entry.
@@ -56,3 +65,14 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+// validateMaxMultiSendOutputs accepts any value, a zero MaxMultiSendOutputs
+// using the default, so that params set before the field was added stay valid.
+func validateMaxMultiSendOutputs(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
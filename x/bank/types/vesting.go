@@ -19,12 +19,14 @@ type VestingAccount interface {
 	// TrackDelegation performs internal vesting accounting necessary when
 	// delegating from a vesting account. It accepts the current block time, the
 	// delegation amount and balance of all coins whose denomination exists in
-	// the account's original vesting balance.
-	TrackDelegation(blockTime time.Time, balance, amount sdk.Coins)
+	// the account's original vesting balance. It returns an error, leaving the
+	// account untouched, if the delegation amount is invalid.
+	TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) error
 
 	// TrackUndelegation performs internal vesting accounting necessary when a
-	// vesting account performs an undelegation.
-	TrackUndelegation(amount sdk.Coins)
+	// vesting account performs an undelegation. It returns an error, leaving
+	// the account untouched, if the undelegation amount is invalid.
+	TrackUndelegation(amount sdk.Coins) error
 
 	GetOriginalVesting() sdk.Coins
 	GetDelegatedFree() sdk.Coins
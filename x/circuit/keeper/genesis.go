@@ -2,6 +2,7 @@ package keeper
 
 import (
 	context "context"
+	"time"
 
 	"cosmossdk.io/x/circuit/types"
 )
@@ -10,6 +11,7 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 	var (
 		permissions  []*types.GenesisAccountPermissions
 		disabledMsgs []string
+		expirations  []*types.GenesisDisabledTypeURLExpiration
 	)
 
 	err := k.Permissions.Walk(ctx, nil, func(address []byte, perm types.Permissions) (stop bool, err error) {
@@ -37,9 +39,21 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
+	err = k.DisableExpiration.Walk(ctx, nil, func(msgUrl string, expiration time.Time) (stop bool, err error) {
+		expirations = append(expirations, &types.GenesisDisabledTypeURLExpiration{
+			TypeUrl:    msgUrl,
+			Expiration: expiration,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		AccountPermissions: permissions,
-		DisabledTypeUrls:   disabledMsgs,
+		AccountPermissions:         permissions,
+		DisabledTypeUrls:           disabledMsgs,
+		DisabledTypeUrlExpirations: expirations,
 	}
 }
 
@@ -62,4 +76,10 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, expiration := range genState.DisabledTypeUrlExpirations {
+		// Set the time at which the disabled type urls are reset
+		if err := k.DisableExpiration.Set(ctx, expiration.TypeUrl, expiration.Expiration); err != nil {
+			panic(err)
+		}
+	}
 }
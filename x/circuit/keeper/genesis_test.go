@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -73,6 +74,9 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		DisabledTypeUrlExpirations: []*types.GenesisDisabledTypeURLExpiration{
+			{TypeUrl: url, Expiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
 	}
 
 	s.keeper.InitGenesis(s.ctx, genesisState)
@@ -87,4 +91,5 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.DisabledTypeUrlExpirations, exportedGenesisState.DisabledTypeUrlExpirations)
 }
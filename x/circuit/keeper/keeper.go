@@ -2,15 +2,37 @@ package keeper
 
 import (
 	context "context"
+	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// circuitMsgTypeURLPrefix is the type URL prefix of the circuit module Msg's,
+// which are never disabled by pausing all Msg processing.
+const circuitMsgTypeURLPrefix = "cosmos.circuit."
+
+// govMsgTypeURLs are the type URLs of the gov Msg's which are never disabled by
+// pausing all Msg processing, so that governance can still pass a proposal, e.g.
+// to reset the circuit breaker, while all the other Msg's are paused.
+var govMsgTypeURLs = map[string]struct{}{
+	"cosmos.gov.v1.MsgSubmitProposal":      {},
+	"cosmos.gov.v1.MsgDeposit":             {},
+	"cosmos.gov.v1.MsgVote":                {},
+	"cosmos.gov.v1.MsgVoteWeighted":        {},
+	"cosmos.gov.v1beta1.MsgSubmitProposal": {},
+	"cosmos.gov.v1beta1.MsgDeposit":        {},
+	"cosmos.gov.v1beta1.MsgVote":           {},
+	"cosmos.gov.v1beta1.MsgVoteWeighted":   {},
+}
+
 // Keeper defines the circuit module's keeper.
 type Keeper struct {
 	cdc codec.BinaryCodec
@@ -25,6 +47,9 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// DisableExpiration contains the time at which disabled message URLs are
+	// automatically reset
+	DisableExpiration collections.Map[string, time.Time]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -54,6 +79,13 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 			"disable_list",
 			collections.StringKey,
 		),
+		DisableExpiration: collections.NewMap(
+			sb,
+			types.DisableExpirationPrefix,
+			"disable_expiration",
+			collections.StringKey,
+			collcodec.KeyToValueCodec(sdk.TimeKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -70,7 +102,51 @@ func (k *Keeper) GetAuthority() []byte {
 }
 
 // IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
+// When all Msg processing is paused, only the circuit module Msg's and the gov Msg's
+// submitting, depositing on and voting on proposals are allowed.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
+	if err != nil || has {
+		return !has, err
+	}
+
+	name := strings.TrimPrefix(msgURL, "/")
+	if strings.HasPrefix(name, circuitMsgTypeURLPrefix) {
+		return true, nil
+	}
+	if _, ok := govMsgTypeURLs[name]; ok {
+		return true, nil
+	}
+
+	has, err = k.DisableList.Has(ctx, types.AllMsgsTypeURL)
 	return !has, err
 }
+
+// ResetExpired resumes processing of the Msg's whose circuit breaker expired at
+// the current block time.
+func (k *Keeper) ResetExpired(ctx context.Context) error {
+	now := k.env.HeaderService.GetHeaderInfo(ctx).Time
+
+	var expired []string
+	err := k.DisableExpiration.Walk(ctx, nil, func(msgURL string, expiration time.Time) (stop bool, err error) {
+		if !expiration.After(now) {
+			expired = append(expired, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, msgURL := range expired {
+		if err := k.DisableList.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+
+		if err := k.DisableExpiration.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	context "context"
 	fmt "fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
@@ -89,14 +90,24 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
-	for _, msgTypeURL := range msg.MsgTypeUrls {
-		// check if the message is in the list of allowed messages
-		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+	if msg.Expiration != nil && !msg.Expiration.After(srv.env.HeaderService.GetHeaderInfo(ctx).Time) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration must be in the future")
+	}
+
+	msgTypeURLs := msg.MsgTypeUrls
+	if len(msgTypeURLs) == 0 {
+		// an empty list pauses the processing of all messages
+		msgTypeURLs = []string{types.AllMsgsTypeURL}
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		// check if the message is already in the list of disabled messages
+		isDisabled, err := srv.DisableList.Has(ctx, msgTypeURL)
 		if err != nil {
 			return nil, err
 		}
 
-		if !isAllowed {
+		if isDisabled {
 			return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
 		}
 
@@ -116,15 +127,22 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, err
 		}
 
+		if msg.Expiration != nil {
+			if err = srv.DisableExpiration.Set(ctx, msgTypeURL, *msg.Expiration); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
-
-	if err = srv.Keeper.env.EventService.EventManager(ctx).EmitKV(
-		"trip_circuit_breaker",
+	attrs := []event.Attribute{
 		event.NewAttribute("authority", msg.Authority),
-		event.NewAttribute("msg_url", urls),
-	); err != nil {
+		event.NewAttribute("msg_url", strings.Join(msgTypeURLs, ",")),
+	}
+	if msg.Expiration != nil {
+		attrs = append(attrs, event.NewAttribute("expiration", msg.Expiration.UTC().Format(time.RFC3339)))
+	}
+
+	if err = srv.Keeper.env.EventService.EventManager(ctx).EmitKV("trip_circuit_breaker", attrs...); err != nil {
 		return nil, err
 	}
 
@@ -148,14 +166,24 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		return nil, err
 	}
 
-	for _, msgTypeURL := range msg.MsgTypeUrls {
-		// check if the message is in the list of allowed messages
-		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+	msgTypeURLs := msg.MsgTypeUrls
+	if len(msgTypeURLs) == 0 {
+		// an empty list resumes the processing of all the messages the account
+		// is authorized to reset
+		msgTypeURLs, err = srv.resettableMsgTypeURLs(ctx, address, perms)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		// check if the message is in the list of disabled messages
+		isDisabled, err := srv.DisableList.Has(ctx, msgTypeURL)
 		if err != nil {
 			return nil, err
 		}
 
-		if isAllowed {
+		if !isDisabled {
 			return nil, fmt.Errorf("message %s is not disabled", msgTypeURL)
 		}
 
@@ -174,9 +202,13 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
+
+		if err = srv.DisableExpiration.Remove(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
 	}
 
-	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
+	urls := strings.Join(msgTypeURLs, ",")
 
 	if err = srv.Keeper.env.EventService.EventManager(ctx).EmitKV(
 		"reset_circuit_breaker",
@@ -189,6 +221,30 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// resettableMsgTypeURLs returns the disabled message URLs the account is
+// authorized to reset.
+func (srv msgServer) resettableMsgTypeURLs(ctx context.Context, address []byte, perms types.Permissions) ([]string, error) {
+	var msgTypeURLs []string
+	err := srv.DisableList.Walk(ctx, nil, func(msgTypeURL string) (stop bool, err error) {
+		switch {
+		case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
+			msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+		case perms.Level == types.Permissions_LEVEL_SOME_MSGS && hasPermissionForMsg(perms, msgTypeURL):
+			msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgTypeURLs) == 0 && perms.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED && !bytes.Equal(address, srv.GetAuthority()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
+	}
+
+	return msgTypeURLs, nil
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
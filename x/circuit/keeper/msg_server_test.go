@@ -3,14 +3,17 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const msgSend = "cosmos.bank.v1beta1.MsgSend"
@@ -200,6 +203,88 @@ func TestTripCircuitBreaker(t *testing.T) {
 	require.ErrorContains(t, err, "already disabled")
 }
 
+func TestTripCircuitBreakerAllMsgs(t *testing.T) {
+	ft := initFixture(t)
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	// an account which can only trip some messages cannot pause all messages
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1]})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// an empty list pauses all messages but the circuit ones
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: authority})
+	require.NoError(t, err)
+
+	allowed, err := ft.keeper.IsAllowed(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed, "all messages should be paused")
+
+	allowed, err = ft.keeper.IsAllowed(ft.ctx, sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{}))
+	require.NoError(t, err)
+	require.True(t, allowed, "circuit messages should not be paused")
+
+	for _, url := range []string{"/cosmos.gov.v1.MsgSubmitProposal", "/cosmos.gov.v1.MsgVote", "/cosmos.gov.v1beta1.MsgVote"} {
+		allowed, err = ft.keeper.IsAllowed(ft.ctx, url)
+		require.NoError(t, err)
+		require.True(t, allowed, "gov messages should not be paused")
+	}
+
+	// gov messages can still be disabled one by one
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{"/cosmos.gov.v1.MsgVote"}})
+	require.NoError(t, err)
+	allowed, err = ft.keeper.IsAllowed(ft.ctx, "/cosmos.gov.v1.MsgVote")
+	require.NoError(t, err)
+	require.False(t, allowed, "a disabled gov message should be paused")
+
+	// an empty list resumes all messages
+	_, err = srv.ResetCircuitBreaker(ft.ctx, &types.MsgResetCircuitBreaker{Authority: authority})
+	require.NoError(t, err)
+
+	allowed, err = ft.keeper.IsAllowed(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed, "all messages should be resumed")
+}
+
+func TestTripCircuitBreakerExpiration(t *testing.T) {
+	ft := initFixture(t)
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithHeaderInfo(header.Info{Time: now})
+
+	// the expiration must be in the future
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, Expiration: &now})
+	require.ErrorContains(t, err, "expiration must be in the future")
+
+	expiration := now.Add(time.Hour)
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, Expiration: &expiration})
+	require.NoError(t, err)
+
+	// the circuit breaker stays tripped until the expiration
+	require.NoError(t, ft.keeper.ResetExpired(ctx.WithHeaderInfo(header.Info{Time: expiration.Add(-time.Second)})))
+	allowed, err := ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed, "circuit breaker should be tripped")
+
+	require.NoError(t, ft.keeper.ResetExpired(ctx.WithHeaderInfo(header.Info{Time: expiration})))
+	allowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed, "circuit breaker should be reset")
+
+	has, err := ft.keeper.DisableExpiration.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
 func TestResetCircuitBreaker(t *testing.T) {
 	ft := initFixture(t)
 	authority, err := ft.ac.BytesToString(ft.mockAddr)
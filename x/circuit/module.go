@@ -29,8 +29,9 @@ var (
 	_ appmodule.HasRegisterInterfaces = AppModule{}
 	_ module.HasGenesis               = AppModule{}
 
-	_ appmodule.AppModule   = AppModule{}
-	_ appmodule.HasServices = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasServices     = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the circuit module.
@@ -107,3 +108,8 @@ func (am AppModule) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json
 	gs := am.keeper.ExportGenesis(ctx)
 	return cdc.MustMarshalJSON(gs)
 }
+
+// BeginBlock resumes processing of the Msg's whose circuit breaker expired.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.ResetExpired(ctx)
+}
@@ -20,6 +20,17 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	disabled := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		disabled[url] = true
+	}
+
+	for _, expiration := range gs.DisabledTypeUrlExpirations {
+		if expiration == nil || !disabled[expiration.TypeUrl] {
+			return fmt.Errorf("expiration set for a type url which is not disabled: %v", expiration)
+		}
+	}
+
 	return nil
 }
 
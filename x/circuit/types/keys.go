@@ -8,10 +8,15 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// AllMsgsTypeURL is the DisableList entry which pauses the processing of
+	// all Msg's, except for the circuit module ones.
+	AllMsgsTypeURL = "*"
 )
 
 // KVStore keys
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	DisableExpirationPrefix = collections.NewPrefix(3)
 )
@@ -7,20 +7,25 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -145,11 +150,16 @@ type MsgTripCircuitBreaker struct {
 	// authority is the account authorized to trip the circuit breaker.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// msg_type_urls specifies a list of type URLs to immediately stop processing.
-	// IF IT IS LEFT EMPTY, ALL MSG PROCESSING WILL STOP IMMEDIATELY.
+	// IF IT IS LEFT EMPTY, ALL MSG PROCESSING WILL STOP IMMEDIATELY, except
+	// for the circuit module Msg's, so that the circuit breaker can be reset.
 	// This value is validated against the authority's permissions and if the
 	// authority does not have permissions to trip the specified msg type URLs
 	// (or all URLs), the operation will fail.
 	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// expiration is the time at which processing of the msg_type_urls resumes
+	// automatically. If it is unset, they stay disabled until they are reset
+	// using ResetCircuitBreaker.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
@@ -199,6 +209,13 @@ func (m *MsgTripCircuitBreaker) GetMsgTypeUrls() []string {
 	return nil
 }
 
+func (m *MsgTripCircuitBreaker) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // MsgTripCircuitBreakerResponse defines the Msg/TripCircuitBreaker response type.
 type MsgTripCircuitBreakerResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -358,34 +375,38 @@ func init() {
 func init() { proto.RegisterFile("cosmos/circuit/v1/tx.proto", fileDescriptor_a02145e57a6fbb1d) }
 
 var fileDescriptor_a02145e57a6fbb1d = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0x87, 0x19, 0x1a, 0xff, 0x30, 0xa8, 0x89, 0x35, 0x7a, 0x9b, 0xc9, 0xbd, 0x13, 0xd2, 0x55,
-	0xbd, 0x89, 0xad, 0x60, 0x34, 0x91, 0x85, 0x51, 0x5c, 0x37, 0x31, 0x0d, 0x6e, 0xdc, 0x10, 0xac,
-	0x93, 0x71, 0x84, 0x32, 0xcd, 0x9c, 0x96, 0x80, 0x1b, 0x8d, 0x4f, 0xe0, 0x23, 0xf8, 0x08, 0x3c,
-	0x86, 0x4b, 0x96, 0x2e, 0x0d, 0x2c, 0x78, 0x01, 0x1f, 0xc0, 0x94, 0x52, 0x4a, 0x64, 0x08, 0x98,
-	0xbb, 0x1c, 0xce, 0x37, 0xe7, 0xf7, 0x0d, 0xa7, 0x07, 0x93, 0x50, 0x42, 0x24, 0xc1, 0x0b, 0x85,
-	0x0a, 0x53, 0x91, 0x78, 0xe3, 0xa6, 0x97, 0x4c, 0xdc, 0x58, 0xc9, 0x44, 0x9a, 0x77, 0xf3, 0x9a,
-	0xbb, 0xa9, 0xb9, 0xe3, 0x26, 0x39, 0xdb, 0xe0, 0x11, 0xf0, 0x0c, 0x8d, 0x80, 0xe7, 0x2c, 0xb9,
-	0xd0, 0xf4, 0x99, 0xc6, 0x0c, 0xf2, 0xb2, 0xfd, 0x03, 0x61, 0xe2, 0x03, 0x7f, 0x95, 0x26, 0x1f,
-	0xa5, 0x12, 0x9f, 0xd9, 0xeb, 0x1c, 0xeb, 0x28, 0xd6, 0x1f, 0x30, 0x65, 0x5a, 0xf8, 0x06, 0x57,
-	0xfd, 0x51, 0xc2, 0x94, 0x85, 0x1a, 0xc8, 0xa9, 0x05, 0xc5, 0xb1, 0xac, 0x30, 0xab, 0xba, 0x5b,
-	0x61, 0xe6, 0x4b, 0x5c, 0x8f, 0x99, 0x8a, 0x04, 0x80, 0x90, 0x23, 0xb0, 0x8c, 0x06, 0x72, 0xea,
-	0x2d, 0xea, 0xee, 0x39, 0xbb, 0x6f, 0x4a, 0x2a, 0xd8, 0xbd, 0xd2, 0xbe, 0xf5, 0x6d, 0x35, 0xbb,
-	0x2c, 0x92, 0xec, 0x17, 0xd8, 0x3e, 0x6c, 0x18, 0x30, 0x88, 0xe5, 0x08, 0x58, 0xe6, 0x03, 0x69,
-	0x18, 0x32, 0x80, 0xb5, 0xe9, 0xcd, 0xa0, 0x38, 0xda, 0x02, 0xdf, 0xf7, 0x81, 0x77, 0x95, 0x88,
-	0xff, 0x79, 0xdc, 0x39, 0xae, 0xf5, 0xf3, 0xae, 0xc9, 0x74, 0xf3, 0xbc, 0xf2, 0x07, 0xd3, 0xc6,
-	0xb7, 0x23, 0xe0, 0xbd, 0xec, 0xcf, 0xea, 0xa5, 0x6a, 0x08, 0x56, 0xb5, 0x61, 0x38, 0xb5, 0xa0,
-	0x1e, 0x01, 0xef, 0x4e, 0x63, 0xf6, 0x56, 0x0d, 0xa1, 0x7d, 0x27, 0x13, 0x2d, 0xef, 0xd8, 0xcf,
-	0xf1, 0x85, 0x36, 0xea, 0x04, 0xcb, 0x4f, 0xf8, 0x81, 0x0f, 0x3c, 0x60, 0xc0, 0x92, 0xab, 0x69,
-	0x1a, 0xc7, 0x35, 0xdb, 0x98, 0xea, 0xb3, 0x8e, 0x7b, 0xb6, 0xfe, 0x54, 0xb1, 0xe1, 0x03, 0x37,
-	0xbf, 0xe0, 0xb3, 0x43, 0x1f, 0xcd, 0x23, 0xcd, 0xac, 0x0f, 0x4f, 0x90, 0x3c, 0xfd, 0x2f, 0x7c,
-	0xab, 0x18, 0x63, 0x53, 0x33, 0x53, 0x47, 0xdf, 0x6c, 0x9f, 0x24, 0x8f, 0x4f, 0x25, 0xb7, 0x89,
-	0x80, 0xef, 0xe9, 0xe6, 0xf3, 0x50, 0xdf, 0x48, 0x83, 0x92, 0xe6, 0xc9, 0x68, 0x11, 0x4a, 0xae,
-	0x7d, 0x5d, 0xcd, 0x2e, 0x51, 0xe7, 0xd9, 0xcf, 0x05, 0x45, 0xf3, 0x05, 0x45, 0xbf, 0x17, 0x14,
-	0x7d, 0x5f, 0xd2, 0xca, 0x7c, 0x49, 0x2b, 0xbf, 0x96, 0xb4, 0xf2, 0xee, 0x3c, 0x6f, 0x09, 0x1f,
-	0x06, 0xae, 0x90, 0xde, 0x64, 0xbb, 0xe8, 0xeb, 0x2d, 0x7f, 0x7f, 0x7d, 0xbd, 0xe6, 0x4f, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x89, 0x07, 0x74, 0x02, 0x4f, 0x04, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x6e, 0x13, 0x31,
+	0x14, 0x8d, 0x13, 0x5e, 0x71, 0x00, 0x89, 0xe1, 0xd1, 0xc8, 0x6a, 0xa7, 0xd1, 0xac, 0x42, 0x25,
+	0x3c, 0xa4, 0x08, 0x24, 0xb2, 0x40, 0x25, 0xac, 0x23, 0xa1, 0x51, 0xd8, 0xb0, 0xa9, 0xa6, 0x83,
+	0x31, 0xa6, 0x99, 0xd8, 0xf2, 0xf5, 0x54, 0x09, 0x1b, 0x10, 0x5f, 0xd0, 0x4f, 0x60, 0xcf, 0xa6,
+	0x9f, 0xc1, 0xb2, 0x4b, 0x76, 0xa0, 0x64, 0xd1, 0x1f, 0xe0, 0x03, 0xd0, 0xbc, 0x92, 0x88, 0x38,
+	0x22, 0x88, 0xdd, 0x5c, 0xdf, 0xe3, 0x73, 0xcf, 0xb9, 0x73, 0x64, 0x4c, 0x22, 0x09, 0xb1, 0x04,
+	0x3f, 0x12, 0x3a, 0x4a, 0x84, 0xf1, 0x4f, 0x3a, 0xbe, 0x19, 0x53, 0xa5, 0xa5, 0x91, 0xce, 0xad,
+	0xbc, 0x47, 0x8b, 0x1e, 0x3d, 0xe9, 0x90, 0xad, 0x02, 0x1e, 0x03, 0x4f, 0xa1, 0x31, 0xf0, 0x1c,
+	0x4b, 0x76, 0x2c, 0x3c, 0x13, 0xc5, 0xa0, 0x68, 0xdf, 0xe1, 0x92, 0xcb, 0xec, 0xd3, 0x4f, 0xbf,
+	0x8a, 0xd3, 0x5d, 0x2e, 0x25, 0x1f, 0x32, 0x3f, 0xab, 0x8e, 0x92, 0xb7, 0xbe, 0x11, 0x31, 0x03,
+	0x13, 0xc6, 0x2a, 0x07, 0x78, 0x5f, 0x10, 0x26, 0x7d, 0xe0, 0xcf, 0x13, 0xf3, 0x4e, 0x6a, 0xf1,
+	0x81, 0xbd, 0xc8, 0xd9, 0x7b, 0x9a, 0x85, 0xc7, 0x4c, 0x3b, 0x4d, 0x7c, 0x95, 0xeb, 0x70, 0x64,
+	0x98, 0x6e, 0xa2, 0x16, 0x6a, 0xd7, 0x83, 0xb2, 0x5c, 0x74, 0x58, 0xb3, 0xba, 0xdc, 0x61, 0xce,
+	0x01, 0x6e, 0x28, 0xa6, 0x63, 0x01, 0x20, 0xe4, 0x08, 0x9a, 0xb5, 0x16, 0x6a, 0x37, 0xf6, 0x5d,
+	0xba, 0x62, 0x95, 0xbe, 0x5c, 0xa0, 0x82, 0xe5, 0x2b, 0xdd, 0xeb, 0x9f, 0x2f, 0xce, 0xf6, 0xca,
+	0x49, 0xde, 0x33, 0xec, 0xad, 0x57, 0x18, 0x30, 0x50, 0x72, 0x04, 0x2c, 0xd5, 0x03, 0x49, 0x14,
+	0x31, 0x80, 0x4c, 0xe9, 0xb5, 0xa0, 0x2c, 0xbd, 0xaf, 0x08, 0xdf, 0xed, 0x03, 0x1f, 0x68, 0xa1,
+	0xfe, 0x70, 0xb7, 0x8d, 0xeb, 0x61, 0x4e, 0x6b, 0x26, 0x85, 0xbf, 0xc5, 0x81, 0xe3, 0xe1, 0x1b,
+	0x31, 0xf0, 0xc3, 0x74, 0xc9, 0x87, 0x89, 0x1e, 0x42, 0xb3, 0xda, 0xaa, 0xb5, 0xeb, 0x41, 0x23,
+	0x06, 0x3e, 0x98, 0x28, 0xf6, 0x4a, 0x0f, 0xc1, 0x39, 0xc0, 0x98, 0x8d, 0x95, 0xd0, 0xa1, 0x11,
+	0x72, 0x54, 0x58, 0x25, 0x34, 0x5f, 0x3a, 0x2d, 0x97, 0x4e, 0x07, 0xe5, 0xd2, 0x7b, 0x97, 0x4e,
+	0x7f, 0xec, 0xa2, 0x60, 0xe9, 0x4e, 0xf7, 0x66, 0xea, 0x75, 0x31, 0xd5, 0x7b, 0x8a, 0x77, 0xac,
+	0x62, 0x37, 0x30, 0xfa, 0x1e, 0xdf, 0xeb, 0x03, 0x0f, 0x18, 0x30, 0xf3, 0x7f, 0x46, 0x6b, 0x2b,
+	0x46, 0x57, 0x64, 0x76, 0xb1, 0x6b, 0x9f, 0xf5, 0x77, 0x9d, 0xfb, 0xbf, 0xaa, 0xb8, 0xd6, 0x07,
+	0xee, 0x7c, 0xc4, 0x5b, 0xeb, 0x72, 0xf7, 0xc0, 0x12, 0x97, 0xf5, 0x21, 0x20, 0x8f, 0xff, 0x09,
+	0x3e, 0x97, 0xa8, 0xb0, 0x63, 0x49, 0x45, 0xdb, 0x4e, 0xb6, 0x8a, 0x24, 0x0f, 0x37, 0x45, 0xce,
+	0x27, 0x02, 0xbe, 0x6d, 0xfb, 0x3f, 0xf7, 0xed, 0x44, 0x16, 0x28, 0xe9, 0x6c, 0x0c, 0x2d, 0x87,
+	0x92, 0xcb, 0x9f, 0x2e, 0xce, 0xf6, 0x50, 0xef, 0xc9, 0xb7, 0xa9, 0x8b, 0xce, 0xa7, 0x2e, 0xfa,
+	0x39, 0x75, 0xd1, 0xe9, 0xcc, 0xad, 0x9c, 0xcf, 0xdc, 0xca, 0xf7, 0x99, 0x5b, 0x79, 0xbd, 0x9d,
+	0x53, 0xc2, 0x9b, 0x63, 0x2a, 0xa4, 0x3f, 0x9e, 0x3f, 0x31, 0xd9, 0xfb, 0x72, 0x74, 0x25, 0xcb,
+	0xf1, 0xa3, 0xdf, 0x03, 0x00, 0x97, 0x78, 0xa3, 0x32, 0xc9, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -652,6 +673,16 @@ func (m *MsgTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.MsgTypeUrls) > 0 {
 		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MsgTypeUrls[iNdEx])
@@ -836,6 +867,10 @@ func (m *MsgTripCircuitBreaker) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1201,6 +1236,42 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -175,17 +180,72 @@ func (m *GenesisAccountPermissions) GetPermissions() *Permissions {
 	return nil
 }
 
+// GenesisDisabledTypeURLExpiration is the time at which a disabled Msg type URL
+// is automatically reset in genesis.
+type GenesisDisabledTypeURLExpiration struct {
+	TypeUrl    string    `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *GenesisDisabledTypeURLExpiration) Reset()         { *m = GenesisDisabledTypeURLExpiration{} }
+func (m *GenesisDisabledTypeURLExpiration) String() string { return proto.CompactTextString(m) }
+func (*GenesisDisabledTypeURLExpiration) ProtoMessage()    {}
+func (*GenesisDisabledTypeURLExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+}
+func (m *GenesisDisabledTypeURLExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisDisabledTypeURLExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisDisabledTypeURLExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisDisabledTypeURLExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisDisabledTypeURLExpiration.Merge(m, src)
+}
+func (m *GenesisDisabledTypeURLExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisDisabledTypeURLExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisDisabledTypeURLExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisDisabledTypeURLExpiration proto.InternalMessageInfo
+
+func (m *GenesisDisabledTypeURLExpiration) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *GenesisDisabledTypeURLExpiration) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
-	AccountPermissions []*GenesisAccountPermissions `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
-	DisabledTypeUrls   []string                     `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+	AccountPermissions         []*GenesisAccountPermissions        `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
+	DisabledTypeUrls           []string                            `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+	DisabledTypeUrlExpirations []*GenesisDisabledTypeURLExpiration `protobuf:"bytes,3,rep,name=disabled_type_url_expirations,json=disabledTypeUrlExpirations,proto3" json:"disabled_type_url_expirations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+	return fileDescriptor_1f5fe523f8a09dbc, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,42 +288,57 @@ func (m *GenesisState) GetDisabledTypeUrls() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDisabledTypeUrlExpirations() []*GenesisDisabledTypeURLExpiration {
+	if m != nil {
+		return m.DisabledTypeUrlExpirations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.circuit.v1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
 	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1.Permissions")
 	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1.GenesisAccountPermissions")
+	proto.RegisterType((*GenesisDisabledTypeURLExpiration)(nil), "cosmos.circuit.v1.GenesisDisabledTypeURLExpiration")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/types.proto", fileDescriptor_1f5fe523f8a09dbc) }
 
 var fileDescriptor_1f5fe523f8a09dbc = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x4f, 0xea, 0x40,
-	0x18, 0xec, 0x42, 0x78, 0x2f, 0x6c, 0xdf, 0x83, 0xb2, 0x44, 0x53, 0x8d, 0x36, 0x4d, 0x63, 0x4c,
-	0x0f, 0xa4, 0x0d, 0x98, 0x78, 0xf0, 0x24, 0x4a, 0x25, 0x24, 0xa5, 0x90, 0x56, 0x3c, 0x98, 0x98,
-	0xa6, 0xb4, 0x7b, 0xd8, 0x58, 0x68, 0xd3, 0x2d, 0x28, 0xff, 0xc2, 0xbb, 0x7f, 0xc8, 0x23, 0x27,
-	0xe3, 0xd1, 0xc0, 0x1f, 0x31, 0xb4, 0xa0, 0x4d, 0x50, 0x8f, 0xfb, 0xcd, 0xcc, 0x7e, 0xdf, 0x4c,
-	0x06, 0x1e, 0xba, 0x01, 0x1d, 0x05, 0x54, 0x75, 0x49, 0xe4, 0x4e, 0x48, 0xac, 0x4e, 0xeb, 0x6a,
-	0x3c, 0x0b, 0x31, 0x55, 0xc2, 0x28, 0x88, 0x03, 0x54, 0x49, 0x61, 0x65, 0x0d, 0x2b, 0xd3, 0xba,
-	0xf4, 0x0a, 0x20, 0xdb, 0xc7, 0xd1, 0x88, 0x50, 0x4a, 0x82, 0x31, 0x45, 0x67, 0xb0, 0xe0, 0xe3,
-	0x29, 0xf6, 0x79, 0x20, 0x02, 0xb9, 0xd4, 0x38, 0x52, 0xb6, 0x24, 0x4a, 0x86, 0xae, 0xe8, 0x2b,
-	0xae, 0x99, 0x4a, 0xd0, 0x31, 0x2c, 0xfb, 0x64, 0x44, 0x62, 0x7b, 0xb5, 0xd3, 0x9e, 0x44, 0x3e,
-	0xe5, 0x73, 0x62, 0x5e, 0x2e, 0x9a, 0xff, 0x93, 0xf1, 0xf5, 0x2c, 0xc4, 0x83, 0xc8, 0xa7, 0x92,
-	0x0b, 0x0b, 0x89, 0x0e, 0xed, 0xc3, 0x5d, 0x5d, 0xbb, 0xd1, 0x74, 0xdb, 0xe8, 0x19, 0x9a, 0x3d,
-	0x30, 0xac, 0xbe, 0x76, 0xd9, 0xb9, 0xea, 0x68, 0x2d, 0x8e, 0x41, 0x55, 0x58, 0x4e, 0x31, 0xab,
-	0xd7, 0xd5, 0xec, 0xae, 0xd5, 0xb6, 0x38, 0x80, 0x10, 0x2c, 0xa5, 0xc3, 0xa6, 0xae, 0xa7, 0xb3,
-	0x1c, 0xda, 0x81, 0x95, 0x35, 0x71, 0xd0, 0xd7, 0x4c, 0xbb, 0xd9, 0xea, 0x76, 0x0c, 0x2e, 0x2f,
-	0x3d, 0xc0, 0xbd, 0x36, 0x1e, 0x63, 0x4a, 0x68, 0xd3, 0x75, 0x83, 0xc9, 0x38, 0xce, 0xba, 0xe4,
-	0xe1, 0x5f, 0xc7, 0xf3, 0x22, 0x4c, 0x69, 0xe2, 0xb3, 0x68, 0x6e, 0x9e, 0xe8, 0x1c, 0xb2, 0xe1,
-	0x17, 0x91, 0xcf, 0x89, 0x40, 0x66, 0x1b, 0xc2, 0xef, 0x29, 0x98, 0x59, 0x89, 0xf4, 0x0c, 0xe0,
-	0xbf, 0xf5, 0x66, 0x2b, 0x76, 0x62, 0x8c, 0xee, 0x60, 0xd5, 0x49, 0x4f, 0xb0, 0xb3, 0x5f, 0x03,
-	0x31, 0x2f, 0xb3, 0x8d, 0xda, 0x37, 0x5f, 0xff, 0x78, 0xb7, 0x89, 0x9c, 0x6d, 0x2f, 0x35, 0x88,
-	0x3c, 0x42, 0x9d, 0xa1, 0x8f, 0xbd, 0xad, 0xe0, 0xb9, 0x0d, 0xb2, 0xc9, 0xfe, 0xe2, 0xf4, 0x65,
-	0x21, 0x80, 0xf9, 0x42, 0x00, 0xef, 0x0b, 0x01, 0x3c, 0x2d, 0x05, 0x66, 0xbe, 0x14, 0x98, 0xb7,
-	0xa5, 0xc0, 0xdc, 0x1e, 0xa4, 0x87, 0x50, 0xef, 0x5e, 0x21, 0x81, 0xfa, 0xf8, 0xd9, 0xa1, 0xa4,
-	0x40, 0xc3, 0x3f, 0x49, 0x83, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xff, 0xdc, 0xa4,
-	0x62, 0x02, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0x20, 0xd5, 0xf6, 0xa1, 0x2d, 0x9d, 0xaa, 0xa1, 0xc4, 0x2e, 0x84, 0x18, 0xc3, 0xa1,
+	0x99, 0x4d, 0x69, 0xe2, 0xc1, 0x93, 0x54, 0xd6, 0x86, 0x64, 0xa1, 0x64, 0x29, 0x1e, 0x4c, 0xcc,
+	0x66, 0xd9, 0x1d, 0xc9, 0xc4, 0x5d, 0x66, 0xb3, 0x33, 0x60, 0x7b, 0xf6, 0x0f, 0x34, 0xf1, 0x4f,
+	0xf5, 0xd8, 0x93, 0xf1, 0xa4, 0x06, 0xfe, 0x88, 0x61, 0x67, 0xb7, 0x6c, 0x44, 0xbc, 0xcd, 0xbc,
+	0xef, 0x7b, 0x79, 0xdf, 0xf7, 0xbd, 0x3c, 0x38, 0x72, 0xb9, 0x08, 0xb8, 0xd0, 0x5d, 0x16, 0xb9,
+	0x53, 0x26, 0xf5, 0xd9, 0x89, 0x2e, 0xaf, 0x43, 0x2a, 0x48, 0x18, 0x71, 0xc9, 0xf1, 0xbe, 0x82,
+	0x49, 0x02, 0x93, 0xd9, 0x49, 0xe5, 0xc9, 0x98, 0x8f, 0x79, 0x8c, 0xea, 0xcb, 0x97, 0x22, 0x56,
+	0xaa, 0x63, 0xce, 0xc7, 0x3e, 0xd5, 0xe3, 0xdf, 0x68, 0xfa, 0x49, 0x97, 0x2c, 0xa0, 0x42, 0x3a,
+	0x41, 0xa8, 0x08, 0xf5, 0xef, 0x08, 0x8a, 0x7d, 0x1a, 0x05, 0x4c, 0x08, 0xc6, 0x27, 0x02, 0xbf,
+	0x86, 0x2d, 0x9f, 0xce, 0xa8, 0x5f, 0x46, 0x35, 0xd4, 0xd8, 0x6d, 0xbe, 0x20, 0x6b, 0x93, 0x48,
+	0x86, 0x4e, 0xcc, 0x25, 0xd7, 0x52, 0x2d, 0xf8, 0x25, 0xec, 0xf9, 0x2c, 0x60, 0xd2, 0x5e, 0x4a,
+	0xb5, 0xa7, 0x91, 0x2f, 0xca, 0xf9, 0x5a, 0xa1, 0xb1, 0x63, 0x3d, 0x8e, 0xcb, 0x97, 0xd7, 0x21,
+	0x1d, 0x46, 0xbe, 0xa8, 0xbb, 0xb0, 0x15, 0xf7, 0xe1, 0x0a, 0x3c, 0x33, 0x8d, 0xf7, 0x86, 0x69,
+	0xf7, 0x2e, 0x7a, 0x86, 0x3d, 0xec, 0x0d, 0xfa, 0xc6, 0xdb, 0xce, 0xbb, 0x8e, 0xd1, 0x2e, 0xe5,
+	0xf0, 0x01, 0xec, 0x29, 0x6c, 0x70, 0xd1, 0x35, 0xec, 0xee, 0xe0, 0x7c, 0x50, 0x42, 0x18, 0xc3,
+	0xae, 0x2a, 0xb6, 0x4c, 0x53, 0xd5, 0xf2, 0xf8, 0x29, 0xec, 0x27, 0xc4, 0x61, 0xdf, 0xb0, 0xec,
+	0x56, 0xbb, 0xdb, 0xe9, 0x95, 0x0a, 0xf5, 0x2f, 0x70, 0x78, 0x4e, 0x27, 0x54, 0x30, 0xd1, 0x72,
+	0x5d, 0x3e, 0x9d, 0xc8, 0xac, 0xcb, 0x32, 0x3c, 0x74, 0x3c, 0x2f, 0xa2, 0x42, 0xc4, 0x3e, 0x77,
+	0xac, 0xf4, 0x8b, 0xdf, 0x40, 0x31, 0x5c, 0x11, 0xcb, 0xf9, 0x1a, 0x6a, 0x14, 0x9b, 0xda, 0xff,
+	0x53, 0xb0, 0xb2, 0x2d, 0xf5, 0xaf, 0x08, 0x6a, 0xc9, 0xe4, 0x36, 0x13, 0xce, 0xc8, 0xa7, 0x5e,
+	0xec, 0xdc, 0x32, 0x8d, 0xab, 0x90, 0x45, 0x8e, 0x64, 0x7c, 0x82, 0x0f, 0x61, 0x3b, 0x0d, 0x29,
+	0x55, 0x20, 0x55, 0x3c, 0xb8, 0x0d, 0x40, 0xef, 0x89, 0x89, 0x80, 0x0a, 0x51, 0x7b, 0x24, 0xe9,
+	0x1e, 0xc9, 0x65, 0xba, 0xc7, 0xb3, 0xed, 0xdb, 0x9f, 0xd5, 0xdc, 0xcd, 0xaf, 0x2a, 0xb2, 0x32,
+	0x7d, 0xf5, 0x6f, 0x79, 0x78, 0x94, 0xa8, 0x18, 0x48, 0x47, 0x52, 0xfc, 0x11, 0x0e, 0x1c, 0x15,
+	0x84, 0x9d, 0x35, 0x88, 0x6a, 0x85, 0x46, 0xb1, 0x79, 0xfc, 0x0f, 0x83, 0x1b, 0xd3, 0xb3, 0xb0,
+	0xb3, 0x9e, 0xe8, 0x31, 0x60, 0x2f, 0x71, 0xbb, 0xb6, 0xfe, 0x92, 0x97, 0xcd, 0x21, 0xf2, 0x05,
+	0x9e, 0xc1, 0xd1, 0x1a, 0xdb, 0x5e, 0xa9, 0x17, 0xe5, 0x42, 0x2c, 0xeb, 0x74, 0xb3, 0xac, 0x8d,
+	0xd1, 0x5a, 0x95, 0xbf, 0xa6, 0xad, 0x20, 0x71, 0xf6, 0xea, 0x76, 0xae, 0xa1, 0xbb, 0xb9, 0x86,
+	0x7e, 0xcf, 0x35, 0x74, 0xb3, 0xd0, 0x72, 0x77, 0x0b, 0x2d, 0xf7, 0x63, 0xa1, 0xe5, 0x3e, 0x3c,
+	0x57, 0x93, 0x84, 0xf7, 0x99, 0x30, 0xae, 0x5f, 0xdd, 0x1f, 0x5e, 0x7c, 0x75, 0xa3, 0x07, 0x71,
+	0xee, 0xa7, 0x7f, 0x06, 0x00, 0xf3, 0x67, 0x3c, 0x22, 0x97, 0x03, 0x00, 0x00,
 }
 
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -345,6 +420,44 @@ func (m *GenesisAccountPermissions) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisDisabledTypeURLExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisDisabledTypeURLExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisDisabledTypeURLExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -365,6 +478,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DisabledTypeUrlExpirations) > 0 {
+		for iNdEx := len(m.DisabledTypeUrlExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisabledTypeUrlExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DisabledTypeUrls) > 0 {
 		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DisabledTypeUrls[iNdEx])
@@ -437,6 +564,21 @@ func (m *GenesisAccountPermissions) Size() (n int) {
 	return n
 }
 
+func (m *GenesisDisabledTypeURLExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -455,6 +597,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.DisabledTypeUrlExpirations) > 0 {
+		for _, e := range m.DisabledTypeUrlExpirations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -683,6 +831,121 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisDisabledTypeURLExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisDisabledTypeURLExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisDisabledTypeURLExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -778,6 +1041,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledTypeUrlExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledTypeUrlExpirations = append(m.DisabledTypeUrlExpirations, &GenesisDisabledTypeURLExpiration{})
+			if err := m.DisabledTypeUrlExpirations[len(m.DisabledTypeUrlExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
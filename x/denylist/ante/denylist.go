@@ -0,0 +1,65 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/denylist/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenyList is an interface that defines the methods for a deny-list.
+type DenyList interface {
+	IsBlocked(ctx context.Context, addr []byte) (bool, error)
+}
+
+// signersTx is a transaction exposing its signers.
+type signersTx interface {
+	GetSigners() ([][]byte, error)
+}
+
+// DenyListDecorator is an AnteDecorator that rejects the transactions signed
+// by, or whose fees are paid by, an address on the deny-list.
+type DenyListDecorator struct {
+	denyList DenyList
+}
+
+// NewDenyListDecorator returns a DenyListDecorator checking addresses against dl.
+func NewDenyListDecorator(dl DenyList) DenyListDecorator {
+	return DenyListDecorator{
+		denyList: dl,
+	}
+}
+
+func (dld DenyListDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var addrs [][]byte
+
+	if sigTx, ok := tx.(signersTx); ok {
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return ctx, err
+		}
+		addrs = append(addrs, signers...)
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		addrs = append(addrs, feeTx.FeePayer())
+		if granter := feeTx.FeeGranter(); granter != nil {
+			addrs = append(addrs, granter)
+		}
+	}
+
+	for _, addr := range addrs {
+		blocked, err := dld.denyList.IsBlocked(ctx, addr)
+		if err != nil {
+			return ctx, err
+		}
+
+		if blocked {
+			return ctx, errorsmod.Wrap(types.ErrAddressBlocked, sdk.AccAddress(addr).String())
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
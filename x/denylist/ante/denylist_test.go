@@ -0,0 +1,72 @@
+package ante_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/denylist/ante"
+	"cosmossdk.io/x/denylist/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type denyList [][]byte
+
+func (dl denyList) IsBlocked(_ context.Context, addr []byte) (bool, error) {
+	for _, blocked := range dl {
+		if bytes.Equal(blocked, addr) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type mockTx struct {
+	signers [][]byte
+	payer   []byte
+	granter []byte
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg                    { return nil }
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockTx) GetSigners() ([][]byte, error)         { return tx.signers, nil }
+func (tx mockTx) GetGas() uint64                        { return 0 }
+func (tx mockTx) GetFee() sdk.Coins                     { return nil }
+func (tx mockTx) FeePayer() []byte                      { return tx.payer }
+func (tx mockTx) FeeGranter() []byte                    { return tx.granter }
+
+func TestDenyListDecorator(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	alice, bob, carol := []byte("alice"), []byte("bob"), []byte("carol")
+	decorator := ante.NewDenyListDecorator(denyList{carol})
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	testCases := map[string]struct {
+		tx      mockTx
+		blocked bool
+	}{
+		"no blocked address": {tx: mockTx{signers: [][]byte{alice}, payer: alice}},
+		"blocked signer":     {tx: mockTx{signers: [][]byte{alice, carol}, payer: alice}, blocked: true},
+		"blocked fee payer":  {tx: mockTx{signers: [][]byte{alice}, payer: carol}, blocked: true},
+		"blocked granter":    {tx: mockTx{signers: [][]byte{alice}, payer: bob, granter: carol}, blocked: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, tc.tx, false, next)
+			if tc.blocked {
+				require.ErrorIs(t, err, types.ErrAddressBlocked)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package denylist
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	denylistv1 "cosmossdk.io/api/cosmos/denylist/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: denylistv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "IsBlocked",
+					Use:            "is-blocked [address]",
+					Short:          "Query whether an address is on the deny-list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "BlockedAddresses",
+					Use:       "blocked-addresses",
+					Short:     "Query the addresses on the deny-list",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: denylistv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "BlockAddresses",
+					Use:            "block-proposal [addresses]",
+					Short:          "Submit a proposal to add addresses to the deny-list",
+					Example:        fmt.Sprintf(`%s tx denylist block-proposal cosmos1... cosmos1... --deposit 10000000stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UnblockAddresses",
+					Use:            "unblock-proposal [addresses]",
+					Short:          "Submit a proposal to remove addresses from the deny-list",
+					Example:        fmt.Sprintf(`%s tx denylist unblock-proposal cosmos1... --deposit 10000000stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
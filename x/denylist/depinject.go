@@ -0,0 +1,67 @@
+package denylist
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/denylist/module/v1"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/denylist/keeper"
+	"cosmossdk.io/x/denylist/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(
+		&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeAppendSendRestriction),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Environment  appmodule.Environment
+	Cdc          codec.Codec
+	AddressCodec address.Codec
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	DenyListKeeper keeper.Keeper
+	Module         appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	as, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.AddressCodec, as)
+	m := NewAppModule(in.Cdc, k)
+
+	return ModuleOutputs{DenyListKeeper: k, Module: m}
+}
+
+// InvokeAppendSendRestriction rejects the bank sends from or to an address on
+// the deny-list.
+func InvokeAppendSendRestriction(k keeper.Keeper, bk types.BankKeeper) {
+	bk.AppendSendRestriction(k.SendRestriction)
+}
@@ -0,0 +1,49 @@
+package denylist_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/denylist"
+	"cosmossdk.io/x/denylist/keeper"
+	denylisttestutil "cosmossdk.io/x/denylist/testutil"
+	"cosmossdk.io/x/denylist/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestInvokeAppendSendRestriction(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, denylist.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	k := keeper.NewKeeper(encCfg.Codec, env, address.NewBech32Codec("cosmos"), "authority")
+
+	var restriction banktypes.SendRestrictionFn
+	bankKeeper := denylisttestutil.NewMockBankKeeper(gomock.NewController(t))
+	bankKeeper.EXPECT().AppendSendRestriction(gomock.Any()).Do(func(fn banktypes.SendRestrictionFn) {
+		restriction = fn
+	})
+	denylist.InvokeAppendSendRestriction(k, bankKeeper)
+	require.NotNil(t, restriction)
+
+	blocked, other := sdk.AccAddress("blocked"), sdk.AccAddress("other")
+	require.NoError(t, k.BlockedAddresses.Set(ctx, blocked))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+
+	_, err := restriction(ctx, blocked, other, coins)
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	to, err := restriction(ctx, other, other, coins)
+	require.NoError(t, err)
+	require.Equal(t, other, to)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/denylist/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the denylist module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, addrStr := range data.BlockedAddresses {
+		addr, err := k.addressCodec.StringToBytes(addrStr)
+		if err != nil {
+			return err
+		}
+		if err := k.BlockedAddresses.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the denylist module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var blocked []string
+	err := k.BlockedAddresses.Walk(ctx, nil, func(addr sdk.AccAddress) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		blocked = append(blocked, addrStr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(blocked), nil
+}
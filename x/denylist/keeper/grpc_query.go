@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/denylist/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/denylist QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// IsBlocked returns whether an address is on the deny-list.
+func (q queryServer) IsBlocked(ctx context.Context, req *types.QueryIsBlockedRequest) (*types.QueryIsBlockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	blocked, err := q.k.IsBlocked(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIsBlockedResponse{Blocked: blocked}, nil
+}
+
+// BlockedAddresses returns the addresses on the deny-list.
+func (q queryServer) BlockedAddresses(ctx context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.BlockedAddresses,
+		req.Pagination,
+		func(addr sdk.AccAddress, _ collections.NoValue) (string, error) {
+			return q.k.addressCodec.BytesToString(addr)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockedAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
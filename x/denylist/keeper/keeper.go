@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/denylist/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the denylist store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	addressCodec address.Codec
+	// the address capable of updating the deny-list. Typically, this should be
+	// the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	// BlockedAddresses contains the addresses on the deny-list
+	BlockedAddresses collections.KeySet[sdk.AccAddress]
+}
+
+// NewKeeper creates a new denylist Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, addressCodec address.Codec, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:              cdc,
+		environment:      env,
+		addressCodec:     addressCodec,
+		authority:        authority,
+		BlockedAddresses: collections.NewKeySet(sb, types.BlockedAddressesPrefix, "blocked_addresses", sdk.AccAddressKey),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/denylist module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// IsBlocked returns whether an address is on the deny-list.
+func (k Keeper) IsBlocked(ctx context.Context, addr []byte) (bool, error) {
+	return k.BlockedAddresses.Has(ctx, addr)
+}
+
+// SendRestriction is a bank send restriction rejecting the sends from or to
+// an address on the deny-list.
+func (k Keeper) SendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+		blocked, err := k.IsBlocked(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			addrStr, err := k.addressCodec.BytesToString(addr)
+			if err != nil {
+				return nil, err
+			}
+			return nil, errorsmod.Wrap(types.ErrAddressBlocked, addrStr)
+		}
+	}
+
+	return toAddr, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/denylist"
+	"cosmossdk.io/x/denylist/keeper"
+	"cosmossdk.io/x/denylist/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+
+	addrs    []sdk.AccAddress
+	addrStrs []string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, denylist.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ac := address.NewBech32Codec("cosmos")
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, ac, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	s.addrs, s.addrStrs = nil, nil
+	for _, name := range []string{"alice", "bob", "carol"} {
+		addr := sdk.AccAddress(name)
+		addrStr, err := ac.BytesToString(addr)
+		s.Require().NoError(err)
+		s.addrs = append(s.addrs, addr)
+		s.addrStrs = append(s.addrStrs, addrStr)
+	}
+}
+
+func (s *KeeperTestSuite) TestBlockUnblockAddresses() {
+	_, err := s.msgServer.BlockAddresses(s.ctx, &types.MsgBlockAddresses{Authority: s.addrStrs[0], Addresses: s.addrStrs[1:]})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.BlockAddresses(s.ctx, &types.MsgBlockAddresses{Authority: govModuleNameStr})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.BlockAddresses(s.ctx, &types.MsgBlockAddresses{Authority: govModuleNameStr, Addresses: s.addrStrs[1:]})
+	s.Require().NoError(err)
+
+	for i, expected := range []bool{false, true, true} {
+		res, err := s.queryServer.IsBlocked(s.ctx, &types.QueryIsBlockedRequest{Address: s.addrStrs[i]})
+		s.Require().NoError(err)
+		s.Require().Equal(expected, res.Blocked)
+	}
+
+	res, err := s.queryServer.BlockedAddresses(s.ctx, &types.QueryBlockedAddressesRequest{Pagination: &query.PageRequest{Limit: 1}})
+	s.Require().NoError(err)
+	s.Require().Len(res.Addresses, 1)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	_, err = s.msgServer.UnblockAddresses(s.ctx, &types.MsgUnblockAddresses{Authority: govModuleNameStr, Addresses: s.addrStrs[1:2]})
+	s.Require().NoError(err)
+
+	blocked, err := s.keeper.IsBlocked(s.ctx, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().False(blocked)
+
+	var events []string
+	for _, e := range s.ctx.EventManager().Events() {
+		events = append(events, e.Type)
+	}
+	s.Require().Equal([]string{types.EventTypeBlockAddress, types.EventTypeBlockAddress, types.EventTypeUnblockAddress}, events)
+}
+
+func (s *KeeperTestSuite) TestSendRestriction() {
+	s.Require().NoError(s.keeper.BlockedAddresses.Set(s.ctx, s.addrs[1]))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+
+	to, err := s.keeper.SendRestriction(s.ctx, s.addrs[0], s.addrs[2], coins)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], to)
+
+	_, err = s.keeper.SendRestriction(s.ctx, s.addrs[1], s.addrs[2], coins)
+	s.Require().ErrorIs(err, types.ErrAddressBlocked)
+
+	_, err = s.keeper.SendRestriction(s.ctx, s.addrs[0], s.addrs[1], coins)
+	s.Require().ErrorIs(err, types.ErrAddressBlocked)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := types.NewGenesisState(s.addrStrs[:2])
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(gs.BlockedAddresses, exported.BlockedAddresses)
+
+	s.Require().Error(types.NewGenesisState([]string{s.addrStrs[0], s.addrStrs[0]}).Validate())
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/denylist/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/denylist MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// BlockAddresses adds addresses to the deny-list.
+func (ms msgServer) BlockAddresses(ctx context.Context, msg *types.MsgBlockAddresses) (*types.MsgBlockAddressesResponse, error) {
+	if err := ms.checkAuthority(msg.Authority, msg.Addresses); err != nil {
+		return nil, err
+	}
+
+	for _, addrStr := range msg.Addresses {
+		addr, err := ms.addressCodec.StringToBytes(addrStr)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addrStr, err)
+		}
+
+		if err := ms.BlockedAddresses.Set(ctx, addr); err != nil {
+			return nil, err
+		}
+
+		if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeBlockAddress,
+			event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBlockAddressesResponse{}, nil
+}
+
+// UnblockAddresses removes addresses from the deny-list.
+func (ms msgServer) UnblockAddresses(ctx context.Context, msg *types.MsgUnblockAddresses) (*types.MsgUnblockAddressesResponse, error) {
+	if err := ms.checkAuthority(msg.Authority, msg.Addresses); err != nil {
+		return nil, err
+	}
+
+	for _, addrStr := range msg.Addresses {
+		addr, err := ms.addressCodec.StringToBytes(addrStr)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addrStr, err)
+		}
+
+		if err := ms.BlockedAddresses.Remove(ctx, addr); err != nil {
+			return nil, err
+		}
+
+		if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeUnblockAddress,
+			event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUnblockAddressesResponse{}, nil
+}
+
+func (ms msgServer) checkAuthority(authority string, addresses []string) error {
+	if ms.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, authority)
+	}
+
+	if len(addresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no address provided")
+	}
+
+	return nil
+}
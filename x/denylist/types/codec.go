@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgBlockAddresses{}, "cosmos-sdk/x/denylist/MsgBlock")
+	legacy.RegisterAminoMsg(cdc, &MsgUnblockAddresses{}, "cosmos-sdk/x/denylist/MsgUnblock")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgBlockAddresses{},
+		&MsgUnblockAddresses{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
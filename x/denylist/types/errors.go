@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/denylist module sentinel errors
+var (
+	ErrInvalidSigner  = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrAddressBlocked = errors.Register(ModuleName, 2, "address is on the deny-list")
+)
@@ -0,0 +1,9 @@
+package types
+
+// denylist module event types
+const (
+	EventTypeBlockAddress   = "block_address"
+	EventTypeUnblockAddress = "unblock_address"
+
+	AttributeKeyAddress = "address"
+)
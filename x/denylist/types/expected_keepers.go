@@ -0,0 +1,9 @@
+package types
+
+import banktypes "cosmossdk.io/x/bank/types"
+
+// BankKeeper defines the expected bank keeper, to which the deny-list send
+// restriction is appended.
+type BankKeeper interface {
+	AppendSendRestriction(restriction banktypes.SendRestrictionFn)
+}
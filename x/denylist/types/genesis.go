@@ -0,0 +1,29 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(blockedAddresses []string) *GenesisState {
+	return &GenesisState{BlockedAddresses: blockedAddresses}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.BlockedAddresses))
+	for _, addr := range gs.BlockedAddresses {
+		if addr == "" {
+			return fmt.Errorf("blocked address cannot be empty")
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate blocked address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	return nil
+}
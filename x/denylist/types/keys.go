@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "denylist"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	BlockedAddressesPrefix = collections.NewPrefix(0)
+)
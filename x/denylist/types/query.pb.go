@@ -0,0 +1,1042 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/denylist/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryIsBlockedRequest is the request type for the Query/IsBlocked RPC method.
+type QueryIsBlockedRequest struct {
+	// address is the address to check.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryIsBlockedRequest) Reset()         { *m = QueryIsBlockedRequest{} }
+func (m *QueryIsBlockedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsBlockedRequest) ProtoMessage()    {}
+func (*QueryIsBlockedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d805f488040f96ac, []int{0}
+}
+func (m *QueryIsBlockedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsBlockedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsBlockedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsBlockedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsBlockedRequest.Merge(m, src)
+}
+func (m *QueryIsBlockedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsBlockedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsBlockedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsBlockedRequest proto.InternalMessageInfo
+
+func (m *QueryIsBlockedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIsBlockedResponse is the response type for the Query/IsBlocked RPC method.
+type QueryIsBlockedResponse struct {
+	// blocked is whether the address is on the deny-list.
+	Blocked bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (m *QueryIsBlockedResponse) Reset()         { *m = QueryIsBlockedResponse{} }
+func (m *QueryIsBlockedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsBlockedResponse) ProtoMessage()    {}
+func (*QueryIsBlockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d805f488040f96ac, []int{1}
+}
+func (m *QueryIsBlockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsBlockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsBlockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsBlockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsBlockedResponse.Merge(m, src)
+}
+func (m *QueryIsBlockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsBlockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsBlockedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsBlockedResponse proto.InternalMessageInfo
+
+func (m *QueryIsBlockedResponse) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+// QueryBlockedAddressesRequest is the request type for the Query/BlockedAddresses RPC method.
+type QueryBlockedAddressesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesRequest) Reset()         { *m = QueryBlockedAddressesRequest{} }
+func (m *QueryBlockedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d805f488040f96ac, []int{2}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedAddressesResponse is the response type for the Query/BlockedAddresses RPC method.
+type QueryBlockedAddressesResponse struct {
+	// addresses are the addresses on the deny-list.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesResponse) Reset()         { *m = QueryBlockedAddressesResponse{} }
+func (m *QueryBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d805f488040f96ac, []int{3}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryBlockedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryIsBlockedRequest)(nil), "cosmos.denylist.v1.QueryIsBlockedRequest")
+	proto.RegisterType((*QueryIsBlockedResponse)(nil), "cosmos.denylist.v1.QueryIsBlockedResponse")
+	proto.RegisterType((*QueryBlockedAddressesRequest)(nil), "cosmos.denylist.v1.QueryBlockedAddressesRequest")
+	proto.RegisterType((*QueryBlockedAddressesResponse)(nil), "cosmos.denylist.v1.QueryBlockedAddressesResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/denylist/v1/query.proto", fileDescriptor_d805f488040f96ac) }
+
+var fileDescriptor_d805f488040f96ac = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xb1, 0xae, 0xd3, 0x30,
+	0x14, 0x86, 0xeb, 0x22, 0x28, 0x35, 0x0b, 0xb2, 0x00, 0x85, 0xb4, 0x8d, 0xaa, 0x48, 0xd0, 0x52,
+	0x09, 0x9b, 0x04, 0x09, 0x66, 0x3a, 0x80, 0x10, 0x0b, 0x84, 0x8d, 0x05, 0x39, 0x8d, 0x89, 0xa2,
+	0x96, 0x38, 0x8d, 0xdd, 0x8a, 0x0a, 0xb1, 0x30, 0x31, 0x22, 0x21, 0x31, 0xc3, 0x1b, 0x30, 0xf0,
+	0x08, 0x0c, 0x8c, 0x15, 0x2c, 0x8c, 0xa8, 0x45, 0xe2, 0x35, 0x50, 0x6d, 0xa7, 0x29, 0xbd, 0x6d,
+	0xef, 0xbd, 0x63, 0x7c, 0xfe, 0xff, 0xfc, 0xdf, 0xf1, 0x71, 0xa0, 0x33, 0xe0, 0xe2, 0x25, 0x17,
+	0x24, 0x62, 0xe9, 0x6c, 0x94, 0x08, 0x49, 0xa6, 0x1e, 0x19, 0x4f, 0x58, 0x3e, 0xc3, 0x59, 0xce,
+	0x25, 0x47, 0x48, 0xd7, 0x71, 0x51, 0xc7, 0x53, 0xcf, 0xbe, 0xaa, 0xcf, 0x9e, 0x2b, 0x05, 0x31,
+	0x02, 0xf5, 0x61, 0x37, 0x63, 0xce, 0xe3, 0x11, 0x23, 0x34, 0x4b, 0x08, 0x4d, 0x53, 0x2e, 0xa9,
+	0x4c, 0x78, 0x5a, 0x54, 0x1b, 0x26, 0x4c, 0x05, 0x6c, 0x25, 0xd9, 0x3d, 0x53, 0x0c, 0xa9, 0x60,
+	0x6b, 0x45, 0xc8, 0x24, 0xf5, 0x48, 0x46, 0xe3, 0x24, 0x55, 0x9d, 0xb4, 0xd6, 0x7d, 0x04, 0x2f,
+	0x3f, 0x59, 0x29, 0x1e, 0x8a, 0xfe, 0x88, 0x0f, 0x86, 0x2c, 0x0a, 0xd8, 0x78, 0xc2, 0x84, 0x44,
+	0x3e, 0xac, 0xd1, 0x28, 0xca, 0x99, 0x10, 0x16, 0x68, 0x83, 0x6e, 0xbd, 0x6f, 0xfd, 0xf8, 0x7a,
+	0xf3, 0x92, 0x41, 0xbc, 0xa7, 0x2b, 0x4f, 0x65, 0x9e, 0xa4, 0x71, 0x50, 0x08, 0x5d, 0x1f, 0x5e,
+	0xd9, 0x6e, 0x26, 0x32, 0x9e, 0x0a, 0x86, 0x2c, 0x58, 0x0b, 0xf5, 0x91, 0xea, 0x76, 0x3e, 0x28,
+	0x3e, 0xdd, 0x17, 0xb0, 0xa9, 0x3c, 0xc6, 0x61, 0x3a, 0x33, 0x51, 0x70, 0xdc, 0x87, 0xb0, 0x84,
+	0x56, 0xe6, 0x0b, 0xfe, 0x75, 0x6c, 0x38, 0x56, 0x13, 0x62, 0x3d, 0xba, 0x99, 0x10, 0x3f, 0xa6,
+	0x31, 0x33, 0xde, 0x60, 0xc3, 0xe9, 0x7e, 0x02, 0xb0, 0xb5, 0x27, 0xc8, 0x30, 0xde, 0x81, 0x75,
+	0x5a, 0x1c, 0x5a, 0xa0, 0x7d, 0xe6, 0xe0, 0xcc, 0xa5, 0x14, 0x3d, 0xf8, 0x8f, 0xb0, 0xaa, 0x08,
+	0x3b, 0xc7, 0x12, 0xea, 0xd0, 0x4d, 0x44, 0xff, 0x5b, 0x15, 0x9e, 0x55, 0x88, 0xe8, 0x23, 0x80,
+	0xf5, 0xf5, 0x25, 0xa2, 0x1b, 0xf8, 0xe8, 0xd3, 0xc1, 0x3b, 0xb7, 0x66, 0xf7, 0x4e, 0x22, 0xd5,
+	0xd1, 0xae, 0xff, 0xee, 0xef, 0x97, 0x1e, 0x78, 0xfb, 0xf3, 0xcf, 0x87, 0x6a, 0x07, 0x5d, 0x23,
+	0x3b, 0x9e, 0xaf, 0xd9, 0x11, 0x79, 0x6d, 0x86, 0x7d, 0x83, 0x3e, 0x03, 0x78, 0x71, 0xfb, 0x02,
+	0xd1, 0xad, 0xbd, 0xa1, 0x7b, 0x96, 0x6a, 0x7b, 0xa7, 0x70, 0x18, 0xda, 0x6e, 0x49, 0xdb, 0x42,
+	0x8d, 0x03, 0xb4, 0xfd, 0xbb, 0xdf, 0x17, 0x0e, 0x98, 0x2f, 0x1c, 0xf0, 0x7b, 0xe1, 0x80, 0xf7,
+	0x4b, 0xa7, 0x32, 0x5f, 0x3a, 0x95, 0x5f, 0x4b, 0xa7, 0xf2, 0xac, 0xa5, 0x5d, 0x22, 0x1a, 0xe2,
+	0x84, 0x93, 0x57, 0xa5, 0x5b, 0xce, 0x32, 0x26, 0xc2, 0x73, 0xea, 0x97, 0xb8, 0xfd, 0x6f, 0x00,
+	0x5d, 0x67, 0x49, 0x3e, 0xca, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// IsBlocked returns whether an address is on the deny-list.
+	IsBlocked(ctx context.Context, in *QueryIsBlockedRequest, opts ...grpc.CallOption) (*QueryIsBlockedResponse, error)
+	// BlockedAddresses returns the addresses on the deny-list.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) IsBlocked(ctx context.Context, in *QueryIsBlockedRequest, opts ...grpc.CallOption) (*QueryIsBlockedResponse, error) {
+	out := new(QueryIsBlockedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.denylist.v1.Query/IsBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.denylist.v1.Query/BlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// IsBlocked returns whether an address is on the deny-list.
+	IsBlocked(context.Context, *QueryIsBlockedRequest) (*QueryIsBlockedResponse, error)
+	// BlockedAddresses returns the addresses on the deny-list.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) IsBlocked(ctx context.Context, req *QueryIsBlockedRequest) (*QueryIsBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAddresses(ctx context.Context, req *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.denylist.v1.Query/IsBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsBlocked(ctx, req.(*QueryIsBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.denylist.v1.Query/BlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.denylist.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsBlocked",
+			Handler:    _Query_IsBlocked_Handler,
+		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/denylist/v1/query.proto",
+}
+
+func (m *QueryIsBlockedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsBlockedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsBlockedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsBlockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsBlockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsBlockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIsBlockedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsBlockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIsBlockedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsBlockedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsBlockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsBlockedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsBlockedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsBlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
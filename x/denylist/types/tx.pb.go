@@ -0,0 +1,985 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/denylist/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgBlockAddresses is the Msg/BlockAddresses request type.
+type MsgBlockAddresses struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses are the addresses to block.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgBlockAddresses) Reset()         { *m = MsgBlockAddresses{} }
+func (m *MsgBlockAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockAddresses) ProtoMessage()    {}
+func (*MsgBlockAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e6e65d89804fac1, []int{0}
+}
+func (m *MsgBlockAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBlockAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockAddresses.Merge(m, src)
+}
+func (m *MsgBlockAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlockAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlockAddresses proto.InternalMessageInfo
+
+func (m *MsgBlockAddresses) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgBlockAddresses) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgBlockAddressesResponse is the Msg/BlockAddresses response type.
+type MsgBlockAddressesResponse struct {
+}
+
+func (m *MsgBlockAddressesResponse) Reset()         { *m = MsgBlockAddressesResponse{} }
+func (m *MsgBlockAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockAddressesResponse) ProtoMessage()    {}
+func (*MsgBlockAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e6e65d89804fac1, []int{1}
+}
+func (m *MsgBlockAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBlockAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockAddressesResponse.Merge(m, src)
+}
+func (m *MsgBlockAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlockAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlockAddressesResponse proto.InternalMessageInfo
+
+// MsgUnblockAddresses is the Msg/UnblockAddresses request type.
+type MsgUnblockAddresses struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses are the addresses to unblock.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgUnblockAddresses) Reset()         { *m = MsgUnblockAddresses{} }
+func (m *MsgUnblockAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockAddresses) ProtoMessage()    {}
+func (*MsgUnblockAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e6e65d89804fac1, []int{2}
+}
+func (m *MsgUnblockAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnblockAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockAddresses.Merge(m, src)
+}
+func (m *MsgUnblockAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnblockAddresses proto.InternalMessageInfo
+
+func (m *MsgUnblockAddresses) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnblockAddresses) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgUnblockAddressesResponse is the Msg/UnblockAddresses response type.
+type MsgUnblockAddressesResponse struct {
+}
+
+func (m *MsgUnblockAddressesResponse) Reset()         { *m = MsgUnblockAddressesResponse{} }
+func (m *MsgUnblockAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockAddressesResponse) ProtoMessage()    {}
+func (*MsgUnblockAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e6e65d89804fac1, []int{3}
+}
+func (m *MsgUnblockAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnblockAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockAddressesResponse.Merge(m, src)
+}
+func (m *MsgUnblockAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnblockAddressesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgBlockAddresses)(nil), "cosmos.denylist.v1.MsgBlockAddresses")
+	proto.RegisterType((*MsgBlockAddressesResponse)(nil), "cosmos.denylist.v1.MsgBlockAddressesResponse")
+	proto.RegisterType((*MsgUnblockAddresses)(nil), "cosmos.denylist.v1.MsgUnblockAddresses")
+	proto.RegisterType((*MsgUnblockAddressesResponse)(nil), "cosmos.denylist.v1.MsgUnblockAddressesResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/denylist/v1/tx.proto", fileDescriptor_9e6e65d89804fac1) }
+
+var fileDescriptor_9e6e65d89804fac1 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x7b, 0x16, 0x85, 0xde, 0x20, 0x36, 0x0a, 0xb6, 0x29, 0x3d, 0x4a, 0x40, 0x94, 0x42,
+	0x73, 0xd4, 0x82, 0x82, 0x9b, 0xdd, 0xbb, 0x54, 0x5c, 0x5c, 0xa4, 0x6d, 0xce, 0x33, 0xb4, 0xc9,
+	0x95, 0xbc, 0xb3, 0xb4, 0x9b, 0x38, 0x3a, 0xf9, 0x51, 0x3a, 0x88, 0x83, 0x9f, 0xc0, 0xb1, 0x38,
+	0x39, 0x4a, 0x3a, 0xf4, 0x6b, 0x88, 0xc9, 0x1d, 0x91, 0xc6, 0x62, 0x37, 0x97, 0x40, 0xee, 0xfd,
+	0xde, 0xff, 0xbd, 0xff, 0xff, 0x0e, 0x97, 0x7a, 0x02, 0x3c, 0x01, 0xd4, 0x61, 0xfe, 0x64, 0xe0,
+	0x82, 0xa4, 0xa3, 0x3a, 0x95, 0x63, 0x7b, 0x18, 0x08, 0x29, 0x0c, 0x23, 0x2e, 0xda, 0xba, 0x68,
+	0x8f, 0xea, 0x66, 0x31, 0x3e, 0xbb, 0x8e, 0x08, 0xaa, 0x80, 0xe8, 0xc7, 0xdc, 0x57, 0x5a, 0x1e,
+	0xf0, 0x6f, 0x19, 0x0f, 0xb8, 0x2a, 0xe4, 0x3b, 0x9e, 0xeb, 0x0b, 0x1a, 0x7d, 0xe3, 0x23, 0xeb,
+	0x05, 0xe1, 0x7c, 0x0b, 0x78, 0x73, 0x20, 0x7a, 0xfd, 0x73, 0xc7, 0x09, 0x18, 0x00, 0x03, 0xe3,
+	0x04, 0xe7, 0x3a, 0x77, 0xf2, 0x56, 0x04, 0xae, 0x9c, 0x14, 0x50, 0x05, 0x1d, 0xe5, 0x9a, 0x85,
+	0xf7, 0xe7, 0xda, 0x9e, 0x1a, 0xa3, 0xc0, 0x0b, 0x19, 0xb8, 0x3e, 0x6f, 0x27, 0x68, 0xd4, 0xa7,
+	0x45, 0x0a, 0x1b, 0x95, 0xec, 0x1f, 0x7d, 0x1a, 0x3d, 0xab, 0x3f, 0x2c, 0xa6, 0xd5, 0x44, 0xe7,
+	0x71, 0x31, 0xad, 0x92, 0xb8, 0xa7, 0x06, 0x4e, 0x9f, 0x8e, 0x93, 0x58, 0xf4, 0xae, 0x56, 0x09,
+	0x17, 0x53, 0x7b, 0xb7, 0x19, 0x0c, 0x85, 0x0f, 0xcc, 0x7a, 0x45, 0x78, 0xb7, 0x05, 0xfc, 0xd2,
+	0xef, 0xfe, 0xaf, 0xaf, 0x46, 0xda, 0x57, 0x65, 0xa5, 0x2f, 0xb5, 0xad, 0x55, 0xc6, 0xa5, 0x5f,
+	0x76, 0xd7, 0xde, 0x8e, 0x43, 0x84, 0xb3, 0x2d, 0xe0, 0xc6, 0x0d, 0xde, 0x5e, 0xba, 0xb5, 0x03,
+	0x3b, 0xfd, 0x4e, 0xec, 0x54, 0x48, 0x66, 0x6d, 0x2d, 0x4c, 0xcf, 0x33, 0x06, 0x78, 0x27, 0x95,
+	0xe3, 0xe1, 0x0a, 0x89, 0x65, 0xd0, 0xa4, 0x6b, 0x82, 0x7a, 0x9a, 0xb9, 0x79, 0xbf, 0x98, 0x56,
+	0x51, 0xf3, 0xf4, 0x2d, 0x24, 0x68, 0x16, 0x12, 0xf4, 0x19, 0x12, 0xf4, 0x34, 0x27, 0x99, 0xd9,
+	0x9c, 0x64, 0x3e, 0xe6, 0x24, 0x73, 0x55, 0x8e, 0x05, 0xc1, 0xe9, 0xdb, 0xae, 0xf8, 0x99, 0xa0,
+	0x9c, 0x0c, 0x19, 0x74, 0xb7, 0xa2, 0x67, 0xdd, 0xf8, 0x1a, 0x00, 0x2b, 0x24, 0xbf, 0x5b, 0x50,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// BlockAddresses adds addresses to the deny-list. The authority defaults to
+	// the x/gov module account.
+	BlockAddresses(ctx context.Context, in *MsgBlockAddresses, opts ...grpc.CallOption) (*MsgBlockAddressesResponse, error)
+	// UnblockAddresses removes addresses from the deny-list. The authority
+	// defaults to the x/gov module account.
+	UnblockAddresses(ctx context.Context, in *MsgUnblockAddresses, opts ...grpc.CallOption) (*MsgUnblockAddressesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) BlockAddresses(ctx context.Context, in *MsgBlockAddresses, opts ...grpc.CallOption) (*MsgBlockAddressesResponse, error) {
+	out := new(MsgBlockAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.denylist.v1.Msg/BlockAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblockAddresses(ctx context.Context, in *MsgUnblockAddresses, opts ...grpc.CallOption) (*MsgUnblockAddressesResponse, error) {
+	out := new(MsgUnblockAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.denylist.v1.Msg/UnblockAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// BlockAddresses adds addresses to the deny-list. The authority defaults to
+	// the x/gov module account.
+	BlockAddresses(context.Context, *MsgBlockAddresses) (*MsgBlockAddressesResponse, error)
+	// UnblockAddresses removes addresses from the deny-list. The authority
+	// defaults to the x/gov module account.
+	UnblockAddresses(context.Context, *MsgUnblockAddresses) (*MsgUnblockAddressesResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) BlockAddresses(ctx context.Context, req *MsgBlockAddresses) (*MsgBlockAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAddresses not implemented")
+}
+func (*UnimplementedMsgServer) UnblockAddresses(ctx context.Context, req *MsgUnblockAddresses) (*MsgUnblockAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAddresses not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_BlockAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.denylist.v1.Msg/BlockAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockAddresses(ctx, req.(*MsgBlockAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.denylist.v1.Msg/UnblockAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockAddresses(ctx, req.(*MsgUnblockAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.denylist.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockAddresses",
+			Handler:    _Msg_BlockAddresses_Handler,
+		},
+		{
+			MethodName: "UnblockAddresses",
+			Handler:    _Msg_UnblockAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/denylist/v1/tx.proto",
+}
+
+func (m *MsgBlockAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlockAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlockAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlockAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBlockAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBlockAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnblockAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnblockAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBlockAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBlockAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBlockAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBlockAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBlockAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBlockAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnblockAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnblockAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnblockAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnblockAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnblockAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnblockAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,62 @@
+package did
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	didv1 "cosmossdk.io/api/cosmos/did/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: didv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "DIDDocument",
+					Use:            "resolve [id]",
+					Short:          "Resolve a DID to its document",
+					Example:        fmt.Sprintf("%s query did resolve did:cosmos:alice", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "DIDsByController",
+					Use:            "dids-by-controller [controller]",
+					Short:          "Query the DID documents of a controller",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "controller"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: didv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateDID",
+					Use:       "create [id] --from [signer]",
+					Short:     "Register a DID document, controlled by the signer unless controllers are given",
+					Example: fmt.Sprintf(`%s tx did create did:cosmos:alice --verification-methods '{"id":"did:cosmos:alice#key-1","type":"Ed25519VerificationKey2020","controller":"did:cosmos:alice","public_key_multibase":"z6Mk..."}' --services '{"id":"did:cosmos:alice#hub","type":"LinkedDomains","service_endpoint":"https://alice.example"}' --from mykey`,
+						version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "UpdateDID",
+					Use:            "update [id] --controllers [controller] --from [signer]",
+					Short:          "Replace the controllers, verification methods and services of a DID document",
+					Long:           "Replace the controllers, verification methods and services of a DID document. Note: the entire document must be provided, the fields which are not given are cleared.",
+					Example:        fmt.Sprintf(`%s tx did update did:cosmos:alice --controllers cosmos1... --services '{"id":"did:cosmos:alice#hub","type":"LinkedDomains","service_endpoint":"https://alice.example"}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "DeactivateDID",
+					Use:            "deactivate [id] --from [signer]",
+					Short:          "Deactivate a DID document for good",
+					Example:        fmt.Sprintf("%s tx did deactivate did:cosmos:alice --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+			},
+		},
+	}
+}
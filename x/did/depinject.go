@@ -0,0 +1,48 @@
+package did
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/did/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/x/did/keeper"
+	"cosmossdk.io/x/did/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(
+		&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config      *modulev1.Module
+	Environment appmodule.Environment
+	Cdc         codec.Codec
+
+	AccountKeeper types.AccountKeeper
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	DIDKeeper keeper.Keeper
+	Module    appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.AccountKeeper)
+	m := NewAppModule(in.Cdc, k)
+
+	return ModuleOutputs{DIDKeeper: k, Module: m}
+}
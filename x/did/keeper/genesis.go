@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/did/types"
+)
+
+// InitGenesis initializes the did module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, document := range data.Documents {
+		if err := k.setDocument(ctx, document); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the did module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var documents []types.DIDDocument
+	err := k.Documents.Walk(ctx, nil, func(_ string, document types.DIDDocument) (bool, error) {
+		documents = append(documents, document)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(documents), nil
+}
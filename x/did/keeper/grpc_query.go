@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/did/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/did QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// DIDDocument resolves a DID to its document.
+func (q queryServer) DIDDocument(ctx context.Context, req *types.QueryDIDDocumentRequest) (*types.QueryDIDDocumentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateDID(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	document, err := q.k.GetDocument(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrDIDNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryDIDDocumentResponse{Document: document}, nil
+}
+
+// DIDsByController returns the DID documents of a controller.
+func (q queryServer) DIDsByController(ctx context.Context, req *types.QueryDIDsByControllerRequest) (*types.QueryDIDsByControllerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	controller, err := q.k.addressCodec.StringToBytes(req.Controller)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid controller address: %s", err)
+	}
+
+	documents, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.DIDsByController,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, string], _ collections.NoValue) (types.DIDDocument, error) {
+			return q.k.Documents.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](controller),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDIDsByControllerResponse{Documents: documents, Pagination: pageRes}, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/did/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the did store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	addressCodec address.Codec
+
+	Schema collections.Schema
+	// Documents contains the DID documents, keyed by DID
+	Documents collections.Map[string, types.DIDDocument]
+	// DIDsByController indexes the DIDs by controller
+	DIDsByController collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+}
+
+// NewKeeper creates a new did Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:              cdc,
+		environment:      env,
+		addressCodec:     ak.AddressCodec(),
+		Documents:        collections.NewMap(sb, types.DocumentsPrefix, "documents", collections.StringKey, codec.CollValue[types.DIDDocument](cdc)),
+		DIDsByController: collections.NewKeySet(sb, types.DIDsByControllerPrefix, "dids_by_controller", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetDocument resolves a DID to its document, or returns ErrDIDNotFound.
+// Deactivated documents are returned as well.
+func (k Keeper) GetDocument(ctx context.Context, id string) (types.DIDDocument, error) {
+	document, err := k.Documents.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return document, errorsmod.Wrap(types.ErrDIDNotFound, id)
+	}
+
+	return document, err
+}
+
+// setDocument stores a document and indexes it by controller.
+func (k Keeper) setDocument(ctx context.Context, document types.DIDDocument) error {
+	if err := k.Documents.Set(ctx, document.Id, document); err != nil {
+		return err
+	}
+
+	for _, controller := range document.Controllers {
+		addr, err := k.addressCodec.StringToBytes(controller)
+		if err != nil {
+			return err
+		}
+		if err := k.DIDsByController.Set(ctx, collections.Join(sdk.AccAddress(addr), document.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unindexDocument removes a document from the index of its controllers.
+func (k Keeper) unindexDocument(ctx context.Context, document types.DIDDocument) error {
+	for _, controller := range document.Controllers {
+		addr, err := k.addressCodec.StringToBytes(controller)
+		if err != nil {
+			return err
+		}
+		if err := k.DIDsByController.Remove(ctx, collections.Join(sdk.AccAddress(addr), document.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/did"
+	"cosmossdk.io/x/did/keeper"
+	didtestutil "cosmossdk.io/x/did/testutil"
+	"cosmossdk.io/x/did/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const id = "did:cosmos:alice"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	now         time.Time
+
+	alice    sdk.AccAddress
+	aliceStr string
+	bobStr   string
+	method   types.VerificationMethod
+	service  types.Service
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, did.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.now})
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := didtestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	var err error
+	s.alice = sdk.AccAddress("alice")
+	s.aliceStr, err = ac.BytesToString(s.alice)
+	s.Require().NoError(err)
+	s.bobStr, err = ac.BytesToString(sdk.AccAddress("bob"))
+	s.Require().NoError(err)
+
+	s.method = types.VerificationMethod{
+		Id:                 id + "#key-1",
+		Type:               "Ed25519VerificationKey2020",
+		Controller:         id,
+		PublicKeyMultibase: "z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+	}
+	s.service = types.Service{Id: id + "#hub", Type: "LinkedDomains", ServiceEndpoint: "https://alice.example"}
+}
+
+func (s *KeeperTestSuite) createDID() {
+	_, err := s.msgServer.CreateDID(s.ctx, &types.MsgCreateDID{
+		Signer:              s.aliceStr,
+		Id:                  id,
+		VerificationMethods: []types.VerificationMethod{s.method},
+		Services:            []types.Service{s.service},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCreateDID() {
+	s.createDID()
+
+	res, err := s.queryServer.DIDDocument(s.ctx, &types.QueryDIDDocumentRequest{Id: id})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.aliceStr}, res.Document.Controllers)
+	s.Require().Equal([]types.VerificationMethod{s.method}, res.Document.VerificationMethods)
+	s.Require().Equal(s.now, res.Document.Created)
+	s.Require().False(res.Document.Deactivated)
+
+	_, err = s.msgServer.CreateDID(s.ctx, &types.MsgCreateDID{Signer: s.aliceStr, Id: id})
+	s.Require().ErrorIs(err, types.ErrDIDExists)
+
+	_, err = s.msgServer.CreateDID(s.ctx, &types.MsgCreateDID{Signer: s.aliceStr, Id: "did:other:alice"})
+	s.Require().ErrorIs(err, types.ErrInvalidDID)
+
+	// the signer must be a controller of the document
+	_, err = s.msgServer.CreateDID(s.ctx, &types.MsgCreateDID{Signer: s.aliceStr, Id: "did:cosmos:bob", Controllers: []string{s.bobStr}})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	method := s.method
+	method.Id = "did:cosmos:bob#key-1"
+	_, err = s.msgServer.CreateDID(s.ctx, &types.MsgCreateDID{Signer: s.aliceStr, Id: "did:cosmos:carol", VerificationMethods: []types.VerificationMethod{method}})
+	s.Require().ErrorIs(err, types.ErrInvalidDocument)
+}
+
+func (s *KeeperTestSuite) TestUpdateDID() {
+	s.createDID()
+
+	_, err := s.msgServer.UpdateDID(s.ctx, &types.MsgUpdateDID{Signer: s.bobStr, Id: id, Controllers: []string{s.bobStr}})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateDID(s.ctx, &types.MsgUpdateDID{Signer: s.aliceStr, Id: id})
+	s.Require().ErrorIs(err, types.ErrInvalidDocument)
+
+	// hand the document over to bob
+	later := s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(time.Hour)})
+	_, err = s.msgServer.UpdateDID(later, &types.MsgUpdateDID{Signer: s.aliceStr, Id: id, Controllers: []string{s.bobStr}, Services: []types.Service{s.service}})
+	s.Require().NoError(err)
+
+	document, err := s.keeper.GetDocument(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.bobStr}, document.Controllers)
+	s.Require().Empty(document.VerificationMethods)
+	s.Require().Equal(s.now, document.Created)
+	s.Require().Equal(s.now.Add(time.Hour), document.Updated)
+
+	has, err := s.keeper.DIDsByController.Has(s.ctx, collections.Join(s.alice, id))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	_, err = s.msgServer.UpdateDID(s.ctx, &types.MsgUpdateDID{Signer: s.aliceStr, Id: id, Controllers: []string{s.aliceStr}})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateDID(s.ctx, &types.MsgUpdateDID{Signer: s.aliceStr, Id: "did:cosmos:bob", Controllers: []string{s.aliceStr}})
+	s.Require().ErrorIs(err, types.ErrDIDNotFound)
+}
+
+func (s *KeeperTestSuite) TestDeactivateDID() {
+	s.createDID()
+
+	_, err := s.msgServer.DeactivateDID(s.ctx, &types.MsgDeactivateDID{Signer: s.bobStr, Id: id})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.DeactivateDID(s.ctx, &types.MsgDeactivateDID{Signer: s.aliceStr, Id: id})
+	s.Require().NoError(err)
+
+	// a deactivated document still resolves, but can no longer be updated
+	res, err := s.queryServer.DIDDocument(s.ctx, &types.QueryDIDDocumentRequest{Id: id})
+	s.Require().NoError(err)
+	s.Require().True(res.Document.Deactivated)
+
+	_, err = s.msgServer.UpdateDID(s.ctx, &types.MsgUpdateDID{Signer: s.aliceStr, Id: id, Controllers: []string{s.aliceStr}})
+	s.Require().ErrorIs(err, types.ErrDIDDeactivated)
+	_, err = s.msgServer.DeactivateDID(s.ctx, &types.MsgDeactivateDID{Signer: s.aliceStr, Id: id})
+	s.Require().ErrorIs(err, types.ErrDIDDeactivated)
+}
+
+func (s *KeeperTestSuite) TestDIDsByController() {
+	s.createDID()
+
+	_, err := s.msgServer.CreateDID(s.ctx, &types.MsgCreateDID{Signer: s.bobStr, Id: "did:cosmos:shared", Controllers: []string{s.aliceStr, s.bobStr}})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.DIDsByController(s.ctx, &types.QueryDIDsByControllerRequest{Controller: s.aliceStr})
+	s.Require().NoError(err)
+	s.Require().Len(res.Documents, 2)
+
+	res, err = s.queryServer.DIDsByController(s.ctx, &types.QueryDIDsByControllerRequest{Controller: s.bobStr})
+	s.Require().NoError(err)
+	s.Require().Len(res.Documents, 1)
+	s.Require().Equal("did:cosmos:shared", res.Documents[0].Id)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.createDID()
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.Documents, 1)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	has, err := s.keeper.DIDsByController.Has(s.ctx, collections.Join(s.alice, id))
+	s.Require().NoError(err)
+	s.Require().True(has)
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/did/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/did MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateDID registers a new DID document, controlled by the signer unless
+// other controllers, including the signer, are given.
+func (ms msgServer) CreateDID(ctx context.Context, msg *types.MsgCreateDID) (*types.MsgCreateDIDResponse, error) {
+	signer, err := ms.normalizeAddress(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	if err := types.ValidateDID(msg.Id); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDID, err.Error())
+	}
+
+	controllers := msg.Controllers
+	if len(controllers) == 0 {
+		controllers = []string{signer}
+	}
+
+	now := ms.environment.HeaderService.GetHeaderInfo(ctx).Time
+	document := types.DIDDocument{
+		Id:                  msg.Id,
+		VerificationMethods: msg.VerificationMethods,
+		Services:            msg.Services,
+		Created:             now,
+		Updated:             now,
+	}
+	if document.Controllers, err = ms.normalizeControllers(controllers); err != nil {
+		return nil, err
+	}
+	if err := document.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDocument, err.Error())
+	}
+	if !document.HasController(signer) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "signer %s must be a controller of %s", signer, msg.Id)
+	}
+
+	has, err := ms.Documents.Has(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrap(types.ErrDIDExists, msg.Id)
+	}
+
+	if err := ms.setDocument(ctx, document); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDID,
+		event.NewAttribute(types.AttributeKeyDID, msg.Id),
+		event.NewAttribute(types.AttributeKeySigner, signer),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDIDResponse{}, nil
+}
+
+// UpdateDID replaces the controllers, verification methods and services of an
+// active DID document.
+func (ms msgServer) UpdateDID(ctx context.Context, msg *types.MsgUpdateDID) (*types.MsgUpdateDIDResponse, error) {
+	previous, signer, err := ms.authorize(ctx, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	document := previous
+	document.VerificationMethods = msg.VerificationMethods
+	document.Services = msg.Services
+	document.Updated = ms.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if document.Controllers, err = ms.normalizeControllers(msg.Controllers); err != nil {
+		return nil, err
+	}
+	if err := document.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDocument, err.Error())
+	}
+
+	if err := ms.unindexDocument(ctx, previous); err != nil {
+		return nil, err
+	}
+	if err := ms.setDocument(ctx, document); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUpdateDID,
+		event.NewAttribute(types.AttributeKeyDID, msg.Id),
+		event.NewAttribute(types.AttributeKeySigner, signer),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDIDResponse{}, nil
+}
+
+// DeactivateDID deactivates an active DID document for good. The document
+// still resolves, flagged as deactivated.
+func (ms msgServer) DeactivateDID(ctx context.Context, msg *types.MsgDeactivateDID) (*types.MsgDeactivateDIDResponse, error) {
+	document, signer, err := ms.authorize(ctx, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	document.Deactivated = true
+	document.Updated = ms.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if err := ms.Documents.Set(ctx, document.Id, document); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDeactivateDID,
+		event.NewAttribute(types.AttributeKeyDID, msg.Id),
+		event.NewAttribute(types.AttributeKeySigner, signer),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeactivateDIDResponse{}, nil
+}
+
+// authorize returns the active document of a DID if the signer is one of its
+// controllers, along with the normalized address of the signer.
+func (ms msgServer) authorize(ctx context.Context, signerStr, id string) (types.DIDDocument, string, error) {
+	signer, err := ms.normalizeAddress(signerStr)
+	if err != nil {
+		return types.DIDDocument{}, "", sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	document, err := ms.GetDocument(ctx, id)
+	if err != nil {
+		return types.DIDDocument{}, "", err
+	}
+	if document.Deactivated {
+		return types.DIDDocument{}, "", errorsmod.Wrap(types.ErrDIDDeactivated, id)
+	}
+	if !document.HasController(signer) {
+		return types.DIDDocument{}, "", errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a controller of %s", signer, id)
+	}
+
+	return document, signer, nil
+}
+
+// normalizeControllers decodes and re-encodes the addresses of controllers, so
+// that they can be compared to the signer.
+func (ms msgServer) normalizeControllers(controllers []string) ([]string, error) {
+	normalized := make([]string, 0, len(controllers))
+	for _, controller := range controllers {
+		addr, err := ms.normalizeAddress(controller)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid controller address %s: %s", controller, err)
+		}
+		normalized = append(normalized, addr)
+	}
+
+	return normalized, nil
+}
+
+func (ms msgServer) normalizeAddress(address string) (string, error) {
+	bz, err := ms.addressCodec.StringToBytes(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ms.addressCodec.BytesToString(bz)
+}
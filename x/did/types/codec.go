@@ -0,0 +1,29 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDID{}, "cosmos-sdk/did/MsgCreateDID")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDID{}, "cosmos-sdk/did/MsgUpdateDID")
+	legacy.RegisterAminoMsg(cdc, &MsgDeactivateDID{}, "cosmos-sdk/did/MsgDeactivateDID")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateDID{},
+		&MsgUpdateDID{},
+		&MsgDeactivateDID{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// DIDMethod is the DID method of the documents registered by the module.
+const DIDMethod = "cosmos"
+
+// MaxDIDLength is the maximum length of a DID.
+const MaxDIDLength = 256
+
+var (
+	// didRegex matches a DID of any method, following the W3C DID syntax.
+	didRegex = regexp.MustCompile(`^did:[a-z0-9]+:(?:(?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})*:)*(?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})+$`)
+	// fragmentRegex matches the fragment of a DID URL.
+	fragmentRegex = regexp.MustCompile(`^[A-Za-z0-9._~!$&'()*+,;=:@/?%-]+$`)
+)
+
+// ValidateDID returns an error if id is not a DID of the cosmos method.
+func ValidateDID(id string) error {
+	if len(id) > MaxDIDLength {
+		return fmt.Errorf("DID %s is longer than %d characters", id, MaxDIDLength)
+	}
+	if !strings.HasPrefix(id, "did:"+DIDMethod+":") {
+		return fmt.Errorf("DID %s is not of the %s method", id, DIDMethod)
+	}
+	if !didRegex.MatchString(id) {
+		return fmt.Errorf("invalid DID %s", id)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the document. The addresses of its
+// controllers are not decoded.
+func (d DIDDocument) Validate() error {
+	if err := ValidateDID(d.Id); err != nil {
+		return err
+	}
+
+	if len(d.Controllers) == 0 {
+		return fmt.Errorf("DID document %s must have a controller", d.Id)
+	}
+	seen := make(map[string]struct{}, len(d.Controllers))
+	for _, controller := range d.Controllers {
+		if controller == "" {
+			return fmt.Errorf("DID document %s has an empty controller", d.Id)
+		}
+		if _, ok := seen[controller]; ok {
+			return fmt.Errorf("duplicate controller %s of DID document %s", controller, d.Id)
+		}
+		seen[controller] = struct{}{}
+	}
+
+	ids := make(map[string]struct{}, len(d.VerificationMethods)+len(d.Services))
+	for _, method := range d.VerificationMethods {
+		if err := d.validateURL(method.Id, ids); err != nil {
+			return err
+		}
+		if method.Type == "" {
+			return fmt.Errorf("verification method %s must have a type", method.Id)
+		}
+		if len(method.Controller) > MaxDIDLength || !didRegex.MatchString(method.Controller) {
+			return fmt.Errorf("verification method %s has an invalid controller %s", method.Id, method.Controller)
+		}
+		if method.PublicKeyMultibase == "" {
+			return fmt.Errorf("verification method %s must have a public key", method.Id)
+		}
+	}
+
+	for _, service := range d.Services {
+		if err := d.validateURL(service.Id, ids); err != nil {
+			return err
+		}
+		if service.Type == "" {
+			return fmt.Errorf("service %s must have a type", service.Id)
+		}
+		if u, err := url.ParseRequestURI(service.ServiceEndpoint); err != nil || u.Scheme == "" {
+			return fmt.Errorf("service %s has an invalid endpoint %s", service.Id, service.ServiceEndpoint)
+		}
+	}
+
+	return nil
+}
+
+// HasController returns true if the given address is a controller of the
+// document.
+func (d DIDDocument) HasController(address string) bool {
+	for _, controller := range d.Controllers {
+		if controller == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateURL checks that id is a DID URL of the document, made of its DID
+// and a fragment, which is not in seen, and adds it to seen.
+func (d DIDDocument) validateURL(id string, seen map[string]struct{}) error {
+	fragment, ok := strings.CutPrefix(id, d.Id+"#")
+	if !ok || !fragmentRegex.MatchString(fragment) {
+		return fmt.Errorf("%s is not a DID URL of %s", id, d.Id)
+	}
+	if _, ok := seen[id]; ok {
+		return fmt.Errorf("duplicate DID URL %s", id)
+	}
+	seen[id] = struct{}{}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/did/types"
+)
+
+func TestValidateDID(t *testing.T) {
+	for _, id := range []string{"did:cosmos:alice", "did:cosmos:testnet:alice", "did:cosmos:a.b-c_d%20"} {
+		require.NoError(t, types.ValidateDID(id), id)
+	}
+
+	for _, id := range []string{
+		"", "alice", "did:cosmos:", "did:other:alice", "did:cosmos:alice:", "did:cosmos:al ice",
+		"did:cosmos:alice#key", "did:cosmos:%2", "did:cosmos:" + strings.Repeat("a", types.MaxDIDLength),
+	} {
+		require.Error(t, types.ValidateDID(id), id)
+	}
+}
+
+func TestDIDDocumentValidate(t *testing.T) {
+	const id = "did:cosmos:alice"
+	valid := func() types.DIDDocument {
+		return types.DIDDocument{
+			Id:          id,
+			Controllers: []string{"cosmos1alice"},
+			VerificationMethods: []types.VerificationMethod{{
+				Id: id + "#key-1", Type: "Ed25519VerificationKey2020", Controller: "did:key:z6Mk", PublicKeyMultibase: "z6Mk",
+			}},
+			Services: []types.Service{{Id: id + "#hub", Type: "LinkedDomains", ServiceEndpoint: "https://alice.example"}},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	testCases := map[string]func(d *types.DIDDocument){
+		"no controller":         func(d *types.DIDDocument) { d.Controllers = nil },
+		"duplicate controller":  func(d *types.DIDDocument) { d.Controllers = append(d.Controllers, d.Controllers[0]) },
+		"method of another DID": func(d *types.DIDDocument) { d.VerificationMethods[0].Id = "did:cosmos:bob#key-1" },
+		"method without type":   func(d *types.DIDDocument) { d.VerificationMethods[0].Type = "" },
+		"invalid controller":    func(d *types.DIDDocument) { d.VerificationMethods[0].Controller = "alice" },
+		"method without key":    func(d *types.DIDDocument) { d.VerificationMethods[0].PublicKeyMultibase = "" },
+		"duplicate DID URL":     func(d *types.DIDDocument) { d.Services[0].Id = d.VerificationMethods[0].Id },
+		"empty fragment":        func(d *types.DIDDocument) { d.Services[0].Id = id + "#" },
+		"invalid endpoint":      func(d *types.DIDDocument) { d.Services[0].ServiceEndpoint = "alice.example" },
+	}
+	for name, malleate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := valid()
+			malleate(&d)
+			require.Error(t, d.Validate())
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/did module sentinel errors
+var (
+	ErrInvalidDID      = errors.Register(ModuleName, 1, "invalid DID")
+	ErrInvalidDocument = errors.Register(ModuleName, 2, "invalid DID document")
+	ErrDIDExists       = errors.Register(ModuleName, 3, "DID already registered")
+	ErrDIDNotFound     = errors.Register(ModuleName, 4, "DID not found")
+	ErrUnauthorized    = errors.Register(ModuleName, 5, "signer is not a controller of the DID document")
+	ErrDIDDeactivated  = errors.Register(ModuleName, 6, "DID deactivated")
+)
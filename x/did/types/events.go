@@ -0,0 +1,11 @@
+package types
+
+// did module event types
+const (
+	EventTypeCreateDID     = "create_did"
+	EventTypeUpdateDID     = "update_did"
+	EventTypeDeactivateDID = "deactivate_did"
+
+	AttributeKeyDID    = "did"
+	AttributeKeySigner = "signer"
+)
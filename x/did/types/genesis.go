@@ -0,0 +1,30 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(documents []DIDDocument) *GenesisState {
+	return &GenesisState{Documents: documents}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.Documents))
+	for _, d := range gs.Documents {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid DID document: %w", err)
+		}
+
+		if _, ok := seen[d.Id]; ok {
+			return fmt.Errorf("duplicate DID document %s", d.Id)
+		}
+		seen[d.Id] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "did"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// KVStore keys
+var (
+	DocumentsPrefix        = collections.NewPrefix(0)
+	DIDsByControllerPrefix = collections.NewPrefix(1)
+)
@@ -0,0 +1,1119 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/did/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryDIDDocumentRequest is the request type for the Query/DIDDocument RPC method.
+type QueryDIDDocumentRequest struct {
+	// id is the DID to resolve.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDIDDocumentRequest) Reset()         { *m = QueryDIDDocumentRequest{} }
+func (m *QueryDIDDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDIDDocumentRequest) ProtoMessage()    {}
+func (*QueryDIDDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2390bc7cc3eab87, []int{0}
+}
+func (m *QueryDIDDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDIDDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDIDDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDIDDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDIDDocumentRequest.Merge(m, src)
+}
+func (m *QueryDIDDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDIDDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDIDDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDIDDocumentRequest proto.InternalMessageInfo
+
+func (m *QueryDIDDocumentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryDIDDocumentResponse is the response type for the Query/DIDDocument RPC method.
+type QueryDIDDocumentResponse struct {
+	// document is the DID document.
+	Document DIDDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document"`
+}
+
+func (m *QueryDIDDocumentResponse) Reset()         { *m = QueryDIDDocumentResponse{} }
+func (m *QueryDIDDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDIDDocumentResponse) ProtoMessage()    {}
+func (*QueryDIDDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2390bc7cc3eab87, []int{1}
+}
+func (m *QueryDIDDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDIDDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDIDDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDIDDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDIDDocumentResponse.Merge(m, src)
+}
+func (m *QueryDIDDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDIDDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDIDDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDIDDocumentResponse proto.InternalMessageInfo
+
+func (m *QueryDIDDocumentResponse) GetDocument() DIDDocument {
+	if m != nil {
+		return m.Document
+	}
+	return DIDDocument{}
+}
+
+// QueryDIDsByControllerRequest is the request type for the Query/DIDsByController RPC method.
+type QueryDIDsByControllerRequest struct {
+	// controller is the address of the controller.
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDIDsByControllerRequest) Reset()         { *m = QueryDIDsByControllerRequest{} }
+func (m *QueryDIDsByControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDIDsByControllerRequest) ProtoMessage()    {}
+func (*QueryDIDsByControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2390bc7cc3eab87, []int{2}
+}
+func (m *QueryDIDsByControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDIDsByControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDIDsByControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDIDsByControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDIDsByControllerRequest.Merge(m, src)
+}
+func (m *QueryDIDsByControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDIDsByControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDIDsByControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDIDsByControllerRequest proto.InternalMessageInfo
+
+func (m *QueryDIDsByControllerRequest) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *QueryDIDsByControllerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDIDsByControllerResponse is the response type for the Query/DIDsByController RPC method.
+type QueryDIDsByControllerResponse struct {
+	// documents are the DID documents of the controller.
+	Documents []DIDDocument `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDIDsByControllerResponse) Reset()         { *m = QueryDIDsByControllerResponse{} }
+func (m *QueryDIDsByControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDIDsByControllerResponse) ProtoMessage()    {}
+func (*QueryDIDsByControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2390bc7cc3eab87, []int{3}
+}
+func (m *QueryDIDsByControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDIDsByControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDIDsByControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDIDsByControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDIDsByControllerResponse.Merge(m, src)
+}
+func (m *QueryDIDsByControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDIDsByControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDIDsByControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDIDsByControllerResponse proto.InternalMessageInfo
+
+func (m *QueryDIDsByControllerResponse) GetDocuments() []DIDDocument {
+	if m != nil {
+		return m.Documents
+	}
+	return nil
+}
+
+func (m *QueryDIDsByControllerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDIDDocumentRequest)(nil), "cosmos.did.v1.QueryDIDDocumentRequest")
+	proto.RegisterType((*QueryDIDDocumentResponse)(nil), "cosmos.did.v1.QueryDIDDocumentResponse")
+	proto.RegisterType((*QueryDIDsByControllerRequest)(nil), "cosmos.did.v1.QueryDIDsByControllerRequest")
+	proto.RegisterType((*QueryDIDsByControllerResponse)(nil), "cosmos.did.v1.QueryDIDsByControllerResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/did/v1/query.proto", fileDescriptor_f2390bc7cc3eab87) }
+
+var fileDescriptor_f2390bc7cc3eab87 = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x11, 0xc5, 0x4c, 0x51, 0x74, 0x28, 0x74, 0x5d, 0xeb, 0x5a, 0x16, 0x6c, 0xb5,
+	0xd6, 0x19, 0x12, 0x2f, 0x7a, 0x6c, 0x1a, 0x14, 0x6f, 0xba, 0xde, 0x04, 0x91, 0x4d, 0x66, 0x58,
+	0x06, 0x93, 0x99, 0xed, 0xce, 0x24, 0x18, 0x4a, 0x2f, 0x82, 0xe0, 0x51, 0xf0, 0x0b, 0x78, 0xec,
+	0x45, 0xf0, 0xe0, 0xc9, 0x4f, 0xd0, 0x63, 0xd1, 0x8b, 0x27, 0x91, 0x44, 0xf0, 0x6b, 0x94, 0x9d,
+	0x99, 0xed, 0x6e, 0xd3, 0x86, 0xf6, 0xb2, 0xec, 0xce, 0xfb, 0xbf, 0xf7, 0x7e, 0xef, 0x3f, 0x6f,
+	0xe1, 0x8d, 0x9e, 0x54, 0x03, 0xa9, 0x08, 0xe5, 0x94, 0x8c, 0x9a, 0x64, 0x7b, 0xc8, 0xb2, 0x31,
+	0x4e, 0x33, 0xa9, 0x25, 0xba, 0x62, 0x43, 0x98, 0x72, 0x8a, 0x47, 0x4d, 0x7f, 0x31, 0x91, 0x89,
+	0x34, 0x11, 0x92, 0xbf, 0x59, 0x91, 0xbf, 0x9c, 0x48, 0x99, 0xf4, 0x19, 0x89, 0x53, 0x4e, 0x62,
+	0x21, 0xa4, 0x8e, 0x35, 0x97, 0x42, 0xb9, 0xe8, 0xba, 0xab, 0xde, 0x8d, 0x15, 0xb3, 0xb5, 0xc9,
+	0xa8, 0xd9, 0x65, 0x3a, 0x6e, 0x92, 0x34, 0x4e, 0xb8, 0x30, 0x62, 0xa7, 0xbd, 0xe9, 0xb4, 0x85,
+	0xac, 0xca, 0xe2, 0x3b, 0xcc, 0x37, 0xb6, 0xbf, 0x03, 0xb3, 0xa1, 0xeb, 0xf1, 0x80, 0x0b, 0x49,
+	0xcc, 0xd3, 0x1d, 0x2d, 0x1d, 0x1f, 0x2a, 0x1f, 0xc0, 0x04, 0xc2, 0x7b, 0x70, 0xe9, 0x45, 0x5e,
+	0xb5, 0xf3, 0xac, 0xd3, 0x91, 0xbd, 0xe1, 0x80, 0x09, 0x1d, 0xb1, 0xed, 0x21, 0x53, 0x1a, 0x5d,
+	0x85, 0x75, 0x4e, 0x3d, 0xb0, 0x02, 0xee, 0x36, 0xa2, 0x3a, 0xa7, 0xe1, 0x6b, 0xe8, 0x9d, 0x94,
+	0xaa, 0x54, 0x0a, 0xc5, 0xd0, 0x26, 0xbc, 0x4c, 0xdd, 0x99, 0xc9, 0x58, 0x68, 0xf9, 0xf8, 0x98,
+	0x59, 0xb8, 0x92, 0xd5, 0x6e, 0xec, 0xff, 0xb9, 0x5d, 0xdb, 0xfb, 0xff, 0x6d, 0x1d, 0x44, 0x47,
+	0x69, 0xe1, 0x17, 0x00, 0x97, 0x8b, 0xfa, 0xaa, 0x3d, 0xde, 0x92, 0x42, 0x67, 0xb2, 0xdf, 0x67,
+	0x59, 0xc1, 0xf3, 0x08, 0xc2, 0xde, 0xd1, 0xa1, 0xe5, 0x6a, 0x7b, 0x3f, 0xbf, 0x3f, 0x58, 0x74,
+	0x8d, 0x36, 0x29, 0xcd, 0x98, 0x52, 0x2f, 0x75, 0xc6, 0x45, 0x12, 0x55, 0xb4, 0xe8, 0x09, 0x84,
+	0xa5, 0xb9, 0x5e, 0xdd, 0xf0, 0xad, 0x16, 0x7c, 0xf9, 0x4d, 0x60, 0xeb, 0xac, 0xbb, 0x09, 0xfc,
+	0x3c, 0x4e, 0x98, 0xeb, 0x1a, 0x55, 0x32, 0xc3, 0xaf, 0x00, 0xde, 0x9a, 0x83, 0xe8, 0x7c, 0xd8,
+	0x82, 0x8d, 0x62, 0x20, 0xe5, 0x81, 0x95, 0x0b, 0xe7, 0x37, 0xa2, 0xcc, 0x43, 0x4f, 0x4f, 0xc1,
+	0x5d, 0x3b, 0x13, 0xd7, 0x12, 0x54, 0x79, 0x5b, 0x3f, 0xea, 0xf0, 0xa2, 0xe1, 0x45, 0x1f, 0x00,
+	0x5c, 0xa8, 0x34, 0x46, 0xab, 0x33, 0x50, 0x73, 0x76, 0xc0, 0x5f, 0x3b, 0x53, 0x67, 0xdb, 0x86,
+	0x77, 0x3e, 0xe6, 0x53, 0xbc, 0xff, 0xf5, 0xef, 0x73, 0xdd, 0x47, 0x1e, 0x39, 0xb1, 0x6e, 0x8a,
+	0xec, 0x70, 0xba, 0x8b, 0xf6, 0x00, 0xbc, 0x36, 0x6b, 0x1e, 0xba, 0x3f, 0xa7, 0xc9, 0x69, 0x5b,
+	0xe0, 0x6f, 0x9c, 0x4f, 0xec, 0xb0, 0x1e, 0x97, 0x58, 0x18, 0x6d, 0xcc, 0x60, 0x95, 0x1b, 0xa2,
+	0xc8, 0x4e, 0xf9, 0xb1, 0x6b, 0x78, 0xdb, 0xad, 0xfd, 0x49, 0x00, 0x0e, 0x26, 0x01, 0xf8, 0x3b,
+	0x09, 0xc0, 0xa7, 0x69, 0x50, 0x3b, 0x98, 0x06, 0xb5, 0xdf, 0xd3, 0xa0, 0xf6, 0xca, 0xb3, 0x65,
+	0x14, 0x7d, 0x8b, 0xb9, 0x24, 0xef, 0x4c, 0x39, 0x3d, 0x4e, 0x99, 0xea, 0x5e, 0x32, 0x3f, 0xd5,
+	0xc3, 0xc3, 0x01, 0x00, 0xe2, 0x71, 0x77, 0xf4, 0x44, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// DIDDocument resolves a DID to its document.
+	DIDDocument(ctx context.Context, in *QueryDIDDocumentRequest, opts ...grpc.CallOption) (*QueryDIDDocumentResponse, error)
+	// DIDsByController returns the DID documents of a controller.
+	DIDsByController(ctx context.Context, in *QueryDIDsByControllerRequest, opts ...grpc.CallOption) (*QueryDIDsByControllerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DIDDocument(ctx context.Context, in *QueryDIDDocumentRequest, opts ...grpc.CallOption) (*QueryDIDDocumentResponse, error) {
+	out := new(QueryDIDDocumentResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.did.v1.Query/DIDDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DIDsByController(ctx context.Context, in *QueryDIDsByControllerRequest, opts ...grpc.CallOption) (*QueryDIDsByControllerResponse, error) {
+	out := new(QueryDIDsByControllerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.did.v1.Query/DIDsByController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DIDDocument resolves a DID to its document.
+	DIDDocument(context.Context, *QueryDIDDocumentRequest) (*QueryDIDDocumentResponse, error)
+	// DIDsByController returns the DID documents of a controller.
+	DIDsByController(context.Context, *QueryDIDsByControllerRequest) (*QueryDIDsByControllerResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DIDDocument(ctx context.Context, req *QueryDIDDocumentRequest) (*QueryDIDDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DIDDocument not implemented")
+}
+func (*UnimplementedQueryServer) DIDsByController(ctx context.Context, req *QueryDIDsByControllerRequest) (*QueryDIDsByControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DIDsByController not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DIDDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDIDDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DIDDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.did.v1.Query/DIDDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DIDDocument(ctx, req.(*QueryDIDDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DIDsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDIDsByControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DIDsByController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.did.v1.Query/DIDsByController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DIDsByController(ctx, req.(*QueryDIDsByControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.did.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DIDDocument",
+			Handler:    _Query_DIDDocument_Handler,
+		},
+		{
+			MethodName: "DIDsByController",
+			Handler:    _Query_DIDsByController_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/did/v1/query.proto",
+}
+
+func (m *QueryDIDDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDIDDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDIDDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDIDDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDIDDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDIDDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDIDsByControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDIDsByControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDIDsByControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDIDsByControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDIDsByControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDIDsByControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDIDDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDIDDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Document.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDIDsByControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDIDsByControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDIDDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDIDDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDIDDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDIDDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDIDDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDIDDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDIDsByControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDIDsByControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDIDsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDIDsByControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDIDsByControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDIDsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Documents = append(m.Documents, DIDDocument{})
+			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
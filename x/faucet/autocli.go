@@ -0,0 +1,52 @@
+package faucet
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	faucetv1 "cosmossdk.io/api/cosmos/faucet/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: faucetv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current faucet parameters",
+				},
+				{
+					RpcMethod:      "LastRequest",
+					Use:            "last-request [address]",
+					Short:          "Query the last request of an address and when it can request funds again",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: faucetv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RequestFunds",
+					Use:       "request-funds --from [requester]",
+					Short:     "Request funds from the faucet",
+					Example:   fmt.Sprintf("%s tx faucet request-funds --from mykey", version.AppName),
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update faucet module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update faucet module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query faucet params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx faucet update-params-proposal '{ "enabled": true, "amount": [{"denom":"stake","amount":"10000000"}], "period": "86400s" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/x/faucet/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the faucet module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, req := range data.LastRequests {
+		addr, err := k.addressCodec.StringToBytes(req.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.LastRequests.Set(ctx, addr, req.Time); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the faucet module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastRequests []types.LastRequest
+	err = k.LastRequests.Walk(ctx, nil, func(addr sdk.AccAddress, t time.Time) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		lastRequests = append(lastRequests, types.LastRequest{Address: addrStr, Time: t})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, lastRequests), nil
+}
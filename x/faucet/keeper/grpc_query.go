@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/faucet/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/faucet QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the faucet module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// LastRequest returns the time of the last request of an address and the time
+// from which it can request funds again.
+func (q queryServer) LastRequest(ctx context.Context, req *types.QueryLastRequestRequest) (*types.QueryLastRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	last, err := q.k.LastRequests.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return &types.QueryLastRequestResponse{}, nil
+		}
+		return nil, err
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryLastRequestResponse{LastRequest: &last}
+	if next := last.Add(params.Period); q.k.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(next) {
+		res.NextRequest = &next
+	}
+
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/faucet/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the faucet store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	bankKeeper   types.BankKeeper
+	addressCodec address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// LastRequests contains the block time of the last request of each address
+	LastRequests collections.Map[sdk.AccAddress, time.Time]
+}
+
+// NewKeeper creates a new faucet Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	// ensure faucet module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:          cdc,
+		environment:  env,
+		bankKeeper:   bk,
+		addressCodec: ak.AddressCodec(),
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		LastRequests: collections.NewMap(sb, types.LastRequestsPrefix, "last_requests", sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.TimeKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/faucet module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
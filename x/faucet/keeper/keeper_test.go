@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/faucet"
+	"cosmossdk.io/x/faucet/keeper"
+	faucettestutil "cosmossdk.io/x/faucet/testutil"
+	"cosmossdk.io/x/faucet/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *faucettestutil.MockBankKeeper
+
+	requester    sdk.AccAddress
+	requesterStr string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, faucet.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := faucettestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress(types.ModuleName))
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.bankKeeper = faucettestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	s.requester = sdk.AccAddress("requester")
+	var err error
+	s.requesterStr, err = address.NewBech32Codec("cosmos").BytesToString(s.requester)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestRequestFunds() {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().BlockedAddr(s.requester).Return(false).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, params.Amount).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.requester, params.Amount).Return(nil).Times(2)
+
+	res, err := s.msgServer.RequestFunds(s.ctx, &types.MsgRequestFunds{Requester: s.requesterStr})
+	s.Require().NoError(err)
+	s.Require().Equal(params.Amount, res.Amount)
+
+	// a second request in the same period is rejected
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: s.ctx.HeaderInfo().Time.Add(params.Period - time.Second)})
+	_, err = s.msgServer.RequestFunds(ctx, &types.MsgRequestFunds{Requester: s.requesterStr})
+	s.Require().ErrorIs(err, types.ErrRateLimited)
+
+	query, err := s.queryServer.LastRequest(ctx, &types.QueryLastRequestRequest{Address: s.requesterStr})
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.HeaderInfo().Time, *query.LastRequest)
+	s.Require().Equal(s.ctx.HeaderInfo().Time.Add(params.Period), *query.NextRequest)
+
+	// the requester can request funds again once the period elapsed
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: s.ctx.HeaderInfo().Time.Add(params.Period)})
+	_, err = s.msgServer.RequestFunds(ctx, &types.MsgRequestFunds{Requester: s.requesterStr})
+	s.Require().NoError(err)
+
+	query, err = s.queryServer.LastRequest(ctx, &types.QueryLastRequestRequest{Address: s.requesterStr})
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.HeaderInfo().Time.Add(params.Period), *query.NextRequest)
+}
+
+func (s *KeeperTestSuite) TestRequestFundsDisabled() {
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	_, err := s.msgServer.RequestFunds(s.ctx, &types.MsgRequestFunds{Requester: s.requesterStr})
+	s.Require().ErrorIs(err, types.ErrDisabled)
+}
+
+func (s *KeeperTestSuite) TestRequestFundsBlockedAddr() {
+	s.bankKeeper.EXPECT().BlockedAddr(s.requester).Return(true)
+
+	_, err := s.msgServer.RequestFunds(s.ctx, &types.MsgRequestFunds{Requester: s.requesterStr})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.Period = time.Hour
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.requesterStr, Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := types.NewGenesisState(types.DefaultParams(), []types.LastRequest{
+		{Address: s.requesterStr, Time: s.ctx.HeaderInfo().Time},
+	})
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/faucet/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/faucet MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// RequestFunds mints the faucet amount to the requester if the faucet is
+// enabled and the requester did not request funds in the current period.
+func (ms msgServer) RequestFunds(ctx context.Context, msg *types.MsgRequestFunds) (*types.MsgRequestFundsResponse, error) {
+	requester, err := ms.addressCodec.StringToBytes(msg.Requester)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid requester address: %s", err)
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.Enabled {
+		return nil, types.ErrDisabled
+	}
+
+	if ms.bankKeeper.BlockedAddr(requester) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", msg.Requester)
+	}
+
+	now := ms.environment.HeaderService.GetHeaderInfo(ctx).Time
+	last, err := ms.LastRequests.Get(ctx, requester)
+	switch {
+	case err == nil:
+		if next := last.Add(params.Period); now.Before(next) {
+			return nil, errorsmod.Wrapf(types.ErrRateLimited, "next request allowed at %s", next)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	if err := ms.bankKeeper.MintCoins(ctx, types.ModuleName, params.Amount); err != nil {
+		return nil, err
+	}
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, requester, params.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := ms.LastRequests.Set(ctx, requester, now); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRequestFunds,
+		event.NewAttribute(types.AttributeKeyRequester, msg.Requester),
+		event.NewAttribute(types.AttributeKeyAmount, params.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestFundsResponse{Amount: params.Amount}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
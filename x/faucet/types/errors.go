@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/faucet module sentinel errors
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrDisabled      = errors.Register(ModuleName, 2, "faucet is disabled")
+	ErrRateLimited   = errors.Register(ModuleName, 3, "funds already requested in the current period")
+)
@@ -0,0 +1,9 @@
+package types
+
+// faucet module event types
+const (
+	EventTypeRequestFunds = "request_funds"
+
+	AttributeKeyRequester = "requester"
+	AttributeKeyAmount    = "amount"
+)
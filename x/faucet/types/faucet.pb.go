@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/faucet/v1/faucet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/faucet module.
+type Params struct {
+	// enabled defines whether funds can be requested. The faucet is disabled by
+	// default.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// amount is the amount minted to the requester by each request.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// period is the minimum duration between two requests of the same address.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc4f5a1a5cbc442, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Params) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.faucet.v1.Params")
+}
+
+func init() { proto.RegisterFile("cosmos/faucet/v1/faucet.proto", fileDescriptor_5cc4f5a1a5cbc442) }
+
+var fileDescriptor_5cc4f5a1a5cbc442 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0x56, 0xca, 0xff, 0x2b, 0x80, 0x04, 0x11, 0x43, 0x28, 0x22, 0xa9, 0x98, 0xaa,
+	0x4a, 0xd8, 0x0a, 0x88, 0x85, 0x09, 0x15, 0xc4, 0x8c, 0x3a, 0xb2, 0x20, 0x27, 0x71, 0x83, 0xd5,
+	0x26, 0xb7, 0x8a, 0x9d, 0x8a, 0x6e, 0xcc, 0x4c, 0x8c, 0x88, 0x27, 0x40, 0x4c, 0x7d, 0x8c, 0x8e,
+	0x1d, 0x99, 0x28, 0x6a, 0x87, 0xbe, 0x06, 0x8a, 0xed, 0x08, 0x96, 0xe4, 0xde, 0x7b, 0x72, 0xf2,
+	0x1d, 0x5f, 0x3b, 0x47, 0x31, 0x88, 0x0c, 0x04, 0x19, 0xd0, 0x32, 0x66, 0x92, 0x4c, 0x42, 0x53,
+	0xe1, 0x71, 0x01, 0x12, 0xdc, 0x5d, 0x2d, 0x63, 0x33, 0x9c, 0x84, 0xad, 0xfd, 0x14, 0x52, 0x50,
+	0x22, 0xa9, 0x2a, 0xfd, 0x5d, 0xcb, 0x4f, 0x01, 0xd2, 0x11, 0x23, 0xaa, 0x8b, 0xca, 0x01, 0x49,
+	0xca, 0x82, 0x4a, 0x0e, 0x79, 0xad, 0x1b, 0x4c, 0x44, 0x05, 0x23, 0x93, 0x30, 0x62, 0x92, 0x86,
+	0x24, 0x06, 0x5e, 0xeb, 0x7b, 0x34, 0xe3, 0x39, 0x10, 0xf5, 0xd4, 0xa3, 0xe3, 0xa7, 0x86, 0x63,
+	0xdf, 0xd2, 0x82, 0x66, 0xc2, 0xf5, 0x9c, 0x7f, 0x2c, 0xa7, 0xd1, 0x88, 0x25, 0x1e, 0x6a, 0xa3,
+	0xce, 0xff, 0x7e, 0xdd, 0xba, 0x53, 0xc7, 0xa6, 0x19, 0x94, 0xb9, 0xf4, 0x1a, 0xed, 0x66, 0x67,
+	0xeb, 0xf4, 0x00, 0x9b, 0xc0, 0x15, 0x08, 0x1b, 0x10, 0xbe, 0x02, 0x9e, 0xf7, 0x6e, 0xe6, 0x5f,
+	0x81, 0xf5, 0xb1, 0x0c, 0x3a, 0x29, 0x97, 0x0f, 0x65, 0x84, 0x63, 0xc8, 0x88, 0x49, 0xa5, 0x5f,
+	0x27, 0x22, 0x19, 0x12, 0x39, 0x1d, 0x33, 0xa1, 0x0c, 0xe2, 0x6d, 0x33, 0xeb, 0x6e, 0x8f, 0x58,
+	0x4a, 0xe3, 0xe9, 0x7d, 0x15, 0x55, 0xbc, 0x6f, 0x66, 0x5d, 0xd4, 0x37, 0x40, 0xf7, 0xd2, 0xb1,
+	0xc7, 0xac, 0xe0, 0x90, 0x78, 0xcd, 0x36, 0x52, 0x68, 0xbd, 0x03, 0x5c, 0xef, 0x00, 0x5f, 0x9b,
+	0x1d, 0xf4, 0x76, 0x2a, 0xf4, 0xeb, 0x32, 0x40, 0xe6, 0x0f, 0xda, 0x77, 0x11, 0x3c, 0x6f, 0x66,
+	0xdd, 0xd6, 0x1f, 0xf8, 0x63, 0x7d, 0x0d, 0xfa, 0xdc, 0xbd, 0xf3, 0xf9, 0xca, 0x47, 0x8b, 0x95,
+	0x8f, 0xbe, 0x57, 0x3e, 0x7a, 0x59, 0xfb, 0xd6, 0x62, 0xed, 0x5b, 0x9f, 0x6b, 0xdf, 0xba, 0x3b,
+	0xd4, 0x2e, 0x91, 0x0c, 0x31, 0x87, 0x5f, 0x9f, 0x4a, 0x1f, 0xd9, 0x2a, 0xc1, 0xd9, 0xcf, 0x00,
+	0x84, 0xf2, 0xc3, 0xe3, 0xdc, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFaucet(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFaucet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFaucet(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFaucet(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFaucet(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFaucet(uint64(l))
+	return n
+}
+
+func sovFaucet(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFaucet(x uint64) (n int) {
+	return sovFaucet(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFaucet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFaucet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFaucet(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFaucet
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFaucet
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFaucet
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFaucet
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFaucet        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFaucet          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFaucet = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,33 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, lastRequests []LastRequest) *GenesisState {
+	return &GenesisState{Params: params, LastRequests: lastRequests}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.LastRequests))
+	for _, req := range gs.LastRequests {
+		if req.Address == "" {
+			return fmt.Errorf("last request address cannot be empty")
+		}
+		if _, ok := seen[req.Address]; ok {
+			return fmt.Errorf("duplicate last request for %s", req.Address)
+		}
+		seen[req.Address] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "faucet"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey          = collections.NewPrefix(0)
+	LastRequestsPrefix = collections.NewPrefix(1)
+)
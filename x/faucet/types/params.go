@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default x/faucet module parameters. The faucet is
+// disabled by default.
+func DefaultParams() Params {
+	return Params{
+		Enabled: false,
+		Amount:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10_000_000))),
+		Period:  24 * time.Hour,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := p.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid faucet amount: %w", err)
+	}
+	if p.Enabled && p.Amount.IsZero() {
+		return fmt.Errorf("faucet amount cannot be empty when the faucet is enabled")
+	}
+	if p.Period < 0 {
+		return fmt.Errorf("faucet period cannot be negative: %s", p.Period)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GasPriceKeeper defines the fee market keeper used by the BaseFeeDecorator.
+type GasPriceKeeper interface {
+	GetGasPrice(ctx context.Context) (sdk.DecCoin, error)
+}
+
+// BaseFeeDecorator is an AnteDecorator that rejects the transactions whose fee
+// does not pay the base fee of the fee market for their gas limit. Unlike the
+// min gas prices of a node, the base fee is enforced by consensus, in CheckTx
+// as well as when the transaction is executed in a block. It must be placed
+// before the DeductFeeDecorator.
+type BaseFeeDecorator struct {
+	feeMarketKeeper GasPriceKeeper
+}
+
+func NewBaseFeeDecorator(fk GasPriceKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		feeMarketKeeper: fk,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// gentxs do not pay fees
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	gasPrice, err := bfd.feeMarketKeeper.GetGasPrice(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	// fee = ceil(gasPrice * gasLimit)
+	required := gasPrice.Amount.MulInt(sdkmath.NewIntFromUint64(feeTx.GetGas())).Ceil().RoundInt()
+	if paid := sdk.Coins(feeTx.GetFee()).AmountOf(gasPrice.Denom); paid.LT(required) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"insufficient fees; got: %s%s, base fee requires: %s%s", paid, gasPrice.Denom, required, gasPrice.Denom,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
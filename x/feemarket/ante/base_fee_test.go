@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	"cosmossdk.io/x/feemarket/ante"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type mockGasPriceKeeper struct {
+	gasPrice sdk.DecCoin
+}
+
+func (m mockGasPriceKeeper) GetGasPrice(context.Context) (sdk.DecCoin, error) {
+	return m.gasPrice, nil
+}
+
+func TestBaseFeeDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	clientCtx := client.Context{}.WithTxConfig(encCfg.TxConfig)
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeight(1)
+
+	// 0.25stake per unit of gas
+	decorator := ante.NewBaseFeeDecorator(mockGasPriceKeeper{sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(25, 2))})
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		gas      uint64
+		simulate bool
+		height   int64
+		expErr   bool
+	}{
+		{name: "fee pays the base fee", fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), gas: 100},
+		{name: "fee rounded up", fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), gas: 101, expErr: true},
+		{name: "fee in another denom", fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), gas: 100, expErr: true},
+		{name: "no fee", gas: 100, expErr: true},
+		{name: "simulation", gas: 100, simulate: true},
+		{name: "genesis", gas: 100, height: -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			txBuilder.SetFeeAmount(tc.fee)
+			txBuilder.SetGasLimit(tc.gas)
+
+			ctx := ctx
+			if tc.height < 0 {
+				ctx = ctx.WithBlockHeight(0)
+			}
+
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), tc.simulate, next)
+			if tc.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
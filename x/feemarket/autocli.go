@@ -0,0 +1,50 @@
+package feemarket
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	feemarketv1 "cosmossdk.io/api/cosmos/feemarket/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: feemarketv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current fee market parameters",
+				},
+				{
+					RpcMethod: "State",
+					Use:       "state",
+					Short:     "Query the current base fee and the gas used by the last block",
+				},
+				{
+					RpcMethod: "GasPrice",
+					Use:       "gas-price",
+					Short:     "Query the minimum gas price of the transactions of the next block",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: feemarketv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update feemarket module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update feemarket module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query feemarket params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx feemarket update-params-proposal '{ "fee_denom": "stake", "min_base_fee": "0.001", "target_utilization": "0.5", "change_rate": "0.125" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
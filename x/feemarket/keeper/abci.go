@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker adjusts the base fee of the next block to the gas used by the
+// current block, relative to the gas targeted out of the block max gas.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var gasUsed uint64
+	if meter := sdkCtx.BlockGasMeter(); meter != nil {
+		gasUsed = meter.GasConsumed()
+	}
+
+	// a block max gas of -1 means that blocks are unlimited
+	var maxGas uint64
+	if cp := sdkCtx.ConsensusParams(); cp.Block != nil && cp.Block.MaxGas > 0 {
+		maxGas = uint64(cp.Block.MaxGas)
+	}
+
+	state = types.NewState(types.NextBaseFee(params, state.BaseFee, gasUsed, maxGas), gasUsed)
+	if err := k.State.Set(ctx, state); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBaseFee,
+		event.NewAttribute(types.AttributeKeyBaseFee, state.BaseFee.String()),
+		event.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+	)
+}
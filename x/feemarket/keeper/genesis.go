@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	return k.State.Set(ctx, data.State)
+}
+
+// ExportGenesis returns the feemarket module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, state), nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/feemarket QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the feemarket module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// State returns the state of the fee market.
+func (q queryServer) State(ctx context.Context, _ *types.QueryStateRequest) (*types.QueryStateResponse, error) {
+	state, err := q.k.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStateResponse{State: state}, nil
+}
+
+// GasPrice returns the minimum gas price of the transactions of the next block.
+func (q queryServer) GasPrice(ctx context.Context, _ *types.QueryGasPriceRequest) (*types.QueryGasPriceResponse, error) {
+	gasPrice, err := q.k.GetGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGasPriceResponse{GasPrice: gasPrice}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	environment appmodule.Environment
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// State contains the current base fee
+	State collections.Item[types.State]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:         cdc,
+		environment: env,
+		authority:   authority,
+		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		State:       collections.NewItem(sb, types.StateKey, "state", codec.CollValue[types.State](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetGasPrice returns the minimum gas price of the transactions of the current
+// block: the base fee in the fee denom.
+func (k Keeper) GetGasPrice(ctx context.Context) (sdk.DecCoin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, state.BaseFee), nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	"cosmossdk.io/x/feemarket/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000}})
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	params := types.DefaultParams()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.NewGenesisState(params, types.NewState(sdkmath.LegacyOneDec(), 0))))
+}
+
+func (s *KeeperTestSuite) endBlock(gasUsed uint64) {
+	meter := storetypes.NewInfiniteGasMeter()
+	meter.ConsumeGas(gasUsed, "test")
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasMeter(meter)))
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	s.endBlock(1000)
+	state, err := s.keeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.LegacyNewDecWithPrec(1125, 3), state.BaseFee)
+	s.Require().Equal(uint64(1000), state.LastBlockGasUsed)
+
+	res, err := s.queryServer.GasPrice(s.ctx, &types.QueryGasPriceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, state.BaseFee), res.GasPrice)
+
+	// the base fee decreases back after empty blocks, down to the min base fee
+	for i := 0; i < 100; i++ {
+		s.endBlock(0)
+	}
+	state, err = s.keeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams().MinBaseFee, state.BaseFee)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerUnlimitedBlock() {
+	s.ctx = s.ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: -1}})
+	s.endBlock(1000)
+
+	state, err := s.keeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.LegacyOneDec(), state.BaseFee)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.MinBaseFee = sdkmath.LegacyNewDec(2)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	// the base fee is raised to the new min base fee
+	state, err := s.keeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MinBaseFee, state.BaseFee)
+
+	params.ChangeRate = sdkmath.LegacyZeroDec()
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.endBlock(800)
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	invalid := types.NewGenesisState(types.DefaultParams(), types.NewState(sdkmath.LegacyZeroDec(), 0))
+	s.Require().Error(invalid.Validate())
+}
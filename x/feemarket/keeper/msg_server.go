@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/feemarket MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// UpdateParams updates the params. The base fee is raised to the new min base
+// fee if it is lower.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	state, err := ms.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state.BaseFee.LT(msg.Params.MinBaseFee) {
+		state.BaseFee = msg.Params.MinBaseFee
+		if err := ms.State.Set(ctx, state); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
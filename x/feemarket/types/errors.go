@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feemarket module sentinel errors
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+)
@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/core/address"
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
@@ -0,0 +1,21 @@
+package types
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, state State) *GenesisState {
+	return &GenesisState{Params: params, State: state}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, InitialState(params))
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return gs.State.Validate(gs.Params)
+}
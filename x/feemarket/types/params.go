@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default x/feemarket module parameters. As in EIP-1559,
+// blocks target half of the block max gas, and the base fee changes by at most
+// 12.5% per block.
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:          sdk.DefaultBondDenom,
+		MinBaseFee:        sdkmath.LegacyNewDecWithPrec(1, 3),
+		TargetUtilization: sdkmath.LegacyNewDecWithPrec(5, 1),
+		ChangeRate:        sdkmath.LegacyNewDecWithPrec(125, 3),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+	if p.TargetUtilization.IsNil() || !p.TargetUtilization.IsPositive() || p.TargetUtilization.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("target utilization must be positive and at most one: %s", p.TargetUtilization)
+	}
+	if p.ChangeRate.IsNil() || !p.ChangeRate.IsPositive() || p.ChangeRate.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("change rate must be positive and at most one: %s", p.ChangeRate)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewState creates a new State object
+func NewState(baseFee sdkmath.LegacyDec, lastBlockGasUsed uint64) State {
+	return State{BaseFee: baseFee, LastBlockGasUsed: lastBlockGasUsed}
+}
+
+// InitialState returns the state of a new fee market, whose base fee is the
+// min base fee.
+func InitialState(params Params) State {
+	return NewState(params.MinBaseFee, 0)
+}
+
+// Validate performs a basic validation of the state against the params.
+func (s State) Validate(params Params) error {
+	if s.BaseFee.IsNil() || s.BaseFee.LT(params.MinBaseFee) {
+		return fmt.Errorf("base fee %s must be at least the min base fee %s", s.BaseFee, params.MinBaseFee)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee following a block which used gasUsed out of
+// maxGas. The base fee moves proportionally to the deviation of the gas used
+// from the targeted gas, relative to the target, times the change rate, and
+// never goes below the min base fee. It does not change when the block max gas
+// is unlimited.
+func NextBaseFee(params Params, baseFee sdkmath.LegacyDec, gasUsed, maxGas uint64) sdkmath.LegacyDec {
+	target := params.TargetUtilization.MulInt(sdkmath.NewIntFromUint64(maxGas))
+	if !target.IsPositive() {
+		return sdkmath.LegacyMaxDec(baseFee, params.MinBaseFee)
+	}
+
+	deviation := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasUsed)).Sub(target).Quo(target)
+	next := baseFee.Add(baseFee.Mul(params.ChangeRate).Mul(deviation))
+
+	return sdkmath.LegacyMaxDec(next, params.MinBaseFee)
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	baseFee := sdkmath.LegacyNewDec(1)
+
+	testCases := []struct {
+		name    string
+		baseFee sdkmath.LegacyDec
+		gasUsed uint64
+		maxGas  uint64
+		exp     sdkmath.LegacyDec
+	}{
+		{name: "target reached", baseFee: baseFee, gasUsed: 500, maxGas: 1000, exp: baseFee},
+		{name: "full block", baseFee: baseFee, gasUsed: 1000, maxGas: 1000, exp: sdkmath.LegacyNewDecWithPrec(1125, 3)},
+		{name: "empty block", baseFee: baseFee, gasUsed: 0, maxGas: 1000, exp: sdkmath.LegacyNewDecWithPrec(875, 3)},
+		{name: "quarter of the block", baseFee: baseFee, gasUsed: 250, maxGas: 1000, exp: sdkmath.LegacyNewDecWithPrec(9375, 4)},
+		{name: "min base fee", baseFee: params.MinBaseFee, gasUsed: 0, maxGas: 1000, exp: params.MinBaseFee},
+		{name: "unlimited block", baseFee: baseFee, gasUsed: 1000, maxGas: 0, exp: baseFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := types.NextBaseFee(params, tc.baseFee, tc.gasUsed, tc.maxGas)
+			require.True(t, tc.exp.Equal(got), "expected %s, got %s", tc.exp, got)
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.MinBaseFee = sdkmath.LegacyZeroDec()
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TargetUtilization = sdkmath.LegacyNewDec(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ChangeRate = sdkmath.LegacyZeroDec()
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, params.Validate())
+}
@@ -35,6 +35,7 @@ func CommandsWithCustomMigrationMap(txConfig client.TxConfig, mm *module.Manager
 		CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, gentxModule.GenTxValidator(), txConfig.SigningContext().ValidatorAddressCodec()),
 		ValidateGenesisCmd(mm),
 		AddGenesisAccountCmd(txConfig.SigningContext().AddressCodec()),
+		MigrateVestingAccountsCmd(),
 		ExportCmd(appExport),
 	)
 
@@ -3,10 +3,13 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	address "cosmossdk.io/core/address"
+	vestingcli "cosmossdk.io/x/auth/vesting/client/cli"
+	authvesting "cosmossdk.io/x/auth/vesting/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -20,8 +23,10 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagVestingFile  = "vesting-periods-file"
 	flagAppendMode   = "append"
 	flagModuleName   = "module-name"
+	flagGranularity  = "granularity"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -34,6 +39,16 @@ func AddGenesisAccountCmd(addressCodec address.Codec) *cobra.Command {
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
 contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+
+A periodic vesting account is created with --vesting-periods-file, pointing at a JSON
+array of periods, with a start time and a vesting amount equal to the sum of the
+coins of all periods. The end time is the start time plus the sum of the period lengths,
+given in seconds or as duration strings:
+
+[
+  {"length": 2592000, "coins": "100stake"},
+  {"length": "720h", "coins": "100stake,50atom"}
+]
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -72,9 +87,18 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			vestingStart, _ := cmd.Flags().GetInt64(flagVestingStart)
 			vestingEnd, _ := cmd.Flags().GetInt64(flagVestingEnd)
 			vestingAmtStr, _ := cmd.Flags().GetString(flagVestingAmt)
+			vestingFile, _ := cmd.Flags().GetString(flagVestingFile)
 			moduleNameStr, _ := cmd.Flags().GetString(flagModuleName)
 
-			return genutil.AddGenesisAccount(clientCtx.Codec, addr, appendflag, config.GenesisFile(), args[1], vestingAmtStr, vestingStart, vestingEnd, moduleNameStr)
+			var vestingPeriods authvesting.Periods
+			if vestingFile != "" {
+				vestingPeriods, err = vestingcli.ReadVestingPeriodsFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			return genutil.AddGenesisAccount(clientCtx.Codec, addr, appendflag, config.GenesisFile(), args[1], vestingAmtStr, vestingStart, vestingEnd, vestingPeriods, moduleNameStr)
 		},
 	}
 
@@ -82,9 +106,47 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingFile, "", "path to a JSON file of vesting periods for periodic vesting accounts")
 	cmd.Flags().Bool(flagAppendMode, false, "append the coins to an account already in the genesis.json file")
 	cmd.Flags().String(flagModuleName, "", "module account name")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// MigrateVestingAccountsCmd returns migrate-vesting-accounts cobra Command.
+func MigrateVestingAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-vesting-accounts",
+		Short: "Convert the continuous vesting accounts of genesis.json into periodic vesting accounts",
+		Long: `Convert each continuous vesting account of genesis.json into a periodic vesting
+account, with periods of the given granularity and a period ending at the cliff time.
+Each period vests the coins vested linearly until its end, rounded down, and the rounding
+dust vests in the last period. The delegated free and delegated vesting coins are preserved.
+genesis.json is left untouched if any converted account is invalid.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			granularity, _ := cmd.Flags().GetDuration(flagGranularity)
+			if granularity < time.Second {
+				return fmt.Errorf("granularity must be at least one second: %s", granularity)
+			}
+
+			migrated, err := genutil.MigrateVestingAccounts(clientCtx.Codec, config.GenesisFile(), int64(granularity/time.Second))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Migrated %d continuous vesting accounts\n", migrated)
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration(flagGranularity, 720*time.Hour, "length of the vesting periods")
+
+	return cmd
+}
@@ -2,6 +2,10 @@ package cli_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -9,6 +13,10 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/x/auth"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingcli "cosmossdk.io/x/auth/vesting/client/cli"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
@@ -19,8 +27,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 func TestAddGenesisAccountCmd(t *testing.T) {
@@ -104,3 +114,296 @@ func TestAddGenesisAccountCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestAddGenesisAccountCmdVestingPeriods(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	tests := []struct {
+		name      string
+		periods   string
+		args      []string
+		expectErr string
+	}{
+		{
+			name:    "valid periods",
+			periods: `[{"length": 100, "coins": "10atom"}, {"length": 200, "coins": "20atom,5stake"}]`,
+			args:    []string{"--vesting-amount=30atom,5stake", "--vesting-start-time=1000"},
+		},
+		{
+			name:    "valid periods as durations",
+			periods: `[{"length": "1h30m", "coins": "10atom"}, {"length": "720h", "coins": "20atom,5stake"}]`,
+			args:    []string{"--vesting-amount=30atom,5stake", "--vesting-start-time=1000"},
+		},
+		{
+			name:      "negative duration",
+			periods:   `[{"length": "-1h30m", "coins": "10atom"}]`,
+			args:      []string{"--vesting-amount=10atom", "--vesting-start-time=1000"},
+			expectErr: "period length cannot be negative",
+		},
+		{
+			name:    "valid periods with matching end time",
+			periods: `[{"length": 100, "coins": "10atom"}]`,
+			args:    []string{"--vesting-amount=10atom", "--vesting-start-time=1000", "--vesting-end-time=1100"},
+		},
+		{
+			name:      "mismatching end time",
+			periods:   `[{"length": 100, "coins": "10atom"}]`,
+			args:      []string{"--vesting-amount=10atom", "--vesting-start-time=1000", "--vesting-end-time=2000"},
+			expectErr: "does not match the start time plus the length of all vesting periods",
+		},
+		{
+			name:      "mismatching vesting amount",
+			periods:   `[{"length": 100, "coins": "10atom"}, {"length": 200, "coins": "20atom"}]`,
+			args:      []string{"--vesting-amount=20atom", "--vesting-start-time=1000"},
+			expectErr: "does not match the sum of all coins in vesting periods",
+		},
+		{
+			name:      "missing start time",
+			periods:   `[{"length": 100, "coins": "10atom"}]`,
+			args:      []string{"--vesting-amount=10atom"},
+			expectErr: "must supply start time with vesting periods",
+		},
+		{
+			name:      "zero length",
+			periods:   `[{"length": 0, "coins": "10atom"}]`,
+			args:      []string{"--vesting-amount=10atom", "--vesting-start-time=1000"},
+			expectErr: "vesting period #0 has a non-positive length",
+		},
+		{
+			name:      "negative length",
+			periods:   `[{"length": 100, "coins": "10atom"}, {"length": -100, "coins": "10atom"}]`,
+			args:      []string{"--vesting-amount=20atom", "--vesting-start-time=1000"},
+			expectErr: "vesting period #1 has a non-positive length",
+		},
+		{
+			name:      "duplicate denoms",
+			periods:   `[{"length": 100, "coins": "10atom,5atom"}]`,
+			args:      []string{"--vesting-amount=15atom", "--vesting-start-time=1000"},
+			expectErr: "vesting period #0 has invalid coins",
+		},
+		{
+			name:      "no periods",
+			periods:   `[]`,
+			args:      []string{"--vesting-amount=10atom", "--vesting-start-time=1000"},
+			expectErr: "vesting periods file contains no period",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			cfg, err := genutiltest.CreateDefaultCometConfig(home)
+			require.NoError(t, err)
+
+			appCodec := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{}).Codec
+			require.NoError(t, genutiltest.ExecInitCmd(testMbm, home, appCodec))
+
+			serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+			clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(home)
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+			ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+			periodsFile := filepath.Join(home, "periods.json")
+			require.NoError(t, os.WriteFile(periodsFile, []byte(tc.periods), 0o600))
+
+			cmd := genutilcli.AddGenesisAccountCmd(addresscodec.NewBech32Codec("cosmos"))
+			cmd.SetArgs(append([]string{addr1.String(), "100atom,10stake", "--vesting-periods-file=" + periodsFile}, tc.args...))
+
+			err = cmd.ExecuteContext(ctx)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			periods, err := vestingcli.ReadVestingPeriodsFile(periodsFile)
+			require.NoError(t, err)
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+			require.NoError(t, err)
+			authGenState := authtypes.GetGenesisStateFromAppState(appCodec, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			require.NoError(t, err)
+			require.Len(t, accs, 1)
+
+			acc, ok := accs[0].(*vestingtypes.PeriodicVestingAccount)
+			require.True(t, ok)
+			require.Equal(t, int64(1000), acc.StartTime)
+			require.Equal(t, 1000+periods.TotalLength(), acc.EndTime)
+			require.Equal(t, periods, vestingtypes.Periods(acc.VestingPeriods))
+			require.Equal(t, periods.TotalAmount(), acc.OriginalVesting)
+
+			// the schedule is exported as is
+			exported, err := appCodec.MarshalJSON(&authGenState)
+			require.NoError(t, err)
+			require.JSONEq(t, string(appState[authtypes.ModuleName]), string(exported))
+		})
+	}
+}
+
+func TestMigrateVestingAccountsCmd(t *testing.T) {
+	_, _, continuousAddr := testdata.KeyTestPubAddr()
+	_, _, baseAddr := testdata.KeyTestPubAddr()
+	const day = 24 * 60 * 60
+
+	tests := []struct {
+		name       string
+		args       []string
+		malleate   func(acc *vestingtypes.ContinuousVestingAccount)
+		expPeriods int
+		expErr     string
+	}{
+		{
+			name:       "default granularity",
+			expPeriods: 13,
+		},
+		{
+			name:       "custom granularity",
+			args:       []string{"--granularity=2160h"},
+			expPeriods: 5,
+		},
+		{
+			name:   "granularity shorter than a second",
+			args:   []string{"--granularity=1ms"},
+			expErr: "granularity must be at least one second",
+		},
+		{
+			name: "invalid account",
+			malleate: func(acc *vestingtypes.ContinuousVestingAccount) {
+				acc.StartTime = acc.EndTime
+			},
+			expErr: "failed to migrate vesting account",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			cfg, err := genutiltest.CreateDefaultCometConfig(home)
+			require.NoError(t, err)
+
+			appCodec := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{}).Codec
+			require.NoError(t, genutiltest.ExecInitCmd(testMbm, home, appCodec))
+
+			serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+			clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(home)
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+			ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+			cmd := genutilcli.AddGenesisAccountCmd(addresscodec.NewBech32Codec("cosmos"))
+			cmd.SetArgs([]string{continuousAddr.String(), "1000atom", "--vesting-amount=1000atom", "--vesting-start-time=1000", fmt.Sprintf("--vesting-end-time=%d", 1000+365*day)})
+			require.NoError(t, cmd.ExecuteContext(ctx))
+			cmd = genutilcli.AddGenesisAccountCmd(addresscodec.NewBech32Codec("cosmos"))
+			cmd.SetArgs([]string{baseAddr.String(), "1000atom"})
+			require.NoError(t, cmd.ExecuteContext(ctx))
+
+			readAccounts := func() authtypes.GenesisAccounts {
+				appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+				require.NoError(t, err)
+				accs, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(appCodec, appState).Accounts)
+				require.NoError(t, err)
+				require.Len(t, accs, 2)
+				return accs
+			}
+
+			// delegations are preserved
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+			require.NoError(t, err)
+			authGenState := authtypes.GetGenesisStateFromAppState(appCodec, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			require.NoError(t, err)
+			for _, acc := range accs {
+				if cva, ok := acc.(*vestingtypes.ContinuousVestingAccount); ok {
+					cva.DelegatedFree = sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+					cva.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin("atom", 200))
+					if tc.malleate != nil {
+						tc.malleate(cva)
+					}
+				}
+			}
+			authGenState.Accounts, err = authtypes.PackAccounts(accs)
+			require.NoError(t, err)
+			appState[authtypes.ModuleName], err = appCodec.MarshalJSON(&authGenState)
+			require.NoError(t, err)
+			appGenesis.AppState, err = json.Marshal(appState)
+			require.NoError(t, err)
+			require.NoError(t, genutil.ExportGenesisFile(appGenesis, cfg.GenesisFile()))
+
+			before, err := os.ReadFile(cfg.GenesisFile())
+			require.NoError(t, err)
+
+			cmd = genutilcli.MigrateVestingAccountsCmd()
+			cmd.SetArgs(tc.args)
+			err = cmd.ExecuteContext(ctx)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+
+				// the genesis file is left untouched
+				after, err := os.ReadFile(cfg.GenesisFile())
+				require.NoError(t, err)
+				require.Equal(t, before, after)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, acc := range readAccounts() {
+				if !acc.GetAddress().Equals(continuousAddr) {
+					require.IsType(t, &authtypes.BaseAccount{}, acc)
+					continue
+				}
+
+				pva, ok := acc.(*vestingtypes.PeriodicVestingAccount)
+				require.True(t, ok)
+				require.Len(t, pva.VestingPeriods, tc.expPeriods)
+				require.Equal(t, int64(1000), pva.StartTime)
+				require.Equal(t, int64(1000+365*day), pva.EndTime)
+				require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), pva.OriginalVesting)
+				require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), pva.DelegatedFree)
+				require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), pva.DelegatedVesting)
+			}
+		})
+	}
+}
+
+func TestAddGenesisAccountCmdAccountNumbers(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := genutiltest.CreateDefaultCometConfig(home)
+	require.NoError(t, err)
+
+	appCodec := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{}).Codec
+	require.NoError(t, genutiltest.ExecInitCmd(testMbm, home, appCodec))
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	// each account is added with the next account number, whatever its address
+	var addrs []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		_, _, addr := testdata.KeyTestPubAddr()
+		addrs = append(addrs, addr)
+
+		cmd := genutilcli.AddGenesisAccountCmd(addresscodec.NewBech32Codec("cosmos"))
+		cmd.SetArgs([]string{addr.String(), "100atom"})
+		require.NoError(t, cmd.ExecuteContext(ctx))
+	}
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	authGenState := authtypes.GetGenesisStateFromAppState(appCodec, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 3)
+	for i, acc := range accs {
+		require.Equal(t, addrs[i], acc.GetAddress())
+		require.Equal(t, uint64(i), acc.GetAccountNumber())
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
@@ -53,6 +54,10 @@ func ValidateGenesisCmd(mm *module.Manager) *cobra.Command {
 				if err = mm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
 					return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
 				}
+
+				if err = genutil.ValidateVestingDelegations(cdc, genState); err != nil {
+					return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+				}
 			}
 
 			fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
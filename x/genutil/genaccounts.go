@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingexported "cosmossdk.io/x/auth/vesting/exported"
 	authvesting "cosmossdk.io/x/auth/vesting/types"
 	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,14 +22,17 @@ import (
 // `accAddr` is the address to be added to the genesis state, `amountStr` is the list of initial coins
 // to be added for the account, `appendAcct` updates the account if already exists.
 // `vestingStart, vestingEnd and vestingAmtStr` respectively are the schedule start time, end time (unix epoch)
-// `moduleName“ is the module name for which the account is being created
 // and coins to be appended to the account already in the genesis.json file.
+// `vestingPeriods` are the optional periods of a periodic vesting account, whose end time is computed from
+// `vestingStart` and the sum of the period lengths.
+// `moduleName“ is the module name for which the account is being created
 func AddGenesisAccount(
 	cdc codec.Codec,
 	accAddr sdk.AccAddress,
 	appendAcct bool,
 	genesisFileURL, amountStr, vestingAmtStr string,
 	vestingStart, vestingEnd int64,
+	vestingPeriods authvesting.Periods,
 	moduleName string,
 ) error {
 	coins, err := sdk.ParseCoinsNormalized(amountStr)
@@ -45,6 +51,24 @@ func AddGenesisAccount(
 	balances := banktypes.Balance{Address: accAddr.String(), Coins: coins.Sort()}
 	baseAccount := authtypes.NewBaseAccount(accAddr, nil, 0, 0)
 
+	if len(vestingPeriods) > 0 {
+		if vestingStart == 0 {
+			return errors.New("invalid vesting parameters; must supply start time with vesting periods")
+		}
+		if !vestingPeriods.TotalAmount().Equal(vestingAmt) {
+			return fmt.Errorf("vesting amount %s does not match the sum of all coins in vesting periods %s", vestingAmt, vestingPeriods.TotalAmount())
+		}
+
+		periodsEnd, err := vestingPeriods.EndTime(vestingStart)
+		if err != nil {
+			return fmt.Errorf("invalid vesting periods: %w", err)
+		}
+		if vestingEnd != 0 && vestingEnd != periodsEnd {
+			return fmt.Errorf("vesting end time %d does not match the start time plus the length of all vesting periods %d", vestingEnd, periodsEnd)
+		}
+		vestingEnd = periodsEnd
+	}
+
 	if !vestingAmt.IsZero() {
 		baseVestingAccount, err := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
 		if err != nil {
@@ -57,6 +81,9 @@ func AddGenesisAccount(
 		}
 
 		switch {
+		case len(vestingPeriods) > 0:
+			genAccount = authvesting.NewPeriodicVestingAccountRaw(baseVestingAccount, vestingStart, vestingPeriods)
+
 		case vestingStart != 0 && vestingEnd != 0:
 			genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
 
@@ -105,7 +132,11 @@ func AddGenesisAccount(
 			break
 		}
 	} else {
-		// Add the new account to the set of genesis accounts and sanitize the accounts afterwards.
+		// Add the new account to the set of genesis accounts with the next account number,
+		// and sort the accounts afterwards.
+		if err := genAccount.SetAccountNumber(authtypes.NextGenesisAccountNumber(accs)); err != nil {
+			return fmt.Errorf("failed to set account number: %w", err)
+		}
 		accs = append(accs, genAccount)
 		accs = authtypes.SanitizeGenesisAccounts(accs)
 
@@ -142,3 +173,163 @@ func AddGenesisAccount(
 	appGenesis.AppState = appStateJSON
 	return ExportGenesisFile(appGenesis, genesisFileURL)
 }
+
+// MigrateVestingAccounts converts the continuous vesting accounts of the genesis
+// state into periodic vesting accounts with periods of the given length, in
+// seconds, using authvesting.NewPeriodicVestingAccountFromContinuous. The genesis
+// file is left untouched if any converted account is invalid. It returns the
+// number of converted accounts.
+func MigrateVestingAccounts(cdc codec.Codec, genesisFileURL string, periodLength int64) (int, error) {
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genesisFileURL)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	migrated := 0
+	for i, acc := range accs {
+		cva, ok := acc.(*authvesting.ContinuousVestingAccount)
+		if !ok {
+			continue
+		}
+
+		pva, err := authvesting.NewPeriodicVestingAccountFromContinuous(cva, periodLength)
+		if err != nil {
+			return 0, fmt.Errorf("failed to migrate vesting account %s: %w", cva.Address, err)
+		}
+
+		accs[i] = pva
+		migrated++
+	}
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	appGenesis.AppState = appStateJSON
+	return migrated, ExportGenesisFile(appGenesis, genesisFileURL)
+}
+
+// ValidateVestingDelegations cross-checks the delegations tracked by the genesis
+// vesting accounts against the staking module. Every delegated token is held by
+// either the bonded or the not bonded pool, so the sum of DelegatedVesting and
+// DelegatedFree across all vesting accounts cannot exceed their balances. Then,
+// for each vesting account, DelegatedVesting plus DelegatedFree must equal the
+// tokens of its delegations and unbonding entries in the staking genesis.
+func ValidateVestingDelegations(cdc codec.Codec, appGenesisState map[string]json.RawMessage) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appGenesisState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	var vaccs []vestingexported.VestingAccount
+	delegated := sdk.NewCoins()
+	for _, acc := range accs {
+		vacc, ok := acc.(vestingexported.VestingAccount)
+		if !ok {
+			continue
+		}
+
+		vaccs = append(vaccs, vacc)
+		delegated = delegated.Add(vacc.GetDelegatedVesting()...).Add(vacc.GetDelegatedFree()...)
+	}
+
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+
+	pooled := sdk.NewCoins()
+	for _, balance := range banktypes.GetGenesisStateFromAppState(cdc, appGenesisState).Balances {
+		if balance.Address == bondedPool || balance.Address == notBondedPool {
+			pooled = pooled.Add(balance.Coins...)
+		}
+	}
+
+	if !delegated.IsAllLTE(pooled) {
+		return fmt.Errorf("delegated coins of vesting accounts (%s) exceed the balances of the bonded and not bonded pools (%s)", delegated, pooled)
+	}
+
+	if len(vaccs) == 0 {
+		return nil
+	}
+
+	stakingGenState := stakingtypes.DefaultGenesisState()
+	if appGenesisState[stakingtypes.ModuleName] != nil {
+		stakingGenState = stakingtypes.GetGenesisStateFromAppState(cdc, appGenesisState)
+	}
+
+	delegatorTokens, err := delegatorTokensFromStakingGenesis(stakingGenState)
+	if err != nil {
+		return err
+	}
+
+	for _, vacc := range vaccs {
+		addr := vacc.GetAddress().String()
+		tracked := vacc.GetDelegatedVesting().Add(vacc.GetDelegatedFree()...)
+		actual := sdk.NewCoins()
+		if tokens, ok := delegatorTokens[addr]; ok {
+			actual = sdk.NewCoins(sdk.NewCoin(stakingGenState.Params.BondDenom, tokens))
+		}
+
+		if !tracked.Equal(actual) {
+			return fmt.Errorf("delegated coins of vesting account %s (%s) do not match its delegations and unbonding entries (%s)", addr, tracked, actual)
+		}
+	}
+
+	return nil
+}
+
+// delegatorTokensFromStakingGenesis returns, for each delegator of the staking
+// genesis, the tokens of its delegations and unbonding entries. The tokens of a
+// delegation are truncated, as when they are undelegated.
+func delegatorTokensFromStakingGenesis(genState *stakingtypes.GenesisState) (map[string]math.Int, error) {
+	validators := make(map[string]stakingtypes.Validator, len(genState.Validators))
+	for _, validator := range genState.Validators {
+		validators[validator.OperatorAddress] = validator
+	}
+
+	tokens := make(map[string]math.Int)
+	add := func(delegator string, amount math.Int) {
+		if total, ok := tokens[delegator]; ok {
+			amount = total.Add(amount)
+		}
+		tokens[delegator] = amount
+	}
+
+	for _, delegation := range genState.Delegations {
+		validator, ok := validators[delegation.ValidatorAddress]
+		if !ok {
+			return nil, fmt.Errorf("delegation of %s to unknown validator %s", delegation.DelegatorAddress, delegation.ValidatorAddress)
+		}
+
+		add(delegation.DelegatorAddress, validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt())
+	}
+
+	for _, ubd := range genState.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			add(ubd.DelegatorAddress, entry.Balance)
+		}
+	}
+
+	return tokens, nil
+}
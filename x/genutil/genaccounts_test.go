@@ -0,0 +1,141 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+)
+
+func TestValidateVestingDelegations(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{}).Codec
+	_, _, addr := testdata.KeyTestPubAddr()
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	// the validator was slashed by 50%, so each share is worth half a token
+	valAddr := sdk.ValAddress(addr).String()
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr,
+		Tokens:          math.NewInt(1000),
+		DelegatorShares: math.LegacyNewDec(2000),
+	}
+	delegation := func(shares int64) stakingtypes.Delegation {
+		return stakingtypes.NewDelegation(addr.String(), valAddr, math.LegacyNewDec(shares))
+	}
+	unbonding := func(balance int64) stakingtypes.UnbondingDelegation {
+		return stakingtypes.UnbondingDelegation{
+			DelegatorAddress: addr.String(),
+			ValidatorAddress: valAddr,
+			Entries:          []stakingtypes.UnbondingDelegationEntry{{InitialBalance: math.NewInt(balance), Balance: math.NewInt(balance)}},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		delegatedVesting sdk.Coins
+		delegatedFree    sdk.Coins
+		balances         []banktypes.Balance
+		delegations      []stakingtypes.Delegation
+		unbondings       []stakingtypes.UnbondingDelegation
+		expErr           string
+	}{
+		{
+			name: "no delegations",
+		},
+		{
+			name:             "delegations held by the pools",
+			delegatedVesting: stake(60),
+			delegatedFree:    stake(40),
+			balances: []banktypes.Balance{
+				{Address: bondedPool, Coins: stake(70)},
+				{Address: notBondedPool, Coins: stake(30)},
+			},
+			delegations: []stakingtypes.Delegation{delegation(140)},
+			unbondings:  []stakingtypes.UnbondingDelegation{unbonding(30)},
+		},
+		{
+			name:             "delegations without pool balances",
+			delegatedVesting: stake(60),
+			balances:         []banktypes.Balance{{Address: addr.String(), Coins: stake(100)}},
+			expErr:           "delegated coins of vesting accounts (60stake) exceed the balances of the bonded and not bonded pools ()",
+		},
+		{
+			name:             "delegations exceeding the pools",
+			delegatedVesting: stake(60),
+			delegatedFree:    stake(40),
+			balances:         []banktypes.Balance{{Address: bondedPool, Coins: stake(99)}},
+			expErr:           "delegated coins of vesting accounts (100stake) exceed the balances of the bonded and not bonded pools (99stake)",
+		},
+		{
+			name:             "delegations over-reported by the account",
+			delegatedVesting: stake(60),
+			delegatedFree:    stake(40),
+			balances:         []banktypes.Balance{{Address: bondedPool, Coins: stake(1000)}},
+			delegations:      []stakingtypes.Delegation{delegation(120)},
+			expErr:           "delegated coins of vesting account " + addr.String() + " (100stake) do not match its delegations and unbonding entries (60stake)",
+		},
+		{
+			name:             "delegations under-reported by the account",
+			delegatedVesting: stake(40),
+			balances:         []banktypes.Balance{{Address: bondedPool, Coins: stake(1000)}},
+			delegations:      []stakingtypes.Delegation{delegation(120)},
+			unbondings:       []stakingtypes.UnbondingDelegation{unbonding(10)},
+			expErr:           "delegated coins of vesting account " + addr.String() + " (40stake) do not match its delegations and unbonding entries (70stake)",
+		},
+		{
+			name:             "delegation to an unknown validator",
+			delegatedVesting: stake(60),
+			balances:         []banktypes.Balance{{Address: bondedPool, Coins: stake(1000)}},
+			delegations:      []stakingtypes.Delegation{stakingtypes.NewDelegation(addr.String(), sdk.ValAddress(bondedPool).String(), math.LegacyNewDec(60))},
+			expErr:           "delegation of " + addr.String() + " to unknown validator " + sdk.ValAddress(bondedPool).String(),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			acc, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(addr), stake(100), 1000)
+			require.NoError(t, err)
+			acc.DelegatedVesting = tc.delegatedVesting
+			acc.DelegatedFree = tc.delegatedFree
+
+			authGenState := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{acc})
+			bankGenState := banktypes.DefaultGenesisState()
+			bankGenState.Balances = tc.balances
+			stakingGenState := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, tc.delegations)
+			stakingGenState.UnbondingDelegations = tc.unbondings
+
+			appState := map[string]json.RawMessage{}
+			appState[authtypes.ModuleName], err = cdc.MarshalJSON(authGenState)
+			require.NoError(t, err)
+			appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState)
+			require.NoError(t, err)
+			appState[stakingtypes.ModuleName], err = cdc.MarshalJSON(stakingGenState)
+			require.NoError(t, err)
+
+			err = genutil.ValidateVestingDelegations(cdc, appState)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -90,6 +90,11 @@ func ValidateAccountInGenesis(
 // DeliverGenTxs iterates over all genesis txs, decodes each into a Tx and
 // invokes the provided deliverTxfn with the decoded Tx. It returns the result
 // of the staking module's ApplyAndReturnValidatorSetUpdates.
+//
+// A gentx may self-delegate the vesting coins of a vesting account, as those are
+// checked against the account balance only. The delegation is tracked on the
+// account by the bank keeper when the staking module delegates the coins, so
+// DelegatedVesting is populated at genesis without any handling here.
 func DeliverGenTxs(
 	ctx context.Context, genTxs []json.RawMessage,
 	stakingKeeper types.StakingKeeper, deliverTx genesis.TxHandler,
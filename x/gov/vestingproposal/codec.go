@@ -0,0 +1,22 @@
+package vestingproposal
+
+import (
+	"cosmossdk.io/core/registry"
+	govtypes "cosmossdk.io/x/gov/types/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterLegacyAminoCodec registers the vesting proposal types with a given LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&ClawbackVestingProposal{}, "cosmos-sdk/ClawbackVestingProposal", nil)
+}
+
+// RegisterInterfaces registers the vesting proposal types as implementations of
+// the gov Content interface.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ClawbackVestingProposal{},
+	)
+}
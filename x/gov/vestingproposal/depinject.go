@@ -0,0 +1,23 @@
+package vestingproposal
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/x/auth/keeper"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
+	"cosmossdk.io/x/auth/vesting/types"
+	govv1beta1 "cosmossdk.io/x/gov/types/v1beta1"
+)
+
+type GovHandlerInputs struct {
+	depinject.In
+
+	AccountKeeper keeper.AccountKeeper
+	PoolKeeper    types.PoolKeeper `optional:"true"`
+	VestingKeeper vestingkeeper.Keeper
+}
+
+// ProvideGovHandler provides the legacy gov route of the vesting proposals, for
+// applications wired with depinject.
+func ProvideGovHandler(in GovHandlerInputs) govv1beta1.HandlerRoute {
+	return govv1beta1.HandlerRoute{RouteKey: RouterKey, Handler: NewClawbackProposalHandler(in.AccountKeeper, in.PoolKeeper, in.VestingKeeper)}
+}
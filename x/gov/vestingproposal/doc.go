@@ -0,0 +1,11 @@
+/*
+Package vestingproposal defines the ClawbackVestingProposal, a gov v1beta1 Content
+type clawing back the coins still vesting of a vesting account to the community
+pool, along with its governance handler.
+
+It lives in x/gov, which depends on x/auth, so that the vesting module does not
+depend on x/gov. It is wired by the application: the handler is routed with the
+vesting RouterKey on the legacy gov router, and the proposal type is registered
+with RegisterInterfaces and RegisterLegacyAminoCodec.
+*/
+package vestingproposal
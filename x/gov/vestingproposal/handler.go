@@ -0,0 +1,88 @@
+package vestingproposal
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/exported"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
+	"cosmossdk.io/x/auth/vesting/types"
+	govtypes "cosmossdk.io/x/gov/types/v1beta1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewClawbackProposalHandler creates a new governance Handler for a
+// ClawbackVestingProposal, sending the clawed back coins to the community pool.
+func NewClawbackProposalHandler(ak keeper.AccountKeeper, pk types.PoolKeeper, vk vestingkeeper.Keeper) govtypes.Handler {
+	return func(ctx context.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *ClawbackVestingProposal:
+			return handleClawbackVestingProposal(ctx, ak, pk, vk, c)
+
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized vesting proposal content type: %T", c)
+		}
+	}
+}
+
+// handleClawbackVestingProposal claws back the coins still vesting of the
+// account of the proposal to the community pool. The delegated vesting coins
+// are excluded from the clawback, and reported in the emitted event: they keep
+// vesting on the schedule of the account, or are returned to the community
+// pool once undelegated for a clawback vesting account.
+func handleClawbackVestingProposal(ctx context.Context, ak keeper.AccountKeeper, pk types.PoolKeeper, vk vestingkeeper.Keeper, p *ClawbackVestingProposal) error {
+	if pk == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("community pool is not available")
+	}
+
+	addr, err := ak.AddressCodec().StringToBytes(p.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.ErrNotFound.Wrapf("account %s does not exist", p.Address)
+	}
+	vestingAccount, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("account %s is not a vesting account", p.Address)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	updated, clawedBack, excluded, err := types.ClawbackVesting(vestingAccount, sdkCtx.HeaderInfo().Time)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	// the clawed back coins are unlocked once the account is updated
+	ak.SetAccount(ctx, updated)
+
+	// the pending clawback of a clawback vesting account is returned to the
+	// community pool once undelegated
+	if _, ok := updated.(*types.ClawbackVestingAccount); ok && !excluded.IsZero() {
+		if err := vk.PendingClawbacks.Set(ctx, addr, true); err != nil {
+			return err
+		}
+	}
+
+	if !clawedBack.IsZero() {
+		if err := pk.FundCommunityPool(ctx, clawedBack, addr); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGovClawback,
+			sdk.NewAttribute(types.AttributeKeyAccount, p.Address),
+			sdk.NewAttribute(types.AttributeKeyAmount, clawedBack.String()),
+			sdk.NewAttribute(types.AttributeKeyExcluded, excluded.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package vestingproposal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
+	vestingtestutil "cosmossdk.io/x/auth/vesting/testutil"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	govtypes "cosmossdk.io/x/gov/types/v1beta1"
+	"cosmossdk.io/x/gov/vestingproposal"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	addr     = sdk.AccAddress([]byte("addr_________________"))
+	fooCoins = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("foo", amount)) }
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	poolKeeper    *vestingtestutil.MockPoolKeeper
+	vestingKeeper vestingkeeper.Keeper
+	handler       govtypes.Handler
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, vestingtypes.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger())
+	s.ctx = testutil.DefaultContextWithKeys(keys, nil, nil).WithHeaderInfo(header.Info{Time: time.Unix(1000, 0)})
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+
+	ctrl := gomock.NewController(s.T())
+	s.poolKeeper = vestingtestutil.NewMockPoolKeeper(ctrl)
+	s.accountKeeper = authkeeper.NewAccountKeeper(
+		env,
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		nil,
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	s.vestingKeeper = vestingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[vestingtypes.StoreKey]), log.NewNopLogger()), s.accountKeeper)
+	s.handler = vestingproposal.NewClawbackProposalHandler(s.accountKeeper, s.poolKeeper, s.vestingKeeper)
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) baseAccount() *authtypes.BaseAccount {
+	return s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount)
+}
+
+func (s *HandlerTestSuite) clawback(ctx sdk.Context) error {
+	return s.handler(ctx, vestingproposal.NewClawbackVestingProposal("title", "description", addr.String()))
+}
+
+func (s *HandlerTestSuite) TestInvalidProposals() {
+	s.accountKeeper.SetAccount(s.ctx, s.baseAccount())
+
+	testCases := []struct {
+		name      string
+		content   govtypes.Content
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			content:   vestingproposal.NewClawbackVestingProposal("title", "description", "invalid"),
+			expErrMsg: "invalid account address",
+		},
+		{
+			name:      "account does not exist",
+			content:   vestingproposal.NewClawbackVestingProposal("title", "description", sdk.AccAddress([]byte("other________________")).String()),
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "not a vesting account",
+			content:   vestingproposal.NewClawbackVestingProposal("title", "description", addr.String()),
+			expErrMsg: "is not a vesting account",
+		},
+		{
+			name:      "unknown content",
+			content:   govtypes.NewTextProposal("title", "description"),
+			expErrMsg: "unrecognized vesting proposal content type",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := s.handler(s.ctx, tc.content)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), tc.expErrMsg)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestClawbackFullyVested() {
+	acc, err := vestingtypes.NewContinuousVestingAccount(s.baseAccount(), fooCoins(100), 0, 500)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// nothing is sent to the community pool
+	s.Require().NoError(s.clawback(s.ctx))
+
+	updated, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(acc.OriginalVesting, updated.OriginalVesting)
+	s.Require().Equal(acc.EndTime, updated.EndTime)
+}
+
+func (s *HandlerTestSuite) TestClawbackContinuous() {
+	acc, err := vestingtypes.NewContinuousVestingAccount(s.baseAccount(), fooCoins(100), 0, 2000)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// half of the coins are vested
+	s.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), fooCoins(50), addr).Return(nil)
+	s.Require().NoError(s.clawback(s.ctx))
+
+	// nothing is left vesting
+	_, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*authtypes.BaseAccount)
+	s.Require().True(ok)
+
+	events := s.ctx.EventManager().Events()
+	s.Require().NotEmpty(events)
+	event := events[len(events)-1]
+	s.Require().Equal(vestingtypes.EventTypeGovClawback, event.Type)
+	amount, _ := event.GetAttribute(vestingtypes.AttributeKeyAmount)
+	s.Require().Equal(fooCoins(50).String(), amount.Value)
+	excluded, _ := event.GetAttribute(vestingtypes.AttributeKeyExcluded)
+	s.Require().Equal("", excluded.Value)
+}
+
+func (s *HandlerTestSuite) TestClawbackContinuousDelegated() {
+	acc, err := vestingtypes.NewContinuousVestingAccount(s.baseAccount(), fooCoins(100), 0, 2000)
+	s.Require().NoError(err)
+	s.Require().NoError(acc.TrackDelegation(s.ctx.HeaderInfo().Time, fooCoins(100), fooCoins(20)))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	s.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), fooCoins(30), addr).Return(nil)
+	s.Require().NoError(s.clawback(s.ctx))
+
+	// the excluded coins keep vesting continuously until the end time
+	updated, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().NoError(updated.Validate())
+	s.Require().Equal(fooCoins(20), updated.OriginalVesting)
+	s.Require().Equal(int64(1000), updated.StartTime)
+	s.Require().Equal(int64(2000), updated.EndTime)
+	s.Require().Equal(fooCoins(20), updated.DelegatedVesting)
+
+	s.Require().NoError(updated.TrackUndelegation(fooCoins(20)))
+	s.Require().Equal(fooCoins(20), updated.LockedCoins(s.ctx.HeaderInfo().Time))
+	s.Require().Equal(fooCoins(10), updated.LockedCoins(time.Unix(1500, 0)))
+	s.Require().Empty(updated.LockedCoins(time.Unix(2000, 0)))
+}
+
+func (s *HandlerTestSuite) TestClawbackPeriodicDelegated() {
+	acc, err := vestingtypes.NewPeriodicVestingAccount(s.baseAccount(), fooCoins(100), 0, vestingtypes.Periods{
+		{Length: 500, Amount: fooCoins(25)},
+		{Length: 500, Amount: fooCoins(25)},
+		{Length: 500, Amount: fooCoins(25)},
+		{Length: 500, Amount: fooCoins(25)},
+	})
+	s.Require().NoError(err)
+
+	// 30 coins are delegated, all of them vesting
+	s.Require().NoError(acc.TrackDelegation(time.Unix(1000, 0), fooCoins(100), fooCoins(30)))
+	s.Require().Equal(fooCoins(30), acc.DelegatedVesting)
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// the delegated vesting coins are excluded from the clawback
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1200, 0)})
+	s.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), fooCoins(20), addr).Return(nil)
+	s.Require().NoError(s.clawback(ctx))
+
+	// the excluded coins keep vesting in the remaining periods
+	updated, ok := s.accountKeeper.GetAccount(ctx, addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().NoError(updated.Validate())
+	s.Require().Equal(fooCoins(80), updated.OriginalVesting)
+	s.Require().Equal(vestingtypes.Periods{
+		{Length: 500, Amount: fooCoins(25)},
+		{Length: 500, Amount: fooCoins(25)},
+		{Length: 500, Amount: fooCoins(15)},
+		{Length: 500, Amount: fooCoins(15)},
+	}, vestingtypes.Periods(updated.VestingPeriods))
+	s.Require().Equal(fooCoins(30), updated.DelegatedVesting)
+	s.Require().Empty(updated.DelegatedFree)
+
+	events := ctx.EventManager().Events()
+	s.Require().NotEmpty(events)
+	excluded, _ := events[len(events)-1].GetAttribute(vestingtypes.AttributeKeyExcluded)
+	s.Require().Equal(fooCoins(30).String(), excluded.Value)
+
+	// once undelegated, the excluded coins unlock with the remaining periods
+	s.Require().NoError(updated.TrackUndelegation(fooCoins(30)))
+	s.Require().Equal(fooCoins(30), updated.LockedCoins(ctx.HeaderInfo().Time))
+	s.Require().Equal(fooCoins(15), updated.LockedCoins(time.Unix(1500, 0)))
+	s.Require().Empty(updated.LockedCoins(time.Unix(2000, 0)))
+}
+
+func (s *HandlerTestSuite) TestClawbackDelayedDelegated() {
+	acc, err := vestingtypes.NewDelayedVestingAccountWithFreeCoins(s.baseAccount(), fooCoins(100), fooCoins(40), 2000)
+	s.Require().NoError(err)
+	s.Require().NoError(acc.TrackDelegation(s.ctx.HeaderInfo().Time, fooCoins(100), fooCoins(70)))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// the 60 vesting coins are delegated first
+	s.Require().Equal(fooCoins(60), acc.DelegatedVesting)
+	s.Require().NoError(s.clawback(s.ctx))
+
+	// the excluded coins keep vesting at the end time
+	updated, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.DelayedVestingAccount)
+	s.Require().True(ok)
+	s.Require().NoError(updated.Validate())
+	s.Require().Equal(fooCoins(100), updated.OriginalVesting)
+	s.Require().Equal(fooCoins(40), updated.FreeCoins)
+	s.Require().Equal(int64(2000), updated.EndTime)
+}
+
+func (s *HandlerTestSuite) TestClawbackClawbackVestingDelegated() {
+	acc, err := vestingtypes.NewClawbackVestingAccount(s.baseAccount(), fooCoins(100), 0, 2000, sdk.AccAddress([]byte("funder_______________")).String())
+	s.Require().NoError(err)
+	s.Require().NoError(acc.TrackDelegation(s.ctx.HeaderInfo().Time, fooCoins(100), fooCoins(20)))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	s.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), fooCoins(30), addr).Return(nil)
+	s.Require().NoError(s.clawback(s.ctx))
+
+	// the delegated vesting coins are pending, and returned to the community
+	// pool once undelegated
+	updated, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(fooCoins(20), updated.PendingClawback)
+	toCommunityPool, err := s.vestingKeeper.PendingClawbacks.Get(s.ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(toCommunityPool)
+}
@@ -0,0 +1,57 @@
+package vestingproposal
+
+import (
+	"strings"
+
+	"cosmossdk.io/x/auth/vesting/types"
+	govtypes "cosmossdk.io/x/gov/types/v1beta1"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ProposalTypeClawbackVesting defines the type for a ClawbackVestingProposal
+	ProposalTypeClawbackVesting = "ClawbackVesting"
+
+	// RouterKey defines the routing key for a ClawbackVestingProposal
+	RouterKey = types.RouterKey
+)
+
+// Assert ClawbackVestingProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &ClawbackVestingProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeClawbackVesting)
+}
+
+// NewClawbackVestingProposal creates a new clawback vesting proposal for the
+// vesting account of the given address.
+func NewClawbackVestingProposal(title, description, address string) *ClawbackVestingProposal {
+	return &ClawbackVestingProposal{Title: title, Description: description, Address: address}
+}
+
+// GetTitle returns the title of a clawback vesting proposal.
+func (cvp *ClawbackVestingProposal) GetTitle() string { return cvp.Title }
+
+// GetDescription returns the description of a clawback vesting proposal.
+func (cvp *ClawbackVestingProposal) GetDescription() string { return cvp.Description }
+
+// ProposalRoute returns the routing key of a clawback vesting proposal.
+func (cvp *ClawbackVestingProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a clawback vesting proposal.
+func (cvp *ClawbackVestingProposal) ProposalType() string { return ProposalTypeClawbackVesting }
+
+// ValidateBasic validates the clawback vesting proposal. The address is checked
+// by the proposal handler, with the address codec of the chain.
+func (cvp *ClawbackVestingProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cvp); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(cvp.Address) == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("vesting account address cannot be empty")
+	}
+
+	return nil
+}
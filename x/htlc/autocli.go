@@ -0,0 +1,79 @@
+package htlc
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	htlcv1 "cosmossdk.io/api/cosmos/htlc/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: htlcv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current htlc parameters",
+				},
+				{
+					RpcMethod:      "Swap",
+					Use:            "swap [swap_id]",
+					Short:          "Query an open or expired swap",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "swap_id"}},
+				},
+				{
+					RpcMethod:      "SwapsBySender",
+					Use:            "swaps-by-sender [sender]",
+					Short:          "Query the open and expired swaps of a sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+				},
+				{
+					RpcMethod:      "SwapsByRecipient",
+					Use:            "swaps-by-recipient [recipient]",
+					Short:          "Query the open and expired swaps of a recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: htlcv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateSwap",
+					Use:            "create [recipient] [amount] [hash_lock] [timeout] --from [sender]",
+					Short:          "Lock an amount paid to a recipient against the secret of a SHA-256 hash lock until the timeout",
+					Example:        fmt.Sprintf("%s tx htlc create cosmos1... 1000stake 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 24h --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount"}, {ProtoField: "hash_lock"}, {ProtoField: "timeout"}},
+				},
+				{
+					RpcMethod:      "ClaimSwap",
+					Use:            "claim [swap_id] [secret] --from [claimer]",
+					Short:          "Pay the amount of a swap to its recipient by revealing the hex-encoded secret",
+					Example:        fmt.Sprintf("%s tx htlc claim 1 74657374 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "swap_id"}, {ProtoField: "secret"}},
+				},
+				{
+					RpcMethod:      "RefundSwap",
+					Use:            "refund [swap_id] --from [sender]",
+					Short:          "Refund the amount of an expired swap to its sender",
+					Example:        fmt.Sprintf("%s tx htlc refund 1 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "swap_id"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update htlc module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update htlc module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query htlc params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx htlc update-params-proposal '{ "max_timeout": "604800s" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/htlc/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker marks the open swaps which timed out as expired, so that they can
+// be refunded to their sender.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	var expired []uint64
+	err := k.Expiry.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(now) {
+			return true, nil
+		}
+		expired = append(expired, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		swap, err := k.Swaps.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		swap.Status = types.StatusExpired
+		if err := k.setSwap(ctx, swap); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeExpireSwap,
+			event.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(id, 10)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/htlc/types"
+)
+
+// InitGenesis initializes the htlc module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	if err := k.NextSwapID.Set(ctx, data.NextSwapId); err != nil {
+		return err
+	}
+
+	for _, swap := range data.Swaps {
+		if err := k.setSwap(ctx, swap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the htlc module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextSwapID, err := k.NextSwapID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var swaps []types.Swap
+	err = k.Swaps.Walk(ctx, nil, func(_ uint64, swap types.Swap) (bool, error) {
+		swaps = append(swaps, swap)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, swaps, nextSwapID), nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/htlc/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/htlc QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the htlc module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Swap returns an open or expired swap.
+func (q queryServer) Swap(ctx context.Context, req *types.QuerySwapRequest) (*types.QuerySwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	swap, err := q.k.GetSwap(ctx, req.SwapId)
+	if err != nil {
+		if errors.Is(err, types.ErrSwapNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QuerySwapResponse{Swap: swap}, nil
+}
+
+// SwapsBySender returns the open and expired swaps of a sender.
+func (q queryServer) SwapsBySender(ctx context.Context, req *types.QuerySwapsBySenderRequest) (*types.QuerySwapsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := q.k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	swaps, pageRes, err := q.paginateSwaps(ctx, q.k.SwapsBySender, sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySwapsBySenderResponse{Swaps: swaps, Pagination: pageRes}, nil
+}
+
+// SwapsByRecipient returns the open and expired swaps of a recipient.
+func (q queryServer) SwapsByRecipient(ctx context.Context, req *types.QuerySwapsByRecipientRequest) (*types.QuerySwapsByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := q.k.addressCodec.StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err)
+	}
+
+	swaps, pageRes, err := q.paginateSwaps(ctx, q.k.SwapsByRecipient, recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySwapsByRecipientResponse{Swaps: swaps, Pagination: pageRes}, nil
+}
+
+// paginateSwaps returns a page of the swaps of an address in the given
+// index.
+func (q queryServer) paginateSwaps(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]],
+	addr sdk.AccAddress,
+	pageReq *query.PageRequest,
+) ([]types.Swap, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		index,
+		pageReq,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Swap, error) {
+			return q.k.Swaps.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/htlc/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the htlc store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	bankKeeper   types.BankKeeper
+	addressCodec address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema     collections.Schema
+	Params     collections.Item[types.Params]
+	NextSwapID collections.Sequence
+	// Swaps contains the open and expired swaps
+	Swaps collections.Map[uint64, types.Swap]
+	// SwapsBySender indexes the swaps by sender
+	SwapsBySender collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// SwapsByRecipient indexes the swaps by recipient
+	SwapsByRecipient collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// Expiry indexes the open swaps by expiration time
+	Expiry collections.KeySet[collections.Pair[time.Time, uint64]]
+}
+
+// NewKeeper creates a new htlc Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:              cdc,
+		environment:      env,
+		bankKeeper:       bk,
+		addressCodec:     ak.AddressCodec(),
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		NextSwapID:       collections.NewSequence(sb, types.NextSwapIDKey, "next_swap_id"),
+		Swaps:            collections.NewMap(sb, types.SwapsPrefix, "swaps", collections.Uint64Key, codec.CollValue[types.Swap](cdc)),
+		SwapsBySender:    collections.NewKeySet(sb, types.SwapsBySenderPrefix, "swaps_by_sender", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		SwapsByRecipient: collections.NewKeySet(sb, types.SwapsByRecipientPrefix, "swaps_by_recipient", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		Expiry:           collections.NewKeySet(sb, types.ExpiryPrefix, "expiry", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/htlc module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetSwap returns an open or expired swap, or ErrSwapNotFound.
+func (k Keeper) GetSwap(ctx context.Context, id uint64) (types.Swap, error) {
+	swap, err := k.Swaps.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return swap, errorsmod.Wrapf(types.ErrSwapNotFound, "swap %d", id)
+	}
+
+	return swap, err
+}
+
+// setSwap stores a swap and its indexes. Only open swaps are indexed by
+// expiration time.
+func (k Keeper) setSwap(ctx context.Context, swap types.Swap) error {
+	sender, err := k.addressCodec.StringToBytes(swap.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addressCodec.StringToBytes(swap.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Swaps.Set(ctx, swap.Id, swap); err != nil {
+		return err
+	}
+	if err := k.SwapsBySender.Set(ctx, collections.Join(sdk.AccAddress(sender), swap.Id)); err != nil {
+		return err
+	}
+	if err := k.SwapsByRecipient.Set(ctx, collections.Join(sdk.AccAddress(recipient), swap.Id)); err != nil {
+		return err
+	}
+
+	if swap.Status == types.StatusOpen {
+		return k.Expiry.Set(ctx, collections.Join(swap.ExpiresAt, swap.Id))
+	}
+
+	return k.Expiry.Remove(ctx, collections.Join(swap.ExpiresAt, swap.Id))
+}
+
+// removeSwap removes a claimed or refunded swap and its indexes.
+func (k Keeper) removeSwap(ctx context.Context, swap types.Swap) error {
+	sender, err := k.addressCodec.StringToBytes(swap.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addressCodec.StringToBytes(swap.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Swaps.Remove(ctx, swap.Id); err != nil {
+		return err
+	}
+	if err := k.SwapsBySender.Remove(ctx, collections.Join(sdk.AccAddress(sender), swap.Id)); err != nil {
+		return err
+	}
+	if err := k.SwapsByRecipient.Remove(ctx, collections.Join(sdk.AccAddress(recipient), swap.Id)); err != nil {
+		return err
+	}
+
+	return k.Expiry.Remove(ctx, collections.Join(swap.ExpiresAt, swap.Id))
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/htlc"
+	"cosmossdk.io/x/htlc/keeper"
+	htlctestutil "cosmossdk.io/x/htlc/testutil"
+	"cosmossdk.io/x/htlc/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *htlctestutil.MockBankKeeper
+	now         time.Time
+
+	sender       sdk.AccAddress
+	senderStr    string
+	recipient    sdk.AccAddress
+	recipientStr string
+	amount       sdk.Coins
+	secret       string
+	hashLock     string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, htlc.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.now})
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := htlctestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	s.bankKeeper = htlctestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	var err error
+	s.sender = sdk.AccAddress("sender")
+	s.senderStr, err = ac.BytesToString(s.sender)
+	s.Require().NoError(err)
+	s.recipient = sdk.AccAddress("recipient")
+	s.recipientStr, err = ac.BytesToString(s.recipient)
+	s.Require().NoError(err)
+
+	s.amount = sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)), sdk.NewCoin("stake", sdkmath.NewInt(50)))
+	s.secret = hex.EncodeToString([]byte("secret"))
+	s.hashLock = types.HashLock([]byte("secret"))
+}
+
+func (s *KeeperTestSuite) createSwap() uint64 {
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.sender, types.ModuleName, s.amount).Return(nil)
+
+	res, err := s.msgServer.CreateSwap(s.ctx, &types.MsgCreateSwap{
+		Sender:    s.senderStr,
+		Recipient: s.recipientStr,
+		Amount:    s.amount,
+		HashLock:  s.hashLock,
+		Timeout:   time.Hour,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.now.Add(time.Hour), res.ExpiresAt)
+
+	return res.SwapId
+}
+
+func (s *KeeperTestSuite) TestCreateSwap() {
+	id := s.createSwap()
+	s.Require().Equal(uint64(1), id)
+
+	swap, err := s.keeper.GetSwap(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusOpen, swap.Status)
+	s.Require().Equal(s.hashLock, swap.HashLock)
+
+	msg := &types.MsgCreateSwap{Sender: s.senderStr, Recipient: s.recipientStr, Amount: s.amount, HashLock: s.hashLock, Timeout: time.Hour}
+
+	invalid := *msg
+	invalid.HashLock = "abcd"
+	_, err = s.msgServer.CreateSwap(s.ctx, &invalid)
+	s.Require().ErrorIs(err, types.ErrInvalidHashLock)
+
+	invalid = *msg
+	invalid.Timeout = types.DefaultParams().MaxTimeout + time.Second
+	_, err = s.msgServer.CreateSwap(s.ctx, &invalid)
+	s.Require().ErrorIs(err, types.ErrInvalidTimeout)
+
+	invalid = *msg
+	invalid.Recipient = s.senderStr
+	_, err = s.msgServer.CreateSwap(s.ctx, &invalid)
+	s.Require().Error(err)
+
+	invalid = *msg
+	invalid.Amount = nil
+	_, err = s.msgServer.CreateSwap(s.ctx, &invalid)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestCreateSwapInsufficientFunds() {
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.sender, types.ModuleName, s.amount).Return(sdkerrors.ErrInsufficientFunds)
+
+	_, err := s.msgServer.CreateSwap(s.ctx, &types.MsgCreateSwap{Sender: s.senderStr, Recipient: s.recipientStr, Amount: s.amount, HashLock: s.hashLock, Timeout: time.Hour})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = s.keeper.GetSwap(s.ctx, 1)
+	s.Require().ErrorIs(err, types.ErrSwapNotFound)
+}
+
+func (s *KeeperTestSuite) TestClaimSwap() {
+	id := s.createSwap()
+
+	_, err := s.msgServer.ClaimSwap(s.ctx, &types.MsgClaimSwap{Claimer: s.senderStr, SwapId: id, Secret: hex.EncodeToString([]byte("wrong"))})
+	s.Require().ErrorIs(err, types.ErrInvalidSecret)
+
+	// any account can claim, the amount is paid to the recipient
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.recipient, s.amount).Return(nil)
+	_, err = s.msgServer.ClaimSwap(s.ctx, &types.MsgClaimSwap{Claimer: s.senderStr, SwapId: id, Secret: s.secret})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.GetSwap(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrSwapNotFound)
+	has, err := s.keeper.SwapsBySender.Has(s.ctx, collections.Join(s.sender, id))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// a swap cannot be claimed once expired
+	id = s.createSwap()
+	expired := s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(time.Hour)})
+	_, err = s.msgServer.ClaimSwap(expired, &types.MsgClaimSwap{Claimer: s.recipientStr, SwapId: id, Secret: s.secret})
+	s.Require().ErrorIs(err, types.ErrSwapExpired)
+}
+
+func (s *KeeperTestSuite) TestExpireAndRefundSwap() {
+	id := s.createSwap()
+
+	_, err := s.msgServer.RefundSwap(s.ctx, &types.MsgRefundSwap{Sender: s.senderStr, SwapId: id})
+	s.Require().ErrorIs(err, types.ErrSwapNotExpired)
+
+	// the swap is still open before its expiration time
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(time.Minute)})))
+	swap, err := s.keeper.GetSwap(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusOpen, swap.Status)
+
+	expired := s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(time.Hour)})
+	s.Require().NoError(s.keeper.EndBlocker(expired))
+	swap, err = s.keeper.GetSwap(expired, id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusExpired, swap.Status)
+
+	_, err = s.msgServer.RefundSwap(expired, &types.MsgRefundSwap{Sender: s.recipientStr, SwapId: id})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.sender, s.amount).Return(nil)
+	_, err = s.msgServer.RefundSwap(expired, &types.MsgRefundSwap{Sender: s.senderStr, SwapId: id})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.GetSwap(expired, id)
+	s.Require().ErrorIs(err, types.ErrSwapNotFound)
+}
+
+func (s *KeeperTestSuite) TestQuerySwaps() {
+	id := s.createSwap()
+
+	res, err := s.queryServer.SwapsBySender(s.ctx, &types.QuerySwapsBySenderRequest{Sender: s.senderStr})
+	s.Require().NoError(err)
+	s.Require().Len(res.Swaps, 1)
+	s.Require().Equal(id, res.Swaps[0].Id)
+
+	recipientRes, err := s.queryServer.SwapsByRecipient(s.ctx, &types.QuerySwapsByRecipientRequest{Recipient: s.senderStr})
+	s.Require().NoError(err)
+	s.Require().Empty(recipientRes.Swaps)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.createSwap()
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(time.Hour)})))
+	s.createSwap()
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.Swaps, 2)
+	s.Require().Equal(uint64(3), gs.NextSwapId)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	// only the open swap is indexed by expiration time
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithHeaderInfo(header.Info{Time: s.now.Add(time.Hour)})))
+	swap, err := s.keeper.GetSwap(s.ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusExpired, swap.Status)
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/htlc/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/htlc MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateSwap locks the amount of the sender in the module account until the
+// swap is claimed or refunded.
+func (ms msgServer) CreateSwap(ctx context.Context, msg *types.MsgCreateSwap) (*types.MsgCreateSwapResponse, error) {
+	sender, err := ms.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := ms.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if msg.Sender == msg.Recipient {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("sender and recipient must differ")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount)
+	}
+
+	hashLock, err := types.ParseHashLock(msg.HashLock)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidHashLock, err.Error())
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Timeout <= 0 || msg.Timeout > params.MaxTimeout {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTimeout, "timeout must be positive and at most %s, got %s", params.MaxTimeout, msg.Timeout)
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.NextSwapID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	swap := types.Swap{
+		Id:        id,
+		Sender:    msg.Sender,
+		Recipient: msg.Recipient,
+		Amount:    msg.Amount,
+		HashLock:  hex.EncodeToString(hashLock),
+		ExpiresAt: ms.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(msg.Timeout),
+		Status:    types.StatusOpen,
+	}
+	if err := ms.setSwap(ctx, swap); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateSwap,
+		event.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, msg.Sender),
+		event.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		event.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		event.NewAttribute(types.AttributeKeyHashLock, swap.HashLock),
+		event.NewAttribute(types.AttributeKeyExpiresAt, swap.ExpiresAt.Format(time.RFC3339Nano)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSwapResponse{SwapId: id, ExpiresAt: swap.ExpiresAt}, nil
+}
+
+// ClaimSwap pays the amount of an open swap to its recipient against its
+// secret. The secret is emitted in an event, so that the counterparty of the
+// swap can use it to claim the other leg of the trade.
+func (ms msgServer) ClaimSwap(ctx context.Context, msg *types.MsgClaimSwap) (*types.MsgClaimSwapResponse, error) {
+	if _, err := ms.addressCodec.StringToBytes(msg.Claimer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid claimer address: %s", err)
+	}
+
+	swap, err := ms.GetSwap(ctx, msg.SwapId)
+	if err != nil {
+		return nil, err
+	}
+	if swap.Expired(ms.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return nil, errorsmod.Wrapf(types.ErrSwapExpired, "swap %d", swap.Id)
+	}
+	if err := swap.VerifySecret(msg.Secret); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSecret, err.Error())
+	}
+
+	recipient, err := ms.addressCodec.StringToBytes(swap.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, swap.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := ms.removeSwap(ctx, swap); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeClaimSwap,
+		event.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(swap.Id, 10)),
+		event.NewAttribute(types.AttributeKeyRecipient, swap.Recipient),
+		event.NewAttribute(types.AttributeKeyAmount, swap.Amount.String()),
+		event.NewAttribute(types.AttributeKeyHashLock, swap.HashLock),
+		event.NewAttribute(types.AttributeKeySecret, msg.Secret),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimSwapResponse{}, nil
+}
+
+// RefundSwap refunds the amount of an expired swap to its sender.
+func (ms msgServer) RefundSwap(ctx context.Context, msg *types.MsgRefundSwap) (*types.MsgRefundSwapResponse, error) {
+	sender, err := ms.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	swap, err := ms.GetSwap(ctx, msg.SwapId)
+	if err != nil {
+		return nil, err
+	}
+	if swap.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the sender of swap %d", msg.Sender, swap.Id)
+	}
+	if !swap.Expired(ms.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return nil, errorsmod.Wrapf(types.ErrSwapNotExpired, "swap %d expires at %s", swap.Id, swap.ExpiresAt.Format(time.RFC3339))
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, swap.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := ms.removeSwap(ctx, swap); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRefundSwap,
+		event.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(swap.Id, 10)),
+		event.NewAttribute(types.AttributeKeySender, swap.Sender),
+		event.NewAttribute(types.AttributeKeyAmount, swap.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundSwapResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/htlc/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgCreateSwap{}, "cosmos-sdk/htlc/MsgCreateSwap")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimSwap{}, "cosmos-sdk/htlc/MsgClaimSwap")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundSwap{}, "cosmos-sdk/htlc/MsgRefundSwap")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/htlc/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateSwap{},
+		&MsgClaimSwap{},
+		&MsgRefundSwap{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/htlc module sentinel errors
+var (
+	ErrInvalidSigner   = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrSwapNotFound    = errors.Register(ModuleName, 2, "swap not found")
+	ErrUnauthorized    = errors.Register(ModuleName, 3, "unauthorized swap operation")
+	ErrInvalidTimeout  = errors.Register(ModuleName, 4, "invalid swap timeout")
+	ErrInvalidHashLock = errors.Register(ModuleName, 5, "invalid hash lock")
+	ErrInvalidSecret   = errors.Register(ModuleName, 6, "invalid secret")
+	ErrSwapExpired     = errors.Register(ModuleName, 7, "swap expired")
+	ErrSwapNotExpired  = errors.Register(ModuleName, 8, "swap not expired")
+)
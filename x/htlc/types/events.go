@@ -0,0 +1,17 @@
+package types
+
+// htlc module event types
+const (
+	EventTypeCreateSwap = "create_swap"
+	EventTypeClaimSwap  = "claim_swap"
+	EventTypeExpireSwap = "expire_swap"
+	EventTypeRefundSwap = "refund_swap"
+
+	AttributeKeySwapID    = "swap_id"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyHashLock  = "hash_lock"
+	AttributeKeyExpiresAt = "expires_at"
+	AttributeKeySecret    = "secret"
+)
@@ -0,0 +1,40 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, swaps []Swap, nextSwapID uint64) *GenesisState {
+	return &GenesisState{Params: params, Swaps: swaps, NextSwapId: nextSwapID}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 1)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextSwapId == 0 {
+		return fmt.Errorf("next swap id must be positive")
+	}
+
+	seen := make(map[uint64]struct{}, len(gs.Swaps))
+	for _, s := range gs.Swaps {
+		if s.Id == 0 || s.Id >= gs.NextSwapId {
+			return fmt.Errorf("swap id %d must be positive and lower than the next swap id %d", s.Id, gs.NextSwapId)
+		}
+		if _, ok := seen[s.Id]; ok {
+			return fmt.Errorf("duplicate swap %d", s.Id)
+		}
+		seen[s.Id] = struct{}{}
+
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
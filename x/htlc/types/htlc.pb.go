@@ -0,0 +1,855 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/htlc/v1/htlc.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SwapStatus is the status of a swap.
+type SwapStatus int32
+
+const (
+	// SWAP_STATUS_UNSPECIFIED defines an invalid status.
+	StatusUnspecified SwapStatus = 0
+	// SWAP_STATUS_OPEN defines a swap which can be claimed by revealing its
+	// secret.
+	StatusOpen SwapStatus = 1
+	// SWAP_STATUS_EXPIRED defines a swap which timed out and can be refunded to
+	// its sender.
+	StatusExpired SwapStatus = 2
+)
+
+var SwapStatus_name = map[int32]string{
+	0: "SWAP_STATUS_UNSPECIFIED",
+	1: "SWAP_STATUS_OPEN",
+	2: "SWAP_STATUS_EXPIRED",
+}
+
+var SwapStatus_value = map[string]int32{
+	"SWAP_STATUS_UNSPECIFIED": 0,
+	"SWAP_STATUS_OPEN":        1,
+	"SWAP_STATUS_EXPIRED":     2,
+}
+
+func (x SwapStatus) String() string {
+	return proto.EnumName(SwapStatus_name, int32(x))
+}
+
+func (SwapStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f77d05831efd779b, []int{0}
+}
+
+// Params defines the parameters for the x/htlc module.
+type Params struct {
+	// max_timeout is the maximum time a swap can stay open.
+	MaxTimeout time.Duration `protobuf:"bytes,1,opt,name=max_timeout,json=maxTimeout,proto3,stdduration" json:"max_timeout"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f77d05831efd779b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxTimeout() time.Duration {
+	if m != nil {
+		return m.MaxTimeout
+	}
+	return 0
+}
+
+// Swap is a hashed-timelock contract: its amount is paid to the recipient
+// against the secret whose SHA-256 digest is the hash lock, or refunded to the
+// sender once it expired.
+type Swap struct {
+	// id is the unique identifier of the swap.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address which locked the amount of the swap.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address paid when the swap is claimed.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount locked in the swap.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// hash_lock is the hex-encoded SHA-256 digest of the secret.
+	HashLock string `protobuf:"bytes,5,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	// expires_at is the time after which the swap can no longer be claimed.
+	ExpiresAt time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// status is the status of the swap.
+	Status SwapStatus `protobuf:"varint,7,opt,name=status,proto3,enum=cosmos.htlc.v1.SwapStatus" json:"status,omitempty"`
+}
+
+func (m *Swap) Reset()         { *m = Swap{} }
+func (m *Swap) String() string { return proto.CompactTextString(m) }
+func (*Swap) ProtoMessage()    {}
+func (*Swap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f77d05831efd779b, []int{1}
+}
+func (m *Swap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Swap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Swap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Swap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Swap.Merge(m, src)
+}
+func (m *Swap) XXX_Size() int {
+	return m.Size()
+}
+func (m *Swap) XXX_DiscardUnknown() {
+	xxx_messageInfo_Swap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Swap proto.InternalMessageInfo
+
+func (m *Swap) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Swap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Swap) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Swap) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Swap) GetHashLock() string {
+	if m != nil {
+		return m.HashLock
+	}
+	return ""
+}
+
+func (m *Swap) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *Swap) GetStatus() SwapStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.htlc.v1.SwapStatus", SwapStatus_name, SwapStatus_value)
+	proto.RegisterType((*Params)(nil), "cosmos.htlc.v1.Params")
+	proto.RegisterType((*Swap)(nil), "cosmos.htlc.v1.Swap")
+}
+
+func init() { proto.RegisterFile("cosmos/htlc/v1/htlc.proto", fileDescriptor_f77d05831efd779b) }
+
+var fileDescriptor_f77d05831efd779b = []byte{
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8d, 0xd3, 0xfc, 0xf2, 0x23, 0x57, 0x1a, 0xa5, 0xa6, 0x80, 0xeb, 0x4a, 0x8e, 0x55, 0x31,
+	0x44, 0x91, 0x6a, 0x93, 0x54, 0x62, 0x60, 0x4b, 0xda, 0x54, 0x44, 0x42, 0x6d, 0x14, 0xa7, 0x02,
+	0xb1, 0x58, 0x17, 0xfb, 0xea, 0x9c, 0x12, 0xfb, 0x2c, 0xdf, 0xb9, 0xa4, 0xff, 0x01, 0xca, 0xd4,
+	0x09, 0x55, 0x48, 0x9d, 0x58, 0x10, 0x53, 0x07, 0xfe, 0x88, 0x8e, 0x15, 0x13, 0x13, 0x45, 0xed,
+	0xd0, 0x7f, 0x03, 0xf9, 0xee, 0x4a, 0x03, 0x0c, 0x2c, 0x89, 0xfd, 0x7d, 0xef, 0xdd, 0xf7, 0xbe,
+	0xf7, 0xce, 0x60, 0xd5, 0x23, 0x34, 0x24, 0xd4, 0x1e, 0xb1, 0x89, 0x67, 0x1f, 0x36, 0xf8, 0xbf,
+	0x15, 0x27, 0x84, 0x11, 0xb5, 0x2c, 0x5a, 0x16, 0x2f, 0x1d, 0x36, 0xf4, 0x95, 0x80, 0x04, 0x84,
+	0xb7, 0xec, 0xec, 0x49, 0xa0, 0x74, 0x23, 0x20, 0x24, 0x98, 0x20, 0x9b, 0xbf, 0x0d, 0xd3, 0x03,
+	0xdb, 0x4f, 0x13, 0xc8, 0x30, 0x89, 0x64, 0xbf, 0xfa, 0x67, 0x9f, 0xe1, 0x10, 0x51, 0x06, 0xc3,
+	0x58, 0x02, 0xa4, 0x02, 0x57, 0x9c, 0x2c, 0x67, 0xca, 0xb3, 0xa5, 0xb8, 0x21, 0xa4, 0xc8, 0x3e,
+	0x6c, 0x0c, 0x11, 0x83, 0x0d, 0xdb, 0x23, 0xf8, 0xf6, 0xec, 0x65, 0x18, 0xe2, 0x88, 0xd8, 0xfc,
+	0x57, 0x94, 0xd6, 0x63, 0x50, 0xec, 0xc1, 0x04, 0x86, 0x54, 0xed, 0x82, 0xc5, 0x10, 0x4e, 0xdd,
+	0x6c, 0x1c, 0x49, 0x99, 0xa6, 0x98, 0x4a, 0x6d, 0xb1, 0xb9, 0x6a, 0x09, 0x39, 0xd6, 0xad, 0x1c,
+	0x6b, 0x5b, 0xca, 0x6d, 0x2f, 0x9d, 0x7f, 0xaf, 0xe6, 0x4e, 0x2e, 0xab, 0xca, 0xa7, 0x9b, 0xb3,
+	0xba, 0xd2, 0x07, 0x21, 0x9c, 0x0e, 0x04, 0xf7, 0xf9, 0xda, 0xec, 0xe6, 0xac, 0xfe, 0x48, 0x88,
+	0xd9, 0xa0, 0xfe, 0x58, 0xb8, 0x25, 0xe6, 0xac, 0xbf, 0x5f, 0x00, 0x05, 0xe7, 0x2d, 0x8c, 0xd5,
+	0x32, 0xc8, 0x63, 0x9f, 0xcf, 0x29, 0xf4, 0xf3, 0xd8, 0x57, 0x9f, 0x82, 0x22, 0x45, 0x91, 0x8f,
+	0x12, 0x2d, 0x6f, 0x2a, 0xb5, 0x52, 0x5b, 0xfb, 0xfa, 0x65, 0x63, 0x45, 0xee, 0xd7, 0xf2, 0xfd,
+	0x04, 0x51, 0xea, 0xb0, 0x04, 0x47, 0x41, 0x5f, 0xe2, 0xd4, 0x67, 0xa0, 0x94, 0x20, 0x0f, 0xc7,
+	0x18, 0x45, 0x4c, 0x5b, 0xf8, 0x07, 0xe9, 0x0e, 0xaa, 0x1e, 0x81, 0x22, 0x0c, 0x49, 0x1a, 0x31,
+	0xad, 0x60, 0x2e, 0xf0, 0x2d, 0x25, 0x23, 0x33, 0xce, 0x92, 0xc6, 0x59, 0x5b, 0x04, 0x47, 0xed,
+	0x9d, 0x6c, 0xcb, 0xcf, 0x97, 0xd5, 0x5a, 0x80, 0xd9, 0x28, 0x1d, 0x5a, 0x1e, 0x09, 0xa5, 0xe7,
+	0xf6, 0xdc, 0x7e, 0xec, 0x28, 0x46, 0x94, 0x13, 0xe8, 0x87, 0x9b, 0xb3, 0xfa, 0xfd, 0x09, 0x0a,
+	0xa0, 0x77, 0xe4, 0x66, 0xd6, 0x53, 0x61, 0x8f, 0x1c, 0xa8, 0xae, 0x81, 0xd2, 0x08, 0xd2, 0x91,
+	0x3b, 0x21, 0xde, 0x58, 0xfb, 0x2f, 0x93, 0xdc, 0xbf, 0x97, 0x15, 0x5e, 0x12, 0x6f, 0xac, 0xbe,
+	0x00, 0x00, 0x4d, 0x63, 0x9c, 0x20, 0xea, 0x42, 0xa6, 0x15, 0x79, 0x02, 0xfa, 0x5f, 0x09, 0x0c,
+	0x6e, 0x2f, 0x84, 0x88, 0xe0, 0xf8, 0x57, 0x04, 0x25, 0x49, 0x6e, 0x31, 0xb5, 0x09, 0x8a, 0x94,
+	0x41, 0x96, 0x52, 0xed, 0x7f, 0x53, 0xa9, 0x95, 0x9b, 0xba, 0xf5, 0xfb, 0xe5, 0xb4, 0xb2, 0x04,
+	0x1c, 0x8e, 0xe8, 0x4b, 0x64, 0xfd, 0x44, 0x01, 0xe0, 0xae, 0xac, 0x36, 0xc1, 0x63, 0xe7, 0x55,
+	0xab, 0xe7, 0x3a, 0x83, 0xd6, 0x60, 0xdf, 0x71, 0xf7, 0x77, 0x9d, 0x5e, 0x67, 0xab, 0xbb, 0xd3,
+	0xed, 0x6c, 0x57, 0x72, 0xfa, 0xc3, 0xd9, 0xa9, 0xb9, 0x2c, 0x80, 0xfb, 0x11, 0x8d, 0x91, 0x87,
+	0x0f, 0x30, 0xf2, 0xd5, 0x27, 0xa0, 0x32, 0xcf, 0xd9, 0xeb, 0x75, 0x76, 0x2b, 0x8a, 0x5e, 0x9e,
+	0x9d, 0x9a, 0x40, 0x80, 0xf7, 0x62, 0x14, 0xa9, 0x75, 0xf0, 0x60, 0x1e, 0xd5, 0x79, 0xdd, 0xeb,
+	0xf6, 0x3b, 0xdb, 0x95, 0xbc, 0xbe, 0x3c, 0x3b, 0x35, 0x97, 0x04, 0xb0, 0xc3, 0x57, 0xf1, 0xf5,
+	0xc2, 0xbb, 0x8f, 0x46, 0xae, 0xbd, 0x79, 0x7e, 0x65, 0x28, 0x17, 0x57, 0x86, 0xf2, 0xe3, 0xca,
+	0x50, 0x8e, 0xaf, 0x8d, 0xdc, 0xc5, 0xb5, 0x91, 0xfb, 0x76, 0x6d, 0xe4, 0xde, 0xc8, 0xaf, 0x81,
+	0xfa, 0x63, 0x0b, 0x13, 0x7b, 0x2a, 0x6e, 0x1a, 0x8f, 0x63, 0x58, 0xe4, 0x8e, 0x6d, 0xfe, 0x1c,
+	0x00, 0x6a, 0x12, 0xee, 0x5e, 0xb3, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHtlc(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Swap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHtlc(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHtlc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHtlc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHtlc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout)
+	n += 1 + l + sovHtlc(uint64(l))
+	return n
+}
+
+func (m *Swap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHtlc(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHtlc(uint64(l))
+		}
+	}
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovHtlc(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovHtlc(uint64(m.Status))
+	}
+	return n
+}
+
+func sovHtlc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHtlc(x uint64) (n int) {
+	return sovHtlc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHtlc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHtlc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Swap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHtlc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Swap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Swap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashLock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHtlc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHtlc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHtlc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHtlc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHtlc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHtlc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHtlc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHtlc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHtlc = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,24 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "htlc"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey              = collections.NewPrefix(0)
+	NextSwapIDKey          = collections.NewPrefix(1)
+	SwapsPrefix            = collections.NewPrefix(2)
+	SwapsBySenderPrefix    = collections.NewPrefix(3)
+	SwapsByRecipientPrefix = collections.NewPrefix(4)
+	ExpiryPrefix           = collections.NewPrefix(5)
+)
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultParams returns default x/htlc module parameters. Swaps stay open for
+// at most a week.
+func DefaultParams() Params {
+	return Params{
+		MaxTimeout: 7 * 24 * time.Hour,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.MaxTimeout <= 0 {
+		return fmt.Errorf("max timeout must be positive: %s", p.MaxTimeout)
+	}
+
+	return nil
+}
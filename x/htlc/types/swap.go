@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// ParseHashLock decodes a hex-encoded hash lock, which must be a SHA-256
+// digest.
+func ParseHashLock(hashLock string) ([]byte, error) {
+	bz, err := hex.DecodeString(hashLock)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not hex-encoded: %w", hashLock, err)
+	}
+	if len(bz) != sha256.Size {
+		return nil, fmt.Errorf("hash lock must be %d bytes long, got %d", sha256.Size, len(bz))
+	}
+
+	return bz, nil
+}
+
+// HashLock returns the hex-encoded hash lock of a secret: its SHA-256 digest.
+func HashLock(secret []byte) string {
+	digest := sha256.Sum256(secret)
+	return hex.EncodeToString(digest[:])
+}
+
+// Validate performs a basic validation of the swap.
+func (s Swap) Validate() error {
+	if s.Sender == "" || s.Recipient == "" {
+		return fmt.Errorf("swap %d must have a sender and a recipient", s.Id)
+	}
+	if s.Sender == s.Recipient {
+		return fmt.Errorf("swap %d sender and recipient must differ", s.Id)
+	}
+	if err := s.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount of swap %d: %w", s.Id, err)
+	}
+	if s.Amount.IsZero() {
+		return fmt.Errorf("amount of swap %d cannot be zero", s.Id)
+	}
+	if _, err := ParseHashLock(s.HashLock); err != nil {
+		return fmt.Errorf("invalid hash lock of swap %d: %w", s.Id, err)
+	}
+	if s.Status != StatusOpen && s.Status != StatusExpired {
+		return fmt.Errorf("invalid status of swap %d: %s", s.Id, s.Status)
+	}
+
+	return nil
+}
+
+// VerifySecret returns an error unless the hex-encoded secret unlocks the
+// hash lock of the swap.
+func (s Swap) VerifySecret(secret string) error {
+	bz, err := hex.DecodeString(secret)
+	if err != nil {
+		return fmt.Errorf("secret is not hex-encoded: %w", err)
+	}
+
+	hashLock, err := ParseHashLock(s.HashLock)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(bz)
+	if !bytes.Equal(digest[:], hashLock) {
+		return fmt.Errorf("secret does not match the hash lock of swap %d", s.Id)
+	}
+
+	return nil
+}
+
+// Expired returns true if the swap can no longer be claimed at the given time.
+// Expired swaps are only marked as such at the end of the block.
+func (s Swap) Expired(now time.Time) bool {
+	return s.Status == StatusExpired || !now.Before(s.ExpiresAt)
+}
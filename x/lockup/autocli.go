@@ -0,0 +1,90 @@
+package lockup
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	lockupv1 "cosmossdk.io/api/cosmos/lockup/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: lockupv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current lockup parameters",
+				},
+				{
+					RpcMethod:      "Lock",
+					Use:            "lock [lock_id]",
+					Short:          "Query a lock",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lock_id"}},
+				},
+				{
+					RpcMethod:      "AccountLocks",
+					Use:            "account-locks [owner]",
+					Short:          "Query the locks of an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "LockedByDuration",
+					Use:            "locked-by-duration [min_duration]",
+					Short:          "Query the coins locked for at least a duration, by an owner or by all owners",
+					Example:        fmt.Sprintf("%s query lockup locked-by-duration 168h --owner cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "min_duration"}},
+				},
+				{
+					RpcMethod:      "Gauge",
+					Use:            "gauge [gauge_id]",
+					Short:          "Query a gauge",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gauge_id"}},
+				},
+				{
+					RpcMethod: "Gauges",
+					Use:       "gauges",
+					Short:     "Query the gauges which are not fully distributed",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: lockupv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "LockTokens",
+					Use:            "lock-tokens [coins] [duration] --from [owner]",
+					Short:          "Lock coins for one of the lock durations",
+					Example:        fmt.Sprintf("%s tx lockup lock-tokens 1000stake 168h --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "coins"}, {ProtoField: "duration"}},
+				},
+				{
+					RpcMethod:      "BeginUnlocking",
+					Use:            "begin-unlocking [lock_id] --from [owner]",
+					Short:          "Begin the unlocking of a lock, whose coins are released once its duration elapsed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lock_id"}},
+				},
+				{
+					RpcMethod:      "CreateGauge",
+					Use:            "create-gauge [denom] [min_duration] [coins] [num_epochs] --from [creator]",
+					Short:          "Create a gauge distributing rewards to the locks of a denom over a number of epochs",
+					Example:        fmt.Sprintf("%s tx lockup create-gauge stake 168h 10000reward 30 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "min_duration"}, {ProtoField: "coins"}, {ProtoField: "num_epochs"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update lockup module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update lockup module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query lockup params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx lockup update-params-proposal '{ "epoch_duration": "86400s", "lock_durations": ["86400s", "604800s"] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker releases the coins of the locks which finished unlocking and, at
+// the end of every epoch, distributes the epoch rewards of the active gauges.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if err := k.releaseUnlocks(ctx, now); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	epochStart, err := k.EpochStart.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		// the first epoch starts at the first block
+		return k.EpochStart.Set(ctx, now)
+	case err != nil:
+		return err
+	case now.Before(epochStart.Add(params.EpochDuration)):
+		return nil
+	}
+
+	if err := k.EpochStart.Set(ctx, now); err != nil {
+		return err
+	}
+
+	return k.distributeGauges(ctx, now)
+}
+
+// releaseUnlocks releases the coins of the locks which finished unlocking. A
+// lock which fails to be released is retried at the next block.
+func (k Keeper) releaseUnlocks(ctx context.Context, now time.Time) error {
+	var released []uint64
+	err := k.Unlocks.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(now) {
+			return true, nil
+		}
+		released = append(released, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range released {
+		lock, err := k.Locks.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// a lock which cannot be released, e.g. because its coins are not
+		// sendable, is left unchanged and retried at the next block, so that it
+		// does not halt the chain
+		if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.unlock(ctx, lock)
+		}); err != nil {
+			k.Logger(ctx).Error("failed to release lock", "lock_id", id, "err", err)
+		}
+	}
+
+	return nil
+}
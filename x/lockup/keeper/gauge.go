@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateGauge creates a gauge funded by creator, distributing the given coins
+// over numEpochs epochs to the locks of denom for at least minDuration. The
+// gauge is distributed from startTime, or from the next epoch if nil. Other
+// modules can use it to attach incentives to locks.
+func (k Keeper) CreateGauge(
+	ctx context.Context,
+	creator sdk.AccAddress,
+	denom string,
+	minDuration time.Duration,
+	coins sdk.Coins,
+	startTime *time.Time,
+	numEpochs uint64,
+) (uint64, error) {
+	creatorStr, err := k.addressCodec.BytesToString(creator)
+	if err != nil {
+		return 0, err
+	}
+
+	gauge := types.Gauge{
+		Creator:     creatorStr,
+		Denom:       denom,
+		MinDuration: minDuration,
+		Coins:       coins,
+		StartTime:   k.environment.HeaderService.GetHeaderInfo(ctx).Time,
+		NumEpochs:   numEpochs,
+	}
+	if startTime != nil {
+		gauge.StartTime = *startTime
+	}
+	if err := gauge.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidGauge, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+
+	gauge.Id, err = k.NextGaugeID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.Gauges.Set(ctx, gauge.Id, gauge); err != nil {
+		return 0, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateGauge,
+		event.NewAttribute(types.AttributeKeyGaugeID, strconv.FormatUint(gauge.Id, 10)),
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+	); err != nil {
+		return 0, err
+	}
+
+	return gauge.Id, nil
+}
+
+// distributeGauges distributes the epoch rewards of every active gauge.
+func (k Keeper) distributeGauges(ctx context.Context, now time.Time) error {
+	var gauges []types.Gauge
+	err := k.Gauges.Walk(ctx, nil, func(_ uint64, gauge types.Gauge) (bool, error) {
+		if gauge.IsActive(now) {
+			gauges = append(gauges, gauge)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, gauge := range gauges {
+		// a gauge which cannot be distributed is left unchanged, so that its
+		// epoch rewards are distributed later, and does not halt the chain
+		if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.distributeGauge(ctx, gauge)
+		}); err != nil {
+			k.Logger(ctx).Error("failed to distribute gauge", "gauge_id", gauge.Id, "err", err)
+		}
+	}
+
+	return nil
+}
+
+// distributeGauge distributes the epoch rewards of a gauge to the qualifying
+// locks, pro-rata to their amount of the gauge denom. An epoch without any
+// qualifying lock is not counted, so that its rewards are distributed later.
+func (k Keeper) distributeGauge(ctx context.Context, gauge types.Gauge) error {
+	var (
+		locks []types.Lock
+		total = sdkmath.ZeroInt()
+		rng   = collections.NewPrefixedPairRange[string, uint64](gauge.Denom)
+	)
+	err := k.LocksByDenom.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		lock, err := k.Locks.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if !lock.IsUnlocking() && lock.Duration >= gauge.MinDuration {
+			locks = append(locks, lock)
+			total = total.Add(lock.Coins.AmountOf(gauge.Denom))
+		}
+		return false, nil
+	})
+	if err != nil || total.IsZero() {
+		return err
+	}
+
+	epochRewards := gauge.EpochRewards()
+	distributed := sdk.NewCoins()
+	for _, lock := range locks {
+		weight := lock.Coins.AmountOf(gauge.Denom)
+		rewards := sdk.NewCoins()
+		for _, coin := range epochRewards {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(weight).Quo(total)))
+		}
+		if rewards.IsZero() {
+			continue
+		}
+
+		owner, err := k.addressCodec.StringToBytes(lock.Owner)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, rewards); err != nil {
+			return err
+		}
+		distributed = distributed.Add(rewards...)
+	}
+
+	gauge.DistributedCoins = gauge.DistributedCoins.Add(distributed...)
+	gauge.FilledEpochs++
+	if gauge.FilledEpochs == gauge.NumEpochs {
+		err = k.Gauges.Remove(ctx, gauge.Id)
+	} else {
+		err = k.Gauges.Set(ctx, gauge.Id, gauge)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDistribute,
+		event.NewAttribute(types.AttributeKeyGaugeID, strconv.FormatUint(gauge.Id, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, distributed.String()),
+	)
+}
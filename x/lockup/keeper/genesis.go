@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/lockup/types"
+)
+
+// InitGenesis initializes the lockup module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	if err := k.NextLockID.Set(ctx, data.NextLockId); err != nil {
+		return err
+	}
+	for _, lock := range data.Locks {
+		if err := k.setLock(ctx, lock); err != nil {
+			return err
+		}
+	}
+
+	if err := k.NextGaugeID.Set(ctx, data.NextGaugeId); err != nil {
+		return err
+	}
+	for _, gauge := range data.Gauges {
+		if err := k.Gauges.Set(ctx, gauge.Id, gauge); err != nil {
+			return err
+		}
+	}
+
+	if data.EpochStart != nil {
+		return k.EpochStart.Set(ctx, *data.EpochStart)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the lockup module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextLockID, err := k.NextLockID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locks []types.Lock
+	err = k.Locks.Walk(ctx, nil, func(_ uint64, lock types.Lock) (bool, error) {
+		locks = append(locks, lock)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nextGaugeID, err := k.NextGaugeID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var gauges []types.Gauge
+	err = k.Gauges.Walk(ctx, nil, func(_ uint64, gauge types.Gauge) (bool, error) {
+		gauges = append(gauges, gauge)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs := types.NewGenesisState(params, locks, nextLockID, gauges, nextGaugeID, nil)
+	epochStart, err := k.EpochStart.Get(ctx)
+	switch {
+	case err == nil:
+		gs.EpochStart = &epochStart
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/lockup QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the lockup module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Lock returns a lock.
+func (q queryServer) Lock(ctx context.Context, req *types.QueryLockRequest) (*types.QueryLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	lock, err := q.k.GetLock(ctx, req.LockId)
+	if err != nil {
+		if errors.Is(err, types.ErrLockNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryLockResponse{Lock: lock}, nil
+}
+
+// AccountLocks returns the locks of an owner.
+func (q queryServer) AccountLocks(ctx context.Context, req *types.QueryAccountLocksRequest) (*types.QueryAccountLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := q.k.addressCodec.StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	locks, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.LocksByOwner,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Lock, error) {
+			return q.k.Locks.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// LockedByDuration returns the coins locked for at least a duration, and not
+// unlocking, by an owner or by all owners.
+func (q queryServer) LockedByDuration(ctx context.Context, req *types.QueryLockedByDurationRequest) (*types.QueryLockedByDurationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		bz, err := q.k.addressCodec.StringToBytes(req.Owner)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+		}
+		owner = bz
+	}
+
+	coins, err := q.k.LockedByDuration(ctx, owner, req.MinDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLockedByDurationResponse{Coins: coins}, nil
+}
+
+// Gauge returns a gauge which is not fully distributed.
+func (q queryServer) Gauge(ctx context.Context, req *types.QueryGaugeRequest) (*types.QueryGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	gauge, err := q.k.GetGauge(ctx, req.GaugeId)
+	if err != nil {
+		if errors.Is(err, types.ErrGaugeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryGaugeResponse{Gauge: gauge}, nil
+}
+
+// Gauges returns the gauges which are not fully distributed.
+func (q queryServer) Gauges(ctx context.Context, req *types.QueryGaugesRequest) (*types.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	gauges, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Gauges,
+		req.Pagination,
+		func(_ uint64, gauge types.Gauge) (types.Gauge, error) {
+			return gauge, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGaugesResponse{Gauges: gauges, Pagination: pageRes}, nil
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the lockup store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	bankKeeper   types.BankKeeper
+	addressCodec address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema     collections.Schema
+	Params     collections.Item[types.Params]
+	NextLockID collections.Sequence
+	// Locks contains the locks
+	Locks collections.Map[uint64, types.Lock]
+	// LocksByOwner indexes the locks by owner
+	LocksByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// LocksByDenom indexes the locks by locked denom
+	LocksByDenom collections.KeySet[collections.Pair[string, uint64]]
+	// Unlocks indexes the unlocking locks by release time
+	Unlocks     collections.KeySet[collections.Pair[time.Time, uint64]]
+	NextGaugeID collections.Sequence
+	// Gauges contains the gauges which are not fully distributed
+	Gauges collections.Map[uint64, types.Gauge]
+	// EpochStart is the start time of the current epoch
+	EpochStart collections.Item[time.Time]
+}
+
+// NewKeeper creates a new lockup Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:          cdc,
+		environment:  env,
+		bankKeeper:   bk,
+		addressCodec: ak.AddressCodec(),
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		NextLockID:   collections.NewSequence(sb, types.NextLockIDKey, "next_lock_id"),
+		Locks:        collections.NewMap(sb, types.LocksPrefix, "locks", collections.Uint64Key, codec.CollValue[types.Lock](cdc)),
+		LocksByOwner: collections.NewKeySet(sb, types.LocksByOwnerPrefix, "locks_by_owner", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		LocksByDenom: collections.NewKeySet(sb, types.LocksByDenomPrefix, "locks_by_denom", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		Unlocks:      collections.NewKeySet(sb, types.UnlocksPrefix, "unlocks", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		NextGaugeID:  collections.NewSequence(sb, types.NextGaugeIDKey, "next_gauge_id"),
+		Gauges:       collections.NewMap(sb, types.GaugesPrefix, "gauges", collections.Uint64Key, codec.CollValue[types.Gauge](cdc)),
+		EpochStart:   collections.NewItem(sb, types.EpochStartKey, "epoch_start", collcodec.KeyToValueCodec(sdk.TimeKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/lockup module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetLock returns a lock, or ErrLockNotFound.
+func (k Keeper) GetLock(ctx context.Context, id uint64) (types.Lock, error) {
+	lock, err := k.Locks.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return lock, errorsmod.Wrapf(types.ErrLockNotFound, "lock %d", id)
+	}
+
+	return lock, err
+}
+
+// GetGauge returns a gauge which is not fully distributed, or ErrGaugeNotFound.
+func (k Keeper) GetGauge(ctx context.Context, id uint64) (types.Gauge, error) {
+	gauge, err := k.Gauges.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return gauge, errorsmod.Wrapf(types.ErrGaugeNotFound, "gauge %d", id)
+	}
+
+	return gauge, err
+}
+
+// LockedByDuration returns the coins locked for at least the given duration,
+// and not unlocking, by an owner, or by all owners if owner is nil.
+func (k Keeper) LockedByDuration(ctx context.Context, owner sdk.AccAddress, minDuration time.Duration) (sdk.Coins, error) {
+	locked := sdk.NewCoins()
+	add := func(lock types.Lock) {
+		if !lock.IsUnlocking() && lock.Duration >= minDuration {
+			locked = locked.Add(lock.Coins...)
+		}
+	}
+
+	if owner == nil {
+		err := k.Locks.Walk(ctx, nil, func(_ uint64, lock types.Lock) (bool, error) {
+			add(lock)
+			return false, nil
+		})
+		return locked, err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](owner)
+	err := k.LocksByOwner.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64]) (bool, error) {
+		lock, err := k.Locks.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		add(lock)
+		return false, nil
+	})
+
+	return locked, err
+}
+
+// setLock stores a lock and its indexes.
+func (k Keeper) setLock(ctx context.Context, lock types.Lock) error {
+	owner, err := k.addressCodec.StringToBytes(lock.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Locks.Set(ctx, lock.Id, lock); err != nil {
+		return err
+	}
+	if err := k.LocksByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), lock.Id)); err != nil {
+		return err
+	}
+	for _, coin := range lock.Coins {
+		if err := k.LocksByDenom.Set(ctx, collections.Join(coin.Denom, lock.Id)); err != nil {
+			return err
+		}
+	}
+	if lock.IsUnlocking() {
+		return k.Unlocks.Set(ctx, collections.Join(*lock.UnlocksAt, lock.Id))
+	}
+
+	return nil
+}
+
+// unlock releases the coins of a lock to its owner and removes the lock.
+func (k Keeper) unlock(ctx context.Context, lock types.Lock) error {
+	owner, err := k.addressCodec.StringToBytes(lock.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, lock.Coins); err != nil {
+		return err
+	}
+
+	if err := k.Locks.Remove(ctx, lock.Id); err != nil {
+		return err
+	}
+	if err := k.LocksByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), lock.Id)); err != nil {
+		return err
+	}
+	for _, coin := range lock.Coins {
+		if err := k.LocksByDenom.Remove(ctx, collections.Join(coin.Denom, lock.Id)); err != nil {
+			return err
+		}
+	}
+	if lock.IsUnlocking() {
+		if err := k.Unlocks.Remove(ctx, collections.Join(*lock.UnlocksAt, lock.Id)); err != nil {
+			return err
+		}
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUnlock,
+		event.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(lock.Id, 10)),
+		event.NewAttribute(types.AttributeKeyOwner, lock.Owner),
+		event.NewAttribute(sdk.AttributeKeyAmount, lock.Coins.String()),
+	)
+}
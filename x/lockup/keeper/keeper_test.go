@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/lockup"
+	"cosmossdk.io/x/lockup/keeper"
+	lockuptestutil "cosmossdk.io/x/lockup/testutil"
+	"cosmossdk.io/x/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+var (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *lockuptestutil.MockBankKeeper
+	now         time.Time
+
+	alice, bob       sdk.AccAddress
+	aliceStr, bobStr string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, lockup.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.now})
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := lockuptestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	s.bankKeeper = lockuptestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	var err error
+	s.alice, s.bob = sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	s.aliceStr, err = ac.BytesToString(s.alice)
+	s.Require().NoError(err)
+	s.bobStr, err = ac.BytesToString(s.bob)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) lock(owner sdk.AccAddress, ownerStr string, coins sdk.Coins, duration time.Duration) uint64 {
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, coins).Return(nil)
+	res, err := s.msgServer.LockTokens(s.ctx, &types.MsgLockTokens{Owner: ownerStr, Coins: coins, Duration: duration})
+	s.Require().NoError(err)
+	return res.LockId
+}
+
+func (s *KeeperTestSuite) setTime(t time.Time) {
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: t})
+}
+
+func (s *KeeperTestSuite) TestLockAndUnlock() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	_, err := s.msgServer.LockTokens(s.ctx, &types.MsgLockTokens{Owner: s.aliceStr, Coins: coins, Duration: time.Hour})
+	s.Require().ErrorIs(err, types.ErrInvalidDuration)
+
+	id := s.lock(s.alice, s.aliceStr, coins, week)
+	s.lock(s.alice, s.aliceStr, coins, day)
+
+	locked, err := s.queryServer.LockedByDuration(s.ctx, &types.QueryLockedByDurationRequest{Owner: s.aliceStr, MinDuration: week})
+	s.Require().NoError(err)
+	s.Require().Equal(coins, locked.Coins)
+	locked, err = s.queryServer.LockedByDuration(s.ctx, &types.QueryLockedByDurationRequest{MinDuration: day})
+	s.Require().NoError(err)
+	s.Require().Equal(coins.Add(coins...), locked.Coins)
+
+	_, err = s.msgServer.BeginUnlocking(s.ctx, &types.MsgBeginUnlocking{Owner: s.bobStr, LockId: id})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	res, err := s.msgServer.BeginUnlocking(s.ctx, &types.MsgBeginUnlocking{Owner: s.aliceStr, LockId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(s.now.Add(week), res.UnlocksAt)
+
+	_, err = s.msgServer.BeginUnlocking(s.ctx, &types.MsgBeginUnlocking{Owner: s.aliceStr, LockId: id})
+	s.Require().ErrorIs(err, types.ErrAlreadyUnlocking)
+
+	// unlocking coins are no longer counted as locked
+	locked, err = s.queryServer.LockedByDuration(s.ctx, &types.QueryLockedByDurationRequest{Owner: s.aliceStr, MinDuration: week})
+	s.Require().NoError(err)
+	s.Require().True(locked.Coins.IsZero())
+
+	s.setTime(s.now.Add(week - time.Second))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	_, err = s.keeper.GetLock(s.ctx, id)
+	s.Require().NoError(err)
+
+	s.setTime(s.now.Add(week))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.alice, coins).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	_, err = s.keeper.GetLock(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrLockNotFound)
+
+	locks, err := s.queryServer.AccountLocks(s.ctx, &types.QueryAccountLocksRequest{Owner: s.aliceStr})
+	s.Require().NoError(err)
+	s.Require().Len(locks.Locks, 1)
+}
+
+func (s *KeeperTestSuite) TestUnlockFailure() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	id := s.lock(s.alice, s.aliceStr, coins, day)
+	_, err := s.msgServer.BeginUnlocking(s.ctx, &types.MsgBeginUnlocking{Owner: s.aliceStr, LockId: id})
+	s.Require().NoError(err)
+
+	// a lock which fails to be released does not fail the block, and is retried
+	// at the next one
+	s.setTime(s.now.Add(day))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.alice, coins).Return(errors.New("release failed"))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	_, err = s.keeper.GetLock(s.ctx, id)
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.alice, coins).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	_, err = s.keeper.GetLock(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrLockNotFound)
+}
+
+func (s *KeeperTestSuite) TestGaugeDistribution() {
+	s.lock(s.alice, s.aliceStr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), week)
+	s.lock(s.bob, s.bobStr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 2*week)
+	// too short to qualify for the gauge
+	s.lock(s.bob, s.bobStr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), day)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("reward", 800))
+	_, err := s.msgServer.CreateGauge(s.ctx, &types.MsgCreateGauge{
+		Creator: s.aliceStr, Denom: sdk.DefaultBondDenom, MinDuration: week, Coins: rewards,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidGauge)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.alice, types.ModuleName, rewards).Return(nil)
+	res, err := s.msgServer.CreateGauge(s.ctx, &types.MsgCreateGauge{
+		Creator: s.aliceStr, Denom: sdk.DefaultBondDenom, MinDuration: week, Coins: rewards, NumEpochs: 2,
+	})
+	s.Require().NoError(err)
+
+	// the first block starts the first epoch
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	for epoch := 1; epoch <= 2; epoch++ {
+		s.setTime(s.now.Add(time.Duration(epoch) * day))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.alice, sdk.NewCoins(sdk.NewInt64Coin("reward", 300))).Return(nil)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.bob, sdk.NewCoins(sdk.NewInt64Coin("reward", 100))).Return(nil)
+		s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+		// nothing is distributed before the end of the next epoch
+		s.setTime(s.now.Add(time.Duration(epoch)*day + time.Hour))
+		s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	}
+
+	_, err = s.keeper.GetGauge(s.ctx, res.GaugeId)
+	s.Require().ErrorIs(err, types.ErrGaugeNotFound)
+}
+
+func (s *KeeperTestSuite) TestGaugeWithoutLocks() {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("reward", 100))
+	creator := sdk.AccAddress(types.ModuleName)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), creator, types.ModuleName, rewards).Return(nil)
+	start := s.now.Add(2 * day)
+	id, err := s.keeper.CreateGauge(s.ctx, creator, sdk.DefaultBondDenom, 0, rewards, &start, 1)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	s.setTime(s.now.Add(day))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	// an epoch without qualifying locks is not counted
+	s.setTime(s.now.Add(2 * day))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	gauge, err := s.keeper.GetGauge(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Zero(gauge.FilledEpochs)
+
+	s.lock(s.alice, s.aliceStr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), day)
+	s.setTime(s.now.Add(3 * day))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.alice, rewards).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.aliceStr, Params: types.DefaultParams()})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: govModuleNameStr,
+		Params:    types.Params{EpochDuration: day, LockDurations: []time.Duration{week, day}},
+	})
+	s.Require().Error(err)
+
+	params := types.Params{EpochDuration: time.Hour, LockDurations: []time.Duration{day}}
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestExportGenesis() {
+	id := s.lock(s.alice, s.aliceStr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), week)
+	_, err := s.msgServer.BeginUnlocking(s.ctx, &types.MsgBeginUnlocking{Owner: s.aliceStr, LockId: id})
+	s.Require().NoError(err)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("reward", 100))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.bob, types.ModuleName, rewards).Return(nil)
+	_, err = s.keeper.CreateGauge(s.ctx, s.bob, sdk.DefaultBondDenom, week, rewards, nil, 10)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.Locks, 1)
+	s.Require().NotNil(gs.Locks[0].UnlocksAt)
+	s.Require().Len(gs.Gauges, 1)
+	s.Require().Equal(uint64(2), gs.NextLockId)
+	s.Require().Equal(uint64(2), gs.NextGaugeId)
+	s.Require().Equal(s.now, *gs.EpochStart)
+}
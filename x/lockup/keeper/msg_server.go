@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/lockup MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// LockTokens locks coins of the owner in the module account for one of the
+// lock durations.
+func (ms msgServer) LockTokens(ctx context.Context, msg *types.MsgLockTokens) (*types.MsgLockTokensResponse, error) {
+	owner, err := ms.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid coins: %s", msg.Coins)
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.IsLockDuration(msg.Duration) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDuration, "%s is not one of %v", msg.Duration, params.LockDurations)
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, msg.Coins); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.NextLockID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := types.Lock{Id: id, Owner: msg.Owner, Coins: msg.Coins, Duration: msg.Duration}
+	if err := ms.setLock(ctx, lock); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeLockTokens,
+		event.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Coins.String()),
+		event.NewAttribute(types.AttributeKeyDuration, msg.Duration.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockTokensResponse{LockId: id}, nil
+}
+
+// BeginUnlocking begins the unlocking of a lock. The lock no longer earns
+// rewards, and its coins are released once its duration elapsed.
+func (ms msgServer) BeginUnlocking(ctx context.Context, msg *types.MsgBeginUnlocking) (*types.MsgBeginUnlockingResponse, error) {
+	lock, err := ms.GetLock(ctx, msg.LockId)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of lock %d", msg.Owner, msg.LockId)
+	}
+	if lock.IsUnlocking() {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyUnlocking, "lock %d unlocks at %s", msg.LockId, lock.UnlocksAt)
+	}
+
+	unlocksAt := ms.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(lock.Duration)
+	lock.UnlocksAt = &unlocksAt
+	if err := ms.setLock(ctx, lock); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBeginUnlocking,
+		event.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(msg.LockId, 10)),
+		event.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		event.NewAttribute(types.AttributeKeyUnlocksAt, unlocksAt.Format(time.RFC3339Nano)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBeginUnlockingResponse{UnlocksAt: unlocksAt}, nil
+}
+
+// CreateGauge creates a gauge funded by its creator.
+func (ms msgServer) CreateGauge(ctx context.Context, msg *types.MsgCreateGauge) (*types.MsgCreateGaugeResponse, error) {
+	creator, err := ms.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	id, err := ms.Keeper.CreateGauge(ctx, creator, msg.Denom, msg.MinDuration, msg.Coins, msg.StartTime, msg.NumEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGaugeResponse{GaugeId: id}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/lockup/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgLockTokens{}, "cosmos-sdk/lockup/MsgLockTokens")
+	legacy.RegisterAminoMsg(cdc, &MsgBeginUnlocking{}, "cosmos-sdk/lockup/MsgBeginUnlocking")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGauge{}, "cosmos-sdk/lockup/MsgCreateGauge")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/lockup/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgLockTokens{},
+		&MsgBeginUnlocking{},
+		&MsgCreateGauge{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
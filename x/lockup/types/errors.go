@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/lockup module sentinel errors
+var (
+	ErrInvalidSigner    = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrInvalidDuration  = errors.Register(ModuleName, 2, "invalid lock duration")
+	ErrLockNotFound     = errors.Register(ModuleName, 3, "lock not found")
+	ErrUnauthorized     = errors.Register(ModuleName, 4, "not the owner of the lock")
+	ErrAlreadyUnlocking = errors.Register(ModuleName, 5, "lock already unlocking")
+	ErrGaugeNotFound    = errors.Register(ModuleName, 6, "gauge not found")
+	ErrInvalidGauge     = errors.Register(ModuleName, 7, "invalid gauge")
+)
@@ -0,0 +1,18 @@
+package types
+
+// lockup module event types
+const (
+	EventTypeLockTokens     = "lock_tokens"
+	EventTypeBeginUnlocking = "begin_unlocking"
+	EventTypeUnlock         = "unlock"
+	EventTypeCreateGauge    = "create_gauge"
+	EventTypeDistribute     = "distribute"
+
+	AttributeKeyLockID    = "lock_id"
+	AttributeKeyGaugeID   = "gauge_id"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyCreator   = "creator"
+	AttributeKeyDuration  = "duration"
+	AttributeKeyUnlocksAt = "unlocks_at"
+	AttributeKeyDenom     = "denom"
+)
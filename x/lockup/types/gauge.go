@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the gauge.
+func (g Gauge) Validate() error {
+	if g.Creator == "" {
+		return fmt.Errorf("gauge %d must have a creator", g.Id)
+	}
+	if err := sdk.ValidateDenom(g.Denom); err != nil {
+		return fmt.Errorf("invalid denom of gauge %d: %w", g.Id, err)
+	}
+	if g.MinDuration < 0 {
+		return fmt.Errorf("min duration of gauge %d cannot be negative", g.Id)
+	}
+	if err := g.Coins.Validate(); err != nil {
+		return fmt.Errorf("invalid coins of gauge %d: %w", g.Id, err)
+	}
+	if g.Coins.IsZero() {
+		return fmt.Errorf("coins of gauge %d cannot be zero", g.Id)
+	}
+	if err := g.DistributedCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid distributed coins of gauge %d: %w", g.Id, err)
+	}
+	if !g.Coins.IsAllGTE(g.DistributedCoins) {
+		return fmt.Errorf("distributed coins of gauge %d exceed its coins", g.Id)
+	}
+	if g.NumEpochs == 0 {
+		return fmt.Errorf("gauge %d must be distributed over at least one epoch", g.Id)
+	}
+	if g.FilledEpochs >= g.NumEpochs {
+		return fmt.Errorf("gauge %d is fully distributed", g.Id)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the gauge is distributed at the given time.
+func (g Gauge) IsActive(now time.Time) bool {
+	return !now.Before(g.StartTime) && g.FilledEpochs < g.NumEpochs
+}
+
+// EpochRewards returns the rewards distributed by the gauge in its next
+// epoch: an equal share of the remaining rewards over the remaining epochs.
+func (g Gauge) EpochRewards() sdk.Coins {
+	remaining := g.Coins.Sub(g.DistributedCoins...)
+	epochs := int64(g.NumEpochs - g.FilledEpochs)
+
+	rewards := sdk.NewCoins()
+	for _, coin := range remaining {
+		rewards = rewards.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(epochs)))
+	}
+
+	return rewards
+}
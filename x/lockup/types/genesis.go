@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, locks []Lock, nextLockID uint64, gauges []Gauge, nextGaugeID uint64, epochStart *time.Time) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		Locks:       locks,
+		NextLockId:  nextLockID,
+		Gauges:      gauges,
+		NextGaugeId: nextGaugeID,
+		EpochStart:  epochStart,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 1, nil, 1, nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextLockId == 0 || gs.NextGaugeId == 0 {
+		return fmt.Errorf("next lock and gauge ids must be positive")
+	}
+
+	seen := make(map[uint64]struct{}, len(gs.Locks))
+	for _, l := range gs.Locks {
+		if l.Id == 0 || l.Id >= gs.NextLockId {
+			return fmt.Errorf("lock id %d must be positive and lower than the next lock id %d", l.Id, gs.NextLockId)
+		}
+		if _, ok := seen[l.Id]; ok {
+			return fmt.Errorf("duplicate lock %d", l.Id)
+		}
+		seen[l.Id] = struct{}{}
+
+		if err := l.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen = make(map[uint64]struct{}, len(gs.Gauges))
+	for _, g := range gs.Gauges {
+		if g.Id == 0 || g.Id >= gs.NextGaugeId {
+			return fmt.Errorf("gauge id %d must be positive and lower than the next gauge id %d", g.Id, gs.NextGaugeId)
+		}
+		if _, ok := seen[g.Id]; ok {
+			return fmt.Errorf("duplicate gauge %d", g.Id)
+		}
+		seen[g.Id] = struct{}{}
+
+		if err := g.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
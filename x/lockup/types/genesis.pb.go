@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/lockup/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the lockup module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// locks are the existing locks.
+	Locks []Lock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+	// next_lock_id is the identifier of the next lock.
+	NextLockId uint64 `protobuf:"varint,3,opt,name=next_lock_id,json=nextLockId,proto3" json:"next_lock_id,omitempty"`
+	// gauges are the gauges which are not fully distributed.
+	Gauges []Gauge `protobuf:"bytes,4,rep,name=gauges,proto3" json:"gauges"`
+	// next_gauge_id is the identifier of the next gauge.
+	NextGaugeId uint64 `protobuf:"varint,5,opt,name=next_gauge_id,json=nextGaugeId,proto3" json:"next_gauge_id,omitempty"`
+	// epoch_start is the start time of the current epoch, unset until the first
+	// block.
+	EpochStart *time.Time `protobuf:"bytes,6,opt,name=epoch_start,json=epochStart,proto3,stdtime" json:"epoch_start,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4a84f1631751444, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLockId() uint64 {
+	if m != nil {
+		return m.NextLockId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextGaugeId() uint64 {
+	if m != nil {
+		return m.NextGaugeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEpochStart() *time.Time {
+	if m != nil {
+		return m.EpochStart
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.lockup.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("cosmos/lockup/v1/genesis.proto", fileDescriptor_d4a84f1631751444) }
+
+var fileDescriptor_d4a84f1631751444 = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x14, 0x86, 0x63, 0x08, 0x91, 0xae, 0xc3, 0x95, 0xee, 0x8d, 0xaa, 0x36, 0xa2, 0x6a, 0x88, 0x98,
+	0x50, 0x07, 0x5b, 0x50, 0x31, 0x75, 0x6a, 0x16, 0x84, 0xd4, 0xa1, 0x0a, 0x9d, 0xba, 0x20, 0x93,
+	0xb8, 0x6e, 0x04, 0xc1, 0x11, 0x36, 0x88, 0xbe, 0x05, 0x8f, 0xd1, 0xb1, 0x6f, 0xd0, 0x95, 0x91,
+	0xb1, 0x53, 0x5b, 0xc1, 0xd0, 0xd7, 0xa8, 0x6c, 0x87, 0xa5, 0x2c, 0x51, 0xce, 0xf9, 0xff, 0xff,
+	0x3b, 0x27, 0x27, 0x30, 0x48, 0xb8, 0xc8, 0xb9, 0xc0, 0x53, 0x9e, 0x4c, 0x16, 0x05, 0x5e, 0x76,
+	0x30, 0xa3, 0x33, 0x2a, 0x32, 0x81, 0x8a, 0x39, 0x97, 0xdc, 0xfb, 0x67, 0x74, 0x64, 0x74, 0xb4,
+	0xec, 0x34, 0x4e, 0x18, 0x67, 0x5c, 0x8b, 0x58, 0xbd, 0x19, 0x5f, 0xa3, 0xc9, 0x38, 0x67, 0x53,
+	0x8a, 0x75, 0x35, 0x5e, 0x3c, 0x62, 0x99, 0xe5, 0x54, 0x48, 0x92, 0x17, 0xa5, 0xe1, 0x3f, 0xc9,
+	0xb3, 0x19, 0xc7, 0xfa, 0x59, 0xb6, 0x2e, 0x8e, 0x66, 0x97, 0x53, 0xb4, 0xdc, 0x7a, 0xab, 0xc0,
+	0x7a, 0xdf, 0x2c, 0x33, 0x94, 0x44, 0x52, 0xef, 0x1a, 0x3a, 0x05, 0x99, 0x93, 0x5c, 0xf8, 0x20,
+	0x04, 0x6d, 0xb7, 0xeb, 0xa3, 0xdf, 0xcb, 0xa1, 0x3b, 0xad, 0x47, 0x7f, 0x36, 0x1f, 0x4d, 0xeb,
+	0xe5, 0xfb, 0xf5, 0x12, 0xc4, 0x65, 0xc4, 0xeb, 0xc2, 0x9a, 0xb2, 0x09, 0xbf, 0x12, 0x56, 0xdb,
+	0x6e, 0xf7, 0xf4, 0x38, 0x7b, 0xcb, 0x93, 0x49, 0x64, 0xab, 0x64, 0x6c, 0xac, 0x5e, 0x08, 0xeb,
+	0x33, 0xba, 0x92, 0x23, 0x55, 0x8d, 0xb2, 0xd4, 0xaf, 0x86, 0xa0, 0x6d, 0xc7, 0x50, 0xf5, 0x94,
+	0x79, 0x90, 0x7a, 0x3d, 0xe8, 0x30, 0xb2, 0x60, 0x54, 0xf8, 0xb6, 0xc6, 0x9e, 0x1d, 0x63, 0xfb,
+	0x4a, 0x2f, 0xb9, 0xa5, 0xd9, 0x6b, 0xc1, 0xbf, 0x1a, 0xac, 0x4b, 0x45, 0xae, 0x69, 0xb2, 0xab,
+	0x9a, 0xda, 0x3f, 0x48, 0xbd, 0x1b, 0xe8, 0xd2, 0x82, 0x27, 0x4f, 0x23, 0x21, 0xc9, 0x5c, 0xfa,
+	0x8e, 0xfe, 0xe4, 0x06, 0x32, 0x77, 0x46, 0x87, 0x3b, 0xa3, 0xfb, 0xc3, 0x9d, 0x23, 0x7b, 0xfd,
+	0xd9, 0x04, 0x31, 0xd4, 0xa1, 0xa1, 0xca, 0x44, 0xbd, 0xcd, 0x2e, 0x00, 0xdb, 0x5d, 0x00, 0xbe,
+	0x76, 0x01, 0x58, 0xef, 0x03, 0x6b, 0xbb, 0x0f, 0xac, 0xf7, 0x7d, 0x60, 0x3d, 0x9c, 0x9b, 0x35,
+	0x45, 0x3a, 0x41, 0x19, 0xc7, 0xab, 0xc3, 0x2f, 0x90, 0xcf, 0x05, 0x15, 0x63, 0x47, 0xc3, 0xaf,
+	0x7e, 0x06, 0x00, 0x7f, 0xcb, 0xea, 0x3a, 0x1c, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochStart != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EpochStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EpochStart):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGenesis(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NextGaugeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextGaugeId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextLockId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLockId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLockId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLockId))
+	}
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextGaugeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextGaugeId))
+	}
+	if m.EpochStart != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EpochStart)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockId", wireType)
+			}
+			m.NextLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGaugeId", wireType)
+			}
+			m.NextGaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextGaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochStart == nil {
+				m.EpochStart = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EpochStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
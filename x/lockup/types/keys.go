@@ -0,0 +1,27 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "lockup"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey          = collections.NewPrefix(0)
+	NextLockIDKey      = collections.NewPrefix(1)
+	LocksPrefix        = collections.NewPrefix(2)
+	LocksByOwnerPrefix = collections.NewPrefix(3)
+	LocksByDenomPrefix = collections.NewPrefix(4)
+	UnlocksPrefix      = collections.NewPrefix(5)
+	NextGaugeIDKey     = collections.NewPrefix(6)
+	GaugesPrefix       = collections.NewPrefix(7)
+	EpochStartKey      = collections.NewPrefix(8)
+)
@@ -0,0 +1,26 @@
+package types
+
+import "fmt"
+
+// Validate performs a basic validation of the lock.
+func (l Lock) Validate() error {
+	if l.Owner == "" {
+		return fmt.Errorf("lock %d must have an owner", l.Id)
+	}
+	if err := l.Coins.Validate(); err != nil {
+		return fmt.Errorf("invalid coins of lock %d: %w", l.Id, err)
+	}
+	if l.Coins.IsZero() {
+		return fmt.Errorf("coins of lock %d cannot be zero", l.Id)
+	}
+	if l.Duration <= 0 {
+		return fmt.Errorf("duration of lock %d must be positive", l.Id)
+	}
+
+	return nil
+}
+
+// IsUnlocking returns true if the owner began unlocking the lock.
+func (l Lock) IsUnlocking() bool {
+	return l.UnlocksAt != nil
+}
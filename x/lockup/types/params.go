@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultParams returns default x/lockup module parameters. Gauges are
+// distributed daily, and coins can be locked for a day, a week or two weeks.
+func DefaultParams() Params {
+	return Params{
+		EpochDuration: 24 * time.Hour,
+		LockDurations: []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 14 * 24 * time.Hour},
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.EpochDuration <= 0 {
+		return fmt.Errorf("epoch duration must be positive: %s", p.EpochDuration)
+	}
+	if len(p.LockDurations) == 0 {
+		return fmt.Errorf("lock durations cannot be empty")
+	}
+
+	for i, d := range p.LockDurations {
+		if d <= 0 {
+			return fmt.Errorf("lock duration must be positive: %s", d)
+		}
+		if i > 0 && d <= p.LockDurations[i-1] {
+			return fmt.Errorf("lock durations must be sorted in strictly increasing order")
+		}
+	}
+
+	return nil
+}
+
+// IsLockDuration returns true if coins can be locked for the given duration.
+func (p Params) IsLockDuration(d time.Duration) bool {
+	for _, lockDuration := range p.LockDurations {
+		if lockDuration == d {
+			return true
+		}
+	}
+
+	return false
+}
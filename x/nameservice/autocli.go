@@ -0,0 +1,87 @@
+package nameservice
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	nameservicev1 "cosmossdk.io/api/cosmos/nameservice/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: nameservicev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current nameservice parameters",
+				},
+				{
+					RpcMethod:      "Name",
+					Use:            "name [name]",
+					Short:          "Query the registration of a name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "Resolve",
+					Use:            "resolve [name]",
+					Short:          "Query the address a name resolves to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "RegistrationFee",
+					Use:            "registration-fee [name]",
+					Short:          "Query the fee of a registration period of a name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: nameservicev1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "RegisterName",
+					Use:            "register [name] [periods] --from [owner]",
+					Short:          "Register a name for a number of registration periods",
+					Example:        fmt.Sprintf("%s tx nameservice register alice 1 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "periods"}},
+				},
+				{
+					RpcMethod:      "RenewName",
+					Use:            "renew [name] [periods] --from [owner]",
+					Short:          "Extend the registration of a name by a number of registration periods",
+					Example:        fmt.Sprintf("%s tx nameservice renew alice 1 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "periods"}},
+				},
+				{
+					RpcMethod:      "TransferName",
+					Use:            "transfer [name] [new-owner] --from [owner]",
+					Short:          "Transfer a name to a new owner, clearing its records",
+					Example:        fmt.Sprintf("%s tx nameservice transfer alice cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "SetNameRecords",
+					Use:            "set-records [name] --from [owner]",
+					Short:          "Set the address a name resolves to and replace its records",
+					Example:        fmt.Sprintf(`%s tx nameservice set-records alice --address cosmos1... --records '{"key":"url","value":"https://example.com"}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update nameservice module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update nameservice module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query nameservice params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx nameservice update-params-proposal '{ "registration_period": "31536000s", "fee_schedule": [{"min_length":3,"fee":[{"denom":"stake","amount":"10000000"}]}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/nameservice/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/nameservice transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Name service transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSendToNameCmd(),
+	)
+
+	return txCmd
+}
+
+// NewSendToNameCmd returns a CLI command handler for creating a bank MsgSend
+// transaction to the address a name resolves to.
+func NewSendToNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [from_key_or_address] [to_name] [amount]",
+		Short: "Send funds to the address a registered name resolves to",
+		Long: `Send funds to the address a registered name resolves to.
+The name is resolved when the transaction is built, and the transaction is a
+regular bank send to the resolved address.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].`,
+		Example: fmt.Sprintf("%s tx nameservice send mykey alice 10stake", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Resolve(cmd.Context(), &types.QueryResolveRequest{Name: args[1]})
+			if err != nil {
+				return fmt.Errorf("failed to resolve %s: %w", args[1], err)
+			}
+
+			from, err := clientCtx.AddressCodec.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := banktypes.NewMsgSend(from, res.Address, coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
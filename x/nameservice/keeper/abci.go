@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/nameservice/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker removes the names whose registration expired.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	var expired []collections.Pair[time.Time, string]
+	err := k.Expiry.Walk(ctx, nil, func(key collections.Pair[time.Time, string]) (bool, error) {
+		if key.K1().After(now) {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.Expiry.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.Names.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeExpireName,
+			event.NewAttribute(types.AttributeKeyName, key.K2()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/nameservice/types"
+)
+
+// InitGenesis initializes the nameservice module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, record := range data.Names {
+		if err := k.setName(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the nameservice module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []types.NameRecord
+	err = k.Names.Walk(ctx, nil, func(_ string, record types.NameRecord) (bool, error) {
+		names = append(names, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, names), nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/nameservice/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/nameservice QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the nameservice module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Name returns the registration of a name.
+func (q queryServer) Name(ctx context.Context, req *types.QueryNameRequest) (*types.QueryNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := q.k.GetName(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, types.ErrNameNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryNameResponse{Name: record}, nil
+}
+
+// Resolve returns the address a name resolves to.
+func (q queryServer) Resolve(ctx context.Context, req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := q.k.GetName(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, types.ErrNameNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryResolveResponse{Address: record.Address}, nil
+}
+
+// RegistrationFee returns the fee of a registration period of a name.
+func (q queryServer) RegistrationFee(ctx context.Context, req *types.QueryRegistrationFeeRequest) (*types.QueryRegistrationFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateName(req.Name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, ok := params.RegistrationFee(req.Name)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is too short", req.Name)
+	}
+
+	return &types.QueryRegistrationFeeResponse{Fee: fee}, nil
+}
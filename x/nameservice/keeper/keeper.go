@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/nameservice/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the nameservice store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	bankKeeper   types.BankKeeper
+	addressCodec address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Names contains the registered names
+	Names collections.Map[string, types.NameRecord]
+	// Expiry indexes the registered names by expiration time
+	Expiry collections.KeySet[collections.Pair[time.Time, string]]
+}
+
+// NewKeeper creates a new nameservice Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:          cdc,
+		environment:  env,
+		bankKeeper:   bk,
+		addressCodec: ak.AddressCodec(),
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Names:        collections.NewMap(sb, types.NamesPrefix, "names", collections.StringKey, codec.CollValue[types.NameRecord](cdc)),
+		Expiry:       collections.NewKeySet(sb, types.ExpiryPrefix, "expiry", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/nameservice module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetName returns the registration of a name, or ErrNameNotFound if the name is
+// not registered or its registration expired.
+func (k Keeper) GetName(ctx context.Context, name string) (types.NameRecord, error) {
+	record, err := k.Names.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return record, errorsmod.Wrap(types.ErrNameNotFound, name)
+		}
+		return record, err
+	}
+
+	// expired names are only removed at the end of the block
+	if !k.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(record.ExpiresAt) {
+		return record, errorsmod.Wrapf(types.ErrNameNotFound, "%s expired", name)
+	}
+
+	return record, nil
+}
+
+// Resolve returns the address a registered name resolves to.
+func (k Keeper) Resolve(ctx context.Context, name string) (sdk.AccAddress, error) {
+	record, err := k.GetName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.addressCodec.StringToBytes(record.Address)
+}
+
+// setName stores a name registration and indexes it by expiration time,
+// replacing the index entry of its previous registration, if any.
+func (k Keeper) setName(ctx context.Context, record types.NameRecord) error {
+	previous, err := k.Names.Get(ctx, record.Name)
+	switch {
+	case err == nil:
+		if err := k.Expiry.Remove(ctx, collections.Join(previous.ExpiresAt, previous.Name)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.Names.Set(ctx, record.Name, record); err != nil {
+		return err
+	}
+
+	return k.Expiry.Set(ctx, collections.Join(record.ExpiresAt, record.Name))
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nameservice"
+	"cosmossdk.io/x/nameservice/keeper"
+	nameservicetestutil "cosmossdk.io/x/nameservice/testutil"
+	"cosmossdk.io/x/nameservice/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *nameservicetestutil.MockBankKeeper
+	params      types.Params
+
+	owner       sdk.AccAddress
+	ownerStr    string
+	newOwnerStr string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, nameservice.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := nameservicetestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	s.bankKeeper = nameservicetestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	s.params = types.DefaultParams()
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, s.params))
+
+	var err error
+	s.owner = sdk.AccAddress("owner")
+	s.ownerStr, err = ac.BytesToString(s.owner)
+	s.Require().NoError(err)
+	s.newOwnerStr, err = ac.BytesToString(sdk.AccAddress("new_owner"))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) register(name string, periods uint32) time.Time {
+	fee, ok := s.params.RegistrationFee(name)
+	s.Require().True(ok)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(s.owner), fee.MulInt(sdkmath.NewInt(int64(periods)))).Return(nil)
+
+	res, err := s.msgServer.RegisterName(s.ctx, &types.MsgRegisterName{Owner: s.ownerStr, Name: name, Periods: periods})
+	s.Require().NoError(err)
+	return res.ExpiresAt
+}
+
+func (s *KeeperTestSuite) TestRegisterName() {
+	expiresAt := s.register("alice", 2)
+	s.Require().Equal(s.ctx.HeaderInfo().Time.Add(2*s.params.RegistrationPeriod), expiresAt)
+
+	res, err := s.queryServer.Resolve(s.ctx, &types.QueryResolveRequest{Name: "alice"})
+	s.Require().NoError(err)
+	s.Require().Equal(s.ownerStr, res.Address)
+
+	testCases := map[string]struct {
+		msg    *types.MsgRegisterName
+		expErr error
+	}{
+		"name taken":       {msg: &types.MsgRegisterName{Owner: s.newOwnerStr, Name: "alice", Periods: 1}, expErr: types.ErrNameTaken},
+		"name too short":   {msg: &types.MsgRegisterName{Owner: s.ownerStr, Name: "ab", Periods: 1}, expErr: types.ErrInvalidName},
+		"uppercase name":   {msg: &types.MsgRegisterName{Owner: s.ownerStr, Name: "Alice", Periods: 1}, expErr: types.ErrInvalidName},
+		"trailing hyphen":  {msg: &types.MsgRegisterName{Owner: s.ownerStr, Name: "alice-", Periods: 1}, expErr: types.ErrInvalidName},
+		"zero periods":     {msg: &types.MsgRegisterName{Owner: s.ownerStr, Name: "bob-1", Periods: 0}, expErr: types.ErrInvalidPeriods},
+		"too many periods": {msg: &types.MsgRegisterName{Owner: s.ownerStr, Name: "bob-1", Periods: types.MaxPeriods + 1}, expErr: types.ErrInvalidPeriods},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			_, err := s.msgServer.RegisterName(s.ctx, tc.msg)
+			s.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// short names are more expensive
+	feeRes, err := s.queryServer.RegistrationFee(s.ctx, &types.QueryRegistrationFeeRequest{Name: "bob"})
+	s.Require().NoError(err)
+	s.Require().Equal(s.params.FeeSchedule[0].Fee, feeRes.Fee)
+	s.register("bob", 1)
+}
+
+func (s *KeeperTestSuite) TestRenewName() {
+	expiresAt := s.register("alice", 1)
+
+	_, err := s.msgServer.RenewName(s.ctx, &types.MsgRenewName{Owner: s.newOwnerStr, Name: "alice", Periods: 1})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.RenewName(s.ctx, &types.MsgRenewName{Owner: s.ownerStr, Name: "alice", Periods: types.MaxPeriods})
+	s.Require().ErrorIs(err, types.ErrInvalidPeriods)
+
+	fee, _ := s.params.RegistrationFee("alice")
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(s.owner), fee).Return(nil)
+	res, err := s.msgServer.RenewName(s.ctx, &types.MsgRenewName{Owner: s.ownerStr, Name: "alice", Periods: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(expiresAt.Add(s.params.RegistrationPeriod), res.ExpiresAt)
+
+	// the name does not expire at its initial expiration time
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithHeaderInfo(header.Info{Time: expiresAt})))
+	_, err = s.keeper.GetName(s.ctx, "alice")
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestTransferAndSetRecords() {
+	s.register("alice", 1)
+
+	records := []types.Record{{Key: "url", Value: "https://example.com"}, {Key: "avatar", Value: "ipfs://avatar"}}
+	_, err := s.msgServer.SetNameRecords(s.ctx, &types.MsgSetNameRecords{Owner: s.ownerStr, Name: "alice", Address: s.newOwnerStr, Records: records})
+	s.Require().NoError(err)
+
+	nameRes, err := s.queryServer.Name(s.ctx, &types.QueryNameRequest{Name: "alice"})
+	s.Require().NoError(err)
+	s.Require().Equal(s.newOwnerStr, nameRes.Name.Address)
+	s.Require().Equal(s.ownerStr, nameRes.Name.Owner)
+	s.Require().Equal("avatar", nameRes.Name.Records[0].Key)
+
+	_, err = s.msgServer.SetNameRecords(s.ctx, &types.MsgSetNameRecords{Owner: s.ownerStr, Name: "alice", Records: []types.Record{{Key: "url"}, {Key: "url"}}})
+	s.Require().ErrorIs(err, types.ErrInvalidRecords)
+
+	_, err = s.msgServer.TransferName(s.ctx, &types.MsgTransferName{Owner: s.newOwnerStr, Name: "alice", NewOwner: s.newOwnerStr})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.TransferName(s.ctx, &types.MsgTransferName{Owner: s.ownerStr, Name: "alice", NewOwner: s.newOwnerStr})
+	s.Require().NoError(err)
+
+	nameRes, err = s.queryServer.Name(s.ctx, &types.QueryNameRequest{Name: "alice"})
+	s.Require().NoError(err)
+	s.Require().Equal(s.newOwnerStr, nameRes.Name.Owner)
+	s.Require().Equal(s.newOwnerStr, nameRes.Name.Address)
+	s.Require().Empty(nameRes.Name.Records)
+}
+
+func (s *KeeperTestSuite) TestExpiry() {
+	expiresAt := s.register("alice", 1)
+	s.register("bob-the-builder", 2)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithHeaderInfo(header.Info{Time: expiresAt.Add(-time.Second)})))
+	_, err := s.keeper.GetName(s.ctx, "alice")
+	s.Require().NoError(err)
+
+	// an expired name no longer resolves, even before the end blocker removes it
+	expired := s.ctx.WithHeaderInfo(header.Info{Time: expiresAt})
+	_, err = s.queryServer.Resolve(expired, &types.QueryResolveRequest{Name: "alice"})
+	s.Require().Error(err)
+
+	s.Require().NoError(s.keeper.EndBlocker(expired))
+	has, err := s.keeper.Names.Has(expired, "alice")
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	_, err = s.keeper.GetName(expired, "bob-the-builder")
+	s.Require().NoError(err)
+
+	var events []string
+	for _, e := range expired.EventManager().Events() {
+		events = append(events, e.Type)
+	}
+	s.Require().Contains(events, types.EventTypeExpireName)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.Params{RegistrationPeriod: time.Hour, FeeSchedule: []types.NameFee{{MinLength: 1}}}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.ownerStr, Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: types.Params{RegistrationPeriod: time.Hour}})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.register("alice", 1)
+	s.register("bob-the-builder", 2)
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.Names, 2)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, exported))
+
+	reExported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported, reExported)
+
+	// the expiry index is restored
+	expired := s.ctx.WithHeaderInfo(header.Info{Time: exported.Names[0].ExpiresAt})
+	s.Require().NoError(s.keeper.EndBlocker(expired))
+	has, err := s.keeper.Names.Has(expired, "alice")
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/nameservice/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/nameservice MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// RegisterName registers an available name, resolving to its owner.
+func (ms msgServer) RegisterName(ctx context.Context, msg *types.MsgRegisterName) (*types.MsgRegisterNameResponse, error) {
+	if err := types.ValidateName(msg.Name); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidName, err.Error())
+	}
+
+	_, err := ms.GetName(ctx, msg.Name)
+	if err == nil {
+		return nil, errorsmod.Wrap(types.ErrNameTaken, msg.Name)
+	}
+	if !errors.Is(err, types.ErrNameNotFound) {
+		return nil, err
+	}
+
+	now := ms.environment.HeaderService.GetHeaderInfo(ctx).Time
+	expiresAt, fee, err := ms.chargeFee(ctx, msg.Owner, msg.Name, msg.Periods, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// the registration of an expired name not yet removed by the end blocker
+	// is replaced
+	record := types.NameRecord{Name: msg.Name, Owner: msg.Owner, Address: msg.Owner, ExpiresAt: expiresAt}
+	if err := ms.setName(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRegisterName,
+		event.NewAttribute(types.AttributeKeyName, msg.Name),
+		event.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		event.NewAttribute(types.AttributeKeyExpiresAt, expiresAt.Format(time.RFC3339Nano)),
+		event.NewAttribute(types.AttributeKeyFee, fee.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterNameResponse{ExpiresAt: expiresAt}, nil
+}
+
+// RenewName extends the registration of a name.
+func (ms msgServer) RenewName(ctx context.Context, msg *types.MsgRenewName) (*types.MsgRenewNameResponse, error) {
+	record, err := ms.getOwnedName(ctx, msg.Owner, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := ms.environment.HeaderService.GetHeaderInfo(ctx).Time
+	expiresAt, fee, err := ms.chargeFee(ctx, msg.Owner, msg.Name, msg.Periods, now, record.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	record.ExpiresAt = expiresAt
+	if err := ms.setName(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRenewName,
+		event.NewAttribute(types.AttributeKeyName, msg.Name),
+		event.NewAttribute(types.AttributeKeyExpiresAt, expiresAt.Format(time.RFC3339Nano)),
+		event.NewAttribute(types.AttributeKeyFee, fee.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewNameResponse{ExpiresAt: expiresAt}, nil
+}
+
+// TransferName transfers a name to a new owner. The name then resolves to the
+// new owner, and its records are cleared.
+func (ms msgServer) TransferName(ctx context.Context, msg *types.MsgTransferName) (*types.MsgTransferNameResponse, error) {
+	record, err := ms.getOwnedName(ctx, msg.Owner, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	record.Owner = msg.NewOwner
+	record.Address = msg.NewOwner
+	record.Records = nil
+	if err := ms.Names.Set(ctx, record.Name, record); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferName,
+		event.NewAttribute(types.AttributeKeyName, msg.Name),
+		event.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferNameResponse{}, nil
+}
+
+// SetNameRecords sets the address a name resolves to, or its owner if unset,
+// and replaces its records.
+func (ms msgServer) SetNameRecords(ctx context.Context, msg *types.MsgSetNameRecords) (*types.MsgSetNameRecordsResponse, error) {
+	record, err := ms.getOwnedName(ctx, msg.Owner, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	address := msg.Address
+	if address == "" {
+		address = msg.Owner
+	}
+	if _, err := ms.addressCodec.StringToBytes(address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	records, err := types.ValidateRecords(msg.Records)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRecords, err.Error())
+	}
+
+	record.Address = address
+	record.Records = records
+	if err := ms.Names.Set(ctx, record.Name, record); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetNameRecords,
+		event.NewAttribute(types.AttributeKeyName, msg.Name),
+		event.NewAttribute(types.AttributeKeyAddress, address),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetNameRecordsResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getOwnedName returns the registration of a name, checking that it is owned
+// by owner.
+func (ms msgServer) getOwnedName(ctx context.Context, owner, name string) (types.NameRecord, error) {
+	record, err := ms.GetName(ctx, name)
+	if err != nil {
+		return record, err
+	}
+	if record.Owner != owner {
+		return record, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of %s", owner, name)
+	}
+
+	return record, nil
+}
+
+// chargeFee burns the fee of the given number of registration periods of a
+// name from the balance of owner, and returns the expiration time of the name
+// once extended from the given time.
+func (ms msgServer) chargeFee(ctx context.Context, owner, name string, periods uint32, now, from time.Time) (time.Time, sdk.Coins, error) {
+	ownerAddr, err := ms.addressCodec.StringToBytes(owner)
+	if err != nil {
+		return time.Time{}, nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	if periods == 0 {
+		return time.Time{}, nil, errorsmod.Wrap(types.ErrInvalidPeriods, "periods must be positive")
+	}
+	expiresAt := from.Add(time.Duration(periods) * params.RegistrationPeriod)
+	if maxExpiry := now.Add(types.MaxPeriods * params.RegistrationPeriod); expiresAt.After(maxExpiry) {
+		return time.Time{}, nil, errorsmod.Wrapf(types.ErrInvalidPeriods, "names cannot be registered for more than %d periods", types.MaxPeriods)
+	}
+
+	fee, ok := params.RegistrationFee(name)
+	if !ok {
+		return time.Time{}, nil, errorsmod.Wrapf(types.ErrInvalidName, "%s is too short", name)
+	}
+	fee = fee.MulInt(sdkmath.NewInt(int64(periods)))
+
+	if !fee.IsZero() {
+		if err := ms.bankKeeper.BurnCoins(ctx, ownerAddr, fee); err != nil {
+			return time.Time{}, nil, err
+		}
+	}
+
+	return expiresAt, fee, nil
+}
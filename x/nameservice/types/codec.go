@@ -0,0 +1,34 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/nameservice/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterName{}, "cosmos-sdk/nameservice/MsgRegisterName")
+	legacy.RegisterAminoMsg(cdc, &MsgRenewName{}, "cosmos-sdk/nameservice/MsgRenewName")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferName{}, "cosmos-sdk/nameservice/MsgTransferName")
+	legacy.RegisterAminoMsg(cdc, &MsgSetNameRecords{}, "cosmos-sdk/nameservice/MsgSetRecords")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/nameservice/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterName{},
+		&MsgRenewName{},
+		&MsgTransferName{},
+		&MsgSetNameRecords{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/nameservice module sentinel errors
+var (
+	ErrInvalidSigner  = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrInvalidName    = errors.Register(ModuleName, 2, "invalid name")
+	ErrNameTaken      = errors.Register(ModuleName, 3, "name already registered")
+	ErrNameNotFound   = errors.Register(ModuleName, 4, "name not registered")
+	ErrUnauthorized   = errors.Register(ModuleName, 5, "not the owner of the name")
+	ErrInvalidPeriods = errors.Register(ModuleName, 6, "invalid number of registration periods")
+	ErrInvalidRecords = errors.Register(ModuleName, 7, "invalid name records")
+)
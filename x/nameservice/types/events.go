@@ -0,0 +1,17 @@
+package types
+
+// nameservice module event types
+const (
+	EventTypeRegisterName   = "register_name"
+	EventTypeRenewName      = "renew_name"
+	EventTypeTransferName   = "transfer_name"
+	EventTypeSetNameRecords = "set_name_records"
+	EventTypeExpireName     = "expire_name"
+
+	AttributeKeyName      = "name"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyNewOwner  = "new_owner"
+	AttributeKeyAddress   = "address"
+	AttributeKeyExpiresAt = "expires_at"
+	AttributeKeyFee       = "fee"
+)
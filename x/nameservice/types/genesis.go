@@ -0,0 +1,40 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, names []NameRecord) *GenesisState {
+	return &GenesisState{Params: params, Names: names}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.Names))
+	for _, n := range gs.Names {
+		if err := ValidateName(n.Name); err != nil {
+			return err
+		}
+		if _, ok := seen[n.Name]; ok {
+			return fmt.Errorf("duplicate name %s", n.Name)
+		}
+		seen[n.Name] = struct{}{}
+
+		if n.Owner == "" || n.Address == "" {
+			return fmt.Errorf("name %s must have an owner and an address", n.Name)
+		}
+		if _, err := ValidateRecords(n.Records); err != nil {
+			return fmt.Errorf("invalid records of %s: %w", n.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+const (
+	// MaxNameLength is the maximum length of a name.
+	MaxNameLength = 64
+
+	// MaxPeriods is the maximum number of registration periods paid at once.
+	MaxPeriods = 10
+
+	// MaxRecords is the maximum number of records of a name.
+	MaxRecords = 32
+
+	// MaxRecordLength is the maximum length of the key and of the value of a
+	// record.
+	MaxRecordLength = 256
+)
+
+var nameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// ValidateName checks that a name is made of lowercase alphanumeric characters
+// and inner hyphens, and is at most MaxNameLength long. The minimum length of
+// a name is defined by the fee schedule.
+func ValidateName(name string) error {
+	if len(name) > MaxNameLength {
+		return fmt.Errorf("name too long, max length is %d", MaxNameLength)
+	}
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf("name must be lowercase alphanumeric characters and inner hyphens: %q", name)
+	}
+
+	return nil
+}
+
+// ValidateRecords checks the number and size of the records of a name, and
+// that their keys are unique. It returns the records sorted by key.
+func ValidateRecords(records []Record) ([]Record, error) {
+	if len(records) > MaxRecords {
+		return nil, fmt.Errorf("too many records, max is %d", MaxRecords)
+	}
+
+	sorted := append([]Record(nil), records...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+	for i, r := range sorted {
+		if r.Key == "" {
+			return nil, fmt.Errorf("record key cannot be empty")
+		}
+		if len(r.Key) > MaxRecordLength || len(r.Value) > MaxRecordLength {
+			return nil, fmt.Errorf("record %q too long, max length is %d", r.Key, MaxRecordLength)
+		}
+		if i > 0 && sorted[i-1].Key == r.Key {
+			return nil, fmt.Errorf("duplicate record %q", r.Key)
+		}
+	}
+
+	return sorted, nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default x/nameservice module parameters. Names are
+// registered by year, and names shorter than 3 characters cannot be
+// registered.
+func DefaultParams() Params {
+	return Params{
+		RegistrationPeriod: 365 * 24 * time.Hour,
+		FeeSchedule: []NameFee{
+			{MinLength: 3, Fee: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000)))},
+			{MinLength: 5, Fee: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10_000_000)))},
+		},
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.RegistrationPeriod <= 0 {
+		return fmt.Errorf("registration period must be positive: %s", p.RegistrationPeriod)
+	}
+	if len(p.FeeSchedule) == 0 {
+		return fmt.Errorf("fee schedule cannot be empty")
+	}
+
+	for i, fee := range p.FeeSchedule {
+		if fee.MinLength == 0 {
+			return fmt.Errorf("fee schedule min length must be positive")
+		}
+		if i > 0 && fee.MinLength <= p.FeeSchedule[i-1].MinLength {
+			return fmt.Errorf("fee schedule must be sorted by strictly increasing min length")
+		}
+		if err := fee.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid fee for min length %d: %w", fee.MinLength, err)
+		}
+	}
+
+	return nil
+}
+
+// RegistrationFee returns the fee of a registration period of the given name,
+// or false if the name is too short to be registered.
+func (p Params) RegistrationFee(name string) (sdk.Coins, bool) {
+	var (
+		fee   sdk.Coins
+		found bool
+	)
+	for _, f := range p.FeeSchedule {
+		if len(name) < int(f.MinLength) {
+			break
+		}
+		fee, found = f.Fee, true
+	}
+
+	return fee, found
+}
@@ -0,0 +1,41 @@
+package notary
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	notaryv1 "cosmossdk.io/api/cosmos/notary/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: notaryv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Notarization",
+					Use:            "notarization [hash]",
+					Short:          "Query the notarization of a hex-encoded document hash",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hash"}},
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: notaryv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Notarize",
+					Use:            "notarize [hash] --from [submitter]",
+					Short:          "Notarize a hex-encoded document hash",
+					Example:        fmt.Sprintf("%s tx notary notarize 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hash"}},
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"io"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/x/notary/types"
+)
+
+// HashFile returns the hex-encoded SHA-256 digest of the content of a file.
+func HashFile(path string) (string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return types.FormatHash(h.Sum(nil)), nil
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/notary/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                types.ModuleName,
+		Short:              "Querying commands for the notary module",
+		RunE:               client.ValidateCmd,
+		DisableFlagParsing: true,
+	}
+
+	cmd.AddCommand(
+		GetCmdVerifyFile(),
+	)
+
+	return cmd
+}
+
+// GetCmdVerifyFile implements a command querying the notarization of the
+// SHA-256 digest of a local file.
+func GetCmdVerifyFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-file [file]",
+		Short: "Query the notarization of the SHA-256 digest of a file",
+		Long: `Query the notarization of the SHA-256 digest of a file.
+The file is hashed locally and the command fails if its digest was never notarized.`,
+		Example: fmt.Sprintf("%s query notary verify-file contract.pdf", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hash, err := HashFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Notarization(cmd.Context(), &types.QueryNotarizationRequest{Hash: hash})
+			if err != nil {
+				return fmt.Errorf("failed to verify %s: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/notary/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/notary transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Notary transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewNotarizeFileCmd(),
+	)
+
+	return txCmd
+}
+
+// NewNotarizeFileCmd returns a CLI command handler for creating a MsgNotarize
+// transaction notarizing the SHA-256 digest of a local file.
+func NewNotarizeFileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notarize-file [file] --from [submitter]",
+		Short: "Notarize the SHA-256 digest of a file",
+		Long: `Notarize the SHA-256 digest of a file.
+The file is hashed locally and only its digest is included in the transaction.`,
+		Example: fmt.Sprintf("%s tx notary notarize-file contract.pdf --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hash, err := HashFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			submitter, err := clientCtx.AddressCodec.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgNotarize{Submitter: submitter, Hash: hash}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/notary/types"
+)
+
+// InitGenesis initializes the notary module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, notarization := range data.Notarizations {
+		bz, err := types.ParseHash(notarization.Hash)
+		if err != nil {
+			return err
+		}
+
+		notarization.Hash = types.FormatHash(bz)
+		if err := k.Notarizations.Set(ctx, bz, notarization); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the notary module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var notarizations []types.Notarization
+	err := k.Notarizations.Walk(ctx, nil, func(_ []byte, notarization types.Notarization) (bool, error) {
+		notarizations = append(notarizations, notarization)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(notarizations), nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/notary/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/notary QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Notarization returns the notarization of a document hash.
+func (q queryServer) Notarization(ctx context.Context, req *types.QueryNotarizationRequest) (*types.QueryNotarizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	notarization, err := q.k.GetNotarization(ctx, req.Hash)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrInvalidHash):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, types.ErrNotarizationNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryNotarizationResponse{Notarization: notarization}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/notary/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Keeper of the notary store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	addressCodec address.Codec
+
+	Schema collections.Schema
+	// Notarizations contains the notarized document hashes, keyed by their
+	// decoded bytes
+	Notarizations collections.Map[[]byte, types.Notarization]
+}
+
+// NewKeeper creates a new notary Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:           cdc,
+		environment:   env,
+		addressCodec:  ak.AddressCodec(),
+		Notarizations: collections.NewMap(sb, types.NotarizationsPrefix, "notarizations", collections.BytesKey, codec.CollValue[types.Notarization](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetNotarization returns the notarization of a hex-encoded document hash, or
+// ErrNotarizationNotFound if the hash was never notarized.
+func (k Keeper) GetNotarization(ctx context.Context, hash string) (types.Notarization, error) {
+	bz, err := types.ParseHash(hash)
+	if err != nil {
+		return types.Notarization{}, errorsmod.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	notarization, err := k.Notarizations.Get(ctx, bz)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return notarization, errorsmod.Wrap(types.ErrNotarizationNotFound, types.FormatHash(bz))
+		}
+		return notarization, err
+	}
+
+	return notarization, nil
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/notary"
+	"cosmossdk.io/x/notary/keeper"
+	notarytestutil "cosmossdk.io/x/notary/testutil"
+	"cosmossdk.io/x/notary/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+
+	submitterStr string
+	otherStr     string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, notary.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := notarytestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	var err error
+	s.submitterStr, err = ac.BytesToString(sdk.AccAddress("submitter"))
+	s.Require().NoError(err)
+	s.otherStr, err = ac.BytesToString(sdk.AccAddress("other"))
+	s.Require().NoError(err)
+}
+
+func hashOf(content string) string {
+	digest := sha256.Sum256([]byte(content))
+	return types.FormatHash(digest[:])
+}
+
+func (s *KeeperTestSuite) TestNotarize() {
+	hash := hashOf("document")
+
+	res, err := s.msgServer.Notarize(s.ctx, &types.MsgNotarize{Submitter: s.submitterStr, Hash: strings.ToUpper(hash)})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(10), res.Height)
+	s.Require().Equal(s.ctx.HeaderInfo().Time, res.Time)
+
+	notarization, err := s.keeper.GetNotarization(s.ctx, hash)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Notarization{Hash: hash, Submitter: s.submitterStr, Height: 10, Time: res.Time}, notarization)
+
+	// the earliest notarization is kept
+	later := s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: res.Time.Add(time.Hour)})
+	_, err = s.msgServer.Notarize(later, &types.MsgNotarize{Submitter: s.otherStr, Hash: hash})
+	s.Require().ErrorIs(err, types.ErrAlreadyNotarized)
+
+	_, err = s.msgServer.Notarize(s.ctx, &types.MsgNotarize{Submitter: s.submitterStr, Hash: "not-hex"})
+	s.Require().ErrorIs(err, types.ErrInvalidHash)
+	_, err = s.msgServer.Notarize(s.ctx, &types.MsgNotarize{Submitter: s.submitterStr, Hash: "abcd"})
+	s.Require().ErrorIs(err, types.ErrInvalidHash)
+	_, err = s.msgServer.Notarize(s.ctx, &types.MsgNotarize{Submitter: "invalid", Hash: hashOf("other")})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestQueryNotarization() {
+	hash := hashOf("document")
+	_, err := s.msgServer.Notarize(s.ctx, &types.MsgNotarize{Submitter: s.submitterStr, Hash: hash})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Notarization(s.ctx, &types.QueryNotarizationRequest{Hash: hash})
+	s.Require().NoError(err)
+	s.Require().Equal(s.submitterStr, res.Notarization.Submitter)
+
+	_, err = s.queryServer.Notarization(s.ctx, &types.QueryNotarizationRequest{Hash: hashOf("unknown")})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = s.queryServer.Notarization(s.ctx, &types.QueryNotarizationRequest{Hash: "xyz"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.queryServer.Notarization(s.ctx, nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := types.NewGenesisState([]types.Notarization{
+		{Hash: hashOf("a"), Submitter: s.submitterStr, Height: 1, Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Hash: hashOf("b"), Submitter: s.otherStr, Height: 2, Time: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+	})
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(gs.Notarizations, exported.Notarizations)
+
+	dup := types.NewGenesisState([]types.Notarization{gs.Notarizations[0], gs.Notarizations[0]})
+	s.Require().Error(dup.Validate())
+}
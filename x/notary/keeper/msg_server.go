@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/notary/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/notary MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// Notarize records the submitter, height and time of a document hash. A hash
+// can only be notarized once, so that its notarization proves the earliest
+// time the document is known to have existed.
+func (ms msgServer) Notarize(ctx context.Context, msg *types.MsgNotarize) (*types.MsgNotarizeResponse, error) {
+	if _, err := ms.addressCodec.StringToBytes(msg.Submitter); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address: %s", err)
+	}
+
+	bz, err := types.ParseHash(msg.Hash)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidHash, err.Error())
+	}
+	hash := types.FormatHash(bz)
+
+	has, err := ms.Notarizations.Has(ctx, bz)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrap(types.ErrAlreadyNotarized, hash)
+	}
+
+	info := ms.environment.HeaderService.GetHeaderInfo(ctx)
+	notarization := types.Notarization{
+		Hash:      hash,
+		Submitter: msg.Submitter,
+		Height:    info.Height,
+		Time:      info.Time,
+	}
+	if err := ms.Notarizations.Set(ctx, bz, notarization); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeNotarize,
+		event.NewAttribute(types.AttributeKeyHash, hash),
+		event.NewAttribute(types.AttributeKeySubmitter, msg.Submitter),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgNotarizeResponse{Height: info.Height, Time: info.Time}, nil
+}
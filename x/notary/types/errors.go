@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/notary module sentinel errors
+var (
+	ErrInvalidHash          = errors.Register(ModuleName, 1, "invalid document hash")
+	ErrAlreadyNotarized     = errors.Register(ModuleName, 2, "document hash already notarized")
+	ErrNotarizationNotFound = errors.Register(ModuleName, 3, "document hash not notarized")
+)
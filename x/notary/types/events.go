@@ -0,0 +1,9 @@
+package types
+
+// notary module event types
+const (
+	EventTypeNotarize = "notarize"
+
+	AttributeKeyHash      = "hash"
+	AttributeKeySubmitter = "submitter"
+)
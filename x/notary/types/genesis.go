@@ -0,0 +1,39 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(notarizations []Notarization) *GenesisState {
+	return &GenesisState{Notarizations: notarizations}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.Notarizations))
+	for _, n := range gs.Notarizations {
+		bz, err := ParseHash(n.Hash)
+		if err != nil {
+			return fmt.Errorf("invalid notarization: %w", err)
+		}
+
+		hash := FormatHash(bz)
+		if _, ok := seen[hash]; ok {
+			return fmt.Errorf("duplicate notarization of %s", hash)
+		}
+		seen[hash] = struct{}{}
+
+		if n.Submitter == "" {
+			return fmt.Errorf("notarization of %s must have a submitter", hash)
+		}
+		if n.Height < 0 {
+			return fmt.Errorf("notarization of %s has a negative height", hash)
+		}
+	}
+
+	return nil
+}
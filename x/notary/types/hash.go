@@ -0,0 +1,34 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// MinHashLength is the minimum length, in bytes, of a notarized hash, e.g.
+	// a SHA-256 digest.
+	MinHashLength = 32
+	// MaxHashLength is the maximum length, in bytes, of a notarized hash, e.g.
+	// a SHA-512 digest.
+	MaxHashLength = 64
+)
+
+// ParseHash decodes a hex-encoded document hash, which must be from
+// MinHashLength to MaxHashLength bytes long.
+func ParseHash(hash string) ([]byte, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not hex-encoded: %w", hash, err)
+	}
+	if len(bz) < MinHashLength || len(bz) > MaxHashLength {
+		return nil, fmt.Errorf("hash must be from %d to %d bytes long, got %d", MinHashLength, MaxHashLength, len(bz))
+	}
+
+	return bz, nil
+}
+
+// FormatHash returns the canonical, lowercase hex encoding of a document hash.
+func FormatHash(hash []byte) string {
+	return hex.EncodeToString(hash)
+}
@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "notary"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// KVStore keys
+var (
+	NotarizationsPrefix = collections.NewPrefix(0)
+)
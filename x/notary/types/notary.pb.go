@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/notary/v1/notary.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Notarization is the proof that a document hash existed at a given time.
+type Notarization struct {
+	// hash is the hex-encoded hash of the document.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// submitter is the address which notarized the hash.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// height is the height of the block in which the hash was notarized.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block in which the hash was notarized.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Notarization) Reset()         { *m = Notarization{} }
+func (m *Notarization) String() string { return proto.CompactTextString(m) }
+func (*Notarization) ProtoMessage()    {}
+func (*Notarization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c44e72ca7098fd2, []int{0}
+}
+func (m *Notarization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notarization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notarization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Notarization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notarization.Merge(m, src)
+}
+func (m *Notarization) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notarization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notarization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notarization proto.InternalMessageInfo
+
+func (m *Notarization) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Notarization) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *Notarization) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Notarization) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Notarization)(nil), "cosmos.notary.v1.Notarization")
+}
+
+func init() { proto.RegisterFile("cosmos/notary/v1/notary.proto", fileDescriptor_3c44e72ca7098fd2) }
+
+var fileDescriptor_3c44e72ca7098fd2 = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x50, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0xdd, 0xd8, 0x52, 0x68, 0x54, 0xd0, 0x50, 0x64, 0xad, 0x98, 0x16, 0x4f, 0x45, 0x30, 0xa1,
+	0x8a, 0xde, 0x3c, 0xd8, 0x0f, 0xf0, 0xb0, 0x7a, 0xf2, 0x22, 0x5b, 0x1b, 0xb3, 0x41, 0x77, 0xa7,
+	0x6c, 0xd2, 0x62, 0xfd, 0x8a, 0x7e, 0x86, 0x47, 0x05, 0x3f, 0xa2, 0xc7, 0xe2, 0xc9, 0x93, 0x4a,
+	0xf7, 0xe0, 0x6f, 0xc8, 0x26, 0x59, 0xbc, 0x84, 0x37, 0xf3, 0xde, 0xbc, 0xcc, 0x1b, 0xbc, 0x7f,
+	0x07, 0x3a, 0x05, 0xcd, 0x33, 0x30, 0x71, 0x3e, 0xe3, 0xd3, 0xbe, 0x47, 0x6c, 0x9c, 0x83, 0x01,
+	0xb2, 0xe5, 0x68, 0xe6, 0x9b, 0xd3, 0x7e, 0xbb, 0x25, 0x41, 0x82, 0x25, 0x79, 0x89, 0x9c, 0xae,
+	0xdd, 0x91, 0x00, 0xf2, 0x51, 0x70, 0x5b, 0x0d, 0x27, 0xf7, 0xdc, 0xa8, 0x54, 0x68, 0x13, 0xa7,
+	0x63, 0x2f, 0xd8, 0x75, 0x46, 0xb7, 0x6e, 0xd2, 0xbb, 0x3a, 0x6a, 0x3b, 0x4e, 0x55, 0x06, 0xdc,
+	0xbe, 0xae, 0x75, 0xf0, 0x86, 0xf0, 0xc6, 0x65, 0xf9, 0xa5, 0x7a, 0x8e, 0x8d, 0x82, 0x8c, 0x10,
+	0x5c, 0x4f, 0x62, 0x9d, 0x84, 0xa8, 0x8b, 0x7a, 0xcd, 0xc8, 0x62, 0x72, 0x86, 0x9b, 0x7a, 0x32,
+	0x4c, 0x95, 0x31, 0x22, 0x0f, 0xd7, 0x4a, 0x62, 0x10, 0x7e, 0xbc, 0x1f, 0xb5, 0xbc, 0xf9, 0xc5,
+	0x68, 0x94, 0x0b, 0xad, 0xaf, 0x4c, 0xae, 0x32, 0x19, 0xfd, 0x4b, 0xc9, 0x0e, 0x6e, 0x24, 0x42,
+	0xc9, 0xc4, 0x84, 0xb5, 0x2e, 0xea, 0xd5, 0x22, 0x5f, 0x91, 0x73, 0x5c, 0x2f, 0xb7, 0x0e, 0xeb,
+	0x5d, 0xd4, 0x5b, 0x3f, 0x6e, 0x33, 0x17, 0x89, 0x55, 0x91, 0xd8, 0x75, 0x15, 0x69, 0xb0, 0xb9,
+	0xf8, 0xea, 0x04, 0xf3, 0xef, 0x0e, 0x7a, 0xf9, 0x7d, 0x3d, 0x44, 0x91, 0x1d, 0x1b, 0x9c, 0x2e,
+	0x56, 0x14, 0x2d, 0x57, 0x14, 0xfd, 0xac, 0x28, 0x9a, 0x17, 0x34, 0x58, 0x16, 0x34, 0xf8, 0x2c,
+	0x68, 0x70, 0xb3, 0xe7, 0x36, 0xd2, 0xa3, 0x07, 0xa6, 0x80, 0x3f, 0x55, 0xb7, 0x36, 0xb3, 0xb1,
+	0xd0, 0xc3, 0x86, 0xf5, 0x3f, 0xf9, 0x1b, 0x00, 0xb9, 0xbf, 0x82, 0xf5, 0x89, 0x01, 0x00, 0x00,
+}
+
+func (m *Notarization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notarization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notarization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintNotary(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintNotary(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintNotary(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNotary(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNotary(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNotary(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Notarization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNotary(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovNotary(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovNotary(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovNotary(uint64(l))
+	return n
+}
+
+func sovNotary(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNotary(x uint64) (n int) {
+	return sovNotary(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Notarization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notarization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notarization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNotary(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNotary
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNotary
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNotary
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNotary
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNotary
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNotary
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNotary        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNotary          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNotary = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/notary/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgNotarize is the Msg/Notarize request type.
+type MsgNotarize struct {
+	// submitter is the address notarizing the hash.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// hash is the hex-encoded hash of the document, from 32 to 64 bytes long.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MsgNotarize) Reset()         { *m = MsgNotarize{} }
+func (m *MsgNotarize) String() string { return proto.CompactTextString(m) }
+func (*MsgNotarize) ProtoMessage()    {}
+func (*MsgNotarize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613b155e1d9cc481, []int{0}
+}
+func (m *MsgNotarize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNotarize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNotarize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgNotarize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNotarize.Merge(m, src)
+}
+func (m *MsgNotarize) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNotarize) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNotarize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgNotarize proto.InternalMessageInfo
+
+func (m *MsgNotarize) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgNotarize) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// MsgNotarizeResponse is the Msg/Notarize response type.
+type MsgNotarizeResponse struct {
+	// height is the height of the block in which the hash was notarized.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block in which the hash was notarized.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *MsgNotarizeResponse) Reset()         { *m = MsgNotarizeResponse{} }
+func (m *MsgNotarizeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgNotarizeResponse) ProtoMessage()    {}
+func (*MsgNotarizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613b155e1d9cc481, []int{1}
+}
+func (m *MsgNotarizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNotarizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNotarizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgNotarizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNotarizeResponse.Merge(m, src)
+}
+func (m *MsgNotarizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNotarizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNotarizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgNotarizeResponse proto.InternalMessageInfo
+
+func (m *MsgNotarizeResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgNotarizeResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MsgNotarize)(nil), "cosmos.notary.v1.MsgNotarize")
+	proto.RegisterType((*MsgNotarizeResponse)(nil), "cosmos.notary.v1.MsgNotarizeResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/notary/v1/tx.proto", fileDescriptor_613b155e1d9cc481) }
+
+var fileDescriptor_613b155e1d9cc481 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xf6, 0x15, 0x8a, 0xca, 0xa1, 0x4a, 0xad, 0x8b, 0x5a, 0xea, 0x0a, 0x53, 0x21, 0x55, 0xaa,
+	0x90, 0xb8, 0x2b, 0x54, 0xed, 0x50, 0xa9, 0x43, 0xd9, 0xa9, 0x2a, 0xb7, 0x53, 0x87, 0x56, 0x26,
+	0xbe, 0x9c, 0x2d, 0xb0, 0xcf, 0xf2, 0x3b, 0x10, 0x64, 0x8a, 0x32, 0x26, 0x0b, 0x3f, 0x23, 0x23,
+	0x43, 0x7e, 0x04, 0x23, 0xca, 0x94, 0x29, 0x89, 0x60, 0xe0, 0x6f, 0x44, 0xbe, 0xb3, 0x03, 0xca,
+	0x90, 0xe5, 0xf4, 0xde, 0x7d, 0xdf, 0x7b, 0xdf, 0x77, 0xdf, 0xe1, 0xb7, 0x07, 0x02, 0x42, 0x01,
+	0x34, 0x12, 0xd2, 0x4d, 0x66, 0x74, 0xd2, 0xa1, 0x72, 0x4a, 0xe2, 0x44, 0x48, 0x61, 0xbe, 0xd0,
+	0x10, 0xd1, 0x10, 0x99, 0x74, 0xac, 0x2a, 0x17, 0x5c, 0x28, 0x90, 0xa6, 0x95, 0xe6, 0x59, 0x0d,
+	0x2e, 0x04, 0x1f, 0x31, 0xaa, 0xba, 0xc1, 0xf8, 0x90, 0xca, 0x20, 0x64, 0x20, 0xdd, 0x30, 0xce,
+	0x08, 0x99, 0xc6, 0x7f, 0x3d, 0x99, 0x6d, 0xd5, 0xd0, 0x9b, 0x4c, 0x3e, 0x04, 0x9e, 0x6a, 0x87,
+	0xc0, 0x33, 0xe0, 0xa5, 0x1b, 0x06, 0x91, 0xa0, 0xea, 0xd4, 0x57, 0xcd, 0x33, 0x84, 0x2b, 0x7d,
+	0xe0, 0x3f, 0x53, 0x3b, 0xc1, 0x11, 0x33, 0xbf, 0xe2, 0x32, 0x8c, 0x07, 0x61, 0x20, 0x25, 0x4b,
+	0x6a, 0xe8, 0x3d, 0xfa, 0x58, 0xee, 0xd5, 0x2e, 0x2f, 0xda, 0xd5, 0x4c, 0xe0, 0x87, 0xe7, 0x25,
+	0x0c, 0xe0, 0xb7, 0x4c, 0x82, 0x88, 0x3b, 0x3b, 0xaa, 0x69, 0xe2, 0xa2, 0xef, 0x82, 0x5f, 0x7b,
+	0x92, 0x8e, 0x38, 0xaa, 0xfe, 0xf6, 0xe9, 0x64, 0xbb, 0x68, 0xed, 0x38, 0xa7, 0xdb, 0x45, 0xab,
+	0xae, 0xf7, 0xb4, 0xc1, 0x1b, 0xe6, 0xe9, 0xec, 0xa9, 0x37, 0x47, 0xf8, 0xd5, 0x5e, 0xeb, 0x30,
+	0x88, 0x45, 0x04, 0xcc, 0x7c, 0x8d, 0x4b, 0x3e, 0x0b, 0xb8, 0x2f, 0x95, 0xa3, 0x82, 0x93, 0x75,
+	0xe6, 0x77, 0x5c, 0x4c, 0x63, 0x51, 0xa2, 0x95, 0xae, 0x45, 0x74, 0x66, 0x24, 0xcf, 0x8c, 0xfc,
+	0xc9, 0x33, 0xeb, 0x3d, 0x5f, 0x5e, 0x37, 0x8c, 0xf9, 0x4d, 0x03, 0x9d, 0x6f, 0x17, 0x2d, 0xe4,
+	0xa8, 0xb1, 0xee, 0x3f, 0x5c, 0xe8, 0x03, 0x37, 0x7f, 0xe1, 0x67, 0xf7, 0xcf, 0xaf, 0x93, 0x87,
+	0xff, 0x43, 0xf6, 0x0c, 0x59, 0x1f, 0x1e, 0x85, 0x73, 0xbf, 0xd6, 0xd3, 0xe3, 0x54, 0xa5, 0xf7,
+	0x65, 0xb9, 0xb6, 0xd1, 0x6a, 0x6d, 0xa3, 0xdb, 0xb5, 0x8d, 0xe6, 0x1b, 0xdb, 0x58, 0x6d, 0x6c,
+	0xe3, 0x6a, 0x63, 0x1b, 0x7f, 0xdf, 0xe9, 0x35, 0xe0, 0x0d, 0x49, 0x20, 0xe8, 0x34, 0x8f, 0x42,
+	0xce, 0x62, 0x06, 0x83, 0x92, 0xf2, 0xff, 0xf9, 0x6e, 0x00, 0xf1, 0x29, 0x5c, 0x04, 0x46, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Notarize records the submitter, height and time of a document hash which
+	// was not notarized yet.
+	Notarize(ctx context.Context, in *MsgNotarize, opts ...grpc.CallOption) (*MsgNotarizeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Notarize(ctx context.Context, in *MsgNotarize, opts ...grpc.CallOption) (*MsgNotarizeResponse, error) {
+	out := new(MsgNotarizeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.notary.v1.Msg/Notarize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Notarize records the submitter, height and time of a document hash which
+	// was not notarized yet.
+	Notarize(context.Context, *MsgNotarize) (*MsgNotarizeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Notarize(ctx context.Context, req *MsgNotarize) (*MsgNotarizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notarize not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Notarize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNotarize)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Notarize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.notary.v1.Msg/Notarize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Notarize(ctx, req.(*MsgNotarize))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.notary.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Notarize",
+			Handler:    _Msg_Notarize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/notary/v1/tx.proto",
+}
+
+func (m *MsgNotarize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNotarize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNotarize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNotarizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNotarizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNotarizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgNotarize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgNotarizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgNotarize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNotarize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNotarize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNotarizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNotarizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNotarizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,79 @@
+package oracle
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	oraclev1 "cosmossdk.io/api/cosmos/oracle/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: oraclev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current oracle parameters",
+				},
+				{
+					RpcMethod:      "ExchangeRate",
+					Use:            "exchange-rate [denom]",
+					Short:          "Query the current price of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "ExchangeRates",
+					Use:       "exchange-rates",
+					Short:     "Query the current prices of all the denoms",
+				},
+				{
+					RpcMethod:      "FeederDelegation",
+					Use:            "feeder [validator]",
+					Short:          "Query the account submitting the price votes of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+				{
+					RpcMethod:      "MissCounter",
+					Use:            "miss-counter [validator]",
+					Short:          "Query the voting windows a validator missed in the current slash window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: oraclev1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AggregateExchangeRateVote",
+					Use:       "vote [validator] [exchange_rates] --from [feeder]",
+					Short:     "Submit the price vote of a validator for the current voting window",
+					Example:   fmt.Sprintf(`%s tx oracle vote cosmosvaloper1... '{"denom":"uatom","amount":"10.5"}' '{"denom":"uusdc","amount":"0.99"}' --from feeder`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator"},
+						{ProtoField: "exchange_rates", Varargs: true},
+					},
+				},
+				{
+					RpcMethod:      "DelegateFeedConsent",
+					Use:            "delegate-feeder [feeder] --from [validator]",
+					Short:          "Appoint the account submitting the price votes of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "feeder"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update oracle module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update oracle module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query oracle params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx oracle update-params-proposal '{ "vote_period": "5", "whitelist": ["uatom"], ... }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bondedValidator is a validator eligible to vote in the current window.
+type bondedValidator struct {
+	operator sdk.ValAddress
+	power    int64
+}
+
+// EndBlocker tallies the votes at the end of every voting window, and slashes
+// the validators which missed too many voting windows at the end of every
+// slash window.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if (uint64(height)+1)%params.VotePeriod != 0 {
+		return nil
+	}
+
+	if err := k.tally(ctx, params); err != nil {
+		return err
+	}
+
+	if (uint64(height)+1)%(params.VotePeriod*params.SlashWindow) != 0 {
+		return nil
+	}
+
+	return k.slashAndResetMissCounters(ctx, params, height)
+}
+
+// tally updates the price of every whitelisted denom to the weighted median of
+// the votes of the window, when enough voting power voted for it, increments
+// the miss counter of the validators which did not submit a valid vote and
+// clears the votes.
+func (k Keeper) tally(ctx context.Context, params types.Params) error {
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+
+	var (
+		validators []bondedValidator
+		totalPower int64
+	)
+	err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator sdk.ValidatorI) bool {
+		operator, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return false
+		}
+		power := validator.GetConsensusPower(powerReduction)
+		validators = append(validators, bondedValidator{operator: operator, power: power})
+		totalPower += power
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	votes := make(map[string]sdk.DecCoins, len(validators))
+	err = k.Votes.Walk(ctx, nil, func(validator sdk.ValAddress, vote types.AggregateExchangeRateVote) (bool, error) {
+		votes[string(validator)] = vote.ExchangeRates
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	threshold := params.VoteThreshold.MulInt64(totalPower)
+	medians := make(map[string]math.LegacyDec, len(params.Whitelist))
+	for _, denom := range params.Whitelist {
+		var ballot types.ExchangeRateBallot
+		for _, val := range validators {
+			if rate := votes[string(val.operator)].AmountOf(denom); rate.IsPositive() {
+				ballot = append(ballot, types.BallotVote{ExchangeRate: rate, Power: val.power})
+			}
+		}
+
+		if ballot.Power() == 0 || math.LegacyNewDec(ballot.Power()).LT(threshold) {
+			if err := k.ExchangeRates.Remove(ctx, denom); err != nil {
+				return err
+			}
+			continue
+		}
+
+		median := ballot.WeightedMedian()
+		medians[denom] = median
+		if err := k.ExchangeRates.Set(ctx, denom, sdk.NewDecCoinFromDec(denom, median)); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeExchangeRateUpdate,
+			event.NewAttribute(types.AttributeKeyDenom, denom),
+			event.NewAttribute(types.AttributeKeyExchangeRate, median.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, val := range validators {
+		if isValidVote(votes[string(val.operator)], medians, params.MaxDeviation) {
+			continue
+		}
+
+		missCount, err := k.MissCounters.Get(ctx, val.operator)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.MissCounters.Set(ctx, val.operator, missCount+1); err != nil {
+			return err
+		}
+	}
+
+	return k.Votes.Clear(ctx, nil)
+}
+
+// isValidVote returns whether a validator voted, and voted within the allowed
+// deviation of the median of every denom whose price was updated.
+func isValidVote(vote sdk.DecCoins, medians map[string]math.LegacyDec, maxDeviation math.LegacyDec) bool {
+	if vote == nil {
+		return false
+	}
+
+	for denom, median := range medians {
+		rate := vote.AmountOf(denom)
+		if !rate.IsPositive() || types.IsDeviant(rate, median, maxDeviation) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// slashAndResetMissCounters slashes and jails the validators whose share of
+// valid votes in the slash window is below the minimum, and resets the miss
+// counters of all the validators.
+func (k Keeper) slashAndResetMissCounters(ctx context.Context, params types.Params, height int64) error {
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	minValidWindows := params.MinValidPerWindow.MulInt64(int64(params.SlashWindow))
+	// the votes were cast with the voting power of previous blocks
+	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+
+	iter, err := k.MissCounters.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	missCounters, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range missCounters {
+		operator, missCount := kv.Key, kv.Value
+		validWindows := int64(params.SlashWindow) - int64(missCount)
+		if math.LegacyNewDec(validWindows).GTE(minValidWindows) {
+			continue
+		}
+
+		validator, err := k.stakingKeeper.Validator(ctx, operator)
+		if err != nil || validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			continue
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.stakingKeeper.Slash(ctx, consAddr, distributionHeight, validator.GetConsensusPower(powerReduction), params.SlashFraction); err != nil {
+			return err
+		}
+		if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeSlash,
+			event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			event.NewAttribute(types.AttributeKeyMissCount, strconv.FormatUint(missCount, 10)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return k.MissCounters.Clear(ctx, nil)
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestEndBlockerTally() {
+	s.Require().NoError(s.vote(0, "11uatom,1uusdc"))
+	s.Require().NoError(s.vote(1, "10uatom,1uusdc"))
+
+	// votes are only tallied at the last block of a voting window
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithHeaderInfo(header.Info{Height: 2})))
+	_, err := s.keeper.GetExchangeRate(s.ctx, "uatom")
+	s.Require().ErrorIs(err, types.ErrNoExchangeRate)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	// weighted median of 11 (power 20) and 10 (power 10)
+	rate, err := s.keeper.GetExchangeRate(s.ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(11), rate)
+	rate, err = s.keeper.GetExchangeRate(s.ctx, "uusdc")
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(1), rate)
+
+	// the validator which did not vote missed the window
+	for i, expected := range []uint64{0, 0, 1} {
+		res, err := s.queryServer.MissCounter(s.ctx, &types.QueryMissCounterRequest{Validator: s.valStrs[i]})
+		s.Require().NoError(err)
+		s.Require().Equal(expected, res.MissCount)
+	}
+
+	// votes are cleared after the tally
+	has, err := s.keeper.Votes.Has(s.ctx, s.valAddrs[0])
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerDeviantVote() {
+	s.Require().NoError(s.vote(0, "10uatom,1uusdc"))
+	s.Require().NoError(s.vote(1, "10.5uatom,1uusdc"))
+	s.Require().NoError(s.vote(2, "12uatom,1uusdc"))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	for i, expected := range []uint64{0, 0, 1} {
+		res, err := s.queryServer.MissCounter(s.ctx, &types.QueryMissCounterRequest{Validator: s.valStrs[i]})
+		s.Require().NoError(err)
+		s.Require().Equal(expected, res.MissCount)
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockerBelowThreshold() {
+	s.Require().NoError(s.keeper.ExchangeRates.Set(s.ctx, "uatom", sdkDecCoin("uatom", 3)))
+
+	// 5 out of 35 voting power is below the vote threshold
+	s.Require().NoError(s.vote(2, "10uatom"))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	_, err := s.keeper.GetExchangeRate(s.ctx, "uatom")
+	s.Require().ErrorIs(err, types.ErrNoExchangeRate)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerSlash() {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.SlashWindow = 2
+	params.MinValidPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	consAddr, err := s.validators[2].GetConsAddr()
+	s.Require().NoError(err)
+	s.stakingKeeper.EXPECT().Slash(gomock.Any(), consAddr, int64(1), int64(5), params.SlashFraction).Return(math.ZeroInt(), nil)
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+
+	for _, height := range []int64{1, 3} {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: height})
+		for i := 0; i < 2; i++ {
+			s.Require().NoError(s.vote(i, "10uatom,1uusdc"))
+		}
+		s.Require().NoError(s.keeper.EndBlocker(ctx))
+	}
+
+	// miss counters are reset at the end of the slash window
+	res, err := s.queryServer.MissCounter(s.ctx, &types.QueryMissCounterRequest{Validator: s.valStrs[2]})
+	s.Require().NoError(err)
+	s.Require().Zero(res.MissCount)
+}
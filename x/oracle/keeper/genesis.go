@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the oracle module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, rate := range data.ExchangeRates {
+		if err := k.ExchangeRates.Set(ctx, rate.Denom, rate); err != nil {
+			return err
+		}
+	}
+
+	for _, fd := range data.FeederDelegations {
+		operator, err := k.validatorAddressCodec.StringToBytes(fd.Validator)
+		if err != nil {
+			return err
+		}
+		feeder, err := k.addressCodec.StringToBytes(fd.Feeder)
+		if err != nil {
+			return err
+		}
+		if err := k.FeederDelegations.Set(ctx, operator, feeder); err != nil {
+			return err
+		}
+	}
+
+	for _, mc := range data.MissCounters {
+		operator, err := k.validatorAddressCodec.StringToBytes(mc.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.MissCounters.Set(ctx, operator, mc.MissCount); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range data.Votes {
+		operator, err := k.validatorAddressCodec.StringToBytes(vote.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.Votes.Set(ctx, operator, vote); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the oracle module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := types.NewGenesisState(params)
+
+	err = k.ExchangeRates.Walk(ctx, nil, func(_ string, rate sdk.DecCoin) (bool, error) {
+		gs.ExchangeRates = append(gs.ExchangeRates, rate)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.FeederDelegations.Walk(ctx, nil, func(operator sdk.ValAddress, feeder []byte) (bool, error) {
+		validator, err := k.validatorAddressCodec.BytesToString(operator)
+		if err != nil {
+			return true, err
+		}
+		feederStr, err := k.addressCodec.BytesToString(feeder)
+		if err != nil {
+			return true, err
+		}
+		gs.FeederDelegations = append(gs.FeederDelegations, types.FeederDelegation{Validator: validator, Feeder: feederStr})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.MissCounters.Walk(ctx, nil, func(operator sdk.ValAddress, missCount uint64) (bool, error) {
+		validator, err := k.validatorAddressCodec.BytesToString(operator)
+		if err != nil {
+			return true, err
+		}
+		gs.MissCounters = append(gs.MissCounters, types.MissCounter{Validator: validator, MissCount: missCount})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Votes.Walk(ctx, nil, func(_ sdk.ValAddress, vote types.AggregateExchangeRateVote) (bool, error) {
+		gs.Votes = append(gs.Votes, vote)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
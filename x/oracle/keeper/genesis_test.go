@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func sdkDecCoin(denom string, amount int64) sdk.DecCoin {
+	return sdk.NewInt64DecCoin(denom, amount)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	params := types.DefaultParams()
+	params.Whitelist = []string{"uatom"}
+
+	gs := &types.GenesisState{
+		Params:            params,
+		ExchangeRates:     sdk.NewDecCoins(sdkDecCoin("uatom", 10)),
+		FeederDelegations: []types.FeederDelegation{{Validator: s.valStrs[0], Feeder: s.feeder(1)}},
+		MissCounters:      []types.MissCounter{{Validator: s.valStrs[2], MissCount: 3}},
+		Votes: []types.AggregateExchangeRateVote{
+			{Validator: s.valStrs[1], ExchangeRates: sdk.NewDecCoins(sdkDecCoin("uatom", 11))},
+		},
+	}
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/oracle QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the oracle module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ExchangeRate returns the current price of a denom.
+func (q queryServer) ExchangeRate(ctx context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
+	if req == nil || req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	rate, err := q.k.GetExchangeRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateResponse{ExchangeRate: rate}, nil
+}
+
+// ExchangeRates returns the current prices of all the denoms.
+func (q queryServer) ExchangeRates(ctx context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+	var rates sdk.DecCoins
+	err := q.k.ExchangeRates.Walk(ctx, nil, func(_ string, rate sdk.DecCoin) (bool, error) {
+		rates = append(rates, rate)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRatesResponse{ExchangeRates: rates}, nil
+}
+
+// FeederDelegation returns the account appointed by a validator to vote.
+func (q queryServer) FeederDelegation(ctx context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := q.k.validatorAddressCodec.StringToBytes(req.Validator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	feeder, err := q.k.GetFeeder(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederStr, err := q.k.addressCodec.BytesToString(feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeederDelegationResponse{Feeder: feederStr}, nil
+}
+
+// MissCounter returns the missed voting windows of a validator in the current
+// slash window.
+func (q queryServer) MissCounter(ctx context.Context, req *types.QueryMissCounterRequest) (*types.QueryMissCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := q.k.validatorAddressCodec.StringToBytes(req.Validator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	missCount, err := q.k.MissCounters.Get(ctx, operator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return &types.QueryMissCounterResponse{MissCount: missCount}, nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the oracle store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	environment   appmodule.Environment
+	stakingKeeper types.StakingKeeper
+
+	addressCodec          address.Codec
+	validatorAddressCodec address.Codec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// ExchangeRates contains the current price of each denom
+	ExchangeRates collections.Map[string, sdk.DecCoin]
+	// FeederDelegations contains the account appointed by each validator to vote
+	FeederDelegations collections.Map[sdk.ValAddress, []byte]
+	// MissCounters contains the missed voting windows of each validator in the
+	// current slash window
+	MissCounters collections.Map[sdk.ValAddress, uint64]
+	// Votes contains the votes submitted in the current voting window
+	Votes collections.Map[sdk.ValAddress, types.AggregateExchangeRateVote]
+}
+
+// NewKeeper creates a new oracle Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	addressCodec address.Codec,
+	sk types.StakingKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:                   cdc,
+		environment:           env,
+		stakingKeeper:         sk,
+		addressCodec:          addressCodec,
+		validatorAddressCodec: sk.ValidatorAddressCodec(),
+		authority:             authority,
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ExchangeRates:         collections.NewMap(sb, types.ExchangeRatesPrefix, "exchange_rates", collections.StringKey, codec.CollValue[sdk.DecCoin](cdc)),
+		FeederDelegations:     collections.NewMap(sb, types.FeederDelegationsPrefix, "feeder_delegations", sdk.ValAddressKey, collections.BytesValue),
+		MissCounters:          collections.NewMap(sb, types.MissCountersPrefix, "miss_counters", sdk.ValAddressKey, collections.Uint64Value),
+		Votes:                 collections.NewMap(sb, types.VotesPrefix, "votes", sdk.ValAddressKey, codec.CollValue[types.AggregateExchangeRateVote](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/oracle module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetExchangeRate returns the current price of a denom. It is the price source
+// other modules are expected to use.
+func (k Keeper) GetExchangeRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	rate, err := k.ExchangeRates.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, errorsmod.Wrap(types.ErrNoExchangeRate, denom)
+		}
+		return math.LegacyDec{}, err
+	}
+
+	return rate.Amount, nil
+}
+
+// GetFeeder returns the account allowed to vote on behalf of a validator:
+// the account it appointed, or by default the validator's own account.
+func (k Keeper) GetFeeder(ctx context.Context, validator sdk.ValAddress) (sdk.AccAddress, error) {
+	feeder, err := k.FeederDelegations.Get(ctx, validator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.AccAddress(validator), nil
+		}
+		return nil, err
+	}
+
+	return feeder, nil
+}
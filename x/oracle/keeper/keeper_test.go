@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/oracle"
+	"cosmossdk.io/x/oracle/keeper"
+	oracletestutil "cosmossdk.io/x/oracle/testutil"
+	"cosmossdk.io/x/oracle/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	msgServer     types.MsgServer
+	queryServer   types.QueryServer
+	stakingKeeper *oracletestutil.MockStakingKeeper
+
+	// validators are the bonded validators, sorted by decreasing power
+	validators []stakingtypes.Validator
+	valAddrs   []sdk.ValAddress
+	valStrs    []string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, oracle.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	s.validators, s.valAddrs, s.valStrs = nil, nil, nil
+	for _, power := range []int64{20, 10, 5} {
+		valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+		valStr, err := valCodec.BytesToString(valAddr)
+		s.Require().NoError(err)
+
+		validator, err := stakingtypes.NewValidator(valStr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+		s.Require().NoError(err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+
+		s.validators = append(s.validators, validator)
+		s.valAddrs = append(s.valAddrs, valAddr)
+		s.valStrs = append(s.valStrs, valStr)
+	}
+
+	ctrl := gomock.NewController(s.T())
+	s.stakingKeeper = oracletestutil.NewMockStakingKeeper(ctrl)
+	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, addr sdk.ValAddress) (sdk.ValidatorI, error) {
+			for i, valAddr := range s.valAddrs {
+				if valAddr.Equals(addr) {
+					return s.validators[i], nil
+				}
+			}
+			return nil, stakingtypes.ErrNoValidatorFound
+		}).AnyTimes()
+	s.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(int64, sdk.ValidatorI) bool) error {
+			for i, validator := range s.validators {
+				if validator.IsBonded() && fn(int64(i), validator) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, address.NewBech32Codec("cosmos"), s.stakingKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 2
+	params.Whitelist = []string{"uatom", "uusdc"}
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+}
+
+// feeder returns the default feeder account of the i-th validator.
+func (s *KeeperTestSuite) feeder(i int) string {
+	feeder, err := address.NewBech32Codec("cosmos").BytesToString(s.valAddrs[i])
+	s.Require().NoError(err)
+	return feeder
+}
+
+func (s *KeeperTestSuite) vote(i int, rates string) error {
+	exchangeRates, err := sdk.ParseDecCoins(rates)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.AggregateExchangeRateVote(s.ctx, &types.MsgAggregateExchangeRateVote{
+		Feeder:        s.feeder(i),
+		Validator:     s.valStrs[i],
+		ExchangeRates: exchangeRates,
+	})
+	return err
+}
+
+func (s *KeeperTestSuite) TestGetExchangeRate() {
+	_, err := s.keeper.GetExchangeRate(s.ctx, "uatom")
+	s.Require().ErrorIs(err, types.ErrNoExchangeRate)
+
+	s.Require().NoError(s.keeper.ExchangeRates.Set(s.ctx, "uatom", sdk.NewDecCoinFromDec("uatom", math.LegacyNewDec(12))))
+
+	rate, err := s.keeper.GetExchangeRate(s.ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(12), rate)
+
+	res, err := s.queryServer.ExchangeRates(s.ctx, &types.QueryExchangeRatesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", math.LegacyNewDec(12))), res.ExchangeRates)
+}
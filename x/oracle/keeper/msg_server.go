@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/oracle/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/oracle MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// AggregateExchangeRateVote records the price vote of a bonded validator for
+// the current voting window, replacing any previous vote of the window.
+func (ms msgServer) AggregateExchangeRateVote(ctx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
+	feeder, err := ms.addressCodec.StringToBytes(msg.Feeder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid feeder address: %s", err)
+	}
+
+	operator, err := ms.validatorAddressCodec.StringToBytes(msg.Validator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	expectedFeeder, err := ms.GetFeeder(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(feeder, expectedFeeder) {
+		return nil, errorsmod.Wrapf(types.ErrNoVotingPermission, "%s is not the feeder of %s", msg.Feeder, msg.Validator)
+	}
+
+	validator, err := ms.stakingKeeper.Validator(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+	if validator == nil || !validator.IsBonded() {
+		return nil, errorsmod.Wrap(types.ErrNotBondedValidator, msg.Validator)
+	}
+
+	if err := msg.ExchangeRates.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidExchangeRate, err.Error())
+	}
+	if msg.ExchangeRates.Empty() {
+		return nil, errorsmod.Wrap(types.ErrInvalidExchangeRate, "no exchange rate")
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rate := range msg.ExchangeRates {
+		if !params.IsWhitelisted(rate.Denom) {
+			return nil, errorsmod.Wrap(types.ErrUnknownDenom, rate.Denom)
+		}
+	}
+
+	vote := types.AggregateExchangeRateVote{Validator: msg.Validator, ExchangeRates: msg.ExchangeRates}
+	if err := ms.Votes.Set(ctx, operator, vote); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeVote,
+		event.NewAttribute(types.AttributeKeyValidator, msg.Validator),
+		event.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+		event.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAggregateExchangeRateVoteResponse{}, nil
+}
+
+// DelegateFeedConsent appoints the account submitting the votes of a validator.
+func (ms msgServer) DelegateFeedConsent(ctx context.Context, msg *types.MsgDelegateFeedConsent) (*types.MsgDelegateFeedConsentResponse, error) {
+	operator, err := ms.validatorAddressCodec.StringToBytes(msg.Operator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	feeder, err := ms.addressCodec.StringToBytes(msg.Feeder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid feeder address: %s", err)
+	}
+
+	validator, err := ms.stakingKeeper.Validator(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+	if validator == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("validator %s", msg.Operator)
+	}
+
+	if err := ms.FeederDelegations.Set(ctx, operator, feeder); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeFeedDelegate,
+		event.NewAttribute(types.AttributeKeyValidator, msg.Operator),
+		event.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateFeedConsentResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/oracle/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestAggregateExchangeRateVote() {
+	// the feeder of a validator is its own account by default
+	s.Require().NoError(s.vote(0, "10.5uatom,1.01uusdc"))
+
+	vote, err := s.keeper.Votes.Get(s.ctx, s.valAddrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.valStrs[0], vote.Validator)
+	s.Require().Equal("10.500000000000000000uatom,1.010000000000000000uusdc", vote.ExchangeRates.String())
+
+	// a new vote in the same window replaces the previous one
+	s.Require().NoError(s.vote(0, "11uatom"))
+	vote, err = s.keeper.Votes.Get(s.ctx, s.valAddrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal("11.000000000000000000uatom", vote.ExchangeRates.String())
+
+	// only whitelisted denoms can be voted
+	s.Require().ErrorIs(s.vote(0, "1ufoo"), types.ErrUnknownDenom)
+
+	// the account of another validator is not allowed to vote
+	_, err = s.msgServer.AggregateExchangeRateVote(s.ctx, &types.MsgAggregateExchangeRateVote{
+		Feeder:        s.feeder(1),
+		Validator:     s.valStrs[0],
+		ExchangeRates: sdk.NewDecCoins(sdk.NewInt64DecCoin("uatom", 1)),
+	})
+	s.Require().ErrorIs(err, types.ErrNoVotingPermission)
+
+	// unbonded validators cannot vote
+	s.validators[2].Status = stakingtypes.Unbonded
+	s.Require().ErrorIs(s.vote(2, "1uatom"), types.ErrNotBondedValidator)
+}
+
+func (s *KeeperTestSuite) TestDelegateFeedConsent() {
+	feeder := s.feeder(1)
+	_, err := s.msgServer.DelegateFeedConsent(s.ctx, &types.MsgDelegateFeedConsent{Operator: s.valStrs[0], Feeder: feeder})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.FeederDelegation(s.ctx, &types.QueryFeederDelegationRequest{Validator: s.valStrs[0]})
+	s.Require().NoError(err)
+	s.Require().Equal(feeder, res.Feeder)
+
+	// the appointed feeder replaces the validator account
+	s.Require().ErrorIs(s.vote(0, "1uatom"), types.ErrNoVotingPermission)
+	_, err = s.msgServer.AggregateExchangeRateVote(s.ctx, &types.MsgAggregateExchangeRateVote{
+		Feeder:        feeder,
+		Validator:     s.valStrs[0],
+		ExchangeRates: sdk.NewDecCoins(sdk.NewInt64DecCoin("uatom", 1)),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.Whitelist = []string{"uatom"}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.feeder(0), Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	params.VotePeriod = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().Error(err)
+
+	params.VotePeriod = 10
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
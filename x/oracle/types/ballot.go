@@ -0,0 +1,59 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// BallotVote is the price of a denom voted by a validator, weighted by the
+// validator's voting power.
+type BallotVote struct {
+	ExchangeRate math.LegacyDec
+	Power        int64
+}
+
+// ExchangeRateBallot is the set of votes for the price of a denom.
+type ExchangeRateBallot []BallotVote
+
+// Power returns the total voting power of the ballot.
+func (b ExchangeRateBallot) Power() int64 {
+	var total int64
+	for _, vote := range b {
+		total += vote.Power
+	}
+
+	return total
+}
+
+// WeightedMedian returns the price at which the cumulated voting power of the
+// votes, sorted by price, reaches half of the total voting power of the
+// ballot. It returns a zero price for an empty ballot.
+func (b ExchangeRateBallot) WeightedMedian() math.LegacyDec {
+	if len(b) == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	sorted := make(ExchangeRateBallot, len(b))
+	copy(sorted, b)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ExchangeRate.LT(sorted[j].ExchangeRate)
+	})
+
+	half := b.Power() / 2
+	var cumulated int64
+	for _, vote := range sorted {
+		cumulated += vote.Power
+		if cumulated > half {
+			return vote.ExchangeRate
+		}
+	}
+
+	return sorted[len(sorted)-1].ExchangeRate
+}
+
+// IsDeviant returns whether the price deviates from the median by more than
+// the given fraction of the median.
+func IsDeviant(exchangeRate, median, maxDeviation math.LegacyDec) bool {
+	return exchangeRate.Sub(median).Abs().GT(median.Mul(maxDeviation))
+}
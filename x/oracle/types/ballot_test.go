@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/types"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	dec := math.LegacyMustNewDecFromStr
+
+	testCases := []struct {
+		name   string
+		ballot types.ExchangeRateBallot
+		expect math.LegacyDec
+	}{
+		{"empty", nil, math.LegacyZeroDec()},
+		{"single vote", types.ExchangeRateBallot{{dec("1.5"), 10}}, dec("1.5")},
+		{
+			"equal power",
+			types.ExchangeRateBallot{{dec("3"), 1}, {dec("1"), 1}, {dec("2"), 1}},
+			dec("2"),
+		},
+		{
+			"heavy vote wins",
+			types.ExchangeRateBallot{{dec("1"), 1}, {dec("2"), 1}, {dec("10"), 5}},
+			dec("10"),
+		},
+		{
+			"exact half",
+			types.ExchangeRateBallot{{dec("1"), 2}, {dec("2"), 2}},
+			dec("2"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expect.String(), tc.ballot.WeightedMedian().String())
+		})
+	}
+}
+
+func TestIsDeviant(t *testing.T) {
+	dec := math.LegacyMustNewDecFromStr
+
+	require.False(t, types.IsDeviant(dec("1.05"), dec("1"), dec("0.1")))
+	require.False(t, types.IsDeviant(dec("1.1"), dec("1"), dec("0.1")))
+	require.True(t, types.IsDeviant(dec("1.11"), dec("1"), dec("0.1")))
+	require.True(t, types.IsDeviant(dec("0.89"), dec("1"), dec("0.1")))
+}
@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/oracle module sentinel errors
+var (
+	ErrInvalidSigner       = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrNoVotingPermission  = errors.Register(ModuleName, 2, "unauthorized voter")
+	ErrNotBondedValidator  = errors.Register(ModuleName, 3, "validator is not bonded")
+	ErrUnknownDenom        = errors.Register(ModuleName, 4, "denom is not whitelisted")
+	ErrInvalidExchangeRate = errors.Register(ModuleName, 5, "invalid exchange rate")
+	ErrNoExchangeRate      = errors.Register(ModuleName, 6, "no price for denom")
+)
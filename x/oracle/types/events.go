@@ -0,0 +1,16 @@
+package types
+
+// oracle module event types
+const (
+	EventTypeVote               = "aggregate_vote"
+	EventTypeFeedDelegate       = "feed_delegate"
+	EventTypeExchangeRateUpdate = "exchange_rate_update"
+	EventTypeSlash              = "oracle_slash"
+
+	AttributeKeyValidator     = "validator"
+	AttributeKeyFeeder        = "feeder"
+	AttributeKeyExchangeRates = "exchange_rates"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyExchangeRate  = "exchange_rate"
+	AttributeKeyMissCount     = "miss_count"
+)
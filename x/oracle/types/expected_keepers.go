@@ -0,0 +1,21 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to weight the votes
+// of the validators and to punish the ones which do not vote.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	Validator(ctx context.Context, addr sdk.ValAddress) (sdk.ValidatorI, error)
+	IterateBondedValidatorsByPower(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) (stop bool)) error
+	PowerReduction(ctx context.Context) math.Int
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error)
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+}
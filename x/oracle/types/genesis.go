@@ -0,0 +1,67 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{Params: params}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.ExchangeRates.Validate(); err != nil {
+		return fmt.Errorf("invalid exchange rates: %w", err)
+	}
+	for _, rate := range gs.ExchangeRates {
+		if !rate.IsPositive() {
+			return fmt.Errorf("exchange rate of %s must be positive", rate.Denom)
+		}
+	}
+
+	feeders := make(map[string]struct{}, len(gs.FeederDelegations))
+	for _, fd := range gs.FeederDelegations {
+		if fd.Validator == "" || fd.Feeder == "" {
+			return fmt.Errorf("feeder delegation validator and feeder cannot be empty")
+		}
+		if _, ok := feeders[fd.Validator]; ok {
+			return fmt.Errorf("duplicate feeder delegation for validator %s", fd.Validator)
+		}
+		feeders[fd.Validator] = struct{}{}
+	}
+
+	counters := make(map[string]struct{}, len(gs.MissCounters))
+	for _, mc := range gs.MissCounters {
+		if mc.Validator == "" {
+			return fmt.Errorf("miss counter validator cannot be empty")
+		}
+		if _, ok := counters[mc.Validator]; ok {
+			return fmt.Errorf("duplicate miss counter for validator %s", mc.Validator)
+		}
+		counters[mc.Validator] = struct{}{}
+	}
+
+	votes := make(map[string]struct{}, len(gs.Votes))
+	for _, vote := range gs.Votes {
+		if vote.Validator == "" {
+			return fmt.Errorf("vote validator cannot be empty")
+		}
+		if _, ok := votes[vote.Validator]; ok {
+			return fmt.Errorf("duplicate vote for validator %s", vote.Validator)
+		}
+		votes[vote.Validator] = struct{}{}
+		if err := vote.ExchangeRates.Validate(); err != nil {
+			return fmt.Errorf("invalid vote of %s: %w", vote.Validator, err)
+		}
+	}
+
+	return nil
+}
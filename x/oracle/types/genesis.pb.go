@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/oracle/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the oracle module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// exchange_rates are the current prices.
+	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
+	// feeder_delegations are the accounts appointed by validators to vote.
+	FeederDelegations []FeederDelegation `protobuf:"bytes,3,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
+	// miss_counters are the missed voting windows of the current slash window.
+	MissCounters []MissCounter `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
+	// votes are the votes submitted in the current voting window.
+	Votes []AggregateExchangeRateVote `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46043ca9c8436fa3, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetExchangeRates() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeederDelegations() []FeederDelegation {
+	if m != nil {
+		return m.FeederDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissCounters() []MissCounter {
+	if m != nil {
+		return m.MissCounters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotes() []AggregateExchangeRateVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.oracle.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("cosmos/oracle/v1/genesis.proto", fileDescriptor_46043ca9c8436fa3) }
+
+var fileDescriptor_46043ca9c8436fa3 = []byte{
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x6a, 0xd4, 0x50,
+	0x14, 0xc6, 0x13, 0xa7, 0x2d, 0x98, 0xb6, 0x62, 0x83, 0x8b, 0x50, 0x6d, 0x5a, 0xba, 0x2a, 0x16,
+	0xef, 0x65, 0x2a, 0x82, 0xe0, 0xca, 0xb4, 0x5a, 0x37, 0x82, 0x8c, 0xa0, 0xe0, 0x66, 0xb8, 0x49,
+	0x4e, 0x6f, 0x2f, 0x6d, 0x72, 0x86, 0x9c, 0x3b, 0xc3, 0xb8, 0xf0, 0x1d, 0x7c, 0x0c, 0x71, 0xe5,
+	0xca, 0x67, 0x98, 0xe5, 0x2c, 0x5d, 0xa9, 0xcc, 0x2c, 0x7c, 0x0d, 0xb9, 0x7f, 0xa2, 0x65, 0xb2,
+	0x49, 0x2e, 0xe7, 0x3b, 0xe7, 0xf7, 0x7d, 0xf7, 0x9e, 0x28, 0x2d, 0x90, 0x2a, 0x24, 0x8e, 0x8d,
+	0x28, 0xae, 0x81, 0x4f, 0xfa, 0x5c, 0x42, 0x0d, 0xa4, 0x88, 0x8d, 0x1a, 0xd4, 0x18, 0xdf, 0x75,
+	0x3a, 0x73, 0x3a, 0x9b, 0xf4, 0x77, 0xef, 0x49, 0x94, 0x68, 0x45, 0x6e, 0x4e, 0xae, 0x6f, 0x77,
+	0x47, 0x54, 0xaa, 0x46, 0x6e, 0xbf, 0xbe, 0xd4, 0xa2, 0x73, 0x41, 0x06, 0x9c, 0x83, 0x16, 0x7d,
+	0x5e, 0xa0, 0xaa, 0xbd, 0xbe, 0xd7, 0xb1, 0xf6, 0x26, 0x56, 0x3e, 0xfc, 0xde, 0x8b, 0xb6, 0xce,
+	0x5d, 0x96, 0xb7, 0x5a, 0x68, 0x88, 0x9f, 0x45, 0x1b, 0x23, 0xd1, 0x88, 0x8a, 0x92, 0xf0, 0x20,
+	0x3c, 0xda, 0x3c, 0x49, 0xd8, 0x6a, 0x36, 0xf6, 0xc6, 0xea, 0xd9, 0xed, 0xd9, 0xcf, 0xfd, 0xe0,
+	0xcb, 0x9f, 0x6f, 0x0f, 0xc3, 0x81, 0x1f, 0x89, 0x3f, 0x45, 0x77, 0x60, 0x5a, 0x5c, 0x8a, 0x5a,
+	0xc2, 0xb0, 0x11, 0x1a, 0x28, 0xb9, 0x75, 0xd0, 0x3b, 0xda, 0x3c, 0x79, 0xd0, 0x42, 0x4c, 0x4a,
+	0xe6, 0x53, 0xb2, 0x33, 0x28, 0x4e, 0x51, 0xd5, 0xd9, 0x53, 0x03, 0xfa, 0xfa, 0x6b, 0xff, 0x58,
+	0x2a, 0x7d, 0x39, 0xce, 0x59, 0x81, 0x15, 0xf7, 0xa9, 0xdd, 0xef, 0x11, 0x95, 0x57, 0x5c, 0x7f,
+	0x1c, 0x01, 0xb5, 0x33, 0xe4, 0x7c, 0xb7, 0x5b, 0xb7, 0x81, 0x31, 0x8b, 0xdf, 0x47, 0xf1, 0x05,
+	0x40, 0x09, 0xcd, 0xb0, 0x84, 0x6b, 0x90, 0x42, 0x2b, 0xac, 0x29, 0xe9, 0xd9, 0x08, 0x87, 0xdd,
+	0x7b, 0xbc, 0xb4, 0xbd, 0x67, 0xff, 0x5a, 0xb3, 0x35, 0x13, 0x64, 0xb0, 0x73, 0xb1, 0x52, 0xa7,
+	0xf8, 0x55, 0xb4, 0x5d, 0x29, 0xa2, 0x61, 0x81, 0xe3, 0x5a, 0x43, 0x43, 0xc9, 0x9a, 0x65, 0xee,
+	0x75, 0x99, 0xaf, 0x15, 0xd1, 0xa9, 0xeb, 0xf2, 0xb8, 0xad, 0xea, 0x7f, 0x89, 0xe2, 0xf3, 0x68,
+	0x7d, 0x82, 0xe6, 0x61, 0xd6, 0x2d, 0xe1, 0xb8, 0x4b, 0x78, 0x2e, 0x65, 0x63, 0x8c, 0xe1, 0xc5,
+	0x8d, 0xbb, 0xbd, 0x43, 0x0d, 0x9e, 0xe7, 0xe6, 0xb3, 0x27, 0xb3, 0x45, 0x1a, 0xce, 0x17, 0x69,
+	0xf8, 0x7b, 0x91, 0x86, 0x9f, 0x97, 0x69, 0x30, 0x5f, 0xa6, 0xc1, 0x8f, 0x65, 0x1a, 0x7c, 0xb8,
+	0xef, 0x90, 0x54, 0x5e, 0x31, 0x85, 0x7c, 0xda, 0x6e, 0xde, 0xbe, 0x5f, 0xbe, 0x61, 0xd7, 0xfe,
+	0xf8, 0xef, 0x00, 0xc2, 0x32, 0x28, 0x90, 0x92, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MissCounters) > 0 {
+		for iNdEx := len(m.MissCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeederDelegations) > 0 {
+		for iNdEx := len(m.FeederDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeederDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeederDelegations) > 0 {
+		for _, e := range m.FeederDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissCounters) > 0 {
+		for _, e := range m.MissCounters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederDelegations = append(m.FeederDelegations, FeederDelegation{})
+			if err := m.FeederDelegations[len(m.FeederDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissCounters = append(m.MissCounters, MissCounter{})
+			if err := m.MissCounters[len(m.MissCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, AggregateExchangeRateVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
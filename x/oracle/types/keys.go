@@ -0,0 +1,23 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey               = collections.NewPrefix(0)
+	ExchangeRatesPrefix     = collections.NewPrefix(1)
+	FeederDelegationsPrefix = collections.NewPrefix(2)
+	MissCountersPrefix      = collections.NewPrefix(3)
+	VotesPrefix             = collections.NewPrefix(4)
+)
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default x/oracle module parameters. No denom is
+// whitelisted by default, prices must be enabled through governance.
+func DefaultParams() Params {
+	return Params{
+		VotePeriod:        5,
+		VoteThreshold:     math.LegacyNewDecWithPrec(50, 2),
+		MaxDeviation:      math.LegacyNewDecWithPrec(10, 2),
+		Whitelist:         []string{},
+		SlashWindow:       1000,
+		MinValidPerWindow: math.LegacyNewDecWithPrec(5, 2),
+		SlashFraction:     math.LegacyNewDecWithPrec(1, 4),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.VotePeriod == 0 {
+		return fmt.Errorf("vote period must be positive")
+	}
+	if p.SlashWindow == 0 {
+		return fmt.Errorf("slash window must be positive")
+	}
+	if err := validateFraction("vote threshold", p.VoteThreshold); err != nil {
+		return err
+	}
+	if !p.VoteThreshold.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive: %s", p.VoteThreshold)
+	}
+	if p.MaxDeviation.IsNil() || p.MaxDeviation.IsNegative() {
+		return fmt.Errorf("max deviation cannot be nil or negative: %s", p.MaxDeviation)
+	}
+	if err := validateFraction("min valid per window", p.MinValidPerWindow); err != nil {
+		return err
+	}
+	if err := validateFraction("slash fraction", p.SlashFraction); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(p.Whitelist))
+	for _, denom := range p.Whitelist {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicate whitelisted denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsWhitelisted returns whether prices are voted for the given denom.
+func (p Params) IsWhitelisted(denom string) bool {
+	for _, d := range p.Whitelist {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateFraction(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s cannot be nil", name)
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1: %s", name, v)
+	}
+
+	return nil
+}
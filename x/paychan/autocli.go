@@ -0,0 +1,80 @@
+package paychan
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	paychanv1 "cosmossdk.io/api/cosmos/paychan/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: paychanv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current payment channels parameters",
+				},
+				{
+					RpcMethod:      "Channel",
+					Use:            "channel [channel_id]",
+					Short:          "Query an open channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod:      "ChannelsBySender",
+					Use:            "channels-by-sender [sender]",
+					Short:          "Query the open channels of a sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+				},
+				{
+					RpcMethod:      "ChannelsByRecipient",
+					Use:            "channels-by-recipient [recipient]",
+					Short:          "Query the open channels of a recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              paychanv1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "OpenChannel",
+					Use:            "open [recipient] [deposit] [timeout] --from [sender]",
+					Short:          "Open a payment channel to a recipient, locking a deposit until the timeout",
+					Example:        fmt.Sprintf("%s tx paychan open cosmos1... 1000stake 720h --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "deposit"}, {ProtoField: "timeout"}},
+				},
+				{
+					RpcMethod:      "ClaimChannel",
+					Use:            "claim [channel_id] [amount] [signature] --from [recipient]",
+					Short:          "Claim a voucher signed by the sender of a channel, optionally closing the channel",
+					Example:        fmt.Sprintf("%s tx paychan claim 1 100stake <base64-signature> --close --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "amount"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "CloseChannel",
+					Use:            "close [channel_id] --from [sender]",
+					Short:          "Close a channel, refunding the unclaimed deposit at the end of the dispute period",
+					Example:        fmt.Sprintf("%s tx paychan close 1 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update paychan module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update paychan module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query paychan params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx paychan update-params-proposal '{ "dispute_period": "86400s", "max_timeout": "31536000s" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
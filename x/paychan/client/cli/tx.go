@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/paychan/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/paychan transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Payment channels transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSignVoucherCmd(),
+	)
+
+	return txCmd
+}
+
+// NewSignVoucherCmd returns a CLI command handler for signing, off-chain, a
+// voucher of a channel with the key of its sender.
+func NewSignVoucherCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-voucher [channel_id] [amount]",
+		Short: "Sign a voucher paying the given cumulative amount through a channel",
+		Long: `Sign, off-chain, a voucher paying the given cumulative amount through a channel
+with the key of its sender, and print the base64 encoded signature. The voucher
+is handed to the recipient of the channel, which claims it with the signature.
+No transaction is broadcast.`,
+		Example: fmt.Sprintf("%s tx paychan sign-voucher 1 100stake --from mykey --chain-id mychain", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("the chain ID must be set with --%s", flags.FlagChainID)
+			}
+
+			channelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid channel id %s: %w", args[0], err)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := types.NewVoucher(clientCtx.ChainID, channelID, amount).SignBytes()
+			if err != nil {
+				return err
+			}
+
+			sig, _, err := clientCtx.Keyring.Sign(clientCtx.FromName, bz, signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(base64.StdEncoding.EncodeToString(sig))
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/paychan/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker settles the channels whose dispute period ended or which timed
+// out, refunding their unclaimed deposit to their sender. A channel which fails
+// to settle is retried at the next block.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	var settled []uint64
+	err := k.Settlements.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(now) {
+			return true, nil
+		}
+		settled = append(settled, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range settled {
+		channel, err := k.Channels.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// a channel which cannot settle, e.g. because its refund is not
+		// sendable, is left unchanged and retried at the next block, so that it
+		// does not halt the chain
+		if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.settleChannel(ctx, channel)
+		}); err != nil {
+			k.Logger(ctx).Error("failed to settle channel", "channel_id", id, "err", err)
+		}
+	}
+
+	return nil
+}
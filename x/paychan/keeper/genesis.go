@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/paychan/types"
+)
+
+// InitGenesis initializes the paychan module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	if err := k.NextChannelID.Set(ctx, data.NextChannelId); err != nil {
+		return err
+	}
+
+	for _, channel := range data.Channels {
+		if err := k.setChannel(ctx, channel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the paychan module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextChannelID, err := k.NextChannelID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []types.Channel
+	err = k.Channels.Walk(ctx, nil, func(_ uint64, channel types.Channel) (bool, error) {
+		channels = append(channels, channel)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, channels, nextChannelID), nil
+}
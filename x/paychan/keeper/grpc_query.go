@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/paychan/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/paychan QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the paychan module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Channel returns an open channel.
+func (q queryServer) Channel(ctx context.Context, req *types.QueryChannelRequest) (*types.QueryChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	channel, err := q.k.GetChannel(ctx, req.ChannelId)
+	if err != nil {
+		if errors.Is(err, types.ErrChannelNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryChannelResponse{Channel: channel}, nil
+}
+
+// ChannelsBySender returns the open channels of a sender.
+func (q queryServer) ChannelsBySender(ctx context.Context, req *types.QueryChannelsBySenderRequest) (*types.QueryChannelsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := q.k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	channels, pageRes, err := q.paginateChannels(ctx, q.k.ChannelsBySender, sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelsBySenderResponse{Channels: channels, Pagination: pageRes}, nil
+}
+
+// ChannelsByRecipient returns the open channels of a recipient.
+func (q queryServer) ChannelsByRecipient(ctx context.Context, req *types.QueryChannelsByRecipientRequest) (*types.QueryChannelsByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := q.k.addressCodec.StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err)
+	}
+
+	channels, pageRes, err := q.paginateChannels(ctx, q.k.ChannelsByRecipient, recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelsByRecipientResponse{Channels: channels, Pagination: pageRes}, nil
+}
+
+// paginateChannels returns a page of the channels of an address in the given
+// index.
+func (q queryServer) paginateChannels(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]],
+	addr sdk.AccAddress,
+	pageReq *query.PageRequest,
+) ([]types.Channel, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		index,
+		pageReq,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Channel, error) {
+			return q.k.Channels.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+}
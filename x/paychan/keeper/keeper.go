@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/paychan/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the paychan store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	environment   appmodule.Environment
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	addressCodec  address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema        collections.Schema
+	Params        collections.Item[types.Params]
+	NextChannelID collections.Sequence
+	// Channels contains the open channels
+	Channels collections.Map[uint64, types.Channel]
+	// ChannelsBySender indexes the open channels by sender
+	ChannelsBySender collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// ChannelsByRecipient indexes the open channels by recipient
+	ChannelsByRecipient collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// Settlements indexes the open channels by settlement time
+	Settlements collections.KeySet[collections.Pair[time.Time, uint64]]
+}
+
+// NewKeeper creates a new paychan Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:                 cdc,
+		environment:         env,
+		accountKeeper:       ak,
+		bankKeeper:          bk,
+		addressCodec:        ak.AddressCodec(),
+		authority:           authority,
+		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		NextChannelID:       collections.NewSequence(sb, types.NextChannelIDKey, "next_channel_id"),
+		Channels:            collections.NewMap(sb, types.ChannelsPrefix, "channels", collections.Uint64Key, codec.CollValue[types.Channel](cdc)),
+		ChannelsBySender:    collections.NewKeySet(sb, types.ChannelsBySenderPrefix, "channels_by_sender", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		ChannelsByRecipient: collections.NewKeySet(sb, types.ChannelsByRecipientPrefix, "channels_by_recipient", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		Settlements:         collections.NewKeySet(sb, types.SettlementsPrefix, "settlements", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/paychan module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// GetChannel returns an open channel, or ErrChannelNotFound.
+func (k Keeper) GetChannel(ctx context.Context, id uint64) (types.Channel, error) {
+	channel, err := k.Channels.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return channel, errorsmod.Wrapf(types.ErrChannelNotFound, "channel %d", id)
+	}
+
+	return channel, err
+}
+
+// setChannel stores a channel and its indexes, replacing the settlement index
+// entry of its previous version, if any.
+func (k Keeper) setChannel(ctx context.Context, channel types.Channel) error {
+	previous, err := k.Channels.Get(ctx, channel.Id)
+	switch {
+	case err == nil:
+		if err := k.Settlements.Remove(ctx, collections.Join(previous.SettlesAt(), previous.Id)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	sender, err := k.addressCodec.StringToBytes(channel.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addressCodec.StringToBytes(channel.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Channels.Set(ctx, channel.Id, channel); err != nil {
+		return err
+	}
+	if err := k.ChannelsBySender.Set(ctx, collections.Join(sdk.AccAddress(sender), channel.Id)); err != nil {
+		return err
+	}
+	if err := k.ChannelsByRecipient.Set(ctx, collections.Join(sdk.AccAddress(recipient), channel.Id)); err != nil {
+		return err
+	}
+
+	return k.Settlements.Set(ctx, collections.Join(channel.SettlesAt(), channel.Id))
+}
+
+// settleChannel refunds the unclaimed deposit of a channel to its sender and
+// removes the channel.
+func (k Keeper) settleChannel(ctx context.Context, channel types.Channel) error {
+	sender, err := k.addressCodec.StringToBytes(channel.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addressCodec.StringToBytes(channel.Recipient)
+	if err != nil {
+		return err
+	}
+
+	refund := channel.Unclaimed()
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, refund); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Channels.Remove(ctx, channel.Id); err != nil {
+		return err
+	}
+	if err := k.ChannelsBySender.Remove(ctx, collections.Join(sdk.AccAddress(sender), channel.Id)); err != nil {
+		return err
+	}
+	if err := k.ChannelsByRecipient.Remove(ctx, collections.Join(sdk.AccAddress(recipient), channel.Id)); err != nil {
+		return err
+	}
+	if err := k.Settlements.Remove(ctx, collections.Join(channel.SettlesAt(), channel.Id)); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSettleChannel,
+		event.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(channel.Id, 10)),
+		event.NewAttribute(types.AttributeKeyClaimed, channel.Claimed.String()),
+		event.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	)
+}
@@ -0,0 +1,318 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/paychan"
+	"cosmossdk.io/x/paychan/keeper"
+	paychantestutil "cosmossdk.io/x/paychan/testutil"
+	"cosmossdk.io/x/paychan/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	chainID          = "paychan-test"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *paychantestutil.MockBankKeeper
+	params      types.Params
+	now         time.Time
+
+	senderKey    *secp256k1.PrivKey
+	senderAcc    *authtypes.BaseAccount
+	sender       sdk.AccAddress
+	senderStr    string
+	recipient    sdk.AccAddress
+	recipientStr string
+	deposit      sdk.Coins
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, paychan.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{ChainID: chainID, Time: s.now})
+
+	ac := address.NewBech32Codec("cosmos")
+	s.senderKey = secp256k1.GenPrivKey()
+	s.sender = sdk.AccAddress(s.senderKey.PubKey().Address())
+	s.recipient = sdk.AccAddress("recipient")
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := paychantestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	s.senderAcc = authtypes.NewBaseAccountWithAddress(s.sender)
+	s.Require().NoError(s.senderAcc.SetPubKey(s.senderKey.PubKey()))
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), s.sender).Return(s.senderAcc).AnyTimes()
+	s.bankKeeper = paychantestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	s.params = types.DefaultParams()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.NewGenesisState(s.params, nil, 1)))
+
+	var err error
+	s.senderStr, err = ac.BytesToString(s.sender)
+	s.Require().NoError(err)
+	s.recipientStr, err = ac.BytesToString(s.recipient)
+	s.Require().NoError(err)
+	s.deposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+}
+
+func (s *KeeperTestSuite) openChannel(timeout time.Duration) uint64 {
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.sender, types.ModuleName, s.deposit).Return(nil)
+	res, err := s.msgServer.OpenChannel(s.ctx, &types.MsgOpenChannel{
+		Sender:    s.senderStr,
+		Recipient: s.recipientStr,
+		Deposit:   s.deposit,
+		Timeout:   timeout,
+	})
+	s.Require().NoError(err)
+	return res.ChannelId
+}
+
+func (s *KeeperTestSuite) signVoucher(channelID uint64, amount sdk.Coins) []byte {
+	bz, err := types.NewVoucher(chainID, channelID, amount).SignBytes()
+	s.Require().NoError(err)
+	sig, err := s.senderKey.Sign(bz)
+	s.Require().NoError(err)
+	return sig
+}
+
+func (s *KeeperTestSuite) TestOpenChannel() {
+	_, err := s.msgServer.OpenChannel(s.ctx, &types.MsgOpenChannel{
+		Sender: s.senderStr, Recipient: s.recipientStr, Deposit: s.deposit, Timeout: s.params.MaxTimeout + time.Second,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidTimeout)
+
+	_, err = s.msgServer.OpenChannel(s.ctx, &types.MsgOpenChannel{
+		Sender: s.senderStr, Recipient: s.senderStr, Deposit: s.deposit, Timeout: time.Hour,
+	})
+	s.Require().Error(err)
+
+	id := s.openChannel(time.Hour)
+	s.Require().Equal(uint64(1), id)
+	s.Require().Equal(uint64(2), s.openChannel(time.Hour))
+
+	res, err := s.queryServer.Channel(s.ctx, &types.QueryChannelRequest{ChannelId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(s.deposit, res.Channel.Deposit)
+	s.Require().Equal(s.now.Add(time.Hour), res.Channel.ExpiresAt)
+
+	bySender, err := s.queryServer.ChannelsBySender(s.ctx, &types.QueryChannelsBySenderRequest{
+		Sender: s.senderStr, Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(bySender.Channels, 1)
+	s.Require().Equal(uint64(2), bySender.Pagination.Total)
+
+	byRecipient, err := s.queryServer.ChannelsByRecipient(s.ctx, &types.QueryChannelsByRecipientRequest{Recipient: s.recipientStr})
+	s.Require().NoError(err)
+	s.Require().Len(byRecipient.Channels, 2)
+}
+
+func (s *KeeperTestSuite) TestClaimChannel() {
+	id := s.openChannel(time.Hour)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	sig := s.signVoucher(id, amount)
+
+	_, err := s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{Recipient: s.senderStr, ChannelId: id, Amount: amount, Signature: sig})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{
+		Recipient: s.recipientStr, ChannelId: id, Amount: amount.Add(amount...), Signature: sig,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	tooMuch := s.deposit.Add(amount...)
+	_, err = s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{
+		Recipient: s.recipientStr, ChannelId: id, Amount: tooMuch, Signature: s.signVoucher(id, tooMuch),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidVoucher)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.recipient, amount).Return(nil)
+	res, err := s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{Recipient: s.recipientStr, ChannelId: id, Amount: amount, Signature: sig})
+	s.Require().NoError(err)
+	s.Require().Equal(amount, res.Claimed)
+
+	// a voucher cannot be claimed twice
+	_, err = s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{Recipient: s.recipientStr, ChannelId: id, Amount: amount, Signature: sig})
+	s.Require().ErrorIs(err, types.ErrInvalidVoucher)
+
+	// a later voucher pays the difference and closes the channel cooperatively
+	total := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	rest := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	refund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 700))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.recipient, rest).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.sender, refund).Return(nil)
+	res, err = s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{
+		Recipient: s.recipientStr, ChannelId: id, Amount: total, Signature: s.signVoucher(id, total), Close: true,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(rest, res.Claimed)
+
+	_, err = s.keeper.GetChannel(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrChannelNotFound)
+	has, err := s.keeper.ChannelsBySender.Has(s.ctx, collections.Join(s.sender, id))
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestClaimChannelAfterKeyRotation() {
+	id := s.openChannel(time.Hour)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	sig := s.signVoucher(id, amount)
+
+	// the sender rotates its key after opening the channel
+	oldKey := s.senderKey
+	s.senderKey = secp256k1.GenPrivKey()
+	s.Require().NoError(s.senderAcc.SetPubKey(s.senderKey.PubKey()))
+
+	// a voucher of the new key is not valid for the channel
+	_, err := s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{
+		Recipient: s.recipientStr, ChannelId: id, Amount: amount, Signature: s.signVoucher(id, amount),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	// a voucher of the key at open still is
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.recipient, amount).Return(nil)
+	_, err = s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{Recipient: s.recipientStr, ChannelId: id, Amount: amount, Signature: sig})
+	s.Require().NoError(err)
+
+	channel, err := s.keeper.GetChannel(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().True(oldKey.PubKey().Equals(channel.SenderPubKeyValue()))
+}
+
+func (s *KeeperTestSuite) TestCloseChannel() {
+	id := s.openChannel(7 * 24 * time.Hour)
+
+	_, err := s.msgServer.CloseChannel(s.ctx, &types.MsgCloseChannel{Sender: s.recipientStr, ChannelId: id})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	res, err := s.msgServer.CloseChannel(s.ctx, &types.MsgCloseChannel{Sender: s.senderStr, ChannelId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(s.now.Add(s.params.DisputePeriod), res.ClosesAt)
+
+	_, err = s.msgServer.CloseChannel(s.ctx, &types.MsgCloseChannel{Sender: s.senderStr, ChannelId: id})
+	s.Require().ErrorIs(err, types.ErrChannelClosing)
+
+	// the recipient can still claim during the dispute period
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{ChainID: chainID, Time: s.now.Add(time.Hour)})
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.recipient, amount).Return(nil)
+	_, err = s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{
+		Recipient: s.recipientStr, ChannelId: id, Amount: amount, Signature: s.signVoucher(id, amount),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	// the channel settles at the end of the dispute period
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{ChainID: chainID, Time: res.ClosesAt})
+	_, err = s.msgServer.ClaimChannel(s.ctx, &types.MsgClaimChannel{
+		Recipient: s.recipientStr, ChannelId: id, Amount: s.deposit, Signature: s.signVoucher(id, s.deposit),
+	})
+	s.Require().ErrorIs(err, types.ErrChannelNotFound)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.sender, s.deposit.Sub(amount...)).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	_, err = s.keeper.GetChannel(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrChannelNotFound)
+}
+
+func (s *KeeperTestSuite) TestTimeout() {
+	id := s.openChannel(time.Hour)
+	s.openChannel(2 * time.Hour)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{ChainID: chainID, Time: s.now.Add(time.Hour)})
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.sender, s.deposit).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	_, err := s.keeper.GetChannel(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrChannelNotFound)
+	_, err = s.keeper.GetChannel(s.ctx, id+1)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSettlementFailure() {
+	id := s.openChannel(time.Hour)
+
+	// a channel which fails to settle does not fail the block, and is retried at
+	// the next one
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{ChainID: chainID, Time: s.now.Add(time.Hour)})
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.sender, s.deposit).Return(errors.New("refund failed"))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	has, err := s.keeper.Channels.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().True(has)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.sender, s.deposit).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	has, err = s.keeper.Channels.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.senderStr, Params: s.params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: types.Params{}})
+	s.Require().Error(err)
+
+	params := types.Params{DisputePeriod: time.Hour, MaxTimeout: 24 * time.Hour}
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestExportGenesis() {
+	id := s.openChannel(time.Hour)
+	_, err := s.msgServer.CloseChannel(s.ctx, &types.MsgCloseChannel{Sender: s.senderStr, ChannelId: id})
+	s.Require().NoError(err)
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.Channels, 1)
+	s.Require().Equal(uint64(2), gs.NextChannelId)
+	s.Require().NotNil(gs.Channels[0].ClosesAt)
+}
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/paychan/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/paychan MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// OpenChannel opens a payment channel, locking the deposit of the sender in the
+// module account until the channel settles.
+func (ms msgServer) OpenChannel(ctx context.Context, msg *types.MsgOpenChannel) (*types.MsgOpenChannelResponse, error) {
+	sender, err := ms.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := ms.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if msg.Sender == msg.Recipient {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("sender and recipient must differ")
+	}
+	if !msg.Deposit.IsValid() || msg.Deposit.IsZero() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid deposit: %s", msg.Deposit)
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Timeout <= 0 || msg.Timeout > params.MaxTimeout {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTimeout, "timeout must be positive and at most %s, got %s", params.MaxTimeout, msg.Timeout)
+	}
+
+	// vouchers are verified against the key of the sender at open, so that a
+	// later rotation of its key does not invalidate them, or let the new key
+	// issue vouchers in their place
+	acc := ms.accountKeeper.GetAccount(ctx, sender)
+	if acc == nil || acc.GetPubKey() == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignature, "sender %s has no public key", msg.Sender)
+	}
+	senderPubKey, err := codectypes.NewAnyWithValue(acc.GetPubKey())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.NextChannelID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := types.Channel{
+		Id:           id,
+		Sender:       msg.Sender,
+		Recipient:    msg.Recipient,
+		Deposit:      msg.Deposit,
+		ExpiresAt:    ms.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(msg.Timeout),
+		SenderPubKey: senderPubKey,
+	}
+	if err := ms.setChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeOpenChannel,
+		event.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, msg.Sender),
+		event.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		event.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+		event.NewAttribute(types.AttributeKeyExpiresAt, channel.ExpiresAt.Format(time.RFC3339Nano)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOpenChannelResponse{ChannelId: id}, nil
+}
+
+// ClaimChannel pays the recipient of a channel the cumulative amount of a
+// voucher signed by the sender, minus the amount already claimed, and closes
+// the channel if requested.
+func (ms msgServer) ClaimChannel(ctx context.Context, msg *types.MsgClaimChannel) (*types.MsgClaimChannelResponse, error) {
+	channel, err := ms.getOpenChannel(ctx, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Recipient != msg.Recipient {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the recipient of channel %d", msg.Recipient, msg.ChannelId)
+	}
+
+	claimed := sdk.NewCoins()
+	if !msg.Amount.Empty() {
+		claimed, err = ms.verifyVoucher(ctx, channel, msg.Amount, msg.Signature)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if claimed.IsZero() && !msg.Close {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVoucher, "nothing to claim above %s", channel.Claimed)
+	}
+
+	if !claimed.IsZero() {
+		recipient, err := ms.addressCodec.StringToBytes(msg.Recipient)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		}
+		if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, claimed); err != nil {
+			return nil, err
+		}
+		channel.Claimed = channel.Claimed.Add(claimed...)
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeClaimChannel,
+		event.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(msg.ChannelId, 10)),
+		event.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		event.NewAttribute(types.AttributeKeyClaimed, claimed.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	if msg.Close {
+		err = ms.settleChannel(ctx, channel)
+	} else {
+		err = ms.setChannel(ctx, channel)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimChannelResponse{Claimed: claimed}, nil
+}
+
+// CloseChannel starts the dispute period of a channel. The recipient can claim
+// its latest voucher until the end of the dispute period, at which the channel
+// settles.
+func (ms msgServer) CloseChannel(ctx context.Context, msg *types.MsgCloseChannel) (*types.MsgCloseChannelResponse, error) {
+	channel, err := ms.getOpenChannel(ctx, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the sender of channel %d", msg.Sender, msg.ChannelId)
+	}
+	if channel.ClosesAt != nil {
+		return nil, errorsmod.Wrapf(types.ErrChannelClosing, "channel %d closes at %s", msg.ChannelId, channel.ClosesAt)
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	closesAt := ms.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(params.DisputePeriod)
+	channel.ClosesAt = &closesAt
+	if err := ms.setChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCloseChannel,
+		event.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(msg.ChannelId, 10)),
+		event.NewAttribute(types.AttributeKeyClosesAt, channel.SettlesAt().Format(time.RFC3339Nano)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCloseChannelResponse{ClosesAt: channel.SettlesAt()}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getOpenChannel returns a channel which has not reached its settlement time.
+// Settled channels are only removed at the end of the block.
+func (ms msgServer) getOpenChannel(ctx context.Context, id uint64) (types.Channel, error) {
+	channel, err := ms.GetChannel(ctx, id)
+	if err != nil {
+		return channel, err
+	}
+
+	if !ms.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(channel.SettlesAt()) {
+		return channel, errorsmod.Wrapf(types.ErrChannelNotFound, "channel %d settled", id)
+	}
+
+	return channel, nil
+}
+
+// verifyVoucher checks that the voucher of the given cumulative amount is
+// signed by the key of the sender at the opening of the channel and returns the amount it pays above the
+// amount already claimed.
+func (ms msgServer) verifyVoucher(ctx context.Context, channel types.Channel, amount sdk.Coins, sig []byte) (sdk.Coins, error) {
+	if !amount.IsValid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVoucher, "invalid amount %s", amount)
+	}
+	if !channel.Deposit.IsAllGTE(amount) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVoucher, "amount %s exceeds the deposit %s", amount, channel.Deposit)
+	}
+	claimed, hasNeg := amount.SafeSub(channel.Claimed...)
+	if hasNeg {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVoucher, "amount %s is below the claimed amount %s", amount, channel.Claimed)
+	}
+
+	pubKey := channel.SenderPubKeyValue()
+	if pubKey == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignature, "channel %d has no sender public key", channel.Id)
+	}
+
+	voucher := types.NewVoucher(ms.environment.HeaderService.GetHeaderInfo(ctx).ChainID, channel.Id, amount)
+	if !voucher.VerifySignature(pubKey, sig) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignature, "voucher of %s on channel %d", amount, channel.Id)
+	}
+
+	return claimed, nil
+}
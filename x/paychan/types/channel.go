@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = Channel{}
+
+// Validate performs a basic validation of the channel.
+func (c Channel) Validate() error {
+	if c.Sender == "" || c.Recipient == "" {
+		return fmt.Errorf("channel %d must have a sender and a recipient", c.Id)
+	}
+	if c.Sender == c.Recipient {
+		return fmt.Errorf("channel %d sender and recipient must differ", c.Id)
+	}
+	if err := c.Deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit of channel %d: %w", c.Id, err)
+	}
+	if c.Deposit.IsZero() {
+		return fmt.Errorf("deposit of channel %d cannot be zero", c.Id)
+	}
+	if err := c.Claimed.Validate(); err != nil {
+		return fmt.Errorf("invalid claimed amount of channel %d: %w", c.Id, err)
+	}
+	if !c.Deposit.IsAllGTE(c.Claimed) {
+		return fmt.Errorf("claimed amount of channel %d exceeds its deposit", c.Id)
+	}
+	if c.SenderPubKey == nil {
+		return fmt.Errorf("channel %d must have a sender public key", c.Id)
+	}
+
+	return nil
+}
+
+// SenderPubKeyValue returns the public key of the sender when it opened the
+// channel, or nil if it is not set.
+func (c Channel) SenderPubKeyValue() cryptotypes.PubKey {
+	if c.SenderPubKey == nil {
+		return nil
+	}
+	pk, ok := c.SenderPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil
+	}
+
+	return pk
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c Channel) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if c.SenderPubKey == nil {
+		return nil
+	}
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(c.SenderPubKey, &pubKey)
+}
+
+// SettlesAt returns the time at which the unclaimed deposit of the channel is
+// refunded to the sender: the end of its dispute period if the sender closed
+// the channel, and its expiration time otherwise.
+func (c Channel) SettlesAt() time.Time {
+	if c.ClosesAt != nil && c.ClosesAt.Before(c.ExpiresAt) {
+		return *c.ClosesAt
+	}
+
+	return c.ExpiresAt
+}
+
+// Unclaimed returns the part of the deposit not claimed by the recipient.
+func (c Channel) Unclaimed() sdk.Coins {
+	return c.Deposit.Sub(c.Claimed...)
+}
+
+// NewVoucher creates a new voucher of the given cumulative amount paid through
+// a channel.
+func NewVoucher(chainID string, channelID uint64, amount sdk.Coins) Voucher {
+	return Voucher{ChainId: chainID, ChannelId: channelID, Amount: amount}
+}
+
+// SignBytes returns the bytes signed by the sender of a channel to issue the
+// voucher: its protobuf encoding.
+func (v Voucher) SignBytes() ([]byte, error) {
+	return v.Marshal()
+}
+
+// VerifySignature returns true if the voucher is signed by the given key.
+func (v Voucher) VerifySignature(pubKey cryptotypes.PubKey, sig []byte) bool {
+	bz, err := v.SignBytes()
+	if err != nil {
+		return false
+	}
+
+	return pubKey.VerifySignature(bz, sig)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/paychan/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgOpenChannel{}, "cosmos-sdk/paychan/MsgOpenChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimChannel{}, "cosmos-sdk/paychan/MsgClaimChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgCloseChannel{}, "cosmos-sdk/paychan/MsgCloseChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/paychan/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgOpenChannel{},
+		&MsgClaimChannel{},
+		&MsgCloseChannel{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/paychan module sentinel errors
+var (
+	ErrInvalidSigner    = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrChannelNotFound  = errors.Register(ModuleName, 2, "channel not found")
+	ErrUnauthorized     = errors.Register(ModuleName, 3, "unauthorized channel operation")
+	ErrInvalidTimeout   = errors.Register(ModuleName, 4, "invalid channel timeout")
+	ErrInvalidVoucher   = errors.Register(ModuleName, 5, "invalid voucher")
+	ErrInvalidSignature = errors.Register(ModuleName, 6, "invalid voucher signature")
+	ErrChannelClosing   = errors.Register(ModuleName, 7, "channel already closing")
+)
@@ -0,0 +1,18 @@
+package types
+
+// paychan module event types
+const (
+	EventTypeOpenChannel   = "open_channel"
+	EventTypeClaimChannel  = "claim_channel"
+	EventTypeCloseChannel  = "close_channel"
+	EventTypeSettleChannel = "settle_channel"
+
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyDeposit   = "deposit"
+	AttributeKeyExpiresAt = "expires_at"
+	AttributeKeyClosesAt  = "closes_at"
+	AttributeKeyClaimed   = "claimed"
+	AttributeKeyRefund    = "refund"
+)
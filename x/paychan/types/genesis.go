@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, channels []Channel, nextChannelID uint64) *GenesisState {
+	return &GenesisState{Params: params, Channels: channels, NextChannelId: nextChannelID}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 1)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextChannelId == 0 {
+		return fmt.Errorf("next channel id must be positive")
+	}
+
+	seen := make(map[uint64]struct{}, len(gs.Channels))
+	for _, c := range gs.Channels {
+		if c.Id == 0 || c.Id >= gs.NextChannelId {
+			return fmt.Errorf("channel id %d must be positive and lower than the next channel id %d", c.Id, gs.NextChannelId)
+		}
+		if _, ok := seen[c.Id]; ok {
+			return fmt.Errorf("duplicate channel %d", c.Id)
+		}
+		seen[c.Id] = struct{}{}
+
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, c := range gs.Channels {
+		if err := c.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
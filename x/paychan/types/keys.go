@@ -0,0 +1,24 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "paychan"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey                 = collections.NewPrefix(0)
+	NextChannelIDKey          = collections.NewPrefix(1)
+	ChannelsPrefix            = collections.NewPrefix(2)
+	ChannelsBySenderPrefix    = collections.NewPrefix(3)
+	ChannelsByRecipientPrefix = collections.NewPrefix(4)
+	SettlementsPrefix         = collections.NewPrefix(5)
+)
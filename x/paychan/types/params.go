@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultParams returns default x/paychan module parameters. The recipient of
+// a channel has a day to claim its latest voucher once the sender closed the
+// channel, and channels stay open for at most a year.
+func DefaultParams() Params {
+	return Params{
+		DisputePeriod: 24 * time.Hour,
+		MaxTimeout:    365 * 24 * time.Hour,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.DisputePeriod <= 0 {
+		return fmt.Errorf("dispute period must be positive: %s", p.DisputePeriod)
+	}
+	if p.MaxTimeout <= 0 {
+		return fmt.Errorf("max timeout must be positive: %s", p.MaxTimeout)
+	}
+
+	return nil
+}
@@ -0,0 +1,1244 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/paychan/v1/paychan.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/paychan module.
+type Params struct {
+	// dispute_period is the time during which the recipient can claim its latest
+	// voucher once the sender closed a channel.
+	DisputePeriod time.Duration `protobuf:"bytes,1,opt,name=dispute_period,json=disputePeriod,proto3,stdduration" json:"dispute_period"`
+	// max_timeout is the maximum time a channel can stay open.
+	MaxTimeout time.Duration `protobuf:"bytes,2,opt,name=max_timeout,json=maxTimeout,proto3,stdduration" json:"max_timeout"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57cd361fee334722, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDisputePeriod() time.Duration {
+	if m != nil {
+		return m.DisputePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeout() time.Duration {
+	if m != nil {
+		return m.MaxTimeout
+	}
+	return 0
+}
+
+// Channel is a unidirectional payment channel from a sender to a recipient.
+type Channel struct {
+	// id is the unique identifier of the channel.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address paying through the channel.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address paid through the channel.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// deposit is the amount locked by the sender in the channel.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// claimed is the part of the deposit already claimed by the recipient.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+	// expires_at is the time at which the unclaimed deposit is refunded to the
+	// sender.
+	ExpiresAt time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// closes_at is the end of the dispute period of a channel closed by its
+	// sender, unset if the sender did not close the channel.
+	ClosesAt *time.Time `protobuf:"bytes,7,opt,name=closes_at,json=closesAt,proto3,stdtime" json:"closes_at,omitempty"`
+	// sender_pub_key is the public key of the sender when it opened the channel,
+	// against which the vouchers of the channel are verified.
+	SenderPubKey *types1.Any `protobuf:"bytes,8,opt,name=sender_pub_key,json=senderPubKey,proto3" json:"sender_pub_key,omitempty"`
+}
+
+func (m *Channel) Reset()         { *m = Channel{} }
+func (m *Channel) String() string { return proto.CompactTextString(m) }
+func (*Channel) ProtoMessage()    {}
+func (*Channel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57cd361fee334722, []int{1}
+}
+func (m *Channel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Channel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Channel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Channel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Channel.Merge(m, src)
+}
+func (m *Channel) XXX_Size() int {
+	return m.Size()
+}
+func (m *Channel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Channel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Channel proto.InternalMessageInfo
+
+func (m *Channel) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Channel) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Channel) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Channel) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Channel) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func (m *Channel) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *Channel) GetClosesAt() *time.Time {
+	if m != nil {
+		return m.ClosesAt
+	}
+	return nil
+}
+
+func (m *Channel) GetSenderPubKey() *types1.Any {
+	if m != nil {
+		return m.SenderPubKey
+	}
+	return nil
+}
+
+// Voucher is an off-chain promise of the sender of a channel to pay the given
+// cumulative amount to its recipient. The recipient claims a voucher with the
+// signature of the sender over its protobuf encoding.
+type Voucher struct {
+	// chain_id is the chain of the channel.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// channel_id is the identifier of the channel.
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// amount is the total amount paid through the channel.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Voucher) Reset()         { *m = Voucher{} }
+func (m *Voucher) String() string { return proto.CompactTextString(m) }
+func (*Voucher) ProtoMessage()    {}
+func (*Voucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57cd361fee334722, []int{2}
+}
+func (m *Voucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Voucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Voucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Voucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Voucher.Merge(m, src)
+}
+func (m *Voucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *Voucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_Voucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Voucher proto.InternalMessageInfo
+
+func (m *Voucher) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Voucher) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *Voucher) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.paychan.v1.Params")
+	proto.RegisterType((*Channel)(nil), "cosmos.paychan.v1.Channel")
+	proto.RegisterType((*Voucher)(nil), "cosmos.paychan.v1.Voucher")
+}
+
+func init() { proto.RegisterFile("cosmos/paychan/v1/paychan.proto", fileDescriptor_57cd361fee334722) }
+
+var fileDescriptor_57cd361fee334722 = []byte{
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8f, 0xd3, 0xfc, 0x93, 0xfa, 0xfa, 0x22, 0xf5, 0xd4, 0xc1, 0x8d, 0xfe, 0x38, 0x55, 0xa7,
+	0xaa, 0x52, 0x6d, 0x02, 0x52, 0x07, 0x24, 0x86, 0xa4, 0x08, 0x51, 0x31, 0x50, 0x99, 0x8a, 0x81,
+	0xc5, 0x3a, 0xdf, 0x1d, 0xce, 0xa9, 0xb1, 0xcf, 0xf2, 0x9d, 0xab, 0x58, 0x7c, 0x03, 0xa6, 0x8e,
+	0x88, 0x4f, 0x80, 0x98, 0x3a, 0x30, 0x33, 0x31, 0x54, 0x4c, 0x15, 0x13, 0x03, 0xa2, 0xa8, 0x1d,
+	0xf2, 0x35, 0x90, 0xef, 0xce, 0x29, 0x6a, 0x87, 0x8a, 0xa5, 0x4b, 0xe4, 0xbb, 0xe7, 0xf9, 0xbd,
+	0x3c, 0xf7, 0x7b, 0x02, 0x7a, 0x98, 0x8b, 0x84, 0x0b, 0x3f, 0x43, 0x25, 0x1e, 0xa1, 0xd4, 0x3f,
+	0xea, 0xd7, 0x9f, 0x5e, 0x96, 0x73, 0xc9, 0xe1, 0x8a, 0x6e, 0xf0, 0xea, 0xdb, 0xa3, 0x7e, 0x77,
+	0x35, 0xe6, 0x31, 0x57, 0x55, 0xbf, 0xfa, 0xd2, 0x8d, 0xdd, 0xb5, 0x98, 0xf3, 0x78, 0x4c, 0x7d,
+	0x75, 0x8a, 0x8a, 0x37, 0x3e, 0x4a, 0x4b, 0x53, 0x72, 0xaf, 0x97, 0x48, 0x91, 0x23, 0xc9, 0xb8,
+	0xd1, 0xe8, 0xf6, 0xae, 0xd7, 0x25, 0x4b, 0xa8, 0x90, 0x28, 0xc9, 0x6a, 0x6e, 0x6d, 0x22, 0xd4,
+	0xa2, 0xb5, 0x23, 0xcd, 0x6d, 0x06, 0x88, 0x90, 0xa0, 0xfe, 0x51, 0x3f, 0xa2, 0x12, 0xf5, 0x7d,
+	0xcc, 0x59, 0xcd, 0xbd, 0x82, 0x12, 0x96, 0x72, 0x5f, 0xfd, 0xea, 0xab, 0x8d, 0xaf, 0x16, 0x68,
+	0xef, 0xa3, 0x1c, 0x25, 0x02, 0xbe, 0x00, 0xcb, 0x84, 0x89, 0xac, 0x90, 0x34, 0xcc, 0x68, 0xce,
+	0x38, 0x71, 0xac, 0x75, 0x6b, 0x73, 0xe1, 0xc1, 0x9a, 0xa7, 0x2d, 0x79, 0xb5, 0x25, 0xef, 0x89,
+	0xb1, 0x3c, 0x5c, 0x3a, 0xfd, 0xd5, 0x6b, 0xbc, 0x3f, 0xef, 0x59, 0x1f, 0xa7, 0x27, 0x5b, 0x56,
+	0xb0, 0x64, 0xf0, 0xfb, 0x0a, 0x0e, 0xf7, 0xc0, 0x42, 0x82, 0x26, 0x61, 0x35, 0x00, 0x2f, 0xa4,
+	0xd3, 0xfc, 0x47, 0x36, 0x90, 0xa0, 0xc9, 0x81, 0xc6, 0x3e, 0x72, 0xdf, 0x4d, 0x4f, 0xb6, 0xcc,
+	0xe4, 0xdb, 0x82, 0x1c, 0xce, 0x32, 0xd2, 0xde, 0x37, 0x7e, 0xb6, 0x40, 0x67, 0x77, 0x84, 0xd2,
+	0x94, 0x8e, 0xe1, 0x32, 0x68, 0x32, 0xed, 0xbd, 0x15, 0x34, 0x19, 0x81, 0xf7, 0x41, 0x5b, 0xd0,
+	0x94, 0xd0, 0x5c, 0x39, 0xb0, 0x87, 0xce, 0xf7, 0xcf, 0xdb, 0xab, 0xe6, 0xdd, 0x06, 0x84, 0xe4,
+	0x54, 0x88, 0x97, 0x32, 0x67, 0x69, 0x1c, 0x98, 0x3e, 0xb8, 0x03, 0xec, 0x9c, 0x62, 0x96, 0x31,
+	0x9a, 0x4a, 0x67, 0xee, 0x16, 0xd0, 0x55, 0x2b, 0x7c, 0x0b, 0x3a, 0x84, 0x66, 0x5c, 0x30, 0xe9,
+	0xb4, 0xd6, 0xe7, 0xd4, 0xb0, 0x06, 0x52, 0x25, 0xe2, 0x99, 0x44, 0xbc, 0x5d, 0xce, 0xd2, 0xe1,
+	0xd3, 0x6a, 0xd8, 0x4f, 0xe7, 0xbd, 0xcd, 0x98, 0xc9, 0x51, 0x11, 0x79, 0x98, 0x27, 0x26, 0x4c,
+	0xff, 0xaf, 0x31, 0x65, 0x99, 0x51, 0xa1, 0x00, 0xe2, 0xc3, 0xf4, 0x64, 0x6b, 0x71, 0x4c, 0x63,
+	0x84, 0xcb, 0xb0, 0xca, 0x54, 0xe8, 0x57, 0xaa, 0x15, 0x2b, 0x71, 0x3c, 0x46, 0x2c, 0xa1, 0xc4,
+	0xf9, 0xef, 0xce, 0xc4, 0x8d, 0x22, 0x7c, 0x06, 0x00, 0x9d, 0x64, 0x2c, 0xa7, 0x22, 0x44, 0xd2,
+	0x69, 0xab, 0xa4, 0xbb, 0x37, 0x92, 0x3e, 0xa8, 0x57, 0x59, 0x47, 0x7d, 0x3c, 0x8b, 0xda, 0x36,
+	0xe0, 0x81, 0x84, 0x8f, 0x81, 0x8d, 0xc7, 0x5c, 0x68, 0xa2, 0xce, 0xad, 0x44, 0xad, 0x8a, 0x24,
+	0x98, 0xd7, 0x90, 0x81, 0x84, 0x07, 0x60, 0x59, 0x87, 0x18, 0x66, 0x45, 0x14, 0x1e, 0xd2, 0xd2,
+	0x99, 0x57, 0x1c, 0xab, 0x37, 0x38, 0x06, 0x69, 0x39, 0x74, 0xbe, 0x5d, 0xa5, 0x8a, 0xf3, 0x32,
+	0x93, 0xdc, 0xdb, 0x2f, 0xa2, 0xe7, 0xb4, 0x0c, 0x16, 0x35, 0x8b, 0x3e, 0x6d, 0x7c, 0xb1, 0x40,
+	0xe7, 0x15, 0x2f, 0xf0, 0x88, 0xe6, 0x70, 0x0d, 0xcc, 0xe3, 0x11, 0x62, 0x69, 0x68, 0x96, 0xcc,
+	0x0e, 0x3a, 0xea, 0xbc, 0x47, 0xe0, 0x3d, 0x00, 0xb0, 0x5e, 0xc2, 0xaa, 0xd8, 0x54, 0x1b, 0x68,
+	0x9b, 0x9b, 0x3d, 0x02, 0x4b, 0xd0, 0x46, 0x09, 0x2f, 0xd4, 0x4e, 0xdd, 0x51, 0x40, 0x46, 0x70,
+	0xb8, 0x73, 0x7a, 0xe1, 0x5a, 0x67, 0x17, 0xae, 0xf5, 0xfb, 0xc2, 0xb5, 0x8e, 0x2f, 0xdd, 0xc6,
+	0xd9, 0xa5, 0xdb, 0xf8, 0x71, 0xe9, 0x36, 0x5e, 0xff, 0xaf, 0xf9, 0x04, 0x39, 0xf4, 0x18, 0xf7,
+	0x27, 0xb3, 0x3f, 0x96, 0xe2, 0x8e, 0xda, 0xea, 0xb9, 0x1e, 0xfe, 0x19, 0x00, 0x7a, 0x73, 0x33,
+	0x8b, 0x1b, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPaychan(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DisputePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPaychan(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Channel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SenderPubKey != nil {
+		{
+			size, err := m.SenderPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPaychan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ClosesAt != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClosesAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClosesAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintPaychan(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintPaychan(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaychan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaychan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintPaychan(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPaychan(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPaychan(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Voucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Voucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Voucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaychan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintPaychan(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintPaychan(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPaychan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPaychan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriod)
+	n += 1 + l + sovPaychan(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout)
+	n += 1 + l + sovPaychan(uint64(l))
+	return n
+}
+
+func (m *Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPaychan(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPaychan(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovPaychan(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovPaychan(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovPaychan(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovPaychan(uint64(l))
+	if m.ClosesAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClosesAt)
+		n += 1 + l + sovPaychan(uint64(l))
+	}
+	if m.SenderPubKey != nil {
+		l = m.SenderPubKey.Size()
+		n += 1 + l + sovPaychan(uint64(l))
+	}
+	return n
+}
+
+func (m *Voucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovPaychan(uint64(l))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovPaychan(uint64(m.ChannelId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovPaychan(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPaychan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPaychan(x uint64) (n int) {
+	return sovPaychan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaychan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DisputePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaychan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Channel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaychan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosesAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClosesAt == nil {
+				m.ClosesAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClosesAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SenderPubKey == nil {
+				m.SenderPubKey = &types1.Any{}
+			}
+			if err := m.SenderPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaychan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Voucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaychan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Voucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Voucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaychan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaychan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPaychan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPaychan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaychan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPaychan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPaychan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPaychan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPaychan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPaychan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPaychan = fmt.Errorf("proto: unexpected end of group")
+)
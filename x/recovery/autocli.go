@@ -0,0 +1,83 @@
+package recovery
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	recoveryv1 "cosmossdk.io/api/cosmos/recovery/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: recoveryv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current recovery parameters",
+				},
+				{
+					RpcMethod:      "RecoveryConfig",
+					Use:            "config [account]",
+					Short:          "Query the guardians of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod:      "RecoveryRequest",
+					Use:            "request [account]",
+					Short:          "Query the pending recovery of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: recoveryv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetGuardians",
+					Use:            "set-guardians [threshold] [guardians] --from [owner]",
+					Short:          "Set the guardians of an account, or remove them if none is given",
+					Example:        fmt.Sprintf("%s tx recovery set-guardians 2 cosmos1... cosmos1... cosmos1... --delay 72h --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "threshold"}, {ProtoField: "guardians", Varargs: true}},
+				},
+				{
+					RpcMethod:      "InitiateRecovery",
+					Use:            "initiate [account] [new-pubkey] --from [guardian]",
+					Short:          "Initiate the recovery of an account, rotating it to a new public key",
+					Example:        fmt.Sprintf(`%s tx recovery initiate cosmos1... '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8Rh..."}' --from guardian`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}, {ProtoField: "new_pub_key"}},
+				},
+				{
+					RpcMethod:      "ApproveRecovery",
+					Use:            "approve [account] --from [guardian]",
+					Short:          "Approve the pending recovery of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod:      "ExecuteRecovery",
+					Use:            "execute [account] --from [guardian]",
+					Short:          "Execute the pending recovery of an account once approved and its delay elapsed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod: "VetoRecovery",
+					Use:       "veto --from [owner]",
+					Short:     "Cancel the pending recovery of your account",
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update recovery module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update recovery module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query recovery params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx recovery update-params-proposal '{ "min_delay": "86400s", "max_guardians": 10 }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/recovery/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the recovery module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, c := range data.Configs {
+		addr, err := k.addressCodec.StringToBytes(c.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.Configs.Set(ctx, addr, c.Config); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range data.Requests {
+		addr, err := k.addressCodec.StringToBytes(r.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.Requests.Set(ctx, addr, r.Request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the recovery module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []types.GenesisRecoveryConfig
+	err = k.Configs.Walk(ctx, nil, func(addr sdk.AccAddress, config types.RecoveryConfig) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		configs = append(configs, types.GenesisRecoveryConfig{Account: addrStr, Config: config})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []types.GenesisRecoveryRequest
+	err = k.Requests.Walk(ctx, nil, func(addr sdk.AccAddress, request types.RecoveryRequest) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		requests = append(requests, types.GenesisRecoveryRequest{Account: addrStr, Request: request})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, configs, requests), nil
+}
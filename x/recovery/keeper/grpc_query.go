@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/recovery/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/recovery QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the recovery module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// RecoveryConfig returns the recovery configuration of an account.
+func (q queryServer) RecoveryConfig(ctx context.Context, req *types.QueryRecoveryConfigRequest) (*types.QueryRecoveryConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+	}
+
+	config, err := q.k.Configs.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return &types.QueryRecoveryConfigResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QueryRecoveryConfigResponse{Config: &config}, nil
+}
+
+// RecoveryRequest returns the pending recovery of an account.
+func (q queryServer) RecoveryRequest(ctx context.Context, req *types.QueryRecoveryRequestRequest) (*types.QueryRecoveryRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+	}
+
+	request, err := q.k.Requests.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return &types.QueryRecoveryRequestResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QueryRecoveryRequestResponse{Request: &request}, nil
+}
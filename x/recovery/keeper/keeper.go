@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/recovery/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the recovery store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	environment   appmodule.Environment
+	accountKeeper types.AccountKeeper
+	addressCodec  address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Configs contains the recovery configuration of each account
+	Configs collections.Map[sdk.AccAddress, types.RecoveryConfig]
+	// Requests contains the pending recovery of each account
+	Requests collections.Map[sdk.AccAddress, types.RecoveryRequest]
+}
+
+// NewKeeper creates a new recovery Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:           cdc,
+		environment:   env,
+		accountKeeper: ak,
+		addressCodec:  ak.AddressCodec(),
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Configs:       collections.NewMap(sb, types.ConfigsPrefix, "configs", sdk.AccAddressKey, codec.CollValue[types.RecoveryConfig](cdc)),
+		Requests:      collections.NewMap(sb, types.RequestsPrefix, "requests", sdk.AccAddressKey, codec.CollValue[types.RecoveryRequest](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/recovery module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
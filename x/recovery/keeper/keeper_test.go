@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/recovery"
+	"cosmossdk.io/x/recovery/keeper"
+	recoverytestutil "cosmossdk.io/x/recovery/testutil"
+	"cosmossdk.io/x/recovery/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	msgServer     types.MsgServer
+	queryServer   types.QueryServer
+	accountKeeper *recoverytestutil.MockAccountKeeper
+
+	owner     sdk.AccAddress
+	ownerStr  string
+	guardians []string
+	newPubKey *codectypes.Any
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, recovery.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = recoverytestutil.NewMockAccountKeeper(ctrl)
+	s.accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, s.accountKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	var err error
+	s.owner = sdk.AccAddress("owner")
+	s.ownerStr, err = ac.BytesToString(s.owner)
+	s.Require().NoError(err)
+	s.guardians = nil
+	for _, name := range []string{"guardian1", "guardian2", "guardian3"} {
+		guardian, err := ac.BytesToString(sdk.AccAddress(name))
+		s.Require().NoError(err)
+		s.guardians = append(s.guardians, guardian)
+	}
+
+	s.newPubKey, err = codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) setGuardians() {
+	_, err := s.msgServer.SetGuardians(s.ctx, &types.MsgSetGuardians{Owner: s.ownerStr, Guardians: s.guardians, Threshold: 2, Delay: 48 * time.Hour})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) initiateRecovery() {
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), s.owner).Return(authtypes.NewBaseAccountWithAddress(s.owner))
+	_, err := s.msgServer.InitiateRecovery(s.ctx, &types.MsgInitiateRecovery{Guardian: s.guardians[0], Account: s.ownerStr, NewPubKey: s.newPubKey})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSetGuardians() {
+	testCases := map[string]struct {
+		msg *types.MsgSetGuardians
+	}{
+		"threshold above the number of guardians": {msg: &types.MsgSetGuardians{Owner: s.ownerStr, Guardians: s.guardians, Threshold: 4, Delay: 48 * time.Hour}},
+		"zero threshold":          {msg: &types.MsgSetGuardians{Owner: s.ownerStr, Guardians: s.guardians, Threshold: 0, Delay: 48 * time.Hour}},
+		"delay below the minimum": {msg: &types.MsgSetGuardians{Owner: s.ownerStr, Guardians: s.guardians, Threshold: 2, Delay: time.Hour}},
+		"duplicate guardian":      {msg: &types.MsgSetGuardians{Owner: s.ownerStr, Guardians: []string{s.guardians[0], s.guardians[0]}, Threshold: 1, Delay: 48 * time.Hour}},
+		"owner as guardian":       {msg: &types.MsgSetGuardians{Owner: s.ownerStr, Guardians: []string{s.ownerStr}, Threshold: 1, Delay: 48 * time.Hour}},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			_, err := s.msgServer.SetGuardians(s.ctx, tc.msg)
+			s.Require().ErrorIs(err, types.ErrInvalidConfig)
+		})
+	}
+
+	s.setGuardians()
+	res, err := s.queryServer.RecoveryConfig(s.ctx, &types.QueryRecoveryConfigRequest{Account: s.ownerStr})
+	s.Require().NoError(err)
+	s.Require().Equal(s.guardians, res.Config.Guardians)
+
+	// setting the guardians cancels the pending recovery
+	s.initiateRecovery()
+	s.setGuardians()
+	reqRes, err := s.queryServer.RecoveryRequest(s.ctx, &types.QueryRecoveryRequestRequest{Account: s.ownerStr})
+	s.Require().NoError(err)
+	s.Require().Nil(reqRes.Request)
+
+	// an empty list of guardians removes the configuration
+	_, err = s.msgServer.SetGuardians(s.ctx, &types.MsgSetGuardians{Owner: s.ownerStr})
+	s.Require().NoError(err)
+	res, err = s.queryServer.RecoveryConfig(s.ctx, &types.QueryRecoveryConfigRequest{Account: s.ownerStr})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Config)
+}
+
+func (s *KeeperTestSuite) TestRecovery() {
+	s.setGuardians()
+
+	_, err := s.msgServer.InitiateRecovery(s.ctx, &types.MsgInitiateRecovery{Guardian: s.ownerStr, Account: s.ownerStr, NewPubKey: s.newPubKey})
+	s.Require().ErrorIs(err, types.ErrNotGuardian)
+
+	s.initiateRecovery()
+	_, err = s.msgServer.InitiateRecovery(s.ctx, &types.MsgInitiateRecovery{Guardian: s.guardians[1], Account: s.ownerStr, NewPubKey: s.newPubKey})
+	s.Require().ErrorIs(err, types.ErrRecoveryPending)
+
+	// the threshold is not reached
+	later := s.ctx.WithHeaderInfo(header.Info{Time: s.ctx.HeaderInfo().Time.Add(48 * time.Hour)})
+	_, err = s.msgServer.ExecuteRecovery(later, &types.MsgExecuteRecovery{Guardian: s.guardians[0], Account: s.ownerStr})
+	s.Require().ErrorIs(err, types.ErrRecoveryNotReady)
+
+	_, err = s.msgServer.ApproveRecovery(s.ctx, &types.MsgApproveRecovery{Guardian: s.guardians[0], Account: s.ownerStr})
+	s.Require().ErrorIs(err, types.ErrAlreadyApproved)
+	_, err = s.msgServer.ApproveRecovery(s.ctx, &types.MsgApproveRecovery{Guardian: s.guardians[1], Account: s.ownerStr})
+	s.Require().NoError(err)
+
+	// the delay did not elapse
+	_, err = s.msgServer.ExecuteRecovery(s.ctx, &types.MsgExecuteRecovery{Guardian: s.guardians[0], Account: s.ownerStr})
+	s.Require().ErrorIs(err, types.ErrRecoveryNotReady)
+
+	// the pubkey rotation is refused, for instance because the account reached its maximum
+	// of rotations, and the recovery stays pending
+	acc := authtypes.NewBaseAccountWithAddress(s.owner)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), s.owner).Return(acc)
+	s.accountKeeper.EXPECT().RotatePubKey(gomock.Any(), acc, s.newPubKey.GetCachedValue()).Return(sdkerrors.ErrUnauthorized)
+	_, err = s.msgServer.ExecuteRecovery(later, &types.MsgExecuteRecovery{Guardian: s.guardians[2], Account: s.ownerStr})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), s.owner).Return(acc)
+	s.accountKeeper.EXPECT().RotatePubKey(gomock.Any(), acc, s.newPubKey.GetCachedValue()).Return(nil)
+	_, err = s.msgServer.ExecuteRecovery(later, &types.MsgExecuteRecovery{Guardian: s.guardians[2], Account: s.ownerStr})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ExecuteRecovery(later, &types.MsgExecuteRecovery{Guardian: s.guardians[0], Account: s.ownerStr})
+	s.Require().ErrorIs(err, types.ErrNoRecoveryRequest)
+}
+
+func (s *KeeperTestSuite) TestRecoveryUnknownAccount() {
+	s.setGuardians()
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), s.owner).Return(nil)
+	_, err := s.msgServer.InitiateRecovery(s.ctx, &types.MsgInitiateRecovery{Guardian: s.guardians[0], Account: s.ownerStr, NewPubKey: s.newPubKey})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	// the account is pruned while the recovery is pending
+	s.initiateRecovery()
+	_, err = s.msgServer.ApproveRecovery(s.ctx, &types.MsgApproveRecovery{Guardian: s.guardians[1], Account: s.ownerStr})
+	s.Require().NoError(err)
+
+	later := s.ctx.WithHeaderInfo(header.Info{Time: s.ctx.HeaderInfo().Time.Add(48 * time.Hour)})
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), s.owner).Return(nil)
+	_, err = s.msgServer.ExecuteRecovery(later, &types.MsgExecuteRecovery{Guardian: s.guardians[0], Account: s.ownerStr})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+}
+
+func (s *KeeperTestSuite) TestVetoRecovery() {
+	s.setGuardians()
+
+	_, err := s.msgServer.VetoRecovery(s.ctx, &types.MsgVetoRecovery{Owner: s.ownerStr})
+	s.Require().ErrorIs(err, types.ErrNoRecoveryRequest)
+
+	s.initiateRecovery()
+	_, err = s.msgServer.VetoRecovery(s.ctx, &types.MsgVetoRecovery{Owner: s.ownerStr})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ApproveRecovery(s.ctx, &types.MsgApproveRecovery{Guardian: s.guardians[1], Account: s.ownerStr})
+	s.Require().ErrorIs(err, types.ErrNoRecoveryRequest)
+
+	var events []string
+	for _, e := range s.ctx.EventManager().Events() {
+		events = append(events, e.Type)
+	}
+	s.Require().Equal([]string{types.EventTypeSetGuardians, types.EventTypeInitiateRecovery, types.EventTypeVetoRecovery}, events)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.Params{MinDelay: time.Hour, MaxGuardians: 5}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.ownerStr, Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.setGuardians()
+	s.initiateRecovery()
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.Configs, 1)
+	s.Require().Len(exported.Requests, 1)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, exported))
+
+	reExported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported.Configs, reExported.Configs)
+	s.Require().Equal(exported.Requests[0].Request.Approvals, reExported.Requests[0].Request.Approvals)
+}
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/recovery/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/recovery MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// SetGuardians sets, or removes if empty, the guardians of the owner account
+// and cancels its pending recovery.
+func (ms msgServer) SetGuardians(ctx context.Context, msg *types.MsgSetGuardians) (*types.MsgSetGuardiansResponse, error) {
+	owner, err := ms.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if err := ms.Requests.Remove(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	if len(msg.Guardians) == 0 {
+		if err := ms.Configs.Remove(ctx, owner); err != nil {
+			return nil, err
+		}
+	} else {
+		config := types.RecoveryConfig{Threshold: msg.Threshold, Delay: msg.Delay}
+		for _, guardian := range msg.Guardians {
+			addr, err := ms.addressCodec.StringToBytes(guardian)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid guardian address: %s", err)
+			}
+			if sdk.AccAddress(addr).Equals(sdk.AccAddress(owner)) {
+				return nil, errorsmod.Wrap(types.ErrInvalidConfig, "an account cannot be its own guardian")
+			}
+			// store the guardians in their canonical form, as they are compared
+			// to the signers of the guardian messages
+			guardian, err = ms.addressCodec.BytesToString(addr)
+			if err != nil {
+				return nil, err
+			}
+			config.Guardians = append(config.Guardians, guardian)
+		}
+
+		params, err := ms.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := config.Validate(params); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidConfig, err.Error())
+		}
+
+		if err := ms.Configs.Set(ctx, owner, config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetGuardians,
+		event.NewAttribute(types.AttributeKeyAccount, msg.Owner),
+		event.NewAttribute(types.AttributeKeyThreshold, fmt.Sprint(msg.Threshold)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetGuardiansResponse{}, nil
+}
+
+// InitiateRecovery starts the recovery of an account, approved by the guardian
+// initiating it.
+func (ms msgServer) InitiateRecovery(ctx context.Context, msg *types.MsgInitiateRecovery) (*types.MsgInitiateRecoveryResponse, error) {
+	account, config, err := ms.getGuardedAccount(ctx, msg.Guardian, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	has, err := ms.Requests.Has(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryPending, "account %s", msg.Account)
+	}
+
+	if ms.accountKeeper.GetAccount(ctx, account) == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Account)
+	}
+
+	request := types.RecoveryRequest{
+		NewPubKey:    msg.NewPubKey,
+		Approvals:    []string{msg.Guardian},
+		ExecutableAt: ms.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(config.Delay),
+	}
+	if _, err := request.PubKey(); err != nil {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrap(err.Error())
+	}
+
+	if err := ms.Requests.Set(ctx, account, request); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeInitiateRecovery,
+		event.NewAttribute(types.AttributeKeyAccount, msg.Account),
+		event.NewAttribute(types.AttributeKeyGuardian, msg.Guardian),
+		event.NewAttribute(types.AttributeKeyExecutableAt, request.ExecutableAt.Format(time.RFC3339Nano)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInitiateRecoveryResponse{}, nil
+}
+
+// ApproveRecovery approves the pending recovery of an account.
+func (ms msgServer) ApproveRecovery(ctx context.Context, msg *types.MsgApproveRecovery) (*types.MsgApproveRecoveryResponse, error) {
+	account, _, err := ms.getGuardedAccount(ctx, msg.Guardian, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := ms.getRequest(ctx, account, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(request.Approvals, msg.Guardian) {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyApproved, "guardian %s", msg.Guardian)
+	}
+
+	request.Approvals = append(request.Approvals, msg.Guardian)
+	if err := ms.Requests.Set(ctx, account, request); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeApproveRecovery,
+		event.NewAttribute(types.AttributeKeyAccount, msg.Account),
+		event.NewAttribute(types.AttributeKeyGuardian, msg.Guardian),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveRecoveryResponse{}, nil
+}
+
+// ExecuteRecovery rotates the public key of an account once its pending
+// recovery is approved by the threshold of guardians and its delay elapsed.
+func (ms msgServer) ExecuteRecovery(ctx context.Context, msg *types.MsgExecuteRecovery) (*types.MsgExecuteRecoveryResponse, error) {
+	account, config, err := ms.getGuardedAccount(ctx, msg.Guardian, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := ms.getRequest(ctx, account, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Approvals) < int(config.Threshold) {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryNotReady, "%d approvals, %d required", len(request.Approvals), config.Threshold)
+	}
+	if ms.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(request.ExecutableAt) {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryNotReady, "executable at %s", request.ExecutableAt)
+	}
+
+	pk, err := request.PubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	acc := ms.accountKeeper.GetAccount(ctx, account)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Account)
+	}
+	// the recovery is a public key rotation, counted and charged as such
+	if err := ms.accountKeeper.RotatePubKey(ctx, acc, pk); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Requests.Remove(ctx, account); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeExecuteRecovery,
+		event.NewAttribute(types.AttributeKeyAccount, msg.Account),
+		event.NewAttribute(types.AttributeKeyGuardian, msg.Guardian),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteRecoveryResponse{}, nil
+}
+
+// VetoRecovery cancels the pending recovery of the owner account.
+func (ms msgServer) VetoRecovery(ctx context.Context, msg *types.MsgVetoRecovery) (*types.MsgVetoRecoveryResponse, error) {
+	owner, err := ms.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if _, err := ms.getRequest(ctx, owner, msg.Owner); err != nil {
+		return nil, err
+	}
+	if err := ms.Requests.Remove(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeVetoRecovery,
+		event.NewAttribute(types.AttributeKeyAccount, msg.Owner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVetoRecoveryResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getGuardedAccount returns the address and recovery configuration of an
+// account, checking that guardian is one of its guardians.
+func (ms msgServer) getGuardedAccount(ctx context.Context, guardian, account string) (sdk.AccAddress, types.RecoveryConfig, error) {
+	if _, err := ms.addressCodec.StringToBytes(guardian); err != nil {
+		return nil, types.RecoveryConfig{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid guardian address: %s", err)
+	}
+
+	addr, err := ms.addressCodec.StringToBytes(account)
+	if err != nil {
+		return nil, types.RecoveryConfig{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	config, err := ms.Configs.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.RecoveryConfig{}, errorsmod.Wrapf(types.ErrNotGuardian, "account %s has no guardians", account)
+		}
+		return nil, types.RecoveryConfig{}, err
+	}
+	if !config.IsGuardian(guardian) {
+		return nil, types.RecoveryConfig{}, errorsmod.Wrapf(types.ErrNotGuardian, "%s is not a guardian of %s", guardian, account)
+	}
+
+	return addr, config, nil
+}
+
+// getRequest returns the pending recovery of an account.
+func (ms msgServer) getRequest(ctx context.Context, addr sdk.AccAddress, account string) (types.RecoveryRequest, error) {
+	request, err := ms.Requests.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return request, errorsmod.Wrapf(types.ErrNoRecoveryRequest, "account %s", account)
+		}
+		return request, err
+	}
+
+	return request, nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/recovery/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgSetGuardians{}, "cosmos-sdk/recovery/MsgSetGuardians")
+	legacy.RegisterAminoMsg(cdc, &MsgInitiateRecovery{}, "cosmos-sdk/recovery/MsgInitiateRecovery")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveRecovery{}, "cosmos-sdk/recovery/MsgApproveRecovery")
+	legacy.RegisterAminoMsg(cdc, &MsgExecuteRecovery{}, "cosmos-sdk/recovery/MsgExecuteRecovery")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoRecovery{}, "cosmos-sdk/recovery/MsgVetoRecovery")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/recovery/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry registry.LegacyRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetGuardians{},
+		&MsgInitiateRecovery{},
+		&MsgApproveRecovery{},
+		&MsgExecuteRecovery{},
+		&MsgVetoRecovery{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
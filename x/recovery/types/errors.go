@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/recovery module sentinel errors
+var (
+	ErrInvalidSigner     = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrInvalidConfig     = errors.Register(ModuleName, 2, "invalid recovery configuration")
+	ErrNotGuardian       = errors.Register(ModuleName, 3, "not a guardian of the account")
+	ErrNoRecoveryRequest = errors.Register(ModuleName, 4, "no pending recovery")
+	ErrRecoveryPending   = errors.Register(ModuleName, 5, "recovery already pending")
+	ErrRecoveryNotReady  = errors.Register(ModuleName, 6, "recovery cannot be executed yet")
+	ErrAlreadyApproved   = errors.Register(ModuleName, 7, "recovery already approved by the guardian")
+)
@@ -0,0 +1,15 @@
+package types
+
+// recovery module event types
+const (
+	EventTypeSetGuardians     = "set_guardians"
+	EventTypeInitiateRecovery = "initiate_recovery"
+	EventTypeApproveRecovery  = "approve_recovery"
+	EventTypeExecuteRecovery  = "execute_recovery"
+	EventTypeVetoRecovery     = "veto_recovery"
+
+	AttributeKeyAccount      = "account"
+	AttributeKeyGuardian     = "guardian"
+	AttributeKeyThreshold    = "threshold"
+	AttributeKeyExecutableAt = "executable_at"
+)
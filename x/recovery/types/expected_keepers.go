@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	RotatePubKey(ctx context.Context, acc sdk.AccountI, newPubKey cryptotypes.PubKey) error
+}
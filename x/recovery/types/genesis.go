@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, configs []GenesisRecoveryConfig, requests []GenesisRecoveryRequest) *GenesisState {
+	return &GenesisState{Params: params, Configs: configs, Requests: requests}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	configs := make(map[string]RecoveryConfig, len(gs.Configs))
+	for _, c := range gs.Configs {
+		if c.Account == "" {
+			return fmt.Errorf("recovery config account cannot be empty")
+		}
+		if _, ok := configs[c.Account]; ok {
+			return fmt.Errorf("duplicate recovery config for %s", c.Account)
+		}
+		if err := c.Config.Validate(gs.Params); err != nil {
+			return fmt.Errorf("invalid recovery config for %s: %w", c.Account, err)
+		}
+		configs[c.Account] = c.Config
+	}
+
+	seen := make(map[string]struct{}, len(gs.Requests))
+	for _, r := range gs.Requests {
+		config, ok := configs[r.Account]
+		if !ok {
+			return fmt.Errorf("recovery request for %s without recovery config", r.Account)
+		}
+		if _, ok := seen[r.Account]; ok {
+			return fmt.Errorf("duplicate recovery request for %s", r.Account)
+		}
+		seen[r.Account] = struct{}{}
+
+		if r.Request.NewPubKey == nil {
+			return fmt.Errorf("recovery request for %s without new public key", r.Account)
+		}
+		for _, approval := range r.Request.Approvals {
+			if !config.IsGuardian(approval) {
+				return fmt.Errorf("recovery request for %s approved by %s, which is not a guardian", r.Account, approval)
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, r := range gs.Requests {
+		if err := r.Request.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
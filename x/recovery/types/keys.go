@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "recovery"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey      = collections.NewPrefix(0)
+	ConfigsPrefix  = collections.NewPrefix(1)
+	RequestsPrefix = collections.NewPrefix(2)
+)
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultParams returns default x/recovery module parameters.
+func DefaultParams() Params {
+	return Params{
+		MinDelay:     24 * time.Hour,
+		MaxGuardians: 10,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.MinDelay < 0 {
+		return fmt.Errorf("min delay cannot be negative: %s", p.MinDelay)
+	}
+	if p.MaxGuardians == 0 {
+		return fmt.Errorf("max guardians must be positive")
+	}
+
+	return nil
+}
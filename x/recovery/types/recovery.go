@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = (*RecoveryRequest)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgInitiateRecovery)(nil)
+)
+
+// Validate checks the configuration against the module parameters. The
+// guardian addresses are not checked.
+func (c RecoveryConfig) Validate(params Params) error {
+	if len(c.Guardians) == 0 {
+		return fmt.Errorf("guardians cannot be empty")
+	}
+	if len(c.Guardians) > int(params.MaxGuardians) {
+		return fmt.Errorf("too many guardians: %d > %d", len(c.Guardians), params.MaxGuardians)
+	}
+	for i, guardian := range c.Guardians {
+		if guardian == "" {
+			return fmt.Errorf("guardian address cannot be empty")
+		}
+		if slices.Contains(c.Guardians[:i], guardian) {
+			return fmt.Errorf("duplicate guardian %s", guardian)
+		}
+	}
+	if c.Threshold == 0 || int(c.Threshold) > len(c.Guardians) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians, got %d", c.Threshold)
+	}
+	if c.Delay < params.MinDelay {
+		return fmt.Errorf("delay %s is below the minimum delay %s", c.Delay, params.MinDelay)
+	}
+
+	return nil
+}
+
+// IsGuardian returns whether the given address is a guardian.
+func (c RecoveryConfig) IsGuardian(addr string) bool {
+	return slices.Contains(c.Guardians, addr)
+}
+
+// PubKey returns the public key the account is rotated to.
+func (r RecoveryRequest) PubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("expecting cryptotypes.PubKey, got %T", r.NewPubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r RecoveryRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(r.NewPubKey, &pubKey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgInitiateRecovery) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
@@ -0,0 +1,937 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/recovery/v1/recovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/recovery module.
+type Params struct {
+	// min_delay is the minimum delay an account can configure between the
+	// initiation of a recovery and its execution.
+	MinDelay time.Duration `protobuf:"bytes,1,opt,name=min_delay,json=minDelay,proto3,stdduration" json:"min_delay"`
+	// max_guardians is the maximum number of guardians of an account.
+	MaxGuardians uint32 `protobuf:"varint,2,opt,name=max_guardians,json=maxGuardians,proto3" json:"max_guardians,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de6223961832e861, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinDelay() time.Duration {
+	if m != nil {
+		return m.MinDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGuardians() uint32 {
+	if m != nil {
+		return m.MaxGuardians
+	}
+	return 0
+}
+
+// RecoveryConfig defines the guardians of an account.
+type RecoveryConfig struct {
+	// guardians are the addresses allowed to recover the account.
+	Guardians []string `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// threshold is the number of guardians which must approve a recovery.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// delay is the minimum duration between the initiation of a recovery and its
+	// execution, during which the owner can veto it.
+	Delay time.Duration `protobuf:"bytes,3,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *RecoveryConfig) Reset()         { *m = RecoveryConfig{} }
+func (m *RecoveryConfig) String() string { return proto.CompactTextString(m) }
+func (*RecoveryConfig) ProtoMessage()    {}
+func (*RecoveryConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de6223961832e861, []int{1}
+}
+func (m *RecoveryConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryConfig.Merge(m, src)
+}
+func (m *RecoveryConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryConfig proto.InternalMessageInfo
+
+func (m *RecoveryConfig) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *RecoveryConfig) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *RecoveryConfig) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+// RecoveryRequest is a pending recovery of an account.
+type RecoveryRequest struct {
+	// new_pub_key is the public key the account is rotated to by the recovery.
+	NewPubKey *types.Any `protobuf:"bytes,1,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// approvals are the guardians which approved the recovery.
+	Approvals []string `protobuf:"bytes,2,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// executable_at is the time from which the recovery can be executed.
+	ExecutableAt time.Time `protobuf:"bytes,3,opt,name=executable_at,json=executableAt,proto3,stdtime" json:"executable_at"`
+}
+
+func (m *RecoveryRequest) Reset()         { *m = RecoveryRequest{} }
+func (m *RecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoveryRequest) ProtoMessage()    {}
+func (*RecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de6223961832e861, []int{2}
+}
+func (m *RecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryRequest.Merge(m, src)
+}
+func (m *RecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryRequest proto.InternalMessageInfo
+
+func (m *RecoveryRequest) GetNewPubKey() *types.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *RecoveryRequest) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *RecoveryRequest) GetExecutableAt() time.Time {
+	if m != nil {
+		return m.ExecutableAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.recovery.v1.Params")
+	proto.RegisterType((*RecoveryConfig)(nil), "cosmos.recovery.v1.RecoveryConfig")
+	proto.RegisterType((*RecoveryRequest)(nil), "cosmos.recovery.v1.RecoveryRequest")
+}
+
+func init() { proto.RegisterFile("cosmos/recovery/v1/recovery.proto", fileDescriptor_de6223961832e861) }
+
+var fileDescriptor_de6223961832e861 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0x2c, 0x96, 0xee, 0xb4, 0xab, 0x38, 0xec, 0x61, 0x1b, 0x34, 0x59, 0xd7, 0xcb,
+	0x52, 0x68, 0x42, 0x15, 0x14, 0x3c, 0x08, 0x1b, 0x2b, 0x22, 0x82, 0x94, 0xe8, 0xc9, 0x4b, 0x98,
+	0x6c, 0xa6, 0xe9, 0xb0, 0x99, 0x99, 0x38, 0x33, 0xd9, 0x6e, 0xbe, 0x82, 0xa7, 0x5e, 0x04, 0x3f,
+	0x81, 0x78, 0xec, 0xc1, 0x0f, 0x51, 0x3c, 0x15, 0x4f, 0x9e, 0xac, 0xec, 0x0a, 0xfd, 0x1a, 0x92,
+	0x9d, 0x64, 0x03, 0xed, 0x41, 0xbc, 0x84, 0x37, 0xef, 0xf3, 0xfe, 0xf9, 0x3d, 0x6f, 0x02, 0xef,
+	0x8f, 0x85, 0x62, 0x42, 0x79, 0x92, 0x8c, 0xc5, 0x94, 0xc8, 0xc2, 0x9b, 0xee, 0xad, 0x62, 0x37,
+	0x93, 0x42, 0x0b, 0x84, 0x4c, 0x89, 0xbb, 0x4a, 0x4f, 0xf7, 0xac, 0x6e, 0x22, 0x12, 0xb1, 0x94,
+	0xbd, 0x32, 0x32, 0x95, 0xd6, 0x76, 0x22, 0x44, 0x92, 0x12, 0x6f, 0xf9, 0x16, 0xe5, 0x87, 0x1e,
+	0xe6, 0xd5, 0x10, 0xcb, 0xbe, 0x2a, 0xc5, 0xb9, 0xc4, 0x9a, 0x0a, 0x5e, 0xe9, 0xce, 0x55, 0x5d,
+	0x53, 0x46, 0x94, 0xc6, 0x2c, 0xab, 0x67, 0x1b, 0x8a, 0xd0, 0x2c, 0xad, 0x90, 0x8c, 0x74, 0x07,
+	0x33, 0xca, 0x85, 0xb7, 0x7c, 0x9a, 0xd4, 0xe0, 0x13, 0x80, 0xeb, 0x07, 0x58, 0x62, 0xa6, 0xd0,
+	0x0b, 0xd8, 0x66, 0x94, 0x87, 0x31, 0x49, 0x71, 0xd1, 0x03, 0x7d, 0x30, 0xdc, 0x7c, 0xb8, 0xed,
+	0x9a, 0x6d, 0x6e, 0xbd, 0xcd, 0xdd, 0xaf, 0x68, 0xfc, 0xce, 0xd9, 0x2f, 0xa7, 0xf5, 0xf9, 0xc2,
+	0x01, 0x5f, 0x2f, 0x4f, 0x77, 0x40, 0xb0, 0xc1, 0x28, 0xdf, 0x2f, 0x3b, 0xd1, 0x03, 0xd8, 0x61,
+	0x78, 0x16, 0x26, 0x39, 0x96, 0x31, 0xc5, 0x5c, 0xf5, 0x6e, 0xf4, 0xc1, 0xb0, 0x13, 0x6c, 0x31,
+	0x3c, 0x7b, 0x59, 0xe7, 0x9e, 0x3a, 0x1f, 0x2f, 0x4f, 0x77, 0x2c, 0x03, 0xb7, 0xab, 0xe2, 0x49,
+	0x73, 0x56, 0x03, 0x33, 0xf8, 0x02, 0xe0, 0xad, 0xa0, 0xca, 0x3d, 0x17, 0xfc, 0x90, 0x26, 0xe8,
+	0x31, 0x6c, 0x37, 0x43, 0x41, 0x7f, 0x6d, 0xd8, 0xf6, 0x7b, 0x3f, 0xbe, 0xed, 0x76, 0x2b, 0x8b,
+	0xa3, 0x38, 0x96, 0x44, 0xa9, 0xb7, 0x5a, 0x52, 0x9e, 0x04, 0x4d, 0x29, 0xba, 0x0b, 0xdb, 0xfa,
+	0x48, 0x12, 0x75, 0x24, 0xd2, 0xb8, 0x82, 0x69, 0x12, 0xe8, 0x19, 0xbc, 0x69, 0x1c, 0xaf, 0xfd,
+	0xa7, 0x63, 0xd3, 0x36, 0xf8, 0x03, 0xe0, 0xed, 0x1a, 0x34, 0x20, 0x1f, 0x72, 0xa2, 0x34, 0x7a,
+	0x03, 0x37, 0x39, 0x39, 0x0e, 0xb3, 0x3c, 0x0a, 0x27, 0xa4, 0xbe, 0x65, 0xf7, 0xda, 0xe4, 0x11,
+	0x2f, 0xfc, 0xde, 0xf7, 0xc6, 0xc1, 0x58, 0x16, 0x99, 0x16, 0xee, 0x41, 0x1e, 0xbd, 0x26, 0x45,
+	0xd0, 0xe6, 0xe4, 0xd8, 0x84, 0xa5, 0x73, 0x9c, 0x65, 0x52, 0x4c, 0x71, 0x5a, 0x9e, 0xf3, 0x1f,
+	0xce, 0x57, 0xa5, 0xe8, 0x15, 0xec, 0x90, 0x19, 0x19, 0xe7, 0x1a, 0x47, 0x29, 0x09, 0xb1, 0xae,
+	0x3c, 0x5a, 0xd7, 0x48, 0xde, 0xd5, 0xff, 0x90, 0xbf, 0x51, 0x9a, 0x3c, 0xb9, 0x70, 0x40, 0xb0,
+	0xd5, 0xb4, 0x8e, 0xb4, 0xff, 0xe4, 0x6c, 0x6e, 0x83, 0xf3, 0xb9, 0x0d, 0x7e, 0xcf, 0x6d, 0x70,
+	0xb2, 0xb0, 0x5b, 0xe7, 0x0b, 0xbb, 0xf5, 0x73, 0x61, 0xb7, 0xde, 0xdf, 0x33, 0x14, 0x2a, 0x9e,
+	0xb8, 0x54, 0x78, 0xb3, 0xe6, 0x4b, 0xea, 0x22, 0x23, 0x2a, 0x5a, 0x5f, 0x2e, 0x79, 0xf4, 0x77,
+	0x00, 0xf4, 0xd0, 0x3c, 0xc2, 0x40, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGuardians != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.MaxGuardians))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRecovery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRecovery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Threshold != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintRecovery(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutableAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutableAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRecovery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintRecovery(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecovery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDelay)
+	n += 1 + l + sovRecovery(uint64(l))
+	if m.MaxGuardians != 0 {
+		n += 1 + sovRecovery(uint64(m.MaxGuardians))
+	}
+	return n
+}
+
+func (m *RecoveryConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovRecovery(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovRecovery(uint64(m.Threshold))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovRecovery(uint64(l))
+	return n
+}
+
+func (m *RecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovRecovery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutableAt)
+	n += 1 + l + sovRecovery(uint64(l))
+	return n
+}
+
+func sovRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecovery(x uint64) (n int) {
+	return sovRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGuardians", wireType)
+			}
+			m.MaxGuardians = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGuardians |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &types.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutableAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecovery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -17,6 +17,9 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagExportReportPathValue   string
+	FlagExportEventLogPathValue string
+	FlagReplayFileValue         string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -27,6 +30,10 @@ var (
 	FlagAllInvariantsValue      bool
 	FlagDBBackendValue          string
 
+	FlagVestingAccountsFractionValue float64
+	FlagVestingMinDurationValue      time.Duration
+	FlagVestingMaxDurationValue      time.Duration
+
 	FlagEnabledValue     bool
 	FlagVerboseValue     bool
 	FlagPeriodValue      uint
@@ -43,6 +50,9 @@ func GetSimulatorFlags() {
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagExportReportPathValue, "ExportReportPath", "", "custom file path to save the simulation report JSON (operations, failure ratios, gas and block times)")
+	flag.StringVar(&FlagExportEventLogPathValue, "ExportEventLogPath", "", "custom file path to stream the simulation event log, as JSON lines")
+	flag.StringVar(&FlagReplayFileValue, "Replay", "", "replay file of a failed simulation; re-executes it up to its failing block with store tracing")
 	flag.Int64Var(&FlagSeedValue, "Seed", DefaultSeedValue, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -53,6 +63,11 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagAllInvariantsValue, "PrintAllInvariants", false, "print all invariants if a broken invariant is found")
 	flag.StringVar(&FlagDBBackendValue, "DBBackend", "goleveldb", "custom db backend type")
 
+	vestingAccounts := simulation.DefaultVestingAccountsConfig()
+	flag.Float64Var(&FlagVestingAccountsFractionValue, "VestingAccountsFraction", vestingAccounts.Fraction, "fraction of the genesis accounts, other than the initial validators, created as vesting accounts")
+	flag.DurationVar(&FlagVestingMinDurationValue, "VestingMinDuration", vestingAccounts.MinDuration, "minimum length of the vesting schedule of the genesis vesting accounts")
+	flag.DurationVar(&FlagVestingMaxDurationValue, "VestingMaxDuration", vestingAccounts.MaxDuration, "maximum length of the vesting schedule of the genesis vesting accounts")
+
 	// simulation flags
 	flag.BoolVar(&FlagEnabledValue, "Enabled", false, "enable the simulation")
 	flag.BoolVar(&FlagVerboseValue, "Verbose", false, "verbose log output")
@@ -70,6 +85,9 @@ func NewConfigFromFlags() simulation.Config {
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		ExportReportPath:   FlagExportReportPathValue,
+		ExportEventLogPath: FlagExportEventLogPathValue,
+		ReplayFile:         FlagReplayFileValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		GenesisTime:        FlagGenesisTimeValue,
@@ -80,5 +98,10 @@ func NewConfigFromFlags() simulation.Config {
 		OnOperation:        FlagOnOperationValue,
 		AllInvariants:      FlagAllInvariantsValue,
 		DBBackend:          FlagDBBackendValue,
+		VestingAccounts: simulation.VestingAccountsConfig{
+			Fraction:    FlagVestingAccountsFractionValue,
+			MinDuration: FlagVestingMinDurationValue,
+			MaxDuration: FlagVestingMaxDurationValue,
+		},
 	}
 }
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"time"
@@ -75,3 +76,25 @@ func (lw *DummyLogWriter) AddEntry(_ OperationEntry) {}
 
 // do nothing
 func (lw *DummyLogWriter) PrintLogs() {}
+
+// EventLogWriter streams every operation entry as a JSON line to w, and
+// forwards it to the wrapped LogWriter.
+type EventLogWriter struct {
+	LogWriter
+	w io.Writer
+}
+
+// NewEventLogWriter returns a LogWriter writing the event log of the simulation
+// to w in addition to lw.
+func NewEventLogWriter(lw LogWriter, w io.Writer) *EventLogWriter {
+	return &EventLogWriter{LogWriter: lw, w: w}
+}
+
+// add an entry to the event log and the wrapped log writer
+func (lw *EventLogWriter) AddEntry(opEntry OperationEntry) {
+	if _, err := fmt.Fprintf(lw.w, "%s\n", opEntry.MustMarshal()); err != nil {
+		panic("Failed to write event log entry")
+	}
+
+	lw.LogWriter.AddEntry(opEntry)
+}
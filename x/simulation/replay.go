@@ -0,0 +1,115 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Replay is a compact description of a failed simulation. It holds everything
+// needed to deterministically re-execute the simulation up to the failing
+// operation, and is written to disk whenever a simulation fails.
+type Replay struct {
+	Seed               int64  `json:"seed"`
+	ChainID            string `json:"chain_id"`
+	GenesisTime        int64  `json:"genesis_time"`
+	GenesisFile        string `json:"genesis_file,omitempty"`
+	ParamsFile         string `json:"params_file,omitempty"`
+	InitialBlockHeight int    `json:"initial_block_height"`
+	BlockSize          int    `json:"block_size"`
+	BlockMaxGas        int64  `json:"block_max_gas,omitempty"`
+	Commit             bool   `json:"commit"`
+
+	// Height is the height of the block at which the simulation failed.
+	Height int64 `json:"height"`
+	// OperationIndex is the index, in the failing block, of the operation which
+	// failed. It is -1 when the failure did not happen in a standard operation.
+	OperationIndex int `json:"operation_index"`
+	// Error is the failure reported by the simulation.
+	Error string `json:"error"`
+}
+
+// NewReplay creates a new Replay of a simulation run with the given config
+// which failed at the given height and operation index.
+func NewReplay(config simulation.Config, height int64, opIndex int, failure error) Replay {
+	replay := Replay{
+		Seed:               config.Seed,
+		ChainID:            config.ChainID,
+		GenesisTime:        config.GenesisTime,
+		GenesisFile:        config.GenesisFile,
+		ParamsFile:         config.ParamsFile,
+		InitialBlockHeight: config.InitialBlockHeight,
+		BlockSize:          config.BlockSize,
+		BlockMaxGas:        config.BlockMaxGas,
+		Commit:             config.Commit,
+		Height:             height,
+		OperationIndex:     opIndex,
+	}
+	if failure != nil {
+		replay.Error = failure.Error()
+	}
+
+	return replay
+}
+
+// LoadReplay reads a Replay from the JSON file at the given path.
+func LoadReplay(path string) (Replay, error) {
+	var replay Replay
+
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return replay, err
+	}
+
+	if err := json.Unmarshal(bz, &replay); err != nil {
+		return replay, fmt.Errorf("invalid replay file %s: %w", path, err)
+	}
+
+	return replay, nil
+}
+
+// Apply returns a copy of config which re-executes the replayed simulation up
+// to, and including, its failing block.
+func (r Replay) Apply(config simulation.Config) simulation.Config {
+	config.Seed = r.Seed
+	config.ChainID = r.ChainID
+	config.GenesisTime = r.GenesisTime
+	config.GenesisFile = r.GenesisFile
+	config.ParamsFile = r.ParamsFile
+	config.InitialBlockHeight = r.InitialBlockHeight
+	config.BlockSize = r.BlockSize
+	config.BlockMaxGas = r.BlockMaxGas
+	config.Commit = r.Commit
+	config.NumBlocks = int(r.Height) - r.InitialBlockHeight + 1
+	config.Lean = false
+
+	return config
+}
+
+// ExportJSON saves the replay as a JSON file on a given path.
+func (r Replay) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// writeReplayFile saves the replay of a failed simulation next to the
+// simulation logs and returns the path of the file.
+func writeReplayFile(replay Replay) (string, error) {
+	fileName := fmt.Sprintf("%s.replay.json", time.Now().Format("2006-01-02_15:04:05"))
+	folderPath := path.Join(os.ExpandEnv("$HOME"), ".simapp", "simulations")
+	if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	filePath := path.Join(folderPath, fileName)
+	return filePath, replay.ExportJSON(filePath)
+}
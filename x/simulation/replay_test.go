@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestReplay(t *testing.T) {
+	config := simtypes.Config{
+		Seed:               42,
+		ChainID:            "test-chain",
+		GenesisTime:        1700000000,
+		InitialBlockHeight: 1,
+		NumBlocks:          500,
+		BlockSize:          200,
+		Commit:             true,
+		Lean:               true,
+	}
+
+	replay := NewReplay(config, 17, 3, errors.New("insufficient funds"))
+	require.Equal(t, int64(17), replay.Height)
+	require.Equal(t, 3, replay.OperationIndex)
+	require.Equal(t, "insufficient funds", replay.Error)
+
+	path := filepath.Join(t.TempDir(), "replay.json")
+	require.NoError(t, replay.ExportJSON(path))
+
+	loaded, err := LoadReplay(path)
+	require.NoError(t, err)
+	require.Equal(t, replay, loaded)
+
+	applied := loaded.Apply(simtypes.Config{Seed: 1, NumBlocks: 10, Lean: true})
+	require.Equal(t, int64(42), applied.Seed)
+	require.Equal(t, "test-chain", applied.ChainID)
+	require.Equal(t, int64(1700000000), applied.GenesisTime)
+	require.Equal(t, 200, applied.BlockSize)
+	require.True(t, applied.Commit)
+	require.Equal(t, 17, applied.NumBlocks)
+	require.False(t, applied.Lean)
+
+	_, err = LoadReplay(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
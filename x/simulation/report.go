@@ -0,0 +1,129 @@
+package simulation
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Report is a structured summary of a simulation run. It is exported as JSON
+// when Config.ExportReportPath is set, so that the health of the simulation
+// can be tracked across commits.
+type Report struct {
+	Seed         int64         `json:"seed"`
+	ChainID      string        `json:"chain_id"`
+	Blocks       int64         `json:"blocks"`
+	Operations   int           `json:"operations"`
+	StoppedEarly bool          `json:"stopped_early"`
+	Duration     time.Duration `json:"duration_ns"`
+
+	// OperationStats holds the statistics of every operation, indexed by
+	// module and operation name.
+	OperationStats map[string]map[string]*OperationStats `json:"operation_stats"`
+	BlockStats     []BlockStats                          `json:"block_stats"`
+	Events         EventStats                            `json:"events"`
+}
+
+// OperationStats defines the statistics of a single simulation operation.
+type OperationStats struct {
+	OK           int      `json:"ok"`
+	Failure      int      `json:"failure"`
+	FailureRatio float64  `json:"failure_ratio"`
+	Gas          GasStats `json:"gas"`
+}
+
+// GasStats defines the distribution of the gas used by an operation. Operations
+// which did not deliver a transaction are not accounted for.
+type GasStats struct {
+	Count uint64  `json:"count"`
+	Total uint64  `json:"total"`
+	Min   uint64  `json:"min"`
+	Max   uint64  `json:"max"`
+	Mean  float64 `json:"mean"`
+}
+
+// BlockStats defines the statistics of a single simulated block.
+type BlockStats struct {
+	Height            int64         `json:"height"`
+	Operations        int           `json:"operations"`
+	GasUsed           uint64        `json:"gas_used"`
+	FinalizeBlockTime time.Duration `json:"finalize_block_time_ns"`
+	OperationsTime    time.Duration `json:"operations_time_ns"`
+	CommitTime        time.Duration `json:"commit_time_ns"`
+}
+
+// NewReport creates a new empty Report for the given seed.
+func NewReport(seed int64, chainID string) *Report {
+	return &Report{
+		Seed:           seed,
+		ChainID:        chainID,
+		OperationStats: make(map[string]map[string]*OperationStats),
+	}
+}
+
+// recordOperation accounts for the result of an operation and the gas it used.
+// It is a no-op on a nil report.
+func (r *Report) recordOperation(opMsg simulation.OperationMsg, gasUsed uint64) {
+	if r == nil {
+		return
+	}
+
+	ops, ok := r.OperationStats[opMsg.Route]
+	if !ok {
+		ops = make(map[string]*OperationStats)
+		r.OperationStats[opMsg.Route] = ops
+	}
+
+	stats, ok := ops[opMsg.Name]
+	if !ok {
+		stats = &OperationStats{}
+		ops[opMsg.Name] = stats
+	}
+
+	if opMsg.OK {
+		stats.OK++
+	} else {
+		stats.Failure++
+	}
+	stats.FailureRatio = float64(stats.Failure) / float64(stats.OK+stats.Failure)
+
+	if gasUsed > 0 {
+		stats.Gas.add(gasUsed)
+	}
+}
+
+// recordBlock appends the statistics of a simulated block. It is a no-op on a
+// nil report.
+func (r *Report) recordBlock(stats BlockStats) {
+	if r == nil {
+		return
+	}
+
+	r.Blocks++
+	r.Operations += stats.Operations
+	r.BlockStats = append(r.BlockStats, stats)
+}
+
+func (gs *GasStats) add(gas uint64) {
+	if gs.Count == 0 || gas < gs.Min {
+		gs.Min = gas
+	}
+	if gas > gs.Max {
+		gs.Max = gas
+	}
+	gs.Count++
+	gs.Total += gas
+	gs.Mean = float64(gs.Total) / float64(gs.Count)
+}
+
+// ExportJSON saves the report as a JSON file on a given path.
+func (r *Report) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestReport(t *testing.T) {
+	report := NewReport(7, "test-chain")
+
+	send := simtypes.NewOperationMsgBasic("bank", "send", "", true, nil)
+	report.recordOperation(send, 100)
+	report.recordOperation(send, 300)
+	report.recordOperation(simtypes.NoOpMsg("bank", "send", "no funds"), 0)
+	report.recordBlock(BlockStats{Height: 1, Operations: 3, GasUsed: 400})
+
+	stats := report.OperationStats["bank"]["send"]
+	require.Equal(t, 2, stats.OK)
+	require.Equal(t, 1, stats.Failure)
+	require.InDelta(t, 1.0/3, stats.FailureRatio, 1e-9)
+	require.Equal(t, GasStats{Count: 2, Total: 400, Min: 100, Max: 300, Mean: 200}, stats.Gas)
+	require.Equal(t, int64(1), report.Blocks)
+	require.Equal(t, 3, report.Operations)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, report.ExportJSON(path))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var exported Report
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Equal(t, *report, exported)
+
+	// a nil report ignores all records
+	var nilReport *Report
+	nilReport.recordOperation(send, 100)
+	nilReport.recordBlock(BlockStats{Height: 1})
+}
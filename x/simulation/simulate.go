@@ -14,6 +14,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -70,6 +71,19 @@ func SimulateFromSeed(
 	tb.Helper()
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, _, b := getTestingMode(tb)
+	simStart := time.Now()
+
+	var replay *Replay
+	if config.ReplayFile != "" {
+		loaded, err := LoadReplay(config.ReplayFile)
+		if err != nil {
+			return true, Params{}, err
+		}
+
+		replay = &loaded
+		config = replay.Apply(config)
+		fmt.Fprintf(w, "Replaying simulation with seed %d up to block %d, operation %d\n", replay.Seed, replay.Height, replay.OperationIndex)
+	}
 
 	r := rand.New(rand.NewSource(config.Seed))
 	params := RandomParams(r)
@@ -92,6 +106,27 @@ func SimulateFromSeed(
 
 	config.ChainID = chainID
 
+	var report *Report
+	if config.ExportReportPath != "" {
+		report = NewReport(config.Seed, chainID)
+	}
+
+	// reportFailure writes a replay file of the simulation when it fails, unless
+	// the simulation is already a replay.
+	reportFailure := func(height int64, opIndex int, failure error) {
+		if replay != nil {
+			return
+		}
+
+		path, err := writeReplayFile(NewReplay(config, height, opIndex, failure))
+		if err != nil {
+			fmt.Fprintf(w, "\nfailed to write the simulation replay file: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(w, "\nSimulation replay written to %s; re-run the failing block with -Replay=%s\n", path, path)
+	}
+
 	fmt.Printf(
 		"Starting the simulation from time %v (unixtime %v)\n",
 		blockTime.UTC().Format(time.UnixDate), blockTime.Unix(),
@@ -145,6 +180,15 @@ func SimulateFromSeed(
 	// These are operations which have been queued by previous operations
 	operationQueue := NewOperationQueue()
 	logWriter := NewLogWriter(testingMode)
+	if config.ExportEventLogPath != "" {
+		f, err := os.Create(config.ExportEventLogPath)
+		if err != nil {
+			return true, params, err
+		}
+		defer f.Close()
+
+		logWriter = NewEventLogWriter(logWriter, f)
+	}
 
 	blockSimulator := createBlockSimulator(
 		tb,
@@ -156,6 +200,8 @@ func SimulateFromSeed(
 		operationQueue,
 		timeOperationQueue,
 		logWriter,
+		report,
+		reportFailure,
 		config,
 	)
 
@@ -166,6 +212,7 @@ func SimulateFromSeed(
 		defer func() {
 			if r := recover(); r != nil {
 				_, _ = fmt.Fprintf(w, "simulation halted due to panic on block %d\n", blockHeight)
+				reportFailure(blockHeight, -1, fmt.Errorf("panic: %v", r))
 				logWriter.PrintLogs()
 				panic(r)
 			}
@@ -184,11 +231,22 @@ func SimulateFromSeed(
 		// Run the BeginBlock handler
 		logWriter.AddEntry(BeginBlockEntry(blockHeight))
 
+		if replay != nil && blockHeight == replay.Height {
+			// trace every store access of the failing block
+			app.CommitMultiStore().SetTracer(w)
+			app.CommitMultiStore().SetTracingContext(storetypes.TraceContext{"blockHeight": blockHeight})
+		}
+
+		finalizeStart := time.Now()
 		res, err := app.FinalizeBlock(finalizeBlockReq)
 		if err != nil {
+			reportFailure(blockHeight, -1, err)
 			return true, params, err
 		}
 
+		blockStats := BlockStats{Height: blockHeight, FinalizeBlockTime: time.Since(finalizeStart)}
+		operationsStart := time.Now()
+
 		ctx := app.NewContextLegacy(false, cmtproto.Header{
 			Height:          blockHeight,
 			Time:            blockTime,
@@ -203,12 +261,12 @@ func SimulateFromSeed(
 		// run queued operations; ignores block size if block size is too small
 		numQueuedOpsRan, futureOps := runQueuedOperations(
 			tb, operationQueue, int(blockHeight), r, app, ctx, accs, logWriter,
-			eventStats.Tally, config.Lean, config.ChainID,
+			eventStats.Tally, report, reportFailure, config.Lean, config.ChainID,
 		)
 
 		numQueuedTimeOpsRan, timeFutureOps := runQueuedTimeOperations(tb,
 			timeOperationQueue, int(blockHeight), blockTime,
-			r, app, ctx, accs, logWriter, eventStats.Tally,
+			r, app, ctx, accs, logWriter, eventStats.Tally, report, reportFailure,
 			config.Lean, config.ChainID,
 		)
 
@@ -222,7 +280,10 @@ func SimulateFromSeed(
 			ProposerAddress: proposerAddress,
 			ChainID:         config.ChainID,
 		})
-		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
+		blockStats.Operations = operations + numQueuedOpsRan + numQueuedTimeOpsRan
+		blockStats.OperationsTime = time.Since(operationsStart)
+		blockStats.GasUsed = app.SimBlockGasConsumed()
+		opCount += blockStats.Operations
 
 		blockHeight++
 
@@ -233,13 +294,23 @@ func SimulateFromSeed(
 		logWriter.AddEntry(EndBlockEntry(blockHeight))
 
 		if config.Commit {
+			commitStart := time.Now()
 			_, err := app.Commit()
 			if err != nil {
+				reportFailure(blockHeight-1, -1, err)
 				return true, params, err
 			}
+			blockStats.CommitTime = time.Since(commitStart)
 
+			if config.OnBlockCommit != nil {
+				if err := config.OnBlockCommit(app.LastBlockHeight()); err != nil {
+					return true, params, err
+				}
+			}
 		}
 
+		report.recordBlock(blockStats)
+
 		if proposerAddress == nil {
 			fmt.Fprintf(w, "\nSimulation stopped early as all validators have been unbonded; nobody left to propose a block!\n")
 			stopEarly = true
@@ -269,6 +340,10 @@ func SimulateFromSeed(
 			eventStats.Print(w)
 		}
 
+		if err := exportReport(report, config, eventStats, simStart, true); err != nil {
+			return true, exportedParams, err
+		}
+
 		return true, exportedParams, err
 	}
 
@@ -285,9 +360,28 @@ func SimulateFromSeed(
 		eventStats.Print(w)
 	}
 
+	if err := exportReport(report, config, eventStats, simStart, false); err != nil {
+		return true, exportedParams, err
+	}
+
 	return false, exportedParams, nil
 }
 
+// exportReport completes the simulation report and saves it to the configured
+// path. It is a no-op when no report was requested.
+func exportReport(report *Report, config simulation.Config, eventStats EventStats, simStart time.Time, stopEarly bool) error {
+	if report == nil {
+		return nil
+	}
+
+	report.StoppedEarly = stopEarly
+	report.Duration = time.Since(simStart)
+	report.Events = eventStats
+
+	fmt.Println("Exporting simulation report...")
+	return report.ExportJSON(config.ExportReportPath)
+}
+
 type blockSimFn func(
 	r *rand.Rand,
 	app *baseapp.BaseApp,
@@ -301,7 +395,8 @@ type blockSimFn func(
 func createBlockSimulator(tb testing.TB, testingMode bool, w io.Writer, params Params,
 	event func(route, op, evResult string), ops WeightedOperations,
 	operationQueue OperationQueue, timeOperationQueue []simulation.FutureOperation,
-	logWriter LogWriter, config simulation.Config,
+	logWriter LogWriter, report *Report, reportFailure func(height int64, opIndex int, failure error),
+	config simulation.Config,
 ) blockSimFn {
 	tb.Helper()
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
@@ -337,8 +432,10 @@ func createBlockSimulator(tb testing.TB, testingMode bool, w io.Writer, params P
 			// NOTE: the Rand 'r' should not be used here.
 			opAndR := opAndRz[i]
 			op, r2 := opAndR.op, opAndR.rand
+			gasBefore := app.SimBlockGasConsumed()
 			opMsg, futureOps, err := op(r2, app, ctx, accounts, config.ChainID)
 			opMsg.LogEvent(event)
+			report.recordOperation(opMsg, app.SimBlockGasConsumed()-gasBefore)
 
 			if !config.Lean || opMsg.OK {
 				logWriter.AddEntry(MsgEntry(header.Height, int64(i), opMsg))
@@ -346,6 +443,7 @@ func createBlockSimulator(tb testing.TB, testingMode bool, w io.Writer, params P
 
 			if err != nil {
 				logWriter.PrintLogs()
+				reportFailure(header.Height, i, err)
 				tb.Fatalf(`error on block  %d/%d, operation (%d/%d) from x/%s:
 %v
 Comment: %s`,
@@ -369,7 +467,8 @@ Comment: %s`,
 func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
 	height int, r *rand.Rand, app *baseapp.BaseApp,
 	ctx sdk.Context, accounts []simulation.Account, logWriter LogWriter,
-	event func(route, op, evResult string), lean bool, chainID string,
+	event func(route, op, evResult string), report *Report,
+	reportFailure func(height int64, opIndex int, failure error), lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
 	tb.Helper()
 	queuedOp, ok := queueOps[height]
@@ -382,12 +481,14 @@ func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
 
 	numOpsRan = len(queuedOp)
 	for i := 0; i < numOpsRan; i++ {
+		gasBefore := app.SimBlockGasConsumed()
 		opMsg, futureOps, err := queuedOp[i](r, app, ctx, accounts, chainID)
 		if len(futureOps) > 0 {
 			allFutureOps = append(allFutureOps, futureOps...)
 		}
 
 		opMsg.LogEvent(event)
+		report.recordOperation(opMsg, app.SimBlockGasConsumed()-gasBefore)
 
 		if !lean || opMsg.OK {
 			logWriter.AddEntry((QueuedMsgEntry(int64(height), opMsg)))
@@ -395,6 +496,7 @@ func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
 
 		if err != nil {
 			logWriter.PrintLogs()
+			reportFailure(int64(height), -1, err)
 			tb.FailNow()
 		}
 	}
@@ -406,8 +508,8 @@ func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
 func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperation,
 	height int, currentTime time.Time, r *rand.Rand,
 	app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account,
-	logWriter LogWriter, event func(route, op, evResult string),
-	lean bool, chainID string,
+	logWriter LogWriter, event func(route, op, evResult string), report *Report,
+	reportFailure func(height int64, opIndex int, failure error), lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
 	tb.Helper()
 	// Keep all future operations
@@ -415,9 +517,11 @@ func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperatio
 
 	numOpsRan = 0
 	for len(queueOps) > 0 && currentTime.After(queueOps[0].BlockTime) {
+		gasBefore := app.SimBlockGasConsumed()
 		opMsg, futureOps, err := queueOps[0].Op(r, app, ctx, accounts, chainID)
 
 		opMsg.LogEvent(event)
+		report.recordOperation(opMsg, app.SimBlockGasConsumed()-gasBefore)
 
 		if !lean || opMsg.OK {
 			logWriter.AddEntry(QueuedMsgEntry(int64(height), opMsg))
@@ -425,6 +529,7 @@ func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperatio
 
 		if err != nil {
 			logWriter.PrintLogs()
+			reportFailure(int64(height), -1, err)
 			tb.FailNow()
 		}
 
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// ExportValidatorSetCmd returns a command which exports, offline, the bonded
+// validator set at a committed height as a CometBFT validators file.
+func ExportValidatorSetCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-validator-set",
+		Short: "Export the bonded validator set at a committed height as a CometBFT validators file",
+		Long: `Export the bonded validator set at a committed height as a CometBFT validators file.
+The validators bonded by the staking module are written with their consensus public
+key and voting power, in the format of the validators of a CometBFT genesis file. The
+file can bootstrap the validator set of a fork or a testnet, or serve as the trust
+root of a light client.
+
+The node must be stopped, as the command reads the application database directly.`,
+		Example: fmt.Sprintf("%s export-validator-set --height 100 --output-document validators.json", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			ctx := server.GetServerContextFromCmd(cmd)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), filepath.Join(ctx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+			if height <= 0 {
+				height = rootmulti.GetLatestVersion(db)
+			}
+			if height <= 0 {
+				return fmt.Errorf("the database has no committed heights")
+			}
+
+			outputDocument, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if err != nil {
+				return err
+			}
+
+			app := appCreator(log.NewNopLogger(), db, nil, ctx.Viper)
+			rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the exporting of rootmulti.Store type")
+			}
+
+			storeKey, ok := rootMultiStore.StoreKeysByName()[types.StoreKey]
+			if !ok {
+				return fmt.Errorf("the application has no %s store", types.StoreKey)
+			}
+
+			ms, err := rootMultiStore.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return err
+			}
+
+			validators, err := BondedValidatorSet(clientCtx.Codec, ms.GetKVStore(storeKey))
+			if err != nil {
+				return err
+			}
+			if len(validators) == 0 {
+				return fmt.Errorf("no validators are bonded at height %d", height)
+			}
+
+			bz, err := cmtjson.MarshalIndent(validators, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if outputDocument == "" {
+				cmd.Println(string(bz))
+				return nil
+			}
+
+			if err := os.WriteFile(filepath.Clean(outputDocument), append(bz, '\n'), 0o600); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("exported %d validators bonded at height %d to %s\n", len(validators), height, outputDocument)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height to export the validator set at (defaults to the latest committed height)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported validators file path (defaults to stdout)")
+
+	return cmd
+}
+
+// BondedValidatorSet returns the validators bonded in the given staking store,
+// with their consensus power, sorted as CometBFT sorts a validator set: by
+// decreasing power, then by address.
+func BondedValidatorSet(cdc codec.BinaryCodec, store storetypes.KVStore) ([]cmttypes.GenesisValidator, error) {
+	var validators []cmttypes.GenesisValidator
+
+	iter := storetypes.KVStorePrefixIterator(store, types.LastValidatorPowerKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := types.AddressFromLastValidatorPowerKey(iter.Key())
+
+		var power gogotypes.Int64Value
+		if err := cdc.Unmarshal(iter.Value(), &power); err != nil {
+			return nil, fmt.Errorf("invalid power of validator %X: %w", operator, err)
+		}
+
+		bz := store.Get(types.GetValidatorKey(operator))
+		if bz == nil {
+			return nil, fmt.Errorf("bonded validator %X not found", operator)
+		}
+
+		validator, err := types.UnmarshalValidator(cdc, bz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator %X: %w", operator, err)
+		}
+
+		pk, err := validator.ConsPubKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid consensus public key of validator %s: %w", validator.GetOperator(), err)
+		}
+
+		cmtPk, err := cryptocodec.ToCmtPubKeyInterface(pk)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported consensus public key of validator %s: %w", validator.GetOperator(), err)
+		}
+
+		validators = append(validators, cmttypes.GenesisValidator{
+			Address: cmtPk.Address(),
+			PubKey:  cmtPk,
+			Power:   power.Value,
+			Name:    validator.GetMoniker(),
+		})
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		if validators[i].Power != validators[j].Power {
+			return validators[i].Power > validators[j].Power
+		}
+		return bytes.Compare(validators[i].Address, validators[j].Address) < 0
+	})
+
+	return validators, nil
+}
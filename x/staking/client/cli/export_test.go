@@ -0,0 +1,59 @@
+package cli_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking"
+	"cosmossdk.io/x/staking/client/cli"
+	"cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestBondedValidatorSet(t *testing.T) {
+	cdc := testutilmod.MakeTestEncodingConfig(codectestutil.CodecOptions{}, staking.AppModule{}).Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	setValidator := func(moniker string, power int64) ed25519.PubKey {
+		pk := ed25519.GenPrivKey().PubKey().(*ed25519.PubKey)
+		operator := sdk.ValAddress(pk.Address())
+
+		validator, err := types.NewValidator(operator.String(), pk, types.Description{Moniker: moniker})
+		require.NoError(t, err)
+		store.Set(types.GetValidatorKey(operator), types.MustMarshalValidator(cdc, &validator))
+
+		if power > 0 {
+			store.Set(append(types.LastValidatorPowerKey, address.MustLengthPrefix(operator)...), cdc.MustMarshal(&gogotypes.Int64Value{Value: power}))
+		}
+
+		return *pk
+	}
+
+	small := setValidator("small", 10)
+	large := setValidator("large", 100)
+	setValidator("unbonded", 0)
+
+	validators, err := cli.BondedValidatorSet(cdc, store)
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+
+	require.Equal(t, "large", validators[0].Name)
+	require.Equal(t, int64(100), validators[0].Power)
+	require.Equal(t, large.Bytes(), validators[0].PubKey.Bytes())
+	require.Equal(t, large.Address().Bytes(), validators[0].Address.Bytes())
+
+	require.Equal(t, "small", validators[1].Name)
+	require.Equal(t, int64(10), validators[1].Power)
+	require.Equal(t, small.Bytes(), validators[1].PubKey.Bytes())
+}
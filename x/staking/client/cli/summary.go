@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func init() {
+	tx.RegisterMsgSummaryFormatter(&types.MsgDelegate{}, formatMsgDelegate)
+	tx.RegisterMsgSummaryFormatter(&types.MsgUndelegate{}, formatMsgUndelegate)
+	tx.RegisterMsgSummaryFormatter(&types.MsgBeginRedelegate{}, formatMsgBeginRedelegate)
+}
+
+func formatMsgDelegate(msg sdk.Msg) (string, error) {
+	m, ok := msg.(*types.MsgDelegate)
+	if !ok {
+		return "", fmt.Errorf("expected %T, got %T", &types.MsgDelegate{}, msg)
+	}
+
+	return fmt.Sprintf("delegate %s from %s to validator %s", m.Amount, m.DelegatorAddress, m.ValidatorAddress), nil
+}
+
+func formatMsgUndelegate(msg sdk.Msg) (string, error) {
+	m, ok := msg.(*types.MsgUndelegate)
+	if !ok {
+		return "", fmt.Errorf("expected %T, got %T", &types.MsgUndelegate{}, msg)
+	}
+
+	return fmt.Sprintf("undelegate %s of %s from validator %s", m.Amount, m.DelegatorAddress, m.ValidatorAddress), nil
+}
+
+func formatMsgBeginRedelegate(msg sdk.Msg) (string, error) {
+	m, ok := msg.(*types.MsgBeginRedelegate)
+	if !ok {
+		return "", fmt.Errorf("expected %T, got %T", &types.MsgBeginRedelegate{}, msg)
+	}
+
+	return fmt.Sprintf("redelegate %s of %s from validator %s to validator %s",
+		m.Amount, m.DelegatorAddress, m.ValidatorSrcAddress, m.ValidatorDstAddress), nil
+}
@@ -1031,6 +1031,47 @@ func (k Keeper) CompleteUnbonding(ctx context.Context, delAddr sdk.AccAddress, v
 	return balances, nil
 }
 
+// reconcileVestingDelegations clamps the tracked delegations of each vesting
+// delegator to its bonded and unbonding tokens. The undelegations tracked by
+// the account only account for the tokens returned to it, so the tokens lost to
+// slashing, of its delegations, unbonding delegations or redelegations, would
+// otherwise remain tracked as delegated forever. It is called once per block
+// for the delegators whose unbonding delegations or redelegations matured, so
+// each of them is only reconciled once per block.
+func (k Keeper) reconcileVestingDelegations(ctx context.Context, delegators []sdk.AccAddress) error {
+	if len(delegators) == 0 {
+		return nil
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, delAddr := range delegators {
+		acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(types.VestingAccount)
+		if !ok {
+			continue
+		}
+
+		bonded, err := k.GetDelegatorBonded(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		unbonding, err := k.GetDelegatorUnbonding(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		if acc.ReconcileDelegations(sdk.NewCoin(bondDenom, bonded.Add(unbonding))) {
+			k.authKeeper.SetAccount(ctx, acc)
+		}
+	}
+
+	return nil
+}
+
 // BeginRedelegation begins unbonding / redelegation and creates a redelegation
 // record.
 func (k Keeper) BeginRedelegation(
@@ -44,6 +44,17 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 		return nil, err
 	}
 
+	// the delegators whose unbonding delegations or redelegations matured, in
+	// the order they matured, to reconcile their vesting delegations once
+	var matureDelegators []sdk.AccAddress
+	seenDelegators := make(map[string]bool)
+	addMatureDelegator := func(delegator sdk.AccAddress) {
+		if !seenDelegators[string(delegator)] {
+			seenDelegators[string(delegator)] = true
+			matureDelegators = append(matureDelegators, delegator)
+		}
+	}
+
 	time := k.environment.HeaderService.GetHeaderInfo(ctx).Time
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds, err := k.DequeueAllMatureUBDQueue(ctx, time)
@@ -65,6 +76,7 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 		if err != nil {
 			continue
 		}
+		addMatureDelegator(delegatorAddress)
 
 		if err := k.environment.EventService.EventManager(ctx).EmitKV(
 			types.EventTypeCompleteUnbonding,
@@ -105,6 +117,7 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 		if err != nil {
 			continue
 		}
+		addMatureDelegator(delegatorAddress)
 
 		if err := k.environment.EventService.EventManager(ctx).EmitKV(
 			types.EventTypeCompleteRedelegation,
@@ -117,6 +130,11 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 		}
 	}
 
+	// reconcile the vesting delegations once all the delegations matured
+	if err := k.reconcileVestingDelegations(ctx, matureDelegators); err != nil {
+		return nil, err
+	}
+
 	err = k.PurgeAllMaturedConsKeyRotatedKeys(ctx, time)
 	if err != nil {
 		return nil, err
@@ -70,6 +70,38 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, "1", stakingGenesis.Validators[2].MinSelfDelegation.String())
 }
 
+// TestRandomizedGenStateAppParams tests that parameters provided through the
+// simulation params file override the randomly generated ones.
+func TestRandomizedGenStateAppParams(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	r := rand.New(rand.NewSource(1))
+
+	simState := module.SimulationState{
+		AppParams: simtypes.AppParams{
+			"historical_entries":       json.RawMessage(`42`),
+			"cons_pubkey_rotation_fee": json.RawMessage(`{"denom":"stake","amount":"12345"}`),
+		},
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var stakingGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &stakingGenesis)
+
+	require.Equal(t, uint32(42), stakingGenesis.Params.HistoricalEntries)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 12345), stakingGenesis.Params.KeyRotationFee)
+}
+
 // TestRandomizedGenState1 tests abnormal scenarios of applying RandomizedGenState.
 func TestRandomizedGenState1(t *testing.T) {
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
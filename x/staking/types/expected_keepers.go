@@ -17,7 +17,8 @@ import (
 type AccountKeeper interface {
 	AddressCodec() address.Codec
 
-	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI // only used for simulation
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
@@ -26,6 +27,15 @@ type AccountKeeper interface {
 	SetModuleAccount(context.Context, sdk.ModuleAccountI)
 }
 
+// VestingAccount defines the expected interface of vesting accounts, whose
+// tracked delegations are reconciled with their actual delegations once their
+// unbondings complete.
+type VestingAccount interface {
+	sdk.AccountI
+
+	ReconcileDelegations(delegated sdk.Coin) bool
+}
+
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
@@ -0,0 +1,80 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	tokenfactoryv1 "cosmossdk.io/api/cosmos/tokenfactory/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current tokenfactory parameters",
+				},
+				{
+					RpcMethod:      "Denom",
+					Use:            "denom [denom]",
+					Short:          "Query the admin and total supply of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the factory denoms created by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom] --from [sender]",
+					Short:          "Create the denom factory/{sender}/{subdenom}, administered by the sender",
+					Example:        fmt.Sprintf("%s tx tokenfactory create-denom mytoken --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [amount] --from [admin]",
+					Short:          "Mint an amount of a factory denom",
+					Example:        fmt.Sprintf("%s tx tokenfactory mint 1000factory/cosmos1.../mytoken --mint-to-address cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [amount] --from [admin]",
+					Short:          "Burn an amount of a factory denom from the admin balance",
+					Example:        fmt.Sprintf("%s tx tokenfactory burn 1000factory/cosmos1.../mytoken --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ChangeAdmin",
+					Use:            "change-admin [denom] [new-admin] --from [admin]",
+					Short:          "Transfer the admin rights over a factory denom, or renounce them with an empty new admin",
+					Example:        fmt.Sprintf("%s tx tokenfactory change-admin factory/cosmos1.../mytoken cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update tokenfactory module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update tokenfactory module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query tokenfactory params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx tokenfactory update-params-proposal '{ "denom_creation_fee": [{"denom":"stake","amount":"10000000"}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
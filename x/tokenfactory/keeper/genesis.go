@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, denom := range data.FactoryDenoms {
+		creatorStr, _, err := types.DeconstructDenom(denom.Denom)
+		if err != nil {
+			return err
+		}
+		creator, err := k.addressCodec.StringToBytes(creatorStr)
+		if err != nil {
+			return err
+		}
+		if err := k.setFactoryDenom(ctx, denom.Denom, creator, denom.AuthorityMetadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var denoms []types.GenesisDenom
+	err = k.CreatorDenoms.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string]) (bool, error) {
+		metadata, err := k.DenomAuthority.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		denoms = append(denoms, types.GenesisDenom{Denom: key.K2(), AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, denoms), nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/tokenfactory QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the tokenfactory module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Denom returns the authority over a factory denom and its total supply.
+func (q queryServer) Denom(ctx context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	metadata, err := q.k.DenomAuthority.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "factory denom %s not found", req.Denom)
+		}
+		return nil, err
+	}
+
+	return &types.QueryDenomResponse{
+		AuthorityMetadata: metadata,
+		Supply:            q.k.bankKeeper.GetSupply(ctx, req.Denom),
+	}, nil
+}
+
+// DenomsFromCreator returns the factory denoms created by an address.
+func (q queryServer) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := q.k.addressCodec.StringToBytes(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+
+	var denoms []string
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, string](creator)
+	err = q.k.CreatorDenoms.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, string]) (bool, error) {
+		denoms = append(denoms, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
+}
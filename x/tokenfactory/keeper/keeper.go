@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	environment  appmodule.Environment
+	bankKeeper   types.BankKeeper
+	addressCodec address.Codec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// DenomAuthority contains the authority over each factory denom
+	DenomAuthority collections.Map[string, types.DenomAuthorityMetadata]
+	// CreatorDenoms indexes the factory denoms by creator
+	CreatorDenoms collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:            cdc,
+		environment:    env,
+		bankKeeper:     bk,
+		addressCodec:   ak.AddressCodec(),
+		authority:      authority,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthority: collections.NewMap(sb, types.DenomAuthorityPrefix, "denom_authority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms:  collections.NewKeySet(sb, types.CreatorDenomsPrefix, "creator_denoms", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
+
+// CreateDenom creates the factory denom of the given creator and subdenom,
+// charging the denom creation fee to the creator, and returns it.
+func (k Keeper) CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.addressCodec.BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetTokenDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthority.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if has || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrap(types.ErrDenomExists, denom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, creator, params.DenomCreationFee); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.setFactoryDenom(ctx, denom, creator, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+	})
+
+	return denom, nil
+}
+
+// GetAdmin returns the admin of a factory denom, or an error if the denom was
+// not created through the module.
+func (k Keeper) GetAdmin(ctx context.Context, denom string) (string, error) {
+	metadata, err := k.DenomAuthority.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", errorsmod.Wrapf(types.ErrInvalidDenom, "%s is not a factory denom", denom)
+		}
+		return "", err
+	}
+
+	return metadata.Admin, nil
+}
+
+// setFactoryDenom stores the authority over a factory denom and indexes it
+// under its creator.
+func (k Keeper) setFactoryDenom(ctx context.Context, denom string, creator sdk.AccAddress, metadata types.DenomAuthorityMetadata) error {
+	if err := k.DenomAuthority.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(creator, denom))
+}
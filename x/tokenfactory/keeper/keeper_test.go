@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/tokenfactory"
+	"cosmossdk.io/x/tokenfactory/keeper"
+	tokenfactorytestutil "cosmossdk.io/x/tokenfactory/testutil"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *tokenfactorytestutil.MockBankKeeper
+
+	creator      sdk.AccAddress
+	creatorStr   string
+	recipient    sdk.AccAddress
+	recipientStr string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, tokenfactory.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ac := address.NewBech32Codec("cosmos")
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := tokenfactorytestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress(types.ModuleName))
+	accountKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	s.bankKeeper = tokenfactorytestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	var err error
+	s.creator, s.recipient = sdk.AccAddress("creator"), sdk.AccAddress("recipient")
+	s.creatorStr, err = ac.BytesToString(s.creator)
+	s.Require().NoError(err)
+	s.recipientStr, err = ac.BytesToString(s.recipient)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.DefaultParams()))
+}
+
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), gomock.Any()).Return(false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), gomock.Any())
+
+	res, err := s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creatorStr, Subdenom: subdenom})
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.Params{DenomCreationFee: fee}))
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(s.creator), fee).Return(nil)
+
+	denom := s.createDenom("mytoken")
+	s.Require().Equal("factory/"+s.creatorStr+"/mytoken", denom)
+
+	// the same denom cannot be created twice
+	_, err := s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creatorStr, Subdenom: "mytoken"})
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// a denom already existing in x/bank cannot be created
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), gomock.Any()).Return(true)
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creatorStr, Subdenom: "other"})
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creatorStr, Subdenom: "a-subdenom-which-is-much-longer-than-the-limit"})
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	res, err := s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: s.creatorStr})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, res.Denoms)
+}
+
+func (s *KeeperTestSuite) TestMintBurn() {
+	denom := s.createDenom("mytoken")
+	amount := sdk.NewCoin(denom, sdkmath.NewInt(1000))
+	coins := sdk.NewCoins(amount)
+
+	s.bankKeeper.EXPECT().BlockedAddr(s.recipient).Return(false)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.recipient, coins).Return(nil)
+	_, err := s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creatorStr, Amount: amount, MintToAddress: s.recipientStr})
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(s.creator), coins).Return(nil)
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: s.creatorStr, Amount: amount})
+	s.Require().NoError(err)
+
+	// only the admin can mint and burn
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.recipientStr, Amount: amount})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: s.recipientStr, Amount: amount})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// only factory denoms can be minted
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creatorStr, Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))})
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(sdk.NewCoin(denom, sdkmath.ZeroInt()))
+	res, err := s.queryServer.Denom(s.ctx, &types.QueryDenomRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(s.creatorStr, res.AuthorityMetadata.Admin)
+	s.Require().True(res.Supply.IsZero())
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	denom := s.createDenom("mytoken")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.recipientStr, Denom: denom, NewAdmin: s.recipientStr})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.creatorStr, Denom: denom, NewAdmin: s.recipientStr})
+	s.Require().NoError(err)
+
+	admin, err := s.keeper.GetAdmin(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(s.recipientStr, admin)
+
+	// renounce the admin rights
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.recipientStr, Denom: denom})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.recipientStr, Amount: sdk.NewCoin(denom, sdkmath.NewInt(1))})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.creatorStr, Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModuleNameStr, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	denom := s.createDenom("mytoken")
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate())
+	s.Require().Equal([]types.GenesisDenom{{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: s.creatorStr}}}, exported.FactoryDenoms)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, exported))
+
+	reExported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported, reExported)
+}
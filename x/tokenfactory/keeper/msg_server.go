@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/tokenfactory MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateDenom creates a factory denom administered by the sender.
+func (ms msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := ms.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := ms.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+		event.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint mints an amount of a factory denom to the mint-to address, or the
+// sender if unset.
+func (ms msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+	recipient, err := ms.addressCodec.StringToBytes(mintTo)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+	}
+	if ms.bankKeeper.BlockedAddr(recipient) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", mintTo)
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	if err := ms.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeMint,
+		event.NewAttribute(types.AttributeKeyMintToAddress, mintTo),
+		event.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn burns an amount of a factory denom from the balance of its admin.
+func (ms msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sender, err := ms.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := ms.bankKeeper.BurnCoins(ctx, sender, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBurn,
+		event.NewAttribute(types.AttributeKeyBurnFrom, msg.Sender),
+		event.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin transfers, or renounces if the new admin is empty, the admin
+// rights over a factory denom.
+func (ms msgServer) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	admin, err := ms.GetAdmin(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if admin == "" || admin != msg.Sender {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", msg.Sender, msg.Denom)
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := ms.addressCodec.StringToBytes(msg.NewAdmin); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	if err := ms.DenomAuthority.Set(ctx, msg.Denom, types.DenomAuthorityMetadata{Admin: msg.NewAdmin}); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		event.NewAttribute(types.AttributeKeyNewAdmin, msg.NewAdmin),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkAdmin checks that amount is a valid positive amount of a factory denom
+// administered by sender.
+func (ms msgServer) checkAdmin(ctx context.Context, sender string, amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", amount)
+	}
+
+	admin, err := ms.GetAdmin(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if admin == "" || admin != sender {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, amount.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DenomPrefix is the prefix of all the factory denoms.
+	DenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the factory denom factory/{creator}/{subdenom}.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "creator cannot contain '/': %s", creator)
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom splits a factory denom into its creator and subdenom. It
+// does not check that the creator is a valid address.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != DenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom must be of the form %s/{creator}/{subdenom}, got %s", DenomPrefix, denom)
+	}
+	if parts[1] == "" {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "creator cannot be empty: %s", denom)
+	}
+	if len(parts[2]) > MaxSubdenomLength {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+
+	return parts[1], parts[2], nil
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+func TestDeconstructDenom(t *testing.T) {
+	testCases := []struct {
+		denom    string
+		creator  string
+		subdenom string
+		expErr   bool
+	}{
+		{denom: "factory/cosmos1creator/mytoken", creator: "cosmos1creator", subdenom: "mytoken"},
+		{denom: "factory/cosmos1creator/my/token", creator: "cosmos1creator", subdenom: "my/token"},
+		{denom: "factory/cosmos1creator/", creator: "cosmos1creator", subdenom: ""},
+		{denom: "factory/cosmos1creator", expErr: true},
+		{denom: "factory//mytoken", expErr: true},
+		{denom: "ibc/cosmos1creator/mytoken", expErr: true},
+		{denom: "stake", expErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.denom, func(t *testing.T) {
+			creator, subdenom, err := types.DeconstructDenom(tc.denom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, creator)
+			require.Equal(t, tc.subdenom, subdenom)
+
+			denom, err := types.GetTokenDenom(creator, subdenom)
+			require.NoError(t, err)
+			require.Equal(t, tc.denom, denom)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrInvalidDenom  = errors.Register(ModuleName, 2, "invalid denom")
+	ErrDenomExists   = errors.Register(ModuleName, 3, "denom already exists")
+	ErrUnauthorized  = errors.Register(ModuleName, 4, "unauthorized account")
+)
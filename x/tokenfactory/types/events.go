@@ -0,0 +1,17 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom = "create_denom"
+	EventTypeMint        = "tf_mint"
+	EventTypeBurn        = "tf_burn"
+	EventTypeChangeAdmin = "change_admin"
+
+	AttributeKeyCreator       = "creator"
+	AttributeKeyNewTokenDenom = "new_token_denom"
+	AttributeKeyMintToAddress = "mint_to_address"
+	AttributeKeyBurnFrom      = "burn_from_address"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyNewAdmin      = "new_admin"
+)
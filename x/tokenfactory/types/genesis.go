@@ -0,0 +1,33 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{Params: params, FactoryDenoms: denoms}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.FactoryDenoms))
+	for _, denom := range gs.FactoryDenoms {
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+		if _, ok := seen[denom.Denom]; ok {
+			return fmt.Errorf("duplicate factory denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
+)
+
+// KVStore keys
+var (
+	ParamsKey            = collections.NewPrefix(0)
+	DenomAuthorityPrefix = collections.NewPrefix(1)
+	CreatorDenomsPrefix  = collections.NewPrefix(2)
+)
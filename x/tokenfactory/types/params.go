@@ -0,0 +1,18 @@
+package types
+
+import "fmt"
+
+// DefaultParams returns default x/tokenfactory module parameters. Denoms can
+// be created for free by default.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}
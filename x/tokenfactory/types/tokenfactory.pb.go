@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tokenfactory/v1/tokenfactory.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/tokenfactory module.
+type Params struct {
+	// denom_creation_fee is the fee charged to the creator of a denom. It is
+	// burned.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_952cd16e50e5fdfd, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+// DenomAuthorityMetadata holds the authority over a factory denom.
+type DenomAuthorityMetadata struct {
+	// admin is the address allowed to mint and burn the denom, and to change its
+	// admin. It is empty if the admin rights were renounced.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
+func (m *DenomAuthorityMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomAuthorityMetadata) ProtoMessage()    {}
+func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_952cd16e50e5fdfd, []int{1}
+}
+func (m *DenomAuthorityMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAuthorityMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAuthorityMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAuthorityMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAuthorityMetadata.Merge(m, src)
+}
+func (m *DenomAuthorityMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAuthorityMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAuthorityMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAuthorityMetadata proto.InternalMessageInfo
+
+func (m *DenomAuthorityMetadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.tokenfactory.v1.Params")
+	proto.RegisterType((*DenomAuthorityMetadata)(nil), "cosmos.tokenfactory.v1.DenomAuthorityMetadata")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tokenfactory/v1/tokenfactory.proto", fileDescriptor_952cd16e50e5fdfd)
+}
+
+var fileDescriptor_952cd16e50e5fdfd = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0xbf, 0x6e, 0xda, 0x40,
+	0x18, 0xf7, 0xa9, 0x2a, 0x52, 0xdd, 0x0e, 0xad, 0x85, 0x10, 0x30, 0x1c, 0x94, 0x2e, 0x14, 0x89,
+	0x3b, 0xb9, 0xdd, 0xda, 0x09, 0xa8, 0x50, 0x97, 0x4a, 0x15, 0xdd, 0xba, 0x58, 0x67, 0xfb, 0x30,
+	0x27, 0xe2, 0xfb, 0x90, 0xef, 0x40, 0xf1, 0x2b, 0x64, 0x49, 0xe6, 0x3c, 0x41, 0x94, 0x89, 0x21,
+	0x7b, 0x56, 0x46, 0x94, 0x29, 0x53, 0x12, 0xc1, 0xc0, 0x6b, 0x44, 0xf6, 0xdd, 0x00, 0x8b, 0x7d,
+	0x77, 0xbf, 0xbf, 0xdf, 0xe7, 0x7e, 0x8d, 0x40, 0xa5, 0xa0, 0xa8, 0x86, 0x39, 0x97, 0x53, 0x16,
+	0x69, 0xc8, 0x72, 0xba, 0xf2, 0x4f, 0xee, 0x64, 0x91, 0x81, 0x06, 0xaf, 0x66, 0xa8, 0xe4, 0x04,
+	0x5a, 0xf9, 0xcd, 0x6a, 0x02, 0x09, 0x94, 0x14, 0x5a, 0x9c, 0x0c, 0xbb, 0xd9, 0x30, 0xec, 0xc0,
+	0x00, 0x56, 0x6a, 0x20, 0x6c, 0x33, 0x43, 0xa6, 0x38, 0x5d, 0xf9, 0x21, 0xd7, 0xcc, 0xa7, 0x11,
+	0x08, 0x69, 0xf1, 0x4f, 0x2c, 0x15, 0x12, 0x68, 0xf9, 0x35, 0x4f, 0x9d, 0x7b, 0xe4, 0x56, 0xfe,
+	0xb2, 0x8c, 0xa5, 0xca, 0xbb, 0x44, 0xae, 0x17, 0x73, 0x09, 0x69, 0x10, 0x65, 0x9c, 0x69, 0x01,
+	0x32, 0x98, 0x72, 0x5e, 0x47, 0xed, 0x37, 0xdd, 0xf7, 0xdf, 0x1a, 0xc4, 0x26, 0x15, 0xde, 0xc4,
+	0x7a, 0x93, 0x11, 0x08, 0x39, 0x1c, 0x6f, 0x9e, 0x5a, 0xce, 0xed, 0x73, 0xab, 0x9b, 0x08, 0x3d,
+	0x5b, 0x86, 0x24, 0x82, 0xd4, 0xd6, 0xb2, 0xbf, 0xbe, 0x8a, 0xe7, 0x54, 0xe7, 0x0b, 0xae, 0x4a,
+	0x81, 0xba, 0x3e, 0xac, 0x7b, 0x1f, 0xce, 0x78, 0xc2, 0xa2, 0x3c, 0x28, 0xda, 0xa9, 0x9b, 0xc3,
+	0xba, 0x87, 0x26, 0x1f, 0xcb, 0xf0, 0x91, 0xcd, 0x1e, 0x73, 0xfe, 0xe3, 0xcb, 0xc5, 0x61, 0xdd,
+	0xc3, 0xc7, 0x26, 0xc7, 0xcb, 0x34, 0xb5, 0x3b, 0xbf, 0xdd, 0xda, 0xaf, 0x42, 0x38, 0x58, 0xea,
+	0x19, 0x64, 0x42, 0xe7, 0x7f, 0xb8, 0x66, 0x31, 0xd3, 0xcc, 0x23, 0xee, 0x5b, 0x16, 0xa7, 0x42,
+	0xd6, 0x51, 0x1b, 0x75, 0xdf, 0x0d, 0xeb, 0x0f, 0x77, 0xfd, 0xaa, 0x9d, 0x62, 0x10, 0xc7, 0x19,
+	0x57, 0xea, 0x9f, 0xce, 0x84, 0x4c, 0x26, 0x86, 0x36, 0xfc, 0xb9, 0xd9, 0x61, 0xb4, 0xdd, 0x61,
+	0xf4, 0xb2, 0xc3, 0xe8, 0x6a, 0x8f, 0x9d, 0xed, 0x1e, 0x3b, 0x8f, 0x7b, 0xec, 0xfc, 0xff, 0x6c,
+	0x64, 0x2a, 0x9e, 0x13, 0x01, 0xf4, 0xfc, 0xb4, 0x47, 0x39, 0x59, 0x58, 0x29, 0xf7, 0xf9, 0xfd,
+	0x75, 0x00, 0x24, 0x30, 0x87, 0xe8, 0xf8, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTokenfactory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTokenfactory(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenfactory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenfactory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovTokenfactory(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomAuthorityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTokenfactory(uint64(l))
+	}
+	return n
+}
+
+func sovTokenfactory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTokenfactory(x uint64) (n int) {
+	return sovTokenfactory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfactory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfactory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTokenfactory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenfactory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenfactory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenfactory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenfactory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenfactory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenfactory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                types.ModuleName,
+		Short:              "Querying commands for the upgrade module",
+		RunE:               client.ValidateCmd,
+		DisableFlagParsing: true,
+	}
+
+	cmd.AddCommand(
+		GetCmdUpgradeInfo(),
+	)
+
+	return cmd
+}
+
+// GetCmdUpgradeInfo implements a command printing the upgrade info file written
+// by the node in its home directory when it halted at an upgrade height.
+func GetCmdUpgradeInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-info",
+		Short: "Query the upgrade info written by the node when it halted for an upgrade",
+		Long: fmt.Sprintf(`Query the upgrade info written by the node when it halted for an upgrade.
+The node writes the upgrade name, height and info (e.g. binary download URLs) to
+<home>/data/%s when it reaches the height of a scheduled upgrade, for process
+managers such as cosmovisor to switch binaries.`, types.UpgradeInfoFilename),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			upgradeInfoPath := filepath.Join(clientCtx.HomeDir, "data", types.UpgradeInfoFilename)
+			bz, err := os.ReadFile(upgradeInfoPath)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return fmt.Errorf("no upgrade info found at %s", upgradeInfoPath)
+				}
+
+				return err
+			}
+
+			var plan types.Plan
+			if err := json.Unmarshal(bz, &plan); err != nil {
+				return fmt.Errorf("invalid upgrade info at %s: %w", upgradeInfoPath, err)
+			}
+
+			return clientCtx.PrintProto(&plan)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestGetCmdUpgradeInfo(t *testing.T) {
+	home := t.TempDir()
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	clientCtx := client.Context{}.WithHomeDir(home).WithCodec(encCfg.Codec)
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, GetCmdUpgradeInfo(), nil)
+	require.ErrorContains(t, err, "no upgrade info found")
+
+	bz, err := json.Marshal(types.Plan{Name: "v2", Height: 100, Info: "https://example.com/v2"})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", types.UpgradeInfoFilename), bz, 0o600))
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, GetCmdUpgradeInfo(), []string{"--output=json"})
+	require.NoError(t, err)
+
+	var plan types.Plan
+	require.NoError(t, encCfg.Codec.UnmarshalJSON(out.Bytes(), &plan))
+	require.Equal(t, "v2", plan.Name)
+	require.Equal(t, int64(100), plan.Height)
+	require.Equal(t, "https://example.com/v2", plan.Info)
+}
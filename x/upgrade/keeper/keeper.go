@@ -460,6 +460,41 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 	return k.setDone(ctx, plan.Name)
 }
 
+// errDryRun reverts the state changes of an upgrade dry run.
+var errDryRun = errors.New("upgrade dry run")
+
+// DryRunUpgrade runs the upgrade handler registered for the given plan against
+// the current state, as ApplyUpgrade would, then reverts every state change.
+// It returns the module version map the handler would store, so that upgrade
+// handlers and the module migrations they run can be checked against an
+// exported or live state before the upgrade height.
+func (k Keeper) DryRunUpgrade(ctx context.Context, plan types.Plan) (module.VersionMap, error) {
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		return nil, fmt.Errorf("no upgrade handler registered for %s", plan.Name)
+	}
+
+	var updatedVM module.VersionMap
+	err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		vm, err := k.GetModuleVersionMap(ctx)
+		if err != nil {
+			return err
+		}
+
+		updatedVM, err = handler(ctx, plan, vm)
+		if err != nil {
+			return err
+		}
+
+		return errDryRun
+	})
+	if !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	return updatedVM, nil
+}
+
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
 func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
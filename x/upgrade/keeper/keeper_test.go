@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 
@@ -325,6 +326,37 @@ func (s *KeeperTestSuite) TestMigrations() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestDryRunUpgrade() {
+	s.Require().NoError(s.upgradeKeeper.SetModuleVersionMap(s.ctx, module.VersionMap{"bank": uint64(1)}))
+
+	dummyPlan := types.Plan{Name: "dry-run", Height: 123450000}
+	_, err := s.upgradeKeeper.DryRunUpgrade(s.ctx, dummyPlan)
+	s.Require().ErrorContains(err, "no upgrade handler registered")
+
+	s.upgradeKeeper.SetUpgradeHandler("dry-run", func(ctx context.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// write state which must be reverted by the dry run
+		if err := s.upgradeKeeper.SetModuleVersionMap(ctx, module.VersionMap{"bank": uint64(5)}); err != nil {
+			return nil, err
+		}
+		vm["bank"]++
+		return vm, nil
+	})
+
+	updatedVM, err := s.upgradeKeeper.DryRunUpgrade(s.ctx, dummyPlan)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), updatedVM["bank"])
+
+	vm, err := s.upgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), vm["bank"])
+
+	s.upgradeKeeper.SetUpgradeHandler("dry-run", func(_ context.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	_, err = s.upgradeKeeper.DryRunUpgrade(s.ctx, dummyPlan)
+	s.Require().ErrorContains(err, "migration failed")
+}
+
 func (s *KeeperTestSuite) TestLastCompletedUpgrade() {
 	keeper := s.upgradeKeeper
 	require := s.Require()